@@ -0,0 +1,489 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"playbook-dispatcher/internal/api/audit"
+	"playbook-dispatcher/internal/api/controllers/private"
+	"playbook-dispatcher/internal/api/controllers/public"
+	"playbook-dispatcher/internal/api/rbac"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/db"
+	"playbook-dispatcher/internal/common/encryption"
+	"playbook-dispatcher/internal/common/kessel"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/secrets"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	adminActionForceCancel  = "force-cancel"
+	adminActionForceTimeout = "force-timeout"
+	adminActionRedispatch   = "redispatch"
+	adminActionPurgeOrg     = "purge-org"
+
+	adminActionBootstrapServicePermissions = "kessel-bootstrap-service-permissions"
+)
+
+type adminContext struct {
+	ctx      context.Context
+	cfg      *viper.Viper
+	log      *zap.SugaredLogger
+	db       *gorm.DB
+	operator string
+}
+
+// runs an admin action with a database connection; every action that modifies data records an audit event
+func withAdminContext(action func(admin *adminContext, args []string) error) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		log := utils.GetLoggerOrDie()
+		defer utils.CloseLogger()
+
+		if err := secrets.Initialize(config.Get(), log); err != nil {
+			return err
+		}
+		defer secrets.Close()
+
+		cfg := config.Get()
+		ctx := utils.SetLog(context.Background(), log)
+
+		operator, err := cmd.Flags().GetString("operator")
+		utils.DieOnError(err)
+
+		if operator == "" {
+			return errors.New("the operator (--operator or $USER) is required for audit purposes")
+		}
+
+		db, sql := db.Connect(ctx, cfg)
+		defer sql.Close()
+
+		return action(&adminContext{ctx: ctx, cfg: cfg, log: log, db: db, operator: operator}, args)
+	}
+}
+
+func (this *adminContext) audit(tx *gorm.DB, action, orgId string, details map[string]string) error {
+	details["action"] = action
+
+	this.log.Infow("Admin action", "action", action, "operator", this.operator, "org_id", orgId, "details", details)
+
+	return audit.Record(this.ctx, tx, audit.Event{
+		Type:      audit.EventAdminAction,
+		OrgId:     orgId,
+		Principal: this.operator,
+		Details:   details,
+	})
+}
+
+func (this *adminContext) getRun(args []string) (*dbModel.Run, error) {
+	runId, err := uuid.Parse(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid run id: %w", err)
+	}
+
+	var run dbModel.Run
+	if err := this.db.WithContext(this.ctx).First(&run, runId).Error; err != nil {
+		return nil, err
+	}
+
+	return &run, nil
+}
+
+// decrypts the labels of the run if label encryption is configured
+func (this *adminContext) decryptLabels(run *dbModel.Run) error {
+	labelCipher, err := encryption.NewLabelCipher(this.ctx, this.cfg)
+	if err != nil {
+		return err
+	}
+
+	run.Labels, err = labelCipher.Decrypt(run.Labels)
+	return err
+}
+
+func (this *adminContext) getRunHosts(runId uuid.UUID) (hosts []dbModel.RunHost, err error) {
+	err = this.db.WithContext(this.ctx).Where("run_id = ?", runId).Order("host").Find(&hosts).Error
+	return
+}
+
+func adminInspect(admin *adminContext, args []string) error {
+	run, err := admin.getRun(args)
+	if err != nil {
+		return err
+	}
+
+	if err := admin.decryptLabels(run); err != nil {
+		return err
+	}
+
+	hosts, err := admin.getRunHosts(run.ID)
+	if err != nil {
+		return err
+	}
+
+	type inspectedHost struct {
+		ID                    uuid.UUID  `json:"id"`
+		Host                  string     `json:"host"`
+		InventoryID           *uuid.UUID `json:"inventory_id,omitempty"`
+		SubscriptionManagerID *uuid.UUID `json:"subscription_manager_id,omitempty"`
+		Status                string     `json:"status"`
+		LogSize               int        `json:"log_size"`
+		CreatedAt             time.Time  `json:"created_at"`
+		UpdatedAt             time.Time  `json:"updated_at"`
+	}
+
+	result := map[string]interface{}{
+		"id":               run.ID,
+		"org_id":           run.OrgID,
+		"service":          run.Service,
+		"recipient":        run.Recipient,
+		"correlation_id":   run.CorrelationID,
+		"url":              run.URL,
+		"status":           run.Status,
+		"labels":           run.Labels,
+		"name":             run.PlaybookName,
+		"web_console_url":  run.PlaybookRunUrl,
+		"principal":        run.Principal,
+		"sat_id":           run.SatId,
+		"sat_org_id":       run.SatOrgId,
+		"timeout":          run.Timeout,
+		"timeout_at":       run.CreatedAt.Add(time.Duration(run.Timeout) * time.Second),
+		"response_full":    run.ResponseFull,
+		"created_at":       run.CreatedAt,
+		"updated_at":       run.UpdatedAt,
+		"run_host_count":   len(hosts),
+		"run_host_details": []inspectedHost{},
+	}
+
+	inspectedHosts := make([]inspectedHost, len(hosts))
+	for i, host := range hosts {
+		inspectedHosts[i] = inspectedHost{
+			ID:                    host.ID,
+			Host:                  host.Host,
+			InventoryID:           host.InventoryID,
+			SubscriptionManagerID: host.SubscriptionManagerID,
+			Status:                host.Status,
+			LogSize:               len(host.Log),
+			CreatedAt:             host.CreatedAt,
+			UpdatedAt:             host.UpdatedAt,
+		}
+	}
+	result["run_host_details"] = inspectedHosts
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+// transitions a running or canceling run (and its running hosts) to the given state without notifying the recipient
+func adminForceStatus(action string, target status.Status) func(admin *adminContext, args []string) error {
+	return func(admin *adminContext, args []string) error {
+		run, err := admin.getRun(args)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Contains(status.Active(), status.Status(run.Status)) {
+			return fmt.Errorf("run %s is not running (status: %s)", run.ID, run.Status)
+		}
+
+		return admin.db.WithContext(admin.ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Model(&dbModel.Run{}).Where("id = ?", run.ID).Update("status", target).Error; err != nil {
+				return err
+			}
+
+			result := tx.Model(&dbModel.RunHost{}).
+				Where("run_id = ?", run.ID).
+				Where("status", status.Running).
+				Update("status", target)
+
+			if result.Error != nil {
+				return result.Error
+			}
+
+			return admin.audit(tx, action, run.OrgID, map[string]string{
+				"run_id":         run.ID.String(),
+				"correlation_id": run.CorrelationID.String(),
+				"run_hosts":      fmt.Sprintf("%d", result.RowsAffected),
+			})
+		})
+	}
+}
+
+// dispatches a copy of the given run using the internal API
+func adminRedispatch(admin *adminContext, args []string) error {
+	run, err := admin.getRun(args)
+	if err != nil {
+		return err
+	}
+
+	// the copy is encrypted again when created
+	if err := admin.decryptLabels(run); err != nil {
+		return err
+	}
+
+	hosts, err := admin.getRunHosts(run.ID)
+	if err != nil {
+		return err
+	}
+
+	principal := admin.operator
+	if run.Principal != nil {
+		principal = *run.Principal
+	}
+
+	name := ""
+	if run.PlaybookName != nil {
+		name = *run.PlaybookName
+	}
+
+	labels := public.Labels(run.Labels)
+	timeout := public.RunTimeout(run.Timeout)
+	webConsoleUrl := public.WebConsoleUrl(run.PlaybookRunUrl)
+
+	input := private.RunInputV2{
+		Recipient:     run.Recipient,
+		OrgId:         public.OrgId(run.OrgID),
+		Principal:     private.Principal(principal),
+		Url:           public.Url(run.URL),
+		Name:          public.PlaybookName(name),
+		Labels:        &labels,
+		Timeout:       &timeout,
+		WebConsoleUrl: &webConsoleUrl,
+	}
+
+	if run.SatId != nil {
+		satId := run.SatId.String()
+		input.RecipientConfig = &private.RecipientConfig{SatId: &satId, SatOrgId: run.SatOrgId}
+	}
+
+	if len(hosts) > 0 {
+		inputHosts := make(private.RunInputHosts, len(hosts))
+		for i := range hosts {
+			inputHosts[i].AnsibleHost = &hosts[i].Host
+			inputHosts[i].InventoryId = hosts[i].InventoryID
+			inputHosts[i].SubscriptionManagerId = hosts[i].SubscriptionManagerID
+		}
+		input.Hosts = &inputHosts
+	}
+
+	created, err := adminDispatch(admin.cfg, input)
+	if err != nil {
+		return err
+	}
+
+	if created.Code != http.StatusCreated || created.Id == nil {
+		message := ""
+		if created.Message != nil {
+			message = *created.Message
+		}
+		return fmt.Errorf("redispatch failed with code %d: %s", created.Code, message)
+	}
+
+	fmt.Printf("Run %s redispatched as %s\n", run.ID, created.Id.String())
+
+	return admin.audit(admin.db, adminActionRedispatch, run.OrgID, map[string]string{
+		"run_id":     run.ID.String(),
+		"new_run_id": created.Id.String(),
+	})
+}
+
+func adminDispatch(cfg *viper.Viper, input private.RunInputV2) (*private.RunCreated, error) {
+	body, err := json.Marshal([]private.RunInputV2{input})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, cfg.GetString("admin.api.url")+"/internal/v2/dispatch", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "PSK "+cfg.GetString("admin.psk"))
+
+	client := http.Client{Timeout: 30 * time.Second}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusMultiStatus {
+		return nil, fmt.Errorf("unexpected status code %d from the internal API", res.StatusCode)
+	}
+
+	result := []private.RunCreated{}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	if len(result) != 1 {
+		return nil, fmt.Errorf("unexpected number of results (%d) from the internal API", len(result))
+	}
+
+	return &result[0], nil
+}
+
+func adminPurgeOrg(cmd *cobra.Command) func(admin *adminContext, args []string) error {
+	return func(admin *adminContext, args []string) error {
+		orgId := args[0]
+
+		confirmed, err := cmd.Flags().GetBool("yes")
+		utils.DieOnError(err)
+
+		var runCount int64
+		if err := admin.db.WithContext(admin.ctx).Model(&dbModel.Run{}).Where("org_id = ?", orgId).Count(&runCount).Error; err != nil {
+			return err
+		}
+
+		if !confirmed {
+			fmt.Printf("Org %s has %d runs. Run again with --yes to delete them\n", orgId, runCount)
+			return nil
+		}
+
+		if err := kessel.Initialize(admin.cfg, admin.log); err != nil {
+			return err
+		}
+		defer kessel.Close()
+
+		var runIds []uuid.UUID
+
+		err = admin.db.WithContext(admin.ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Model(&dbModel.Run{}).Where("org_id = ?", orgId).Pluck("id", &runIds).Error; err != nil {
+				return err
+			}
+
+			runs := tx.Model(&dbModel.Run{}).Select("id").Where("org_id = ?", orgId)
+
+			hosts := tx.Where("run_id IN (?)", runs).Delete(&dbModel.RunHost{})
+			if hosts.Error != nil {
+				return hosts.Error
+			}
+
+			deletedRuns := tx.Where("org_id = ?", orgId).Delete(&dbModel.Run{})
+			if deletedRuns.Error != nil {
+				return deletedRuns.Error
+			}
+
+			usage := tx.Where("org_id = ?", orgId).Delete(&dbModel.ApiUsage{})
+			if usage.Error != nil {
+				return usage.Error
+			}
+
+			fmt.Printf("Deleted %d runs and %d run hosts of org %s\n", deletedRuns.RowsAffected, hosts.RowsAffected, orgId)
+
+			return admin.audit(tx, adminActionPurgeOrg, orgId, map[string]string{
+				"runs":      fmt.Sprintf("%d", deletedRuns.RowsAffected),
+				"run_hosts": fmt.Sprintf("%d", hosts.RowsAffected),
+			})
+		})
+
+		if err != nil {
+			return err
+		}
+
+		// a second purge would not find the runs anymore so the failed ones are reported here
+		if err := kessel.NewTupleWriter().DeleteRuns(admin.ctx, runIds...); err != nil {
+			admin.log.Errorw("Error deleting the Kessel relationships of purged runs", "org_id", orgId, "error", err)
+			return err
+		}
+
+		return nil
+	}
+}
+
+// an entry of the principal access export of RBAC, data holds the playbook-dispatcher permissions of the principal
+type principalAccess struct {
+	OrgId  string        `json:"org_id"`
+	UserId string        `json:"user_id"`
+	Data   []rbac.Access `json:"data"`
+}
+
+// translates the service attribute filters of run:read permissions the same way the RBAC authorization does
+func servicePermissionOf(access principalAccess) kessel.ServicePermission {
+	permissions := rbac.FilterPermissions(access.Data, rbac.DispatcherPermission("run", "read"))
+
+	return kessel.ServicePermission{
+		OrgID:    access.OrgId,
+		UserID:   access.UserId,
+		Services: rbac.GetPredicateValues(permissions, "service"),
+	}
+}
+
+func adminBootstrapServicePermissions(admin *adminContext, args []string) error {
+	if !admin.cfg.GetBool("kessel.tuples.enabled") {
+		return errors.New("kessel.tuples.enabled is required to write service permissions")
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := kessel.Initialize(admin.cfg, admin.log); err != nil {
+		return err
+	}
+	defer kessel.Close()
+
+	writer := kessel.NewTupleWriter()
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	written := 0
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var access principalAccess
+		if err := json.Unmarshal(scanner.Bytes(), &access); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if access.OrgId == "" || access.UserId == "" {
+			return fmt.Errorf("line %d: org_id and user_id are required", line)
+		}
+
+		permission := servicePermissionOf(access)
+		if len(permission.Services) == 0 {
+			continue
+		}
+
+		if err := writer.WriteServicePermission(admin.ctx, permission); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+
+		err := admin.audit(admin.db.WithContext(admin.ctx), adminActionBootstrapServicePermissions, access.OrgId, map[string]string{
+			"user_id":  access.UserId,
+			"services": strings.Join(permission.Services, ","),
+		})
+		if err != nil {
+			return err
+		}
+
+		written++
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Wrote the service permissions of %d principals\n", written)
+	return nil
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"playbook-dispatcher/internal/api/audit"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/db"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/secrets"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// verifies the hash chain of audit events exported from the audit topic (one event per line)
+func auditVerify(cmd *cobra.Command, args []string) error {
+	checkAnchors, err := cmd.Flags().GetBool("anchors")
+	utils.DieOnError(err)
+
+	var input io.Reader = os.Stdin
+	if len(args) > 0 {
+		file, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		input = file
+	}
+
+	events := []audit.Event{}
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var event audit.Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+
+		events = append(events, event)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	verified, err := audit.VerifyChain(events)
+	if err != nil {
+		return err
+	}
+
+	if len(verified) == 0 {
+		return fmt.Errorf("no events to verify")
+	}
+
+	fmt.Printf("Verified %d events (sequence %d to %d)\n", len(verified), verified[0].Sequence, verified[len(verified)-1].Sequence)
+
+	if !checkAnchors {
+		return nil
+	}
+
+	log := utils.GetLoggerOrDie()
+	defer utils.CloseLogger()
+	utils.DieOnError(secrets.Initialize(config.Get(), log))
+	defer secrets.Close()
+	cfg := config.Get()
+	ctx := utils.SetLog(context.Background(), log)
+
+	db, sql := db.Connect(ctx, cfg)
+	defer sql.Close()
+
+	var anchors []dbModel.AuditChainAnchor
+	if err := db.WithContext(ctx).
+		Where("sequence BETWEEN ? AND ?", verified[0].Sequence, verified[len(verified)-1].Sequence).
+		Order("sequence").
+		Find(&anchors).Error; err != nil {
+		return err
+	}
+
+	checked, err := audit.VerifyAnchors(verified, anchors)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Matched %d anchors\n", checked)
+	return nil
+}
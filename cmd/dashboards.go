@@ -0,0 +1,290 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	apiInstrumentation "playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/config"
+	responseConsumerInstrumentation "playbook-dispatcher/internal/response-consumer/instrumentation"
+	validatorInstrumentation "playbook-dispatcher/internal/validator/instrumentation"
+	"sort"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	"github.com/spf13/cobra"
+)
+
+const (
+	dashboardPanelWidth  = 12
+	dashboardPanelHeight = 8
+)
+
+// metrics of the Go runtime / client library are covered by the platform dashboards
+var dashboardIgnoredPrefixes = []string{"go_", "process_", "promhttp_"}
+
+type grafanaDashboard struct {
+	Title         string            `json:"title"`
+	UID           string            `json:"uid"`
+	Tags          []string          `json:"tags"`
+	Timezone      string            `json:"timezone"`
+	SchemaVersion int               `json:"schemaVersion"`
+	Time          grafanaTime       `json:"time"`
+	Templating    grafanaTemplating `json:"templating"`
+	Panels        []grafanaPanel    `json:"panels"`
+}
+
+type grafanaTime struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type grafanaTemplating struct {
+	List []grafanaVariable `json:"list"`
+}
+
+type grafanaVariable struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Query string `json:"query"`
+}
+
+type grafanaDatasource struct {
+	Type string `json:"type"`
+	UID  string `json:"uid"`
+}
+
+type grafanaGridPos struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+type grafanaTarget struct {
+	Expr         string `json:"expr"`
+	LegendFormat string `json:"legendFormat"`
+	RefID        string `json:"refId"`
+}
+
+type grafanaPanel struct {
+	ID          int                `json:"id"`
+	Type        string             `json:"type"`
+	Title       string             `json:"title"`
+	Description string             `json:"description,omitempty"`
+	Datasource  *grafanaDatasource `json:"datasource,omitempty"`
+	GridPos     grafanaGridPos     `json:"gridPos"`
+	Targets     []grafanaTarget    `json:"targets,omitempty"`
+	Collapsed   bool               `json:"collapsed,omitempty"`
+}
+
+func dashboards(cmd *cobra.Command, args []string) error {
+	cfg := config.Get()
+
+	// initialize label values so that labeled metrics show up when gathered
+	apiInstrumentation.Start()
+	responseConsumerInstrumentation.Start()
+	validatorInstrumentation.Start(cfg)
+
+	metricsUrl, err := cmd.Flags().GetString("metrics-url")
+	if err != nil {
+		return err
+	}
+
+	var families []*dto.MetricFamily
+	if metricsUrl != "" {
+		families, err = gatherRemote(metricsUrl)
+	} else {
+		families, err = prometheus.DefaultGatherer.Gather()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return err
+	}
+
+	var writer io.Writer = os.Stdout
+	if output != "" {
+		file, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		writer = file
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(buildDashboard(families))
+}
+
+// labeled metrics only show up once a label value has been used so reading the metrics of a running instance gives a more complete picture
+func gatherRemote(url string) ([]*dto.MetricFamily, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d fetching %s", resp.StatusCode, url)
+	}
+
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	parsed, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.MetricFamily, 0, len(parsed))
+	for _, family := range parsed {
+		result = append(result, family)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetName() < result[j].GetName()
+	})
+
+	return result, nil
+}
+
+func buildDashboard(families []*dto.MetricFamily) grafanaDashboard {
+	dashboard := grafanaDashboard{
+		Title:         "Playbook Dispatcher (generated)",
+		UID:           "playbook-dispatcher-generated",
+		Tags:          []string{"playbook-dispatcher", "generated"},
+		Timezone:      "utc",
+		SchemaVersion: 39,
+		Time:          grafanaTime{From: "now-6h", To: "now"},
+		Templating: grafanaTemplating{List: []grafanaVariable{
+			{Name: "datasource", Type: "datasource", Query: "prometheus"},
+		}},
+		Panels: []grafanaPanel{},
+	}
+
+	datasource := &grafanaDatasource{Type: "prometheus", UID: "${datasource}"}
+	groups := map[string][]*dto.MetricFamily{}
+
+	for _, family := range families {
+		if dashboardIgnored(family.GetName()) {
+			continue
+		}
+
+		group := dashboardGroup(family.GetName())
+		groups[group] = append(groups[group], family)
+	}
+
+	groupNames := make([]string, 0, len(groups))
+	for name := range groups {
+		groupNames = append(groupNames, name)
+	}
+	sort.Strings(groupNames)
+
+	id, y := 1, 0
+	for _, group := range groupNames {
+		dashboard.Panels = append(dashboard.Panels, grafanaPanel{
+			ID:      id,
+			Type:    "row",
+			Title:   group,
+			GridPos: grafanaGridPos{X: 0, Y: y, W: 2 * dashboardPanelWidth, H: 1},
+		})
+		id++
+		y++
+
+		for i, family := range groups[group] {
+			panel := metricPanel(family)
+			panel.ID = id
+			panel.Datasource = datasource
+			panel.GridPos = grafanaGridPos{X: (i % 2) * dashboardPanelWidth, Y: y + (i/2)*dashboardPanelHeight, W: dashboardPanelWidth, H: dashboardPanelHeight}
+			dashboard.Panels = append(dashboard.Panels, panel)
+			id++
+		}
+
+		y += ((len(groups[group]) + 1) / 2) * dashboardPanelHeight
+	}
+
+	return dashboard
+}
+
+func metricPanel(family *dto.MetricFamily) grafanaPanel {
+	name := family.GetName()
+	labels := metricLabels(family)
+	by := ""
+	legend := "{{instance}}"
+
+	if len(labels) > 0 {
+		by = fmt.Sprintf(" by (%s)", strings.Join(labels, ", "))
+		legend = "{{" + strings.Join(labels, "}} {{") + "}}"
+	}
+
+	panel := grafanaPanel{
+		Type:        "timeseries",
+		Title:       name,
+		Description: family.GetHelp(),
+	}
+
+	switch family.GetType() {
+	case dto.MetricType_COUNTER:
+		panel.Targets = []grafanaTarget{{Expr: fmt.Sprintf("sum%s (rate(%s[5m]))", by, name), LegendFormat: legend, RefID: "A"}}
+	case dto.MetricType_HISTOGRAM:
+		bucketsBy := fmt.Sprintf(" by (%s)", strings.Join(append([]string{"le"}, labels...), ", "))
+		panel.Targets = []grafanaTarget{
+			{Expr: fmt.Sprintf("histogram_quantile(0.5, sum%s (rate(%s_bucket[5m])))", bucketsBy, name), LegendFormat: "p50 " + legend, RefID: "A"},
+			{Expr: fmt.Sprintf("histogram_quantile(0.95, sum%s (rate(%s_bucket[5m])))", bucketsBy, name), LegendFormat: "p95 " + legend, RefID: "B"},
+		}
+	case dto.MetricType_SUMMARY:
+		panel.Targets = []grafanaTarget{{Expr: fmt.Sprintf("sum%s (rate(%s_sum[5m])) / sum%s (rate(%s_count[5m]))", by, name, by, name), LegendFormat: legend, RefID: "A"}}
+	default:
+		panel.Targets = []grafanaTarget{{Expr: fmt.Sprintf("max%s (%s)", by, name), LegendFormat: legend, RefID: "A"}}
+	}
+
+	return panel
+}
+
+// returns the (sorted) names of all labels used by the given metric family
+func metricLabels(family *dto.MetricFamily) []string {
+	labels := map[string]bool{}
+
+	for _, metric := range family.GetMetric() {
+		for _, label := range metric.GetLabel() {
+			labels[label.GetName()] = true
+		}
+	}
+
+	result := make([]string, 0, len(labels))
+	for label := range labels {
+		result = append(result, label)
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+func dashboardGroup(name string) string {
+	for _, prefix := range []string{"api_", "response_consumer_", "validator_"} {
+		if strings.HasPrefix(name, prefix) {
+			return strings.TrimSuffix(prefix, "_")
+		}
+	}
+
+	return "other"
+}
+
+func dashboardIgnored(name string) bool {
+	for _, prefix := range dashboardIgnoredPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
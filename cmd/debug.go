@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/pprof"
+	"playbook-dispatcher/internal/api/middleware"
+	"playbook-dispatcher/internal/common/utils"
+	"runtime"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+)
+
+var startTime = time.Now()
+
+type runtimeStats struct {
+	GoVersion     string  `json:"go_version"`
+	Commit        string  `json:"commit"`
+	UptimeSeconds float64 `json:"uptime_seconds"`
+	Goroutines    int     `json:"goroutines"`
+	NumCPU        int     `json:"num_cpu"`
+	GOMAXPROCS    int     `json:"gomaxprocs"`
+	HeapAlloc     uint64  `json:"heap_alloc_bytes"`
+	HeapInuse     uint64  `json:"heap_inuse_bytes"`
+	HeapObjects   uint64  `json:"heap_objects"`
+	Sys           uint64  `json:"sys_bytes"`
+	NumGC         uint32  `json:"num_gc"`
+	PauseTotalNs  uint64  `json:"gc_pause_total_ns"`
+}
+
+// registerDebugHandlers exposes pprof, runtime stats and log level control on the management (metrics) port.
+// Requests from localhost are allowed as-is, any other request needs a valid PSK.
+func registerDebugHandlers(server *echo.Echo, cfg *viper.Viper) {
+	authConfig, err := middleware.BuildPskAuthConfigFromEnv()
+	utils.DieOnError(err)
+
+	group := server.Group("/debug", middleware.ContextLogger, middleware.AllowLocalhostOrPsk(authConfig))
+
+	group.GET("/pprof/", echo.WrapHandler(http.HandlerFunc(pprof.Index)))
+	group.GET("/pprof/cmdline", echo.WrapHandler(http.HandlerFunc(pprof.Cmdline)))
+	group.GET("/pprof/profile", echo.WrapHandler(http.HandlerFunc(pprof.Profile)))
+	group.GET("/pprof/symbol", echo.WrapHandler(http.HandlerFunc(pprof.Symbol)))
+	group.POST("/pprof/symbol", echo.WrapHandler(http.HandlerFunc(pprof.Symbol)))
+	group.GET("/pprof/trace", echo.WrapHandler(http.HandlerFunc(pprof.Trace)))
+	group.GET("/pprof/:profile", func(c echo.Context) error {
+		pprof.Handler(c.Param("profile")).ServeHTTP(c.Response(), c.Request())
+		return nil
+	})
+
+	group.GET("/log/level", echo.WrapHandler(utils.LogLevelHandler()))
+	group.PUT("/log/level", echo.WrapHandler(utils.LogLevelHandler()))
+
+	group.GET("/runtime", func(c echo.Context) error {
+		var mem runtime.MemStats
+		runtime.ReadMemStats(&mem)
+
+		return c.JSON(http.StatusOK, runtimeStats{
+			GoVersion:     runtime.Version(),
+			Commit:        cfg.GetString("build.commit"),
+			UptimeSeconds: time.Since(startTime).Seconds(),
+			Goroutines:    runtime.NumGoroutine(),
+			NumCPU:        runtime.NumCPU(),
+			GOMAXPROCS:    runtime.GOMAXPROCS(0),
+			HeapAlloc:     mem.HeapAlloc,
+			HeapInuse:     mem.HeapInuse,
+			HeapObjects:   mem.HeapObjects,
+			Sys:           mem.Sys,
+			NumGC:         mem.NumGC,
+			PauseTotalNs:  mem.PauseTotalNs,
+		})
+	})
+}
@@ -0,0 +1,294 @@
+package cmd
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/rand"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/constants"
+	"playbook-dispatcher/internal/common/db"
+	"playbook-dispatcher/internal/common/kafka"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/model/message"
+	"playbook-dispatcher/internal/common/secrets"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+	"time"
+
+	k "github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"gorm.io/gorm"
+)
+
+const (
+	generatedLabel       = "synthetic"
+	generatedOrgIdOffset = 9000000
+)
+
+var generatedServices = []string{"remediations", "config_manager", "tasks"}
+
+type generator struct {
+	rand      *rand.Rand
+	orgs      *rand.Zipf
+	orgCount  int
+	batch     string
+	hostsMin  int
+	hostsMax  int
+	satRatio  float64
+	since     time.Duration
+	statusMix []status.Status
+}
+
+// picks an org following the configured tenancy distribution so that a few orgs own most of the runs
+func (this *generator) orgId() string {
+	if this.orgs != nil {
+		return fmt.Sprintf("%d", generatedOrgIdOffset+int(this.orgs.Uint64()))
+	}
+
+	return fmt.Sprintf("%d", generatedOrgIdOffset+this.rand.Intn(this.orgCount))
+}
+
+func (this *generator) run() (dbModel.Run, []dbModel.RunHost) {
+	id := uuid.New()
+	createdAt := time.Now().Add(-time.Duration(this.rand.Int63n(int64(this.since))))
+	service := generatedServices[this.rand.Intn(len(generatedServices))]
+	name := fmt.Sprintf("%s-playbook-%d", service, this.rand.Intn(100))
+	principal := fmt.Sprintf("synthetic-user-%d", this.rand.Intn(20))
+
+	run := dbModel.Run{
+		ID:             id,
+		OrgID:          this.orgId(),
+		Service:        service,
+		Recipient:      uuid.New(),
+		CorrelationID:  uuid.New(),
+		URL:            fmt.Sprintf("https://cloud.redhat.com/api/%s/v1/playbooks/%s", service, id),
+		Status:         string(this.statusMix[this.rand.Intn(len(this.statusMix))]),
+		PlaybookName:   &name,
+		PlaybookRunUrl: fmt.Sprintf("https://console.redhat.com/insights/%s", service),
+		Principal:      &principal,
+		Timeout:        3600,
+		CreatedAt:      createdAt,
+		UpdatedAt:      createdAt,
+		Labels: dbModel.Labels{
+			generatedLabel: this.batch,
+			"service":      service,
+		},
+	}
+
+	if this.rand.Float64() < this.satRatio {
+		satId := uuid.New()
+		satOrgId := fmt.Sprintf("%d", this.rand.Intn(10))
+		run.SatId = &satId
+		run.SatOrgId = &satOrgId
+	}
+
+	hosts := make([]dbModel.RunHost, this.hostsMin+this.rand.Intn(this.hostsMax-this.hostsMin+1))
+	for i := range hosts {
+		inventoryId := uuid.New()
+		hosts[i] = dbModel.RunHost{
+			ID:          uuid.New(),
+			RunID:       id,
+			InventoryID: &inventoryId,
+			Host:        fmt.Sprintf("host-%d.example.com", i),
+			Status:      run.Status,
+			CreatedAt:   createdAt,
+			UpdatedAt:   createdAt,
+		}
+
+		if run.Status != string(status.Running) {
+			hosts[i].Log = fmt.Sprintf("PLAY [%s] *****\n\nTASK [synthetic] *****\nok: [%s]\n", name, hosts[i].Host)
+		}
+	}
+
+	return run, hosts
+}
+
+// builds the runner events the validator would produce for a successfully finished run
+func generatedEvents(run dbModel.Run, hosts []dbModel.RunHost) *message.PlaybookRunResponseMessageYaml {
+	correlationId := run.CorrelationID.String()
+	events := []message.PlaybookRunResponseMessageYamlEventsElem{{
+		Event:     message.EventExecutorOnStart,
+		Uuid:      uuid.New().String(),
+		EventData: &message.PlaybookRunResponseMessageYamlEventsElemEventData{CrcDispatcherCorrelationId: &correlationId},
+	}}
+
+	for i := range hosts {
+		stdout := fmt.Sprintf("ok: [%s]", hosts[i].Host)
+		events = append(events, message.PlaybookRunResponseMessageYamlEventsElem{
+			Event:     "runner_on_ok",
+			Uuid:      uuid.New().String(),
+			Counter:   i + 1,
+			StartLine: i,
+			EndLine:   i + 1,
+			Stdout:    &stdout,
+			EventData: &message.PlaybookRunResponseMessageYamlEventsElemEventData{Host: &hosts[i].Host},
+		})
+	}
+
+	events = append(events, message.PlaybookRunResponseMessageYamlEventsElem{
+		Event:   "playbook_on_stats",
+		Uuid:    uuid.New().String(),
+		Counter: len(hosts) + 1,
+	})
+
+	identity := fmt.Sprintf(`{"identity":{"org_id":"%s","type":"System","internal":{"org_id":"%s"}}}`, run.OrgID, run.OrgID)
+
+	return &message.PlaybookRunResponseMessageYaml{
+		OrgId:           run.OrgID,
+		B64Identity:     base64.StdEncoding.EncodeToString([]byte(identity)),
+		RequestId:       uuid.New().String(),
+		UploadTimestamp: time.Now(),
+		Events:          events,
+	}
+}
+
+func generate(cmd *cobra.Command, args []string) error {
+	log := utils.GetLoggerOrDie()
+	defer utils.CloseLogger()
+	utils.DieOnError(secrets.Initialize(config.Get(), log))
+	defer secrets.Close()
+	cfg := config.Get()
+	ctx := utils.SetLog(context.Background(), log)
+
+	flags := cmd.Flags()
+	runs, _ := flags.GetInt("runs")
+	orgs, _ := flags.GetInt("orgs")
+	skew, _ := flags.GetFloat64("org-skew")
+	hostsMin, _ := flags.GetInt("hosts-min")
+	hostsMax, _ := flags.GetInt("hosts-max")
+	runningRatio, _ := flags.GetFloat64("running-ratio")
+	satRatio, _ := flags.GetFloat64("satellite-ratio")
+	days, _ := flags.GetInt("days")
+	batchSize, _ := flags.GetInt("batch-size")
+	events, _ := flags.GetBool("events")
+	seed, _ := flags.GetInt64("seed")
+
+	if runs < 1 || orgs < 1 || batchSize < 1 || days < 1 || hostsMin < 1 || hostsMax < hostsMin {
+		return fmt.Errorf("invalid generator parameters")
+	}
+
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	random := rand.New(rand.NewSource(seed))
+
+	gen := &generator{
+		rand:     random,
+		orgCount: orgs,
+		batch:    fmt.Sprintf("%d", seed),
+		hostsMin: hostsMin,
+		hostsMax: hostsMax,
+		satRatio: satRatio,
+		since:    time.Duration(days) * 24 * time.Hour,
+	}
+
+	if skew > 1 && orgs > 1 {
+		gen.orgs = rand.NewZipf(random, skew, 1, uint64(orgs-1))
+	}
+
+	// status distribution expressed in tenths
+	running := int(runningRatio * 10)
+	for i := 0; i < 10; i++ {
+		switch {
+		case i < running:
+			gen.statusMix = append(gen.statusMix, status.Running)
+		case i == 9:
+			gen.statusMix = append(gen.statusMix, status.Failure)
+		case i == 8:
+			gen.statusMix = append(gen.statusMix, status.Timeout)
+		default:
+			gen.statusMix = append(gen.statusMix, status.Success)
+		}
+	}
+
+	db, sql := db.Connect(ctx, cfg)
+	defer sql.Close()
+
+	var producer *k.Producer
+	if events {
+		var err error
+		if producer, err = kafka.NewProducer(cfg); err != nil {
+			return err
+		}
+		defer producer.Close()
+	}
+
+	log.Infow("Generating synthetic data", "runs", runs, "orgs", orgs, "label", fmt.Sprintf("%s=%s", generatedLabel, gen.batch))
+
+	started := time.Now()
+	generatedHosts := 0
+	produced := 0
+
+	for done := 0; done < runs; done += batchSize {
+		count := batchSize
+		if runs-done < count {
+			count = runs - done
+		}
+
+		batchRuns := make([]dbModel.Run, count)
+		batchHosts := []dbModel.RunHost{}
+
+		for i := range batchRuns {
+			run, hosts := gen.run()
+			batchRuns[i] = run
+			batchHosts = append(batchHosts, hosts...)
+		}
+
+		err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.CreateInBatches(batchRuns, batchSize).Error; err != nil {
+				return err
+			}
+
+			return tx.CreateInBatches(batchHosts, batchSize).Error
+		})
+
+		if err != nil {
+			return err
+		}
+
+		generatedHosts += len(batchHosts)
+
+		if producer != nil {
+			for i := range batchRuns {
+				if batchRuns[i].Status != string(status.Running) || batchRuns[i].SatId != nil {
+					continue
+				}
+
+				hosts := []dbModel.RunHost{}
+				for _, host := range batchHosts {
+					if host.RunID == batchRuns[i].ID {
+						hosts = append(hosts, host)
+					}
+				}
+
+				if err := produceGeneratedEvents(producer, cfg.GetString("topic.updates"), batchRuns[i], hosts); err != nil {
+					return err
+				}
+
+				produced++
+			}
+		}
+
+		log.Infow("Generated batch", "runs", done+count, "run_hosts", generatedHosts, "events", produced)
+	}
+
+	if producer != nil {
+		producer.Flush(cfg.GetInt("kafka.timeout"))
+	}
+
+	log.Infow("Synthetic data generated", "runs", runs, "run_hosts", generatedHosts, "events", produced, "duration", time.Since(started).String())
+	return nil
+}
+
+func produceGeneratedEvents(producer *k.Producer, topic string, run dbModel.Run, hosts []dbModel.RunHost) error {
+	headers := kafka.Headers(
+		constants.HeaderRequestId, uuid.New().String(),
+		constants.HeaderCorrelationId, run.CorrelationID.String(),
+		constants.HeaderRequestType, "playbook",
+	)
+
+	return kafka.Produce(producer, topic, generatedEvents(run, hosts), run.CorrelationID.String(), headers...)
+}
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/encryption"
+	"playbook-dispatcher/internal/common/secrets"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// prints a new encrypted data key to be prepended to LABEL_ENCRYPTION_DATA_KEYS
+func labelDataKey(cmd *cobra.Command, args []string) error {
+	log := utils.GetLoggerOrDie()
+	defer utils.CloseLogger()
+	utils.DieOnError(secrets.Initialize(config.Get(), log))
+	defer secrets.Close()
+	cfg := config.Get()
+
+	key, err := encryption.GenerateDataKey(context.Background(), cfg)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(key)
+	return nil
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/db"
+	"playbook-dispatcher/internal/common/utils"
+
+	k "github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	goMigrate "github.com/golang-migrate/migrate/v4"
+	"github.com/spf13/cobra"
+)
+
+// overrides applied by local-dev regardless of the environment, so that no platform service is needed
+var localDevEnvironment = map[string]string{
+	"DEV_AUTH_RELAXED":         "true",
+	"CLOUD_CONNECTOR_IMPL":     "mock",
+	"INVENTORY_CONNECTOR_IMPL": "mock",
+	"SOURCES_IMPL":             "mock",
+	"RBAC_IMPL":                "mock",
+	"TENANT_TRANSLATOR_IMPL":   "dynamic-mock",
+	"KESSEL_ENABLED":           "false",
+	"UNLEASH_ENABLED":          "false",
+	// allows contracts to be verified against the local instance
+	"PACT_PROVIDER_STATES_ENABLED": "true",
+}
+
+var localDevTopics = []string{"topic.updates", "topic.validation.request", "topic.validation.response", "topic.audit"}
+
+// localDev runs the selected modules (see run) with mock connectors and relaxed authentication after applying the
+// database migrations, optionally against an in-process Kafka cluster
+func localDev(cmd *cobra.Command, args []string) error {
+	fakeKafka, err := cmd.Flags().GetBool("fake-kafka")
+	utils.DieOnError(err)
+
+	log := utils.GetLoggerOrDie()
+
+	for key, value := range localDevEnvironment {
+		utils.DieOnError(os.Setenv(key, value))
+	}
+
+	if fakeKafka {
+		cluster, err := k.NewMockCluster(1)
+		utils.DieOnError(err)
+		defer cluster.Close()
+
+		cfg := config.Get()
+		for _, topic := range localDevTopics {
+			utils.DieOnError(cluster.CreateTopic(cfg.GetString(topic), 1, 1))
+		}
+
+		utils.DieOnError(os.Setenv("KAFKA_BOOTSTRAP_SERVERS", cluster.BootstrapServers()))
+		log.Infow("Fake Kafka cluster started", "bootstrap_servers", cluster.BootstrapServers())
+	}
+
+	cfg := config.Get()
+	ctx := utils.SetLog(context.Background(), log)
+
+	_, sql := db.Connect(ctx, cfg)
+	m, err := newMigrate(cfg, sql)
+	utils.DieOnError(err)
+
+	if err := m.Up(); err != nil && err != goMigrate.ErrNoChange {
+		log.Error(err)
+		return err
+	}
+
+	log.Info("Migrations applied")
+	utils.DieOnError(sql.Close())
+
+	log.Warnw("Starting in local development mode, do not use this outside of development",
+		"org_id", cfg.GetString("dev.org.id"),
+		"kafka", cfg.GetString("kafka.bootstrap.servers"),
+	)
+
+	return run(cmd, args)
+}
@@ -2,37 +2,42 @@ package cmd
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"playbook-dispatcher/internal/common/config"
 	"playbook-dispatcher/internal/common/db"
+	"playbook-dispatcher/internal/common/secrets"
 	"playbook-dispatcher/internal/common/utils"
 
 	goMigrate "github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 const (
 	migrationActionUp      = "up"
 	migrationActionDown    = "down"
 	migrationActionDownAll = "down-all"
+	migrationActionCheck   = "check"
 )
 
 func migrate(cmd *cobra.Command, args []string) error {
 	log := utils.GetLoggerOrDie()
 	defer utils.CloseLogger()
+	utils.DieOnError(secrets.Initialize(config.Get(), log))
+	defer secrets.Close()
 	cfg := config.Get()
 	ctx := utils.SetLog(context.Background(), log)
 
 	_, sql := db.Connect(ctx, cfg)
-	driver, err := postgres.WithInstance(sql, &postgres.Config{})
-	utils.DieOnError(err)
 
-	m, err := goMigrate.NewWithDatabaseInstance(
-		fmt.Sprintf("file://%s", cfg.GetString("migrations.dir")),
-		"postgresql",
-		driver)
+	if cmd.CalledAs() == migrationActionCheck {
+		return checkSchema(ctx, cfg, sql)
+	}
+
+	m, err := newMigrate(cfg, sql)
 	utils.DieOnError(err)
 
 	log.Info("Running migrations")
@@ -65,3 +70,38 @@ func migrate(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func newMigrate(cfg *viper.Viper, sql *sql.DB) (*goMigrate.Migrate, error) {
+	driver, err := postgres.WithInstance(sql, &postgres.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	return goMigrate.NewWithDatabaseInstance(
+		fmt.Sprintf("file://%s", cfg.GetString("migrations.dir")),
+		"postgresql",
+		driver)
+}
+
+// checkSchema fails if the code cannot serve traffic against the current database schema (e.g. as a pre-deploy gate)
+func checkSchema(ctx context.Context, cfg *viper.Viper, sql *sql.DB) error {
+	log := utils.GetLogFromContext(ctx)
+
+	status, err := db.GetSchemaStatus(ctx, cfg, sql)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	if latest, err := db.LatestMigration(cfg.GetString("migrations.dir")); err == nil && latest != db.SchemaVersion {
+		log.Warnw("The latest migration does not match the schema version of the code", "latest_migration", latest, "expected", db.SchemaVersion)
+	}
+
+	if err := status.Check(); err != nil {
+		log.Errorw("Database schema is not compatible", "version", status.Version, "dirty", status.Dirty, "min", status.Min, "max", status.Max, "error", err)
+		return err
+	}
+
+	log.Infow("Database schema is compatible", "version", status.Version, "expected", status.Expected, "min", status.Min, "max", status.Max)
+	return nil
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"playbook-dispatcher/internal/api/pact"
+	"playbook-dispatcher/internal/common/utils"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// verifies consumer contracts (pact files, or directories containing them) against a running instance
+func pactVerify(cmd *cobra.Command, args []string) error {
+	flags := cmd.Flags()
+
+	providerUrl, err := flags.GetString("provider-url")
+	utils.DieOnError(err)
+	skipStates, err := flags.GetBool("skip-states")
+	utils.DieOnError(err)
+	headers, err := flags.GetStringArray("header")
+	utils.DieOnError(err)
+
+	verifier := &pact.Verifier{
+		ProviderURL: providerUrl,
+		Headers:     http.Header{},
+	}
+
+	if !skipStates {
+		verifier.StatesURL = strings.TrimSuffix(providerUrl, "/") + pact.StatesPath
+	}
+
+	for _, header := range headers {
+		name, value, found := strings.Cut(header, ":")
+		if !found {
+			return fmt.Errorf("invalid header %q, expected <name>: <value>", header)
+		}
+
+		verifier.Headers.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+
+	files, err := pactFiles(args)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+
+	for _, file := range files {
+		contract, err := pact.ReadPact(file)
+		if err != nil {
+			return err
+		}
+
+		failures, err := verifier.Verify(context.Background(), contract)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s: %d of %d interactions verified\n", file, len(contract.Interactions)-len(failures), len(contract.Interactions))
+
+		for _, failure := range failures {
+			fmt.Fprintln(os.Stderr, failure.Error())
+		}
+
+		failed += len(failures)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d interactions failed", failed)
+	}
+
+	return nil
+}
+
+func pactFiles(args []string) (result []string, err error) {
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			result = append(result, arg)
+			continue
+		}
+
+		matches, err := filepath.Glob(filepath.Join(arg, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, matches...)
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no pact files found")
+	}
+
+	return result, nil
+}
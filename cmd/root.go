@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"os"
+	"playbook-dispatcher/pkg/status"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -17,6 +19,7 @@ const (
 	moduleApi              = "api"
 	moduleResponseConsumer = "response-consumer"
 	moduleValidator        = "validator"
+	moduleJobs             = "jobs"
 )
 
 func init() {
@@ -30,9 +33,23 @@ func init() {
 		},
 	}
 
-	runCommand.Flags().StringSliceP("module", "m", []string{moduleApi, moduleResponseConsumer, moduleValidator}, "module(s) to run")
+	runCommand.Flags().StringSliceP("module", "m", []string{moduleApi, moduleResponseConsumer, moduleValidator, moduleJobs}, "module(s) to run")
 	rootCmd.AddCommand(runCommand)
 
+	localDevCmd := &cobra.Command{
+		Use:   "local-dev",
+		Short: "Run playbook-dispatcher for local development (mock connectors, relaxed authentication)",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := localDev(cmd, args); err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
+	localDevCmd.Flags().StringSliceP("module", "m", []string{moduleApi, moduleResponseConsumer}, "module(s) to run")
+	localDevCmd.Flags().Bool("fake-kafka", false, "start an in-process Kafka cluster instead of connecting to KAFKA_BOOTSTRAP_SERVERS")
+	rootCmd.AddCommand(localDevCmd)
+
 	migrateCmd := &cobra.Command{
 		Use:   "migrate",
 		Short: "Run database migrations",
@@ -58,11 +75,142 @@ func init() {
 		RunE:  migrate,
 	})
 
+	migrateCmd.AddCommand(&cobra.Command{
+		Use:   migrationActionCheck,
+		Short: "Check that the database schema is compatible with this version",
+		RunE:  migrate,
+	})
+
+	rootCmd.AddCommand(&cobra.Command{
+		Use:   "label-data-key",
+		Short: "Generate a data key for encrypting label values",
+		RunE:  labelDataKey,
+	})
+
+	pactVerifyCmd := &cobra.Command{
+		Use:   "pact-verify <pact file or directory>...",
+		Short: "Verify consumer contracts against a running instance (e.g. started with local-dev)",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  pactVerify,
+	}
+
+	pactVerifyCmd.Flags().String("provider-url", "http://localhost:8000", "address of the instance to verify")
+	pactVerifyCmd.Flags().Bool("skip-states", false, "do not set up provider states (if the instance does not expose the provider state endpoint)")
+	pactVerifyCmd.Flags().StringArray("header", nil, "header set on every request instead of the recorded one, e.g. \"Authorization: PSK <key>\"")
+	rootCmd.AddCommand(pactVerifyCmd)
+
+	auditVerifyCmd := &cobra.Command{
+		Use:   "audit-verify [file]",
+		Short: "Verify the hash chain of audit events read from a file (or stdin) with one event per line",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  auditVerify,
+	}
+
+	auditVerifyCmd.Flags().Bool("anchors", false, "also check the events against the anchors stored in the database")
+	rootCmd.AddCommand(auditVerifyCmd)
+
 	rootCmd.AddCommand(&cobra.Command{
 		Use:   "clean",
 		Short: "Run database cleanup actions",
 		RunE:  clean,
 	})
+
+	dashboardsCmd := &cobra.Command{
+		Use:   "dashboards",
+		Short: "Generate Grafana dashboard definitions from the registered metrics",
+		RunE:  dashboards,
+	}
+
+	dashboardsCmd.Flags().StringP("output", "o", "", "file to write the dashboard to (defaults to stdout)")
+	dashboardsCmd.Flags().String("metrics-url", "", "read the metrics from a running instance (e.g. http://localhost:9001/metrics) instead of this binary")
+	rootCmd.AddCommand(dashboardsCmd)
+
+	topicMigrateCmd := &cobra.Command{
+		Use:   "topic-migrate",
+		Short: "Start the response consumer group on a new topic or cluster where it left off on the current one",
+		RunE:  topicMigrate,
+	}
+
+	topicMigrateCmd.Flags().String("source-servers", "", "bootstrap servers of the current cluster (defaults to KAFKA_BOOTSTRAP_SERVERS)")
+	topicMigrateCmd.Flags().String("source-topic", "", "current topic (defaults to TOPIC_UPDATES)")
+	topicMigrateCmd.Flags().String("source-group", "", "current consumer group (defaults to KAFKA_GROUP_ID)")
+	topicMigrateCmd.Flags().String("destination-servers", "", "bootstrap servers of the new cluster (defaults to the source servers)")
+	topicMigrateCmd.Flags().String("destination-topic", "", "new topic")
+	topicMigrateCmd.Flags().String("destination-group", "", "consumer group on the new topic (defaults to the source group)")
+	topicMigrateCmd.Flags().Duration("margin", time.Minute, "start this much before the position of the source group on the new topic")
+	topicMigrateCmd.Flags().Bool("dry-run", false, "print the offsets without committing them")
+	rootCmd.AddCommand(topicMigrateCmd)
+
+	generateCmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate synthetic runs for load testing and demo environments",
+		RunE:  generate,
+	}
+
+	generateCmd.Flags().Int("runs", 1000, "number of runs to generate")
+	generateCmd.Flags().Int("orgs", 10, "number of organizations to spread the runs across")
+	generateCmd.Flags().Float64("org-skew", 1.5, "skew of the org distribution (zipf, values <= 1 distribute runs uniformly)")
+	generateCmd.Flags().Int("hosts-min", 1, "minimum number of hosts per run")
+	generateCmd.Flags().Int("hosts-max", 10, "maximum number of hosts per run")
+	generateCmd.Flags().Float64("running-ratio", 0.1, "ratio of runs left in the running state")
+	generateCmd.Flags().Float64("satellite-ratio", 0.1, "ratio of runs dispatched to satellite")
+	generateCmd.Flags().Int("days", 30, "spread the creation time of runs over the given number of days")
+	generateCmd.Flags().Int("batch-size", 500, "number of rows inserted per statement")
+	generateCmd.Flags().Bool("events", false, "produce response events completing the running runs to the updates topic")
+	generateCmd.Flags().Int64("seed", 0, "random seed (defaults to the current time)")
+	rootCmd.AddCommand(generateCmd)
+
+	adminCmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Operational run management",
+	}
+
+	adminCmd.PersistentFlags().String("operator", os.Getenv("USER"), "name of the person performing the action (recorded in the audit log)")
+	rootCmd.AddCommand(adminCmd)
+
+	adminCmd.AddCommand(&cobra.Command{
+		Use:   "inspect <run id>",
+		Short: "Print a run and its hosts",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withAdminContext(adminInspect),
+	})
+
+	adminCmd.AddCommand(&cobra.Command{
+		Use:   adminActionForceCancel + " <run id>",
+		Short: "Mark a running run as canceled without notifying the recipient",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withAdminContext(adminForceStatus(adminActionForceCancel, status.Canceled)),
+	})
+
+	adminCmd.AddCommand(&cobra.Command{
+		Use:   adminActionForceTimeout + " <run id>",
+		Short: "Mark a running run as timed out",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withAdminContext(adminForceStatus(adminActionForceTimeout, status.Timeout)),
+	})
+
+	adminCmd.AddCommand(&cobra.Command{
+		Use:   adminActionRedispatch + " <run id>",
+		Short: "Dispatch a copy of a run using the internal API (ADMIN_API_URL, ADMIN_PSK)",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withAdminContext(adminRedispatch),
+	})
+
+	purgeOrgCmd := &cobra.Command{
+		Use:   adminActionPurgeOrg + " <org id>",
+		Short: "Delete all runs of an organization",
+		Args:  cobra.ExactArgs(1),
+	}
+	purgeOrgCmd.RunE = withAdminContext(adminPurgeOrg(purgeOrgCmd))
+	purgeOrgCmd.Flags().Bool("yes", false, "confirm the deletion")
+	adminCmd.AddCommand(purgeOrgCmd)
+
+	adminCmd.AddCommand(&cobra.Command{
+		Use:   adminActionBootstrapServicePermissions + " <file>",
+		Short: "Write Kessel service permissions from the RBAC service attribute filters of principals (JSON lines of org_id, user_id, data)",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withAdminContext(adminBootstrapServicePermissions),
+	})
 }
 
 func Execute() error {
@@ -8,8 +8,10 @@ import (
 	"playbook-dispatcher/internal/api"
 	"playbook-dispatcher/internal/common/config"
 	"playbook-dispatcher/internal/common/kessel"
+	"playbook-dispatcher/internal/common/secrets"
 	"playbook-dispatcher/internal/common/unleash"
 	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/jobs"
 	responseConsumer "playbook-dispatcher/internal/response-consumer"
 	"playbook-dispatcher/internal/validator"
 	"sync"
@@ -24,8 +26,6 @@ import (
 	"github.com/spf13/viper"
 )
 
-const shutdownTimeout = 20 * time.Second
-
 type startModuleFn = func(
 	ctx context.Context,
 	cfg *viper.Viper,
@@ -46,6 +46,12 @@ func run(cmd *cobra.Command, args []string) error {
 	defer utils.CloseLogger()
 	cfg := config.Get()
 
+	utils.DieOnError(secrets.Initialize(cfg, log))
+	defer secrets.Close()
+	// pick up the values loaded from the secret manager
+	cfg = config.Get()
+	config.RecordFingerprint(cfg, log)
+
 	// Log Kessel configuration at startup
 	if cfg.GetBool("kessel.enabled") {
 		log.Infow("Kessel authorization enabled",
@@ -93,15 +99,24 @@ func run(cmd *cobra.Command, args []string) error {
 
 	readinessProbeHandler := &utils.ProbeHandler{}
 	livenessProbeHandler := &utils.ProbeHandler{}
+	readinessProbeHandler.CacheTTL = cfg.GetDuration("health.cache.ttl") * time.Second
+	config.OnReload(func(cfg *viper.Viper) {
+		readinessProbeHandler.SetCacheTTL(cfg.GetDuration("health.cache.ttl") * time.Second)
+	})
 
 	metricsServer.GET("/ready", readinessProbeHandler.Check)
 	metricsServer.GET("/live", livenessProbeHandler.Check)
+	metricsServer.GET("/health", readinessProbeHandler.Report)
 	metricsServer.GET(cfg.GetString("metrics.path"), echo.WrapHandler(promhttp.Handler()))
 
+	if cfg.GetBool("debug.endpoints.enabled") {
+		registerDebugHandlers(metricsServer, cfg)
+	}
+
 	wg := sync.WaitGroup{}
 
 	ctx, stop := context.WithCancel(utils.SetLog(context.Background(), log))
-	defer shutdown(metricsServer, log, &wg)
+	defer shutdown(metricsServer, log, &wg, cfg.GetDuration("shutdown.timeout")*time.Second)
 	defer stop()
 
 	for _, module := range modules {
@@ -116,6 +131,8 @@ func run(cmd *cobra.Command, args []string) error {
 			startModule = responseConsumer.Start
 		case moduleValidator:
 			startModule = validator.Start
+		case moduleJobs:
+			startModule = jobs.Start
 		default:
 			return fmt.Errorf("Unknown module %s", module)
 		}
@@ -128,12 +145,19 @@ func run(cmd *cobra.Command, args []string) error {
 		errors <- metricsServer.Start(fmt.Sprintf("0.0.0.0:%d", cfg.GetInt("metrics.port")))
 	}()
 
+	config.Watch(cfg, log)
+
 	log.Infow("Playbook dispatcher started", "version", cfg.GetString("build.commit"))
 
 	// stop on signal or error, whatever comes first
 	select {
 	case signal := <-signals:
 		log.Infow("Shutting down", "signal", signal)
+
+		// keep serving while load balancers notice the failing readiness probe
+		readinessProbeHandler.SetShuttingDown()
+		time.Sleep(cfg.GetDuration("shutdown.delay") * time.Second)
+
 		return nil
 	case error := <-errors:
 		log.Errorw("Shutting down", "error", error)
@@ -141,7 +165,8 @@ func run(cmd *cobra.Command, args []string) error {
 	}
 }
 
-func shutdown(server *echo.Echo, log *zap.SugaredLogger, wg *sync.WaitGroup) {
+// shutdown waits for the modules to finish in-flight work (up to the given timeout) before stopping the metrics server
+func shutdown(server *echo.Echo, log *zap.SugaredLogger, wg *sync.WaitGroup, timeout time.Duration) {
 	defer func() {
 		if err := log.Sync(); err != nil {
 			log.Error(err)
@@ -150,11 +175,11 @@ func shutdown(server *echo.Echo, log *zap.SugaredLogger, wg *sync.WaitGroup) {
 
 	defer log.Info("Shutdown complete")
 
-	if err := utils.WgWaitFor(wg, shutdownTimeout); err != nil {
+	if err := utils.WgWaitFor(wg, timeout); err != nil {
 		log.Warn(err)
 	}
 
-	ctx, cancel := context.WithTimeout(utils.SetLog(context.Background(), log), shutdownTimeout)
+	ctx, cancel := context.WithTimeout(utils.SetLog(context.Background(), log), timeout)
 	defer cancel()
 
 	utils.StopServer(ctx, server)
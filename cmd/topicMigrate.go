@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/kafka"
+	"playbook-dispatcher/internal/common/secrets"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func topicMigrate(cmd *cobra.Command, args []string) error {
+	log := utils.GetLoggerOrDie()
+	defer utils.CloseLogger()
+	utils.DieOnError(secrets.Initialize(config.Get(), log))
+	defer secrets.Close()
+	cfg := config.Get()
+	ctx := utils.SetLog(context.Background(), log)
+
+	flags := cmd.Flags()
+	flag := func(name, fallback string) string {
+		value, err := flags.GetString(name)
+		utils.DieOnError(err)
+
+		if value == "" {
+			return fallback
+		}
+
+		return value
+	}
+
+	source := kafka.Endpoint{
+		Servers: flag("source-servers", cfg.GetString("kafka.bootstrap.servers")),
+		Topic:   flag("source-topic", cfg.GetString("topic.updates")),
+		Group:   flag("source-group", cfg.GetString("kafka.group.id")),
+	}
+
+	destination := kafka.Endpoint{
+		Servers: flag("destination-servers", source.Servers),
+		Topic:   flag("destination-topic", ""),
+		Group:   flag("destination-group", source.Group),
+	}
+
+	if destination.Topic == "" {
+		return fmt.Errorf("--destination-topic is required")
+	}
+
+	if source == destination {
+		return fmt.Errorf("source and destination are the same")
+	}
+
+	margin, err := flags.GetDuration("margin")
+	utils.DieOnError(err)
+	dryRun, err := flags.GetBool("dry-run")
+	utils.DieOnError(err)
+
+	log.Infow("Mirroring consumer offsets", "source", source, "destination", destination, "margin", margin.String(), "dry_run", dryRun)
+
+	result, err := kafka.MirrorOffsets(ctx, cfg, source, destination, margin, dryRun)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	for _, partition := range result.Offsets {
+		fmt.Printf("%s[%d] %s\n", destination.Topic, partition.Partition, partition.Offset.String())
+	}
+
+	if dryRun {
+		log.Infow("Dry run, no offsets committed", "position", result.Position.Format(time.RFC3339))
+	} else {
+		log.Infow("Offsets committed", "position", result.Position.Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// Package apitokens mints and verifies the tokens organizations use to read from the public API without an identity
+// header (e.g. in CI systems). Only a hash of each token is stored.
+package apitokens
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Prefix tells API tokens apart from other bearer tokens
+const Prefix = "pdt_"
+
+// number of random bytes of a token
+const tokenBytes = 32
+
+// ErrInvalid is returned for tokens that are unknown, revoked or expired
+var ErrInvalid = errors.New("invalid API token")
+
+// New generates a token along with the hash to store
+func New() (token string, hash string, err error) {
+	random := make([]byte, tokenBytes)
+	if _, err = rand.Read(random); err != nil {
+		return "", "", err
+	}
+
+	token = Prefix + base64.RawURLEncoding.EncodeToString(random)
+	return token, Hash(token), nil
+}
+
+// Hash computes the (hex-encoded sha256) hash of the token stored in place of the token
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsApiToken tells whether the bearer token is meant to be an API token
+func IsApiToken(token string) bool {
+	return strings.HasPrefix(token, Prefix)
+}
+
+// Verify looks up the token, which needs to exist and not to have expired
+func Verify(ctx context.Context, db *gorm.DB, token string) (*dbModel.ApiToken, error) {
+	var result dbModel.ApiToken
+
+	err := db.WithContext(ctx).
+		Where("hash = ?", Hash(token)).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Take(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvalid
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,28 @@
+package apitokens
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTokenMatchesItsHash(t *testing.T) {
+	token, hash, err := New()
+	assert.NoError(t, err)
+	assert.True(t, IsApiToken(token))
+	assert.Equal(t, Hash(token), hash)
+	assert.NotContains(t, hash, token)
+}
+
+func TestNewTokensDiffer(t *testing.T) {
+	first, _, err := New()
+	assert.NoError(t, err)
+	second, _, err := New()
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
+
+func TestIsApiToken(t *testing.T) {
+	assert.True(t, IsApiToken("pdt_abc"))
+	assert.False(t, IsApiToken("eyJhbGciOiJSUzI1NiJ9"))
+}
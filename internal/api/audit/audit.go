@@ -0,0 +1,68 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	dbModel "playbook-dispatcher/internal/common/model/db"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	EventAuthzDenied  = "authz.denied"
+	EventInternalCall = "internal.call"
+	EventRunCanceled  = "run.canceled"
+	EventAdminAction  = "admin.action"
+)
+
+// Event is the structured record produced to the audit topic
+type Event struct {
+	ID        uuid.UUID         `json:"id"`
+	Type      string            `json:"event_type"`
+	Timestamp time.Time         `json:"timestamp"`
+	OrgId     string            `json:"org_id,omitempty"`
+	Principal string            `json:"principal,omitempty"`
+	RequestId string            `json:"request_id,omitempty"`
+	Method    string            `json:"method,omitempty"`
+	Path      string            `json:"path,omitempty"`
+	Status    int               `json:"status,omitempty"`
+	Details   map[string]string `json:"details,omitempty"`
+	Sequence  int64             `json:"sequence,omitempty"`
+	PrevHash  string            `json:"prev_hash,omitempty"`
+	Hash      string            `json:"hash,omitempty"`
+}
+
+// Record stores the event in the outbox from which it is later relayed to the audit topic.
+// Pass a transaction in order to make the event part of a larger unit of work.
+func Record(ctx context.Context, db *gorm.DB, event Event) error {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := chain(tx, &event); err != nil {
+			return err
+		}
+
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&dbModel.AuditOutbox{
+			ID:        event.ID,
+			EventType: event.Type,
+			OrgID:     event.OrgId,
+			Payload:   payload,
+			Sequence:  event.Sequence,
+			CreatedAt: event.Timestamp,
+		}).Error
+	})
+}
@@ -0,0 +1,191 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Audit events form a hash chain: every event carries a sequence number, the hash of the previous event and its own
+// hash computed over the event (including the previous hash). Altering, removing or reordering an event therefore
+// breaks the chain from that event on. The head of the chain is periodically anchored (recorded in the
+// audit_chain_anchors table and the application log) so that the chain cannot be rewritten from some event on either.
+
+// computes the hash of the event; the hash covers the JSON representation of the event without the hash itself
+func hashEvent(event Event) (string, error) {
+	event.Hash = ""
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// appends the event to the chain; the chain head row is locked until the surrounding transaction ends so that
+// events are chained in the order they are committed
+func chain(tx *gorm.DB, event *Event) error {
+	var head dbModel.AuditChainHead
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&head).Error; err != nil {
+		return fmt.Errorf("error reading audit chain head: %w", err)
+	}
+
+	event.Sequence = head.Sequence + 1
+	event.PrevHash = head.Hash
+
+	hash, err := hashEvent(*event)
+	if err != nil {
+		return err
+	}
+
+	event.Hash = hash
+
+	return tx.Model(&head).Updates(map[string]interface{}{
+		"sequence": event.Sequence,
+		"hash":     event.Hash,
+	}).Error
+}
+
+// VerifyChain checks that the given events form an unbroken hash chain.
+// The events may be passed in any order and contain duplicates (delivery to the audit topic is at-least-once).
+// The first event is trusted to link to the preceding part of the chain, use anchors to verify the start.
+// Returns the events ordered by sequence number.
+func VerifyChain(events []Event) ([]Event, error) {
+	bySequence := map[int64]Event{}
+
+	for _, event := range events {
+		if existing, ok := bySequence[event.Sequence]; ok && existing.ID != event.ID {
+			return nil, fmt.Errorf("events %s and %s share the sequence number %d", existing.ID, event.ID, event.Sequence)
+		}
+
+		bySequence[event.Sequence] = event
+	}
+
+	result := make([]Event, 0, len(bySequence))
+	for _, event := range bySequence {
+		result = append(result, event)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Sequence < result[j].Sequence
+	})
+
+	for i, event := range result {
+		hash, err := hashEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		if hash != event.Hash {
+			return nil, fmt.Errorf("event %s (sequence %d) has been altered", event.ID, event.Sequence)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		previous := result[i-1]
+		if event.Sequence != previous.Sequence+1 {
+			return nil, fmt.Errorf("events with sequence numbers %d to %d are missing", previous.Sequence+1, event.Sequence-1)
+		}
+
+		if event.PrevHash != previous.Hash {
+			return nil, fmt.Errorf("event %s (sequence %d) does not link to the previous event", event.ID, event.Sequence)
+		}
+	}
+
+	return result, nil
+}
+
+// VerifyAnchors checks verified events (as returned by VerifyChain) against the anchors falling into their range.
+// Returns the number of anchors checked.
+func VerifyAnchors(events []Event, anchors []dbModel.AuditChainAnchor) (int, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	first := events[0].Sequence
+	checked := 0
+
+	for _, anchor := range anchors {
+		i := anchor.Sequence - first
+		if i < 0 || i >= int64(len(events)) {
+			continue
+		}
+
+		if events[i].Hash != anchor.Hash {
+			return checked, fmt.Errorf("event %s (sequence %d) does not match the anchor taken at %s", events[i].ID, anchor.Sequence, anchor.CreatedAt.Format(time.RFC3339))
+		}
+
+		checked++
+	}
+
+	return checked, nil
+}
+
+// StartAnchoring periodically records the head of the audit chain
+func StartAnchoring(ctx context.Context, cfg *viper.Viper, db *gorm.DB, wg *sync.WaitGroup) {
+	log := utils.GetLogFromContext(ctx)
+	ticker := time.NewTicker(cfg.GetDuration("audit.anchor.interval") * time.Second)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := anchor(ctx, db); err != nil {
+					log.Errorw("Error anchoring audit chain", "error", err)
+				}
+			}
+		}
+	}()
+}
+
+func anchor(ctx context.Context, db *gorm.DB) error {
+	var head dbModel.AuditChainHead
+	if err := db.WithContext(ctx).First(&head).Error; err != nil {
+		return err
+	}
+
+	if head.Sequence == 0 {
+		return nil
+	}
+
+	// replicas anchoring the same head insert the same row
+	result := db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&dbModel.AuditChainAnchor{
+		Sequence:  head.Sequence,
+		Hash:      head.Hash,
+		CreatedAt: time.Now().UTC(),
+	})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		// the log is shipped outside of the database, which keeps a copy of the anchor out of reach of anyone
+		// able to modify the database
+		utils.GetLogFromContext(ctx).Infow("Audit chain anchored", "sequence", head.Sequence, "hash", head.Hash)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	dbModel "playbook-dispatcher/internal/common/model/db"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// builds a chain the same way chain() does, without the database
+func buildChain(n int) []Event {
+	events := make([]Event, n)
+	prevHash := ""
+
+	for i := range events {
+		events[i] = Event{
+			ID:        uuid.New(),
+			Type:      EventInternalCall,
+			Timestamp: time.Now().UTC(),
+			Principal: "remediations",
+			Details:   map[string]string{"index": string(rune('a' + i))},
+			Sequence:  int64(i + 1),
+			PrevHash:  prevHash,
+		}
+
+		hash, err := hashEvent(events[i])
+		Expect(err).ToNot(HaveOccurred())
+		events[i].Hash = hash
+		prevHash = hash
+	}
+
+	return events
+}
+
+var _ = Describe("Audit chain", func() {
+	It("verifies an unbroken chain", func() {
+		verified, err := VerifyChain(buildChain(5))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(verified).To(HaveLen(5))
+	})
+
+	It("verifies events delivered out of order and more than once", func() {
+		events := buildChain(4)
+		verified, err := VerifyChain([]Event{events[2], events[0], events[3], events[1], events[2]})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(verified).To(Equal(events))
+	})
+
+	It("verifies events that went through JSON", func() {
+		payload, err := json.Marshal(buildChain(3))
+		Expect(err).ToNot(HaveOccurred())
+
+		var events []Event
+		Expect(json.Unmarshal(payload, &events)).To(Succeed())
+
+		_, err = VerifyChain(events)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("detects an altered event", func() {
+		events := buildChain(3)
+		events[1].Principal = "someone-else"
+
+		_, err := VerifyChain(events)
+		Expect(err).To(MatchError(ContainSubstring("sequence 2) has been altered")))
+	})
+
+	It("detects a removed event", func() {
+		events := buildChain(4)
+
+		_, err := VerifyChain(append(events[:1], events[2:]...))
+		Expect(err).To(MatchError("events with sequence numbers 2 to 2 are missing"))
+	})
+
+	It("detects a replaced event", func() {
+		events := buildChain(3)
+		replacement := buildChain(2)[1]
+
+		_, err := VerifyChain([]Event{events[0], replacement, events[2]})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("detects a rewritten chain using anchors", func() {
+		original := buildChain(3)
+		rewritten := buildChain(3)
+
+		anchors := []dbModel.AuditChainAnchor{{Sequence: 2, Hash: original[1].Hash}}
+
+		checked, err := VerifyAnchors(original, anchors)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(checked).To(Equal(1))
+
+		_, err = VerifyAnchors(rewritten, anchors)
+		Expect(err).To(MatchError(ContainSubstring("does not match the anchor")))
+	})
+
+	It("ignores anchors outside of the verified range", func() {
+		checked, err := VerifyAnchors(buildChain(3), []dbModel.AuditChainAnchor{{Sequence: 10, Hash: "x"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(checked).To(Equal(0))
+	})
+})
@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"playbook-dispatcher/internal/common/kafka"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+
+	k "github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	auditDeliveredTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_audit_event_delivered_total",
+		Help: "The total number of audit events delivered to the audit topic",
+	}, []string{"event_type"})
+
+	auditRelayErrorTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "api_audit_relay_error_total",
+		Help: "The total number of errors relaying audit events from the outbox",
+	})
+)
+
+type relay struct {
+	db        *gorm.DB
+	producer  *k.Producer
+	topic     string
+	batchSize int
+}
+
+// StartRelay periodically moves events from the outbox to the audit topic.
+// An event is removed from the outbox only once the broker acknowledges it so delivery is at-least-once.
+// Concurrent relays (one per API replica) skip rows locked by each other.
+func StartRelay(ctx context.Context, cfg *viper.Viper, db *gorm.DB, producer *k.Producer, wg *sync.WaitGroup) {
+	log := utils.GetLogFromContext(ctx)
+
+	this := &relay{
+		db:        db,
+		producer:  producer,
+		topic:     cfg.GetString("topic.audit"),
+		batchSize: cfg.GetInt("audit.relay.batch.size"),
+	}
+
+	ticker := time.NewTicker(cfg.GetDuration("audit.relay.interval") * time.Second)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer producer.Close()
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for {
+					delivered, err := this.deliver(ctx)
+					if err != nil {
+						log.Errorw("Error relaying audit events", "error", err)
+						auditRelayErrorTotal.Inc()
+					}
+
+					if err != nil || delivered < this.batchSize {
+						break
+					}
+				}
+			}
+		}
+	}()
+}
+
+// deliver relays a single batch of events and returns the number of events delivered
+func (this *relay) deliver(ctx context.Context) (int, error) {
+	var produceErr error
+	delivered := []uuid.UUID{}
+
+	err := this.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var entries []dbModel.AuditOutbox
+
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Order("sequence").
+			Limit(this.batchSize).
+			Find(&entries).Error; err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if produceErr = kafka.Produce(this.producer, this.topic, json.RawMessage(entry.Payload), entry.OrgID, kafka.Headers("event_type", entry.EventType)...); produceErr != nil {
+				break
+			}
+
+			delivered = append(delivered, entry.ID)
+			auditDeliveredTotal.WithLabelValues(entry.EventType).Inc()
+		}
+
+		if len(delivered) == 0 {
+			return nil
+		}
+
+		return tx.Delete(&dbModel.AuditOutbox{}, delivered).Error
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(delivered), produceErr
+}
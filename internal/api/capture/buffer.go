@@ -0,0 +1,71 @@
+package capture
+
+import (
+	"sync"
+	"time"
+)
+
+type Entry struct {
+	Timestamp    time.Time `json:"timestamp"`
+	OrgId        string    `json:"org_id"`
+	RequestId    string    `json:"request_id"`
+	Method       string    `json:"method"`
+	Url          string    `json:"url"`
+	Status       int       `json:"status"`
+	DurationMs   int64     `json:"duration_ms"`
+	RequestBody  string    `json:"request_body"`
+	ResponseBody string    `json:"response_body"`
+}
+
+// Buffer keeps the last N captured request/response pairs
+type Buffer struct {
+	lock    sync.Mutex
+	entries []Entry
+	next    int
+	full    bool
+}
+
+func NewBuffer(size int) *Buffer {
+	if size < 1 {
+		size = 1
+	}
+
+	return &Buffer{
+		entries: make([]Entry, size),
+	}
+}
+
+func (this *Buffer) Add(entry Entry) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.entries[this.next] = entry
+	this.next = (this.next + 1) % len(this.entries)
+
+	if this.next == 0 {
+		this.full = true
+	}
+}
+
+// Get returns the captured entries of the given org (or all entries if orgId is empty), oldest first
+func (this *Buffer) Get(orgId string) []Entry {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	result := []Entry{}
+
+	start, count := 0, this.next
+	if this.full {
+		start, count = this.next, len(this.entries)
+	}
+
+	for i := 0; i < count; i++ {
+		entry := this.entries[(start+i)%len(this.entries)]
+
+		if orgId == "" || entry.OrgId == orgId {
+			result = append(result, entry)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,43 @@
+package capture
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBufferKeepsInsertionOrder(t *testing.T) {
+	buffer := NewBuffer(3)
+	buffer.Add(Entry{OrgId: "1", Url: "a"})
+	buffer.Add(Entry{OrgId: "2", Url: "b"})
+
+	entries := buffer.Get("")
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "a", entries[0].Url)
+	assert.Equal(t, "b", entries[1].Url)
+}
+
+func TestBufferOverwritesOldestEntries(t *testing.T) {
+	buffer := NewBuffer(2)
+	buffer.Add(Entry{OrgId: "1", Url: "a"})
+	buffer.Add(Entry{OrgId: "1", Url: "b"})
+	buffer.Add(Entry{OrgId: "1", Url: "c"})
+
+	entries := buffer.Get("")
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "b", entries[0].Url)
+	assert.Equal(t, "c", entries[1].Url)
+}
+
+func TestBufferFiltersByOrg(t *testing.T) {
+	buffer := NewBuffer(5)
+	buffer.Add(Entry{OrgId: "1", Url: "a"})
+	buffer.Add(Entry{OrgId: "2", Url: "b"})
+	buffer.Add(Entry{OrgId: "1", Url: "c"})
+
+	entries := buffer.Get("1")
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "a", entries[0].Url)
+	assert.Equal(t, "c", entries[1].Url)
+	assert.Empty(t, buffer.Get("3"))
+}
@@ -7,8 +7,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"playbook-dispatcher/internal/common/config"
 	"playbook-dispatcher/internal/common/constants"
-	"time"
+	"sync/atomic"
 
 	"playbook-dispatcher/internal/common/utils"
 
@@ -49,6 +50,13 @@ type cloudConnectorClientImpl struct {
 }
 
 func NewConnectorClientWithHttpRequestDoer(cfg *viper.Viper, doer HttpRequestDoer) CloudConnectorClient {
+	// the PSK may be rotated by the secret manager
+	var psk atomic.Value
+	psk.Store(cfg.GetString("cloud.connector.psk"))
+	config.OnReload(func(cfg *viper.Viper) {
+		psk.Store(cfg.GetString("cloud.connector.psk"))
+	})
+
 	client := &ClientWithResponses{
 		ClientInterface: &Client{
 			Server: fmt.Sprintf("%s://%s:%d%s", cfg.GetString("cloud.connector.scheme"), cfg.GetString("cloud.connector.host"), cfg.GetInt("cloud.connector.port"), basePath),
@@ -57,7 +65,7 @@ func NewConnectorClientWithHttpRequestDoer(cfg *viper.Viper, doer HttpRequestDoe
 				req.Header.Set(constants.HeaderRequestId, request_id.GetReqID(ctx))
 
 				req.Header.Set(constants.HeaderCloudConnectorClientID, cfg.GetString("cloud.connector.client.id"))
-				req.Header.Set(constants.HeaderCloudConnectorPSK, cfg.GetString("cloud.connector.psk"))
+				req.Header.Set(constants.HeaderCloudConnectorPSK, psk.Load().(string))
 				req.Header.Set(constants.HeaderCloudConnectorOrgID, ctx.Value(orgIDKey).(string))
 
 				return nil
@@ -71,11 +79,9 @@ func NewConnectorClientWithHttpRequestDoer(cfg *viper.Viper, doer HttpRequestDoe
 }
 
 func NewConnectorClient(cfg *viper.Viper) CloudConnectorClient {
-	httpClient := http.Client{
-		Timeout: time.Duration(cfg.GetInt64("cloud.connector.timeout") * int64(time.Second)),
-	}
+	httpClient := utils.NewTimeoutHttpRequestDoer(cfg, "cloud.connector.timeout")
 
-	return NewConnectorClientWithHttpRequestDoer(cfg, &httpClient)
+	return NewConnectorClientWithHttpRequestDoer(cfg, httpClient)
 }
 
 func encodedBody(body PostV2ConnectionsClientIdMessageJSONRequestBody) (io.Reader, error) {
@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"playbook-dispatcher/internal/common/constants"
 	"playbook-dispatcher/internal/common/utils"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/redhatinsights/platform-go-middlewares/v2/request_id"
@@ -118,11 +117,9 @@ func NewInventoryClientWithHttpRequestDoer(cfg *viper.Viper, doer HttpRequestDoe
 }
 
 func NewInventoryClient(cfg *viper.Viper) InventoryConnector {
-	httpClient := http.Client{
-		Timeout: time.Duration(cfg.GetInt64("inventory.connector.timeout") * int64(time.Second)),
-	}
+	httpClient := utils.NewTimeoutHttpRequestDoer(cfg, "inventory.connector.timeout")
 
-	return NewInventoryClientWithHttpRequestDoer(cfg, &httpClient)
+	return NewInventoryClientWithHttpRequestDoer(cfg, httpClient)
 }
 
 func (this *inventoryConnectorImpl) getHostDetails(
@@ -212,6 +209,7 @@ func (this *inventoryConnectorImpl) GetHostConnectionDetails(ctx context.Context
 		satelliteFacts := getSatelliteFacts(host.Facts)
 		hostConnectionDetails[i] = HostDetails{
 			ID:                  *host.Id,
+			DisplayName:         host.DisplayName,
 			OwnerID:             systemProfileResults[*host.Id].SystemProfile.OwnerId,
 			SatelliteInstanceID: satelliteFacts.SatelliteInstanceID,
 			SatelliteVersion:    satelliteFacts.SatelliteVersion,
@@ -3,6 +3,7 @@ package inventory
 import (
 	"context"
 	"fmt"
+	"playbook-dispatcher/internal/common/utils"
 )
 
 type inventoryConnectorMock struct {
@@ -33,6 +34,7 @@ func (this *inventoryConnectorMock) GetHostConnectionDetails(
 
 	hostDetails := HostDetails{
 		ID:                  "c484f980-ab8d-401b-90e7-aa1d4ccf8c0e",
+		DisplayName:         utils.StringRef("satellite.example.com"),
 		OwnerID:             &ownerID,
 		SatelliteInstanceID: &satelliteInstanceID,
 		SatelliteVersion:    &satelliteVersion,
@@ -41,6 +43,7 @@ func (this *inventoryConnectorMock) GetHostConnectionDetails(
 
 	directConnectDetails := HostDetails{
 		ID:          "fe30b997-c15a-44a9-89df-c236c3b5c540",
+		DisplayName: utils.StringRef("rhc.example.com"),
 		OwnerID:     &ownerID,
 		RHCClientID: &rhcClientID,
 	}
@@ -26,6 +26,7 @@ var _ = Describe("Inventory", func() {
 			resultData := result[0]
 			Expect(err).ToNot(HaveOccurred())
 			Expect(resultData.ID).To(Equal("1234"))
+			Expect(*resultData.DisplayName).To(Equal("test"))
 			Expect(*resultData.OwnerID).To(Equal("b2ea37a0-7fb0-4f14-815d-fb582a916d5b"))
 			Expect(*resultData.SatelliteInstanceID).To(Equal("5678"))
 			Expect(*resultData.SatelliteVersion).To(Equal("6.11.3"))
@@ -77,6 +78,7 @@ var _ = Describe("Inventory", func() {
 			resultData := result[0]
 			Expect(err).ToNot(HaveOccurred())
 			Expect(resultData.ID).To(Equal("1234"))
+			Expect(*resultData.DisplayName).To(Equal("test"))
 			Expect(resultData.OwnerID).To(BeNil())
 			Expect(resultData.SatelliteInstanceID).To(BeNil())
 			Expect(resultData.SatelliteVersion).To(BeNil())
@@ -98,6 +100,7 @@ var _ = Describe("Inventory", func() {
 			resultData := result[0]
 			Expect(err).ToNot(HaveOccurred())
 			Expect(resultData.ID).To(Equal("1234"))
+			Expect(*resultData.DisplayName).To(Equal("test"))
 			Expect(*resultData.OwnerID).To(Equal("b2ea37a0-7fb0-4f14-815d-fb582a916d5b"))
 			Expect(*resultData.SatelliteInstanceID).To(Equal("5678"))
 			Expect(*resultData.SatelliteVersion).To(Equal("6.11.3"))
@@ -12,6 +12,7 @@ type satelliteFacts struct {
 
 type HostDetails struct {
 	ID                  string  `json:"id"`
+	DisplayName         *string `json:"display_name,omitempty"`
 	OwnerID             *string `json:"owner_id,omitempty"`
 	SatelliteInstanceID *string `json:"satellite_instance_id,omitempty"`
 	SatelliteVersion    *string `json:"satellite_version,omitempty"`
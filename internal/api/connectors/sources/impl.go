@@ -8,7 +8,6 @@ import (
 	"playbook-dispatcher/internal/common/constants"
 	"playbook-dispatcher/internal/common/utils"
 	"strings"
-	"time"
 
 	"github.com/redhatinsights/platform-go-middlewares/v2/request_id"
 	"github.com/spf13/viper"
@@ -62,11 +61,9 @@ func NewSourcesClientWithHttpRequestDoer(cfg *viper.Viper, doer HttpRequestDoer)
 }
 
 func NewSourcesClient(cfg *viper.Viper) SourcesConnector {
-	doer := http.Client{
-		Timeout: time.Duration(cfg.GetInt64("sources.timeout") * int64(time.Second)),
-	}
+	doer := utils.NewTimeoutHttpRequestDoer(cfg, "sources.timeout")
 
-	return NewSourcesClientWithHttpRequestDoer(cfg, &doer)
+	return NewSourcesClientWithHttpRequestDoer(cfg, doer)
 }
 
 func (this *sourcesClientImpl) getRHCConnectionStatus(ctx context.Context, sourceId string) (*string, *string, error) {
@@ -0,0 +1,99 @@
+package private
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/apitokens"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func apiTokenResponse(token *dbModel.ApiToken) ApiToken {
+	return ApiToken{
+		Id:        token.ID,
+		OrgId:     OrgId(token.OrgID),
+		Name:      token.Name,
+		CreatedAt: token.CreatedAt,
+		ExpiresAt: token.ExpiresAt,
+	}
+}
+
+func (this *controllers) ApiInternalV2ApiTokensList(ctx echo.Context, params ApiInternalV2ApiTokensListParams) error {
+	var tokens []dbModel.ApiToken
+
+	err := this.database.WithContext(ctx.Request().Context()).
+		Where("org_id = ?", string(params.OrgId)).
+		Order("created_at, id").
+		Find(&tokens).Error
+	if err != nil {
+		return err
+	}
+
+	result := make([]ApiToken, len(tokens))
+	for i := range tokens {
+		result[i] = apiTokenResponse(&tokens[i])
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+func (this *controllers) ApiInternalV2ApiTokensCreate(ctx echo.Context) error {
+	var input ApiTokenInput
+	if err := utils.ReadRequestBody(ctx, &input); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+	}
+
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(time.Now()) {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: "expires_at must be in the future"})
+	}
+
+	token, hash, err := apitokens.New()
+	if err != nil {
+		return err
+	}
+
+	record := dbModel.ApiToken{
+		ID:        uuid.New(),
+		OrgID:     string(input.OrgId),
+		Name:      input.Name,
+		Hash:      hash,
+		ExpiresAt: input.ExpiresAt,
+	}
+
+	if err := this.database.WithContext(ctx.Request().Context()).Create(&record).Error; err != nil {
+		return err
+	}
+
+	utils.GetLogFromEcho(ctx).Infow("Created API token", "org_id", record.OrgID, "token_id", record.ID.String(), "name", record.Name)
+
+	created := apiTokenResponse(&record)
+	return ctx.JSON(http.StatusCreated, ApiTokenCreated{
+		Id:        created.Id,
+		OrgId:     created.OrgId,
+		Name:      created.Name,
+		CreatedAt: created.CreatedAt,
+		ExpiresAt: created.ExpiresAt,
+		Token:     token,
+	})
+}
+
+func (this *controllers) ApiInternalV2ApiTokensDelete(ctx echo.Context, tokenId uuid.UUID, params ApiInternalV2ApiTokensDeleteParams) error {
+	result := this.database.WithContext(ctx.Request().Context()).
+		Where("id = ? AND org_id = ?", tokenId, string(params.OrgId)).
+		Delete(&dbModel.ApiToken{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ctx.JSON(http.StatusNotFound, Error{Message: "API token not found"})
+	}
+
+	utils.GetLogFromEcho(ctx).Infow("Deleted API token", "org_id", string(params.OrgId), "token_id", tokenId.String())
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,33 @@
+package private
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (this *controllers) ApiInternalV2DebugCaptures(ctx echo.Context, params ApiInternalV2DebugCapturesParams) error {
+	orgId := ""
+	if params.OrgId != nil {
+		orgId = string(*params.OrgId)
+	}
+
+	entries := this.captures.Get(orgId)
+	result := make([]DebugCapture, len(entries))
+
+	for i, entry := range entries {
+		result[i] = DebugCapture{
+			Timestamp:    entry.Timestamp,
+			OrgId:        entry.OrgId,
+			RequestId:    entry.RequestId,
+			Method:       entry.Method,
+			Url:          entry.Url,
+			Status:       entry.Status,
+			DurationMs:   entry.DurationMs,
+			RequestBody:  entry.RequestBody,
+			ResponseBody: entry.ResponseBody,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
@@ -2,11 +2,14 @@ package private
 
 import (
 	"fmt"
+	"playbook-dispatcher/internal/api/capture"
 	"playbook-dispatcher/internal/api/connectors"
 	"playbook-dispatcher/internal/api/connectors/inventory"
 	"playbook-dispatcher/internal/api/connectors/sources"
 	"playbook-dispatcher/internal/api/dispatch"
 	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/encryption"
+	"playbook-dispatcher/internal/common/utils"
 
 	"github.com/RedHatInsights/tenant-utils/pkg/tenantid"
 
@@ -16,9 +19,7 @@ import (
 	"gorm.io/gorm"
 )
 
-func CreateController(database *gorm.DB, cloudConnectorClient connectors.CloudConnectorClient, inventoryConnectorClient inventory.InventoryConnector, sourcesConnectorClient sources.SourcesConnector, config *viper.Viper, translator tenantid.Translator) ServerInterfaceWrapper {
-	rateLimiter := getRateLimiter(config)
-
+func CreateController(database *gorm.DB, cloudConnectorClient connectors.CloudConnectorClient, inventoryConnectorClient inventory.InventoryConnector, sourcesConnectorClient sources.SourcesConnector, config *viper.Viper, translator tenantid.Translator, captures *capture.Buffer, dispatchManager dispatch.DispatchManager, rateLimiter *rate.Limiter, labelCipher *encryption.LabelCipher) ServerInterfaceWrapper {
 	return ServerInterfaceWrapper{
 		Handler: &controllers{
 			database:                 database,
@@ -28,7 +29,10 @@ func CreateController(database *gorm.DB, cloudConnectorClient connectors.CloudCo
 			config:                   config,
 			rateLimiter:              rateLimiter,
 			translator:               translator,
-			dispatchManager:          dispatch.NewDispatchManager(config, cloudConnectorClient, rateLimiter, database),
+			dispatchManager:          dispatchManager,
+			captures:                 captures,
+			labelCipher:              labelCipher,
+			playbookUrlClient:        utils.NewTimeoutHttpRequestDoer(config, "dispatch.validate.url.timeout"),
 		},
 	}
 }
@@ -43,6 +47,10 @@ type controllers struct {
 	rateLimiter              *rate.Limiter
 	translator               tenantid.Translator
 	dispatchManager          dispatch.DispatchManager
+	captures                 *capture.Buffer
+	labelCipher              *encryption.LabelCipher
+	// checks the playbook URL of runs validated using /internal/v2/dispatch/validate
+	playbookUrlClient utils.HttpRequestDoer
 }
 
 // workaround for https://github.com/deepmap/oapi-codegen/issues/42
@@ -57,10 +65,3 @@ func GetSwaggerWithExternalRefs() (*openapi3.T, error) {
 
 	return swagger, nil
 }
-
-// returns a rate limiter reference that uses the token-bucket algorithm
-func getRateLimiter(config *viper.Viper) *rate.Limiter {
-	limit := rate.Limit(config.GetInt("cloud.connector.rps"))
-	bucket := config.GetInt("cloud.connector.req.bucket")
-	return rate.NewLimiter(limit, bucket)
-}
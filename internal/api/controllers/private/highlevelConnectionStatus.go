@@ -6,10 +6,13 @@ import (
 	"playbook-dispatcher/internal/api/connectors/inventory"
 	"playbook-dispatcher/internal/api/connectors/sources"
 	"playbook-dispatcher/internal/api/controllers/public"
+	dbModel "playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/utils"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type rhcSatellite struct {
@@ -58,6 +61,8 @@ func (this *controllers) ApiInternalHighlevelConnectionStatus(ctx echo.Context)
 		return ctx.NoContent(http.StatusBadRequest)
 	}
 
+	cacheInventoryHosts(ctx, this.database, input.OrgId, hostConnectorDetails)
+
 	if len(hostConnectorDetails) == 0 {
 		utils.GetLogFromEcho(ctx).Infow("host(s) not found in inventory", "data", noRHCResponses)
 		return ctx.JSON(http.StatusOK, noRHCResponses)
@@ -100,6 +105,33 @@ func (this *controllers) ApiInternalHighlevelConnectionStatus(ctx echo.Context)
 	return ctx.JSON(http.StatusOK, highLevelStatus)
 }
 
+// cacheInventoryHosts records the display names of the hosts looked up in the inventory, which the public API
+// returns along with the hosts of a run. Failing to do so does not fail the request.
+func cacheInventoryHosts(ctx echo.Context, db *gorm.DB, orgId OrgId, details []inventory.HostDetails) {
+	hosts := make([]dbModel.InventoryHost, 0, len(details))
+	for _, host := range details {
+		id, err := uuid.Parse(host.ID)
+		if err != nil || host.DisplayName == nil {
+			continue
+		}
+
+		hosts = append(hosts, dbModel.InventoryHost{OrgID: string(orgId), ID: id, DisplayName: *host.DisplayName})
+	}
+
+	if len(hosts) == 0 {
+		return
+	}
+
+	err := db.WithContext(ctx.Request().Context()).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "org_id"}, {Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"display_name", "updated_at"}),
+	}).Create(&hosts).Error
+
+	if err != nil {
+		utils.GetLogFromEcho(ctx).Errorw("Error caching inventory hosts", "error", err)
+	}
+}
+
 func sortHostsByRecipient(details []inventory.HostDetails) (satelliteDetails []inventory.HostDetails, directConnectedDetails []inventory.HostDetails, noRhc []inventory.HostDetails) {
 	var satelliteConnectedHosts []inventory.HostDetails
 	var directConnectedHosts []inventory.HostDetails
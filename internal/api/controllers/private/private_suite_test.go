@@ -88,3 +88,25 @@ var _ = Describe("Blocklisted OrgIDs", func() {
 		),
 	)
 })
+
+var _ = Describe("Playbook URL allowlist", func() {
+	DescribeTable("IsPlaybookUrlAllowed",
+		func(enabled bool, service, url string, result bool) {
+			cfg := config.Get()
+
+			cfg.Set("playbook.url.allowlist.enabled", enabled)
+			cfg.Set("playbook.url.allowlist.default", "https://console.redhat.com/api/*")
+			cfg.Set("playbook.url.allowlist.service.remediations", "https://console.redhat.com/api/remediations/v1/*, https://cert.console.redhat.com/api/remediations/v1/*")
+
+			Expect(utils.IsPlaybookUrlAllowed(cfg, service, url)).To(Equal(result))
+		},
+
+		Entry("disabled", false, "config_manager", "https://example.com/playbook.yml", true),
+		Entry("default pattern", true, "config_manager", "https://console.redhat.com/api/config-manager/v2/states/1/playbook", true),
+		Entry("default pattern - other host", true, "config_manager", "https://example.com/api/playbook.yml", false),
+		Entry("default pattern - host prefix", true, "config_manager", "https://console.redhat.com.example.com/api/playbook.yml", false),
+		Entry("service pattern", true, "remediations", "https://cert.console.redhat.com/api/remediations/v1/remediations/1/playbook", true),
+		Entry("service pattern replaces the default", true, "remediations", "https://console.redhat.com/api/config-manager/v2/states/1/playbook", false),
+		Entry("no pattern matches an empty url", true, "config_manager", "", false),
+	)
+})
@@ -0,0 +1,85 @@
+package private
+
+import (
+	"errors"
+	"net/http"
+	"playbook-dispatcher/internal/api/controllers/public"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+func (this *controllers) ApiInternalV3GroupsGet(ctx echo.Context, groupId uuid.UUID, params ApiInternalV3GroupsGetParams) error {
+	db := this.database.WithContext(ctx.Request().Context())
+
+	var group dbModel.RunGroup
+	err := db.Where("id = ? AND org_id = ?", groupId, string(params.OrgId)).Take(&group).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ctx.JSON(http.StatusNotFound, Error{Message: "Run group not found"})
+	} else if err != nil {
+		utils.GetLogFromEcho(ctx).Errorw("Error reading run group", "error", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	var runs []struct {
+		ID        uuid.UUID
+		Recipient uuid.UUID
+		Status    string
+	}
+
+	err = db.Table("runs").
+		Select("runs.id", "runs.recipient", public.RunStatusSql+" AS status").
+		Where("runs.group_id = ? AND runs.org_id = ?", group.ID, group.OrgID).
+		Order("runs.created_at, runs.id").
+		Scan(&runs).Error
+	if err != nil {
+		utils.GetLogFromEcho(ctx).Errorw("Error reading runs of run group", "error", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	result := RunGroupStatus{
+		Id:        group.ID,
+		OrgId:     OrgId(group.OrgID),
+		Name:      public.PlaybookName(group.Name),
+		CreatedAt: group.CreatedAt,
+		Runs:      make([]RunGroupRun, len(runs)),
+	}
+
+	counts := &result.Counts
+	for i, run := range runs {
+		result.Runs[i] = RunGroupRun{Id: run.ID, Recipient: run.Recipient, Status: public.RunStatus(run.Status)}
+		counts.Total++
+
+		switch status.Status(run.Status) {
+		case status.Running:
+			counts.Running++
+		case status.Success:
+			counts.Success++
+		case status.Failure:
+			counts.Failure++
+		case status.Timeout:
+			counts.Timeout++
+		case status.Canceled:
+			counts.Canceled++
+		case status.WaitingForConnection:
+			counts.WaitingForConnection++
+		case status.Canceling:
+			counts.Canceling++
+		}
+	}
+
+	switch {
+	case counts.Running+counts.Canceling+counts.WaitingForConnection > 0:
+		result.Status = Running
+	case counts.Total > 0 && counts.Success == counts.Total:
+		result.Status = Success
+	default:
+		result.Status = Failure
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
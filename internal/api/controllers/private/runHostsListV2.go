@@ -8,9 +8,13 @@ import (
 	"playbook-dispatcher/internal/api/controllers/public"
 	"playbook-dispatcher/internal/api/instrumentation"
 	"playbook-dispatcher/internal/api/middleware"
+	"playbook-dispatcher/internal/api/pagination"
 	dbModel "playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -43,17 +47,21 @@ func (apii *controllers) ApiInternalV2RunHostsList(ctx echo.Context, params ApiI
 
 	// NOTE: Removed GetAllowedServices() check - internal endpoint does not filter by service
 
+	if slices.Contains(fields, fieldDisplayName) {
+		queryBuilder.Joins("LEFT JOIN inventory_hosts ON inventory_hosts.org_id = runs.org_id AND inventory_hosts.id = run_hosts.inventory_id")
+	}
+
 	if params.Filter != nil {
 		if params.Filter.Status != nil {
-			status := *params.Filter.Status
-			switch status {
-			case dbModel.RunStatusTimeout:
-				queryBuilder.Where("runs.status = 'timeout' OR runs.status = 'running' AND runs.created_at + runs.timeout * interval '1 second' <= NOW()")
-			case dbModel.RunStatusRunning:
-				queryBuilder.Where("run_hosts.status = ?", status)
+			filterStatus := status.Status(*params.Filter.Status)
+			switch filterStatus {
+			case status.Timeout:
+				queryBuilder.Where("runs.status = ? OR runs.status IN ? AND runs.created_at + runs.timeout * interval '1 second' <= NOW()", status.Timeout, status.Strings(status.Active()...))
+			case status.Running:
+				queryBuilder.Where("run_hosts.status = ?", filterStatus)
 				queryBuilder.Where("runs.created_at + runs.timeout * interval '1 second' > NOW()")
 			default:
-				queryBuilder.Where("run_hosts.status = ?", status)
+				queryBuilder.Where("run_hosts.status = ?", filterStatus)
 			}
 		}
 
@@ -116,6 +124,8 @@ func (apii *controllers) ApiInternalV2RunHostsList(ctx echo.Context, params ApiI
 
 		for _, field := range fields {
 			switch field {
+			case fieldId:
+				runHost.Id = &host.ID
 			case fieldHost:
 				runHost.Host = utils.StringRef(host.Host)
 			case fieldStdout:
@@ -134,35 +144,64 @@ func (apii *controllers) ApiInternalV2RunHostsList(ctx echo.Context, params ApiI
 				if host.InventoryID != nil {
 					runHost.InventoryId = host.InventoryID
 				}
+			case fieldCancelState:
+				if host.CancelState != nil {
+					cancelState := public.CancelState(*host.CancelState)
+					runHost.CancelState = &cancelState
+				}
+			case fieldDiffs:
+				if host.Diffs != nil {
+					diffs := public.RunHostDiffs{}
+					if err := json.Unmarshal(host.Diffs, &diffs); err != nil {
+						instrumentation.PlaybookRunReadError(ctx, err)
+						return ctx.NoContent(http.StatusInternalServerError)
+					}
+
+					runHost.Diffs = &diffs
+				}
+			case fieldStdoutSize:
+				runHost.StdoutSize = host.StdoutSize
+			case fieldLastUpdatedDelta:
+				delta := int64(time.Since(host.UpdatedAt).Seconds())
+				runHost.LastUpdatedDelta = &delta
+			case fieldDisplayName:
+				runHost.DisplayName = host.DisplayName
 			}
 		}
 
 		hosts = append(hosts, runHost)
 	}
 
+	page := pagination.Page{Base: "/internal/v2/run_hosts", Query: middleware.GetQueryString(ctx), Limit: getLimit(params.Limit)}
+	meta, links := page.Offset(getOffset(params.Offset), len(hosts), int(total))
+
 	return ctx.JSON(http.StatusOK, &public.RunHosts{
-		Data: hosts,
-		Meta: public.Meta{
-			Count: len(hosts),
-			Total: int(total),
-		},
-		Links: createLinks("/internal/v2/run_hosts", middleware.GetQueryString(ctx), getLimit(params.Limit), getOffset(params.Offset), int(total)),
+		Data:  hosts,
+		Meta:  public.Meta(meta),
+		Links: public.Links(links),
 	})
 }
 
 // Helper functions duplicated from public package
 
 const (
+	fieldId          = "id"
 	fieldHost        = "host"
 	fieldRun         = "run"
 	fieldStatus      = "status"
 	fieldStdout      = "stdout"
 	fieldLinks       = "links"
 	fieldInventoryId = "inventory_id"
+	fieldCancelState = "cancel_state"
+	fieldDiffs       = "diffs"
+
+	fieldStdoutSize       = "stdout_size"
+	fieldLastUpdatedDelta = "last_updated_delta"
+	fieldDisplayName      = "display_name"
 )
 
 var (
-	runHostFields        = utils.IndexStrings(fieldHost, fieldRun, fieldStatus, fieldStdout, fieldLinks, fieldInventoryId)
+	runHostFields        = utils.IndexStrings(fieldId, fieldHost, fieldRun, fieldStatus, fieldStdout, fieldLinks, fieldInventoryId, fieldCancelState, fieldDiffs, fieldStdoutSize, fieldLastUpdatedDelta, fieldDisplayName)
 	defaultRunHostFields = []string{fieldHost, fieldRun, fieldStatus}
 )
 
@@ -204,36 +243,10 @@ func parseFields(input map[string][]string, key string, knownFields map[string]s
 	return result, nil
 }
 
-func createLinks(base string, queryString string, limit, offset, total int) public.Links {
-	lastPage := int(utils.Max(total-1, 0) / limit)
-
-	links := public.Links{
-		First: createLink(base, queryString, limit, 0),
-		Last:  createLink(base, queryString, limit, lastPage*limit),
-	}
-
-	if offset > 0 {
-		previous := createLink(base, queryString, limit, utils.Max(offset-limit, 0))
-		links.Previous = &previous
-	}
-
-	if offset+limit < total {
-		next := createLink(base, queryString, limit, offset+limit)
-		links.Next = &next
-	}
-
-	return links
-}
-
-func createLink(base string, queryString string, limit, offset int) string {
-	if queryString == "" {
-		return fmt.Sprintf("%s?limit=%d&offset=%d", base, limit, offset)
-	}
-	return fmt.Sprintf("%s?%s&limit=%d&offset=%d", base, queryString, limit, offset)
-}
-
 func mapHostFieldsToSql(field string) string {
 	switch field {
+	case fieldId:
+		return "run_hosts.id"
 	case "host":
 		return "run_hosts.host"
 	case "run":
@@ -246,6 +259,16 @@ func mapHostFieldsToSql(field string) string {
 		return "run_hosts.inventory_id"
 	case fieldInventoryId:
 		return "run_hosts.inventory_id"
+	case fieldCancelState:
+		return "run_hosts.cancel_state"
+	case fieldDiffs:
+		return "run_hosts.diffs"
+	case fieldStdoutSize:
+		return "octet_length(run_hosts.log) AS stdout_size"
+	case fieldLastUpdatedDelta:
+		return "run_hosts.updated_at"
+	case fieldDisplayName:
+		return "inventory_hosts.display_name"
 	default:
 		panic("unknown field " + field)
 	}
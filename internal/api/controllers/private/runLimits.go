@@ -0,0 +1,73 @@
+package private
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/dispatch"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm/clause"
+)
+
+func (this *controllers) runLimitResponse(ctx echo.Context, orgId OrgId) error {
+	db := this.database.WithContext(ctx.Request().Context())
+
+	limit, override, err := dispatch.GetRunLimit(db, this.config, string(orgId))
+	if err != nil {
+		return err
+	}
+
+	running, err := dispatch.CountActiveRuns(db, string(orgId))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, RunLimit{
+		OrgId:      orgId,
+		MaxRunning: limit,
+		Override:   override,
+		Running:    int(running),
+	})
+}
+
+func (this *controllers) ApiInternalV2RunLimitsGet(ctx echo.Context, orgId OrgId) error {
+	return this.runLimitResponse(ctx, orgId)
+}
+
+func (this *controllers) ApiInternalV2RunLimitsUpdate(ctx echo.Context, orgId OrgId) error {
+	var input RunLimitInput
+	if err := utils.ReadRequestBody(ctx, &input); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+	}
+
+	err := this.database.WithContext(ctx.Request().Context()).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "org_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"max_running", "updated_at"}),
+	}).Create(&dbModel.RunLimit{OrgID: string(orgId), MaxRunning: input.MaxRunning}).Error
+	if err != nil {
+		return err
+	}
+
+	utils.GetLogFromEcho(ctx).Infow("Updated limit of running runs", "org_id", string(orgId), "max_running", input.MaxRunning)
+
+	return this.runLimitResponse(ctx, orgId)
+}
+
+func (this *controllers) ApiInternalV2RunLimitsDelete(ctx echo.Context, orgId OrgId) error {
+	result := this.database.WithContext(ctx.Request().Context()).
+		Where("org_id = ?", string(orgId)).
+		Delete(&dbModel.RunLimit{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ctx.JSON(http.StatusNotFound, Error{Message: "Run limit not overridden for the org"})
+	}
+
+	utils.GetLogFromEcho(ctx).Infow("Removed limit of running runs override", "org_id", string(orgId))
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,196 @@
+package private
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"playbook-dispatcher/internal/api/controllers/public"
+	"playbook-dispatcher/internal/api/middleware"
+	"playbook-dispatcher/internal/api/scheduler"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+func (this *controllers) runTemplateResponse(template *dbModel.RunTemplate) (RunTemplate, error) {
+	labels, err := this.labelCipher.Decrypt(template.Labels)
+	if err != nil {
+		return RunTemplate{}, err
+	}
+
+	return RunTemplate{
+		Id:            template.ID,
+		OrgId:         OrgId(template.OrgID),
+		Service:       template.Service,
+		Principal:     Principal(template.Principal),
+		Name:          public.PlaybookName(template.Name),
+		Schedule:      template.Schedule,
+		Recipient:     template.Recipient,
+		Url:           public.Url(template.URL),
+		WebConsoleUrl: template.WebConsoleURL,
+		Labels:        labels,
+		Timeout:       template.Timeout,
+		Enabled:       template.Enabled,
+		NextRunAt:     template.NextRunAt,
+		LastRunAt:     template.LastRunAt,
+		LastRunId:     template.LastRunID,
+		CreatedAt:     template.CreatedAt,
+		UpdatedAt:     template.UpdatedAt,
+	}, nil
+}
+
+// applyRunTemplateInput validates the input and sets the fields of the template accordingly
+func (this *controllers) applyRunTemplateInput(input *RunTemplateInput, template *dbModel.RunTemplate) error {
+	schedule, err := scheduler.ParseSchedule(input.Schedule, this.config.GetDuration("run.templates.min.interval")*time.Second)
+	if err != nil {
+		return err
+	}
+
+	if !utils.IsPlaybookUrlAllowed(this.config, template.Service, string(input.Url)) {
+		return fmt.Errorf("playbook url not allowed for service %s", template.Service)
+	}
+
+	template.Principal = string(input.Principal)
+	template.Name = string(input.Name)
+	template.Schedule = input.Schedule
+	template.Recipient = input.Recipient
+	template.URL = string(input.Url)
+	template.WebConsoleURL = (*string)(input.WebConsoleUrl)
+	template.Labels = this.labelCipher.Encrypt(getLabels(input.Labels))
+	template.Timeout = (*int)(input.Timeout)
+	template.Enabled = input.Enabled == nil || *input.Enabled
+	template.NextRunAt = scheduler.NextRunAt(schedule, template.Enabled, time.Now())
+
+	return nil
+}
+
+func (this *controllers) ApiInternalV2RunTemplatesList(ctx echo.Context, params ApiInternalV2RunTemplatesListParams) error {
+	var templates []dbModel.RunTemplate
+
+	err := this.database.WithContext(ctx.Request().Context()).
+		Where("org_id = ?", string(params.OrgId)).
+		Order("created_at, id").
+		Find(&templates).Error
+	if err != nil {
+		return err
+	}
+
+	result := make([]RunTemplate, len(templates))
+	for i := range templates {
+		if result[i], err = this.runTemplateResponse(&templates[i]); err != nil {
+			return err
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+func (this *controllers) ApiInternalV2RunTemplatesCreate(ctx echo.Context) error {
+	var input RunTemplateInput
+	if err := utils.ReadRequestBody(ctx, &input); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+	}
+
+	template := dbModel.RunTemplate{
+		ID:      uuid.New(),
+		OrgID:   string(input.OrgId),
+		Service: middleware.GetPSKPrincipal(ctx.Request().Context()),
+	}
+
+	if err := this.applyRunTemplateInput(&input, &template); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+	}
+
+	if err := this.database.WithContext(ctx.Request().Context()).Create(&template).Error; err != nil {
+		return err
+	}
+
+	utils.GetLogFromEcho(ctx).Infow("Created run template", "org_id", template.OrgID, "template_id", template.ID.String(), "schedule", template.Schedule)
+
+	result, err := this.runTemplateResponse(&template)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, result)
+}
+
+func (this *controllers) ApiInternalV2RunTemplatesGet(ctx echo.Context, templateId uuid.UUID, params ApiInternalV2RunTemplatesGetParams) error {
+	var template dbModel.RunTemplate
+
+	err := this.database.WithContext(ctx.Request().Context()).
+		Where("id = ? AND org_id = ?", templateId, string(params.OrgId)).
+		Take(&template).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ctx.JSON(http.StatusNotFound, Error{Message: "Run template not found"})
+	} else if err != nil {
+		return err
+	}
+
+	result, err := this.runTemplateResponse(&template)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+func (this *controllers) ApiInternalV2RunTemplatesUpdate(ctx echo.Context, templateId uuid.UUID, params ApiInternalV2RunTemplatesUpdateParams) error {
+	var input RunTemplateInput
+	if err := utils.ReadRequestBody(ctx, &input); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+	}
+
+	if input.OrgId != params.OrgId {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: "org_id of the run template cannot be changed"})
+	}
+
+	db := this.database.WithContext(ctx.Request().Context())
+
+	var template dbModel.RunTemplate
+	err := db.Where("id = ? AND org_id = ?", templateId, string(params.OrgId)).Take(&template).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ctx.JSON(http.StatusNotFound, Error{Message: "Run template not found"})
+	} else if err != nil {
+		return err
+	}
+
+	if err := this.applyRunTemplateInput(&input, &template); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+	}
+
+	if err := db.Save(&template).Error; err != nil {
+		return err
+	}
+
+	utils.GetLogFromEcho(ctx).Infow("Updated run template", "org_id", template.OrgID, "template_id", template.ID.String(), "schedule", template.Schedule)
+
+	result, err := this.runTemplateResponse(&template)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+func (this *controllers) ApiInternalV2RunTemplatesDelete(ctx echo.Context, templateId uuid.UUID, params ApiInternalV2RunTemplatesDeleteParams) error {
+	result := this.database.WithContext(ctx.Request().Context()).
+		Where("id = ? AND org_id = ?", templateId, string(params.OrgId)).
+		Delete(&dbModel.RunTemplate{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ctx.JSON(http.StatusNotFound, Error{Message: "Run template not found"})
+	}
+
+	utils.GetLogFromEcho(ctx).Infow("Deleted run template", "org_id", string(params.OrgId), "template_id", templateId.String())
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -56,9 +56,12 @@ func handleRunCancelError(err error) *RunCanceled {
 	return runCancelError(http.StatusInternalServerError)
 }
 
-func runCanceled(runID uuid.UUID) *RunCanceled {
+func runCanceled(runID, correlationID uuid.UUID) *RunCanceled {
+	correlationIDValue := public.RunCorrelationId(correlationID.String())
+
 	return &RunCanceled{
-		Code:  http.StatusAccepted,
-		RunId: public.RunId(runID),
+		Code:          http.StatusAccepted,
+		RunId:         public.RunId(runID),
+		CorrelationId: &correlationIDValue,
 	}
 }
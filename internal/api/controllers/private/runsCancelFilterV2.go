@@ -0,0 +1,106 @@
+package private
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+func (this *controllers) ApiInternalV2RunsCancelFilter(ctx echo.Context) error {
+	var input CancelFilterInputV2
+
+	if err := utils.ReadRequestBody(ctx, &input); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+	}
+
+	filter := input.Filter
+	if (filter.Labels == nil || len(*filter.Labels) == 0) && filter.Service == nil && filter.CreatedBefore == nil {
+		return invalidRequest(ctx, errors.New("filter must not be empty"))
+	}
+
+	maxRuns := this.config.GetInt("cancel.filter.max.runs")
+
+	queryBuilder := this.database.WithContext(ctx.Request().Context()).
+		Model(&dbModel.Run{}).
+		Where("runs.org_id = ? AND runs.status = ?", string(input.OrgId), status.Running)
+
+	if filter.Labels != nil {
+		for key, value := range *filter.Labels {
+			// encrypted values are matched in any of their stored representations
+			conditions := queryBuilder.Session(&gorm.Session{NewDB: true})
+			for _, representation := range this.labelCipher.FilterValues(key, value) {
+				labelJson, err := json.Marshal(map[string]string{key: representation})
+				if err != nil {
+					return err
+				}
+
+				conditions = conditions.Or("runs.labels @> ?", string(labelJson))
+			}
+
+			queryBuilder.Where(conditions)
+		}
+	}
+
+	if filter.Service != nil {
+		queryBuilder.Where("runs.service = ?", *filter.Service)
+	}
+
+	if filter.CreatedBefore != nil {
+		queryBuilder.Where("runs.created_at < ?", *filter.CreatedBefore)
+	}
+
+	var runIds []uuid.UUID
+	if err := queryBuilder.Order("runs.created_at, runs.id").Limit(maxRuns+1).Pluck("runs.id", &runIds).Error; err != nil {
+		return err
+	}
+
+	more := len(runIds) > maxRuns
+	if more {
+		runIds = runIds[:maxRuns]
+	}
+
+	cancelInputs := make(CancelInputV2List, len(runIds))
+	for i, runId := range runIds {
+		cancelInputs[i] = CancelInputV2{RunId: runId, OrgId: input.OrgId, Principal: input.Principal}
+	}
+
+	// process individual runs concurrently
+	runs := cancelInputs.PMapRunCanceled(func(cancelInputV2 CancelInputV2) *RunCanceled {
+		context := utils.WithOrgId(ctx.Request().Context(), string(cancelInputV2.OrgId))
+		context = utils.WithRequestType(context, instrumentation.LabelAnsibleRequest)
+
+		cancelInput := CancelInputV2GenericMap(cancelInputV2, cancelInputV2.RunId)
+
+		runID, correlationID, err := this.dispatchManager.ProcessCancel(context, cancelInput.OrgId, cancelInput)
+		if err != nil {
+			result := handleRunCancelError(err)
+			result.RunId = cancelInputV2.RunId
+			return result
+		}
+
+		return runCanceled(runID, correlationID)
+	})
+
+	summary := RunsCancelSummary{Runs: make(RunsCanceled, len(runs)), More: more}
+	for i, run := range runs {
+		summary.Runs[i] = *run
+
+		if run.Code == http.StatusAccepted {
+			summary.Canceled++
+		} else {
+			summary.Failed++
+		}
+	}
+
+	utils.GetLogFromEcho(ctx).Infow("Canceled runs matching filter", "org_id", string(input.OrgId), "canceled", summary.Canceled, "failed", summary.Failed, "more", more)
+
+	return ctx.JSON(http.StatusOK, summary)
+}
@@ -25,12 +25,12 @@ func (this *controllers) ApiInternalV2RunsCancel(ctx echo.Context) error {
 
 		cancelInput := CancelInputV2GenericMap(cancelInputV2, cancelInputV2.RunId)
 
-		runID, _, err := this.dispatchManager.ProcessCancel(context, cancelInput.OrgId, cancelInput)
+		runID, correlationID, err := this.dispatchManager.ProcessCancel(context, cancelInput.OrgId, cancelInput)
 		if err != nil {
 			return handleRunCancelError(err)
 		}
 
-		return runCanceled(runID)
+		return runCanceled(runID, correlationID)
 	})
 
 	return ctx.JSON(http.StatusMultiStatus, result)
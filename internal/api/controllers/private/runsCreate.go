@@ -35,19 +35,25 @@ func (this *controllers) ApiInternalRunsCreate(ctx echo.Context) error {
 			return handleRunCreateError(&utils.BlocklistedOrgIdError{OrgID: orgIdString})
 		}
 
+		service := middleware.GetPSKPrincipal(context)
+		if !utils.IsPlaybookUrlAllowed(this.config, service, string(runInputV1.Url)) {
+			utils.GetLogFromEcho(ctx).Warnw("Rejecting request because the playbook url is not allowed", "service", service, "url", runInputV1.Url)
+			return handleRunCreateError(&utils.PlaybookUrlNotAllowedError{Service: service, Url: string(runInputV1.Url)})
+		}
+
 		hosts := parseRunHosts(runInputV1.Hosts)
 
 		context = utils.WithOrgId(context, orgIdString)
 
 		runInput := RunInputV1GenericMap(runInputV1, orgIdString, runInputV1.Recipient, hosts, this.config)
 
-		runID, _, err := this.dispatchManager.ProcessRun(context, orgIdString, middleware.GetPSKPrincipal(context), runInput)
+		runID, correlationID, err := this.dispatchManager.ProcessRun(context, orgIdString, service, runInput)
 
 		if err != nil {
 			return handleRunCreateError(err)
 		}
 
-		return runCreated(runID)
+		return runCreated(runID, correlationID)
 	})
 
 	return ctx.JSON(http.StatusMultiStatus, result)
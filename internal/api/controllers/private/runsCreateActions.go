@@ -86,12 +86,25 @@ func RunInputV2GenericMap(
 		WebConsoleUrl: (*string)(runInput.WebConsoleUrl),
 		Principal:     &principal,
 		SatId:         parsedSatID,
+		ExecutionMode: (*string)(runInput.ExecutionMode),
 	}
 
 	if runInput.RecipientConfig != nil {
 		result.SatOrgId = runInput.RecipientConfig.SatOrgId
 	}
 
+	if runInput.WaitForConnection != nil {
+		result.WaitForConnection = *runInput.WaitForConnection
+	}
+
+	if runInput.Priority != nil {
+		result.Priority = string(*runInput.Priority)
+	}
+
+	if runInput.CheckRecipient != nil {
+		result.CheckRecipient = *runInput.CheckRecipient
+	}
+
 	return result
 }
 
@@ -137,6 +150,20 @@ func handleRunCreateError(err error) *RunCreated {
 		return runCreateError(http.StatusNotFound, "Receipient not found")
 	}
 
+	if _, ok := err.(*dispatch.RunLimitExceededError); ok {
+		return runCreateError(http.StatusTooManyRequests, "Limit of running runs reached")
+	}
+
+	if _, ok := err.(*dispatch.PlaybookSignatureError); ok {
+		return runCreateError(http.StatusBadRequest, err.Error())
+	}
+
+	if preflightErr, ok := err.(*dispatch.PreflightError); ok {
+		result := runCreateError(http.StatusConflict, "Pre-flight check failed")
+		result.Reason = (*PreflightReason)(utils.StringRef(preflightErr.Reason()))
+		return result
+	}
+
 	if _, ok := err.(*tenantid.TenantNotFoundError); ok {
 		return runCreateError(http.StatusNotFound, "Tenant not found")
 	}
@@ -145,13 +172,20 @@ func handleRunCreateError(err error) *RunCreated {
 		return runCreateError(http.StatusBadRequest, "Block listed org")
 	}
 
+	if _, ok := err.(*utils.PlaybookUrlNotAllowedError); ok {
+		return runCreateError(http.StatusBadRequest, "Playbook URL not allowed")
+	}
+
 	return runCreateError(http.StatusInternalServerError, "Unexpected error during processing")
 }
 
-func runCreated(runID uuid.UUID) *RunCreated {
+func runCreated(runID, correlationID uuid.UUID) *RunCreated {
+	correlationIDValue := public.RunCorrelationId(correlationID.String())
+
 	return &RunCreated{
-		Code: http.StatusCreated,
-		Id:   &runID,
+		Code:          http.StatusCreated,
+		Id:            &runID,
+		CorrelationId: &correlationIDValue,
 	}
 }
 
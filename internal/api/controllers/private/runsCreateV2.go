@@ -1,9 +1,11 @@
 package private
 
 import (
+	"context"
 	"net/http"
 	"playbook-dispatcher/internal/api/instrumentation"
 	"playbook-dispatcher/internal/api/middleware"
+	"playbook-dispatcher/internal/common/model/generic"
 	"playbook-dispatcher/internal/common/utils"
 
 	"github.com/google/uuid"
@@ -30,33 +32,54 @@ func (this *controllers) ApiInternalV2RunsCreate(ctx echo.Context) error {
 
 	// process individual requests concurrently
 	result := input.PMapRunCreatedV2(func(runInputV2 RunInputV2) *RunCreated {
-		context := utils.WithOrgId(ctx.Request().Context(), string(runInputV2.OrgId))
-		context = utils.WithRequestType(context, getRequestTypeLabel(runInputV2))
+		return this.createRunV2(ctx, runInputV2, nil)
+	})
 
-		if utils.IsOrgIdBlocklisted(this.config, string(runInputV2.OrgId)) {
-			utils.GetLogFromEcho(ctx).Debugw("Rejecting request because the org_id is blocklisted")
-			return handleRunCreateError(&utils.BlocklistedOrgIdError{OrgID: string(runInputV2.OrgId)})
-		}
+	return ctx.JSON(http.StatusMultiStatus, result)
+}
 
-		hosts := parseRunHosts(runInputV2.Hosts)
+// createRunV2 dispatches a single run, as part of the group if given
+func (this *controllers) createRunV2(ctx echo.Context, runInputV2 RunInputV2, groupId *uuid.UUID) *RunCreated {
+	context, service, runInput, err := this.prepareRunV2(ctx, runInputV2)
+	if err != nil {
+		return handleRunCreateError(err)
+	}
 
-		var parsedSatID *uuid.UUID
-		if runInputV2.RecipientConfig != nil && runInputV2.RecipientConfig.SatId != nil {
-			parsedSatID = utils.UUIDRef(parseValidatedUUID(string(*runInputV2.RecipientConfig.SatId)))
-		}
+	runInput.GroupId = groupId
 
-		runInput := RunInputV2GenericMap(runInputV2, runInputV2.Recipient, hosts, parsedSatID, this.config)
+	runID, correlationID, err := this.dispatchManager.ProcessRun(context, runInput.OrgId, service, runInput)
 
-		runID, _, err := this.dispatchManager.ProcessRun(context, runInput.OrgId, middleware.GetPSKPrincipal(context), runInput)
+	if err != nil {
+		return handleRunCreateError(err)
+	}
 
-		if err != nil {
-			return handleRunCreateError(err)
-		}
+	return runCreated(runID, correlationID)
+}
 
-		return runCreated(runID)
-	})
+// prepareRunV2 checks whether the run may be dispatched by the calling service and maps it to the generic run input
+func (this *controllers) prepareRunV2(ctx echo.Context, runInputV2 RunInputV2) (context.Context, string, generic.RunInput, error) {
+	context := utils.WithOrgId(ctx.Request().Context(), string(runInputV2.OrgId))
+	context = utils.WithRequestType(context, getRequestTypeLabel(runInputV2))
 
-	return ctx.JSON(http.StatusMultiStatus, result)
+	if utils.IsOrgIdBlocklisted(this.config, string(runInputV2.OrgId)) {
+		utils.GetLogFromEcho(ctx).Debugw("Rejecting request because the org_id is blocklisted")
+		return context, "", generic.RunInput{}, &utils.BlocklistedOrgIdError{OrgID: string(runInputV2.OrgId)}
+	}
+
+	service := middleware.GetPSKPrincipal(context)
+	if !utils.IsPlaybookUrlAllowed(this.config, service, string(runInputV2.Url)) {
+		utils.GetLogFromEcho(ctx).Warnw("Rejecting request because the playbook url is not allowed", "service", service, "url", runInputV2.Url)
+		return context, service, generic.RunInput{}, &utils.PlaybookUrlNotAllowedError{Service: service, Url: string(runInputV2.Url)}
+	}
+
+	hosts := parseRunHosts(runInputV2.Hosts)
+
+	var parsedSatID *uuid.UUID
+	if runInputV2.RecipientConfig != nil && runInputV2.RecipientConfig.SatId != nil {
+		parsedSatID = utils.UUIDRef(parseValidatedUUID(string(*runInputV2.RecipientConfig.SatId)))
+	}
+
+	return context, service, RunInputV2GenericMap(runInputV2, runInputV2.Recipient, hosts, parsedSatID, this.config), nil
 }
 
 func getRequestTypeLabel(run RunInputV2) string {
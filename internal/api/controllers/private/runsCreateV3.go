@@ -0,0 +1,73 @@
+package private
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/api/middleware"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (this *controllers) ApiInternalV3RunsCreate(ctx echo.Context) error {
+	var input RunGroupInputV3
+
+	err := utils.ReadRequestBody(ctx, &input)
+	if err != nil {
+		utils.GetLogFromEcho(ctx).Error(err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	// each recipient is dispatched to as a v2 run sharing the playbook
+	runs := make(RunInputV2List, len(input.Recipients))
+	for i, recipient := range input.Recipients {
+		runs[i] = RunInputV2{
+			Recipient:         recipient.Recipient,
+			Hosts:             recipient.Hosts,
+			RecipientConfig:   recipient.RecipientConfig,
+			OrgId:             input.OrgId,
+			Principal:         input.Principal,
+			Url:               input.Url,
+			Name:              input.Name,
+			WebConsoleUrl:     input.WebConsoleUrl,
+			Labels:            input.Labels,
+			Timeout:           input.Timeout,
+			ExecutionMode:     input.ExecutionMode,
+			WaitForConnection: input.WaitForConnection,
+			Priority:          input.Priority,
+		}
+
+		err = validateSatelliteFields(runs[i])
+		if err != nil {
+			instrumentation.InvalidSatelliteRequest(ctx, err)
+			return invalidRequest(ctx, err)
+		}
+	}
+
+	group := dbModel.RunGroup{
+		ID:        uuid.New(),
+		OrgID:     string(input.OrgId),
+		Service:   middleware.GetPSKPrincipal(ctx.Request().Context()),
+		Name:      string(input.Name),
+		Principal: string(input.Principal),
+	}
+
+	if err := this.database.WithContext(ctx.Request().Context()).Create(&group).Error; err != nil {
+		utils.GetLogFromEcho(ctx).Errorw("Error creating run group", "error", err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	// process individual recipients concurrently
+	created := runs.PMapRunCreatedV2(func(runInputV2 RunInputV2) *RunCreated {
+		return this.createRunV2(ctx, runInputV2, &group.ID)
+	})
+
+	result := RunGroupCreated{GroupId: group.ID, Runs: make([]RunCreated, len(created))}
+	for i, run := range created {
+		result.Runs[i] = *run
+	}
+
+	return ctx.JSON(http.StatusMultiStatus, result)
+}
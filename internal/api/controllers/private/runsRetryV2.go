@@ -0,0 +1,111 @@
+package private
+
+import (
+	"errors"
+	"net/http"
+	"playbook-dispatcher/internal/api/controllers/public"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// statuses of the runs that can be retried
+var retryableStatuses = []status.Status{status.Failure, status.Timeout, status.Canceled}
+
+func (this *controllers) ApiInternalV2RunsRetry(ctx echo.Context, runId public.RunId) error {
+	var input RunRetryInputV2
+
+	if err := utils.ReadRequestBody(ctx, &input); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+	}
+
+	database := this.database.WithContext(ctx.Request().Context())
+
+	var run dbModel.Run
+	err := database.Where("id = ? AND org_id = ?", runId, string(input.OrgId)).First(&run).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ctx.JSON(http.StatusNotFound, runCreateError(http.StatusNotFound, "Run not found"))
+	} else if err != nil {
+		return err
+	}
+
+	if !slices.Contains(retryableStatuses, status.Status(run.Status)) {
+		return ctx.JSON(http.StatusConflict, runCreateError(http.StatusConflict, "Run has not failed and cannot be retried"))
+	}
+
+	var hosts []dbModel.RunHost
+	if err := database.Where("run_id = ?", run.ID).Order("host").Find(&hosts).Error; err != nil {
+		return err
+	}
+
+	// the retry is encrypted again when created
+	labels, err := this.labelCipher.Decrypt(run.Labels)
+	if err != nil {
+		return err
+	}
+
+	runInputV2 := retryInput(&run, hosts, labels, input.Principal)
+
+	context, service, runInput, err := this.prepareRunV2(ctx, runInputV2)
+	if err != nil {
+		result := handleRunCreateError(err)
+		return ctx.JSON(result.Code, result)
+	}
+
+	runInput.ParentRunId = &run.ID
+
+	utils.GetLogFromEcho(ctx).Infow("Retrying run", "run_id", run.ID.String(), "status", run.Status)
+
+	newRunID, correlationID, err := this.dispatchManager.ProcessRun(context, runInput.OrgId, service, runInput)
+	if err != nil {
+		result := handleRunCreateError(err)
+		return ctx.JSON(result.Code, result)
+	}
+
+	return ctx.JSON(http.StatusCreated, runCreated(newRunID, correlationID))
+}
+
+// retryInput describes a copy of the run the way it was dispatched
+func retryInput(run *dbModel.Run, hosts []dbModel.RunHost, labels map[string]string, principal Principal) RunInputV2 {
+	name := ""
+	if run.PlaybookName != nil {
+		name = *run.PlaybookName
+	}
+
+	timeout := public.RunTimeout(run.Timeout)
+	webConsoleUrl := public.WebConsoleUrl(run.PlaybookRunUrl)
+	executionMode := public.ExecutionMode(run.ExecutionMode)
+
+	input := RunInputV2{
+		Recipient:     run.Recipient,
+		OrgId:         OrgId(run.OrgID),
+		Principal:     principal,
+		Url:           public.Url(run.URL),
+		Name:          public.PlaybookName(name),
+		Labels:        (*public.Labels)(&labels),
+		Timeout:       &timeout,
+		WebConsoleUrl: &webConsoleUrl,
+		ExecutionMode: &executionMode,
+	}
+
+	if run.SatId != nil {
+		satId := run.SatId.String()
+		input.RecipientConfig = &RecipientConfig{SatId: &satId, SatOrgId: run.SatOrgId}
+	}
+
+	if len(hosts) > 0 {
+		inputHosts := make(RunInputHosts, len(hosts))
+		for i := range hosts {
+			inputHosts[i].AnsibleHost = &hosts[i].Host
+			inputHosts[i].InventoryId = hosts[i].InventoryID
+			inputHosts[i].SubscriptionManagerId = hosts[i].SubscriptionManagerID
+		}
+		input.Hosts = &inputHosts
+	}
+
+	return input
+}
@@ -0,0 +1,132 @@
+package private
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"playbook-dispatcher/internal/api/dispatch"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/constants"
+	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/labstack/echo/v4"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+)
+
+func (this *controllers) ApiInternalV2RunsValidate(ctx echo.Context) error {
+	var input RunInputV2List
+
+	err := utils.ReadRequestBody(ctx, &input)
+	if err != nil {
+		utils.GetLogFromEcho(ctx).Error(err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	for _, run := range input {
+		err = validateSatelliteFields(run)
+		if err != nil {
+			instrumentation.InvalidSatelliteRequest(ctx, err)
+			return invalidRequest(ctx, err)
+		}
+	}
+
+	// process individual requests concurrently
+	result := input.PMapRunCreatedV2(func(runInputV2 RunInputV2) *RunCreated {
+		return this.validateRunV2(ctx, runInputV2)
+	})
+
+	return ctx.JSON(http.StatusMultiStatus, result)
+}
+
+// validateRunV2 reports how the run would be dispatched, with the code the dispatch would respond with
+func (this *controllers) validateRunV2(ctx echo.Context, runInputV2 RunInputV2) *RunCreated {
+	context, service, runInput, err := this.prepareRunV2(ctx, runInputV2)
+	if err != nil {
+		return handleRunCreateError(err)
+	}
+
+	plan, err := this.dispatchManager.PlanRun(context, runInput.OrgId, service, runInput)
+	switch err.(type) {
+	case nil, *dispatch.RecipientNotFoundError, *dispatch.PreflightError, *dispatch.PlaybookSignatureError:
+	default:
+		utils.GetLogFromEcho(ctx).Errorw("Error validating run", "recipient", runInput.Recipient, "error", err)
+		return handleRunCreateError(err)
+	}
+
+	dryRun := RunDryRun{
+		Protocol:             "rhc",
+		Timeout:              *plan.Run.Timeout,
+		WebConsoleUrl:        *plan.Run.WebConsoleUrl,
+		ExecutionMode:        *plan.Run.ExecutionMode,
+		Hosts:                len(plan.Run.Hosts),
+		DispatchChunks:       plan.DispatchChunks,
+		RecipientConnected:   plan.RecipientConnected,
+		WaitsForConnection:   plan.WaitsForConnection,
+		PlaybookUrlReachable: this.isPlaybookUrlReachable(context, runInput.Url),
+	}
+
+	if plan.Satellite {
+		dryRun.Protocol = "satellite"
+		dryRun.SatelliteRegistered = utils.BoolRef(this.isSatelliteRegistered(context, runInput))
+	}
+
+	result := &RunCreated{Code: http.StatusOK}
+	if err != nil {
+		result = handleRunCreateError(err)
+	}
+
+	result.DryRun = &dryRun
+	return result
+}
+
+// isPlaybookUrlReachable checks that the playbook URL responds, authentication required by it is not considered
+func (this *controllers) isPlaybookUrlReachable(ctx context.Context, url string) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return false
+	}
+
+	res, err := this.playbookUrlClient.Do(req)
+	if err != nil {
+		utils.GetLogFromContext(ctx).Debugw("Playbook URL not reachable", "url", url, "error", err)
+		return false
+	}
+
+	res.Body.Close()
+	return res.StatusCode < http.StatusInternalServerError
+}
+
+// isSatelliteRegistered checks that Sources knows the Satellite of the run within the org, connected as the recipient
+func (this *controllers) isSatelliteRegistered(ctx context.Context, run generic.RunInput) bool {
+	// Sources scopes its data by the identity of the caller, the run is dispatched on behalf of its org
+	header, err := orgIdentity(run.OrgId)
+	if err != nil {
+		return false
+	}
+
+	ctx = context.WithValue(ctx, constants.HeaderIdentity, header)
+
+	source, err := this.sourcesConnectorClient.GetSourceConnectionDetails(ctx, run.SatId.String())
+	if err != nil {
+		utils.GetLogFromContext(ctx).Debugw("Satellite not found in Sources", "sat_id", run.SatId.String(), "error", err)
+		return false
+	}
+
+	return source.RhcID != nil && *source.RhcID == run.Recipient.String()
+}
+
+func orgIdentity(orgId string) (string, error) {
+	data, err := json.Marshal(identity.XRHID{Identity: identity.Identity{
+		OrgID:    orgId,
+		Type:     "System",
+		Internal: identity.Internal{OrgID: orgId},
+	}})
+
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
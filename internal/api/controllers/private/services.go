@@ -0,0 +1,24 @@
+package private
+
+import (
+	"net/http"
+
+	"playbook-dispatcher/internal/common/kessel"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (this *controllers) ApiInternalV2Services(ctx echo.Context) error {
+	applications := kessel.Applications()
+	result := make([]KnownService, len(applications))
+
+	for i, application := range applications {
+		result[i] = KnownService{
+			Name:       application.Name,
+			Permission: application.Permission,
+			Source:     KnownServiceSource(application.Source),
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // ServerInterface represents all server handlers.
@@ -25,21 +26,81 @@ type ServerInterface interface {
 	// Dispatch Playbooks
 	// (POST /internal/dispatch)
 	ApiInternalRunsCreate(ctx echo.Context) error
+	// API tokens of an organization
+	// (GET /internal/v2/api_tokens)
+	ApiInternalV2ApiTokensList(ctx echo.Context, params ApiInternalV2ApiTokensListParams) error
+	// Create an API token
+	// (POST /internal/v2/api_tokens)
+	ApiInternalV2ApiTokensCreate(ctx echo.Context) error
+	// Revoke an API token
+	// (DELETE /internal/v2/api_tokens/{token_id})
+	ApiInternalV2ApiTokensDelete(ctx echo.Context, tokenId openapi_types.UUID, params ApiInternalV2ApiTokensDeleteParams) error
 	// Cancel Playbook Runs
 	// (POST /internal/v2/cancel)
 	ApiInternalV2RunsCancel(ctx echo.Context) error
+	// Cancel Playbook Runs matching a filter
+	// (POST /internal/v2/cancel/filter)
+	ApiInternalV2RunsCancelFilter(ctx echo.Context) error
 	// Obtain Connection Status of recipient(s) based on a list of host IDs
 	// (POST /internal/v2/connection_status)
 	ApiInternalHighlevelConnectionStatus(ctx echo.Context) error
+	// Captured requests
+	// (GET /internal/v2/debug/captures)
+	ApiInternalV2DebugCaptures(ctx echo.Context, params ApiInternalV2DebugCapturesParams) error
 	// Dispatch Playbooks
 	// (POST /internal/v2/dispatch)
 	ApiInternalV2RunsCreate(ctx echo.Context) error
+	// Validate Playbook dispatch
+	// (POST /internal/v2/dispatch/validate)
+	ApiInternalV2RunsValidate(ctx echo.Context) error
 	// Obtain connection status of recipient(s)
 	// (POST /internal/v2/recipients/status)
 	ApiInternalV2RecipientsStatus(ctx echo.Context) error
 	// List hosts involved in Playbook runs
 	// (GET /internal/v2/run_hosts)
 	ApiInternalV2RunHostsList(ctx echo.Context, params ApiInternalV2RunHostsListParams) error
+	// Reset the limit of running runs of an organization
+	// (DELETE /internal/v2/run_limits/{org_id})
+	ApiInternalV2RunLimitsDelete(ctx echo.Context, orgId OrgId) error
+	// Limit of running runs of an organization
+	// (GET /internal/v2/run_limits/{org_id})
+	ApiInternalV2RunLimitsGet(ctx echo.Context, orgId OrgId) error
+	// Override the limit of running runs of an organization
+	// (PUT /internal/v2/run_limits/{org_id})
+	ApiInternalV2RunLimitsUpdate(ctx echo.Context, orgId OrgId) error
+	// Run templates of an organization
+	// (GET /internal/v2/run_templates)
+	ApiInternalV2RunTemplatesList(ctx echo.Context, params ApiInternalV2RunTemplatesListParams) error
+	// Create a run template
+	// (POST /internal/v2/run_templates)
+	ApiInternalV2RunTemplatesCreate(ctx echo.Context) error
+	// Delete a run template
+	// (DELETE /internal/v2/run_templates/{template_id})
+	ApiInternalV2RunTemplatesDelete(ctx echo.Context, templateId openapi_types.UUID, params ApiInternalV2RunTemplatesDeleteParams) error
+	// Get a run template
+	// (GET /internal/v2/run_templates/{template_id})
+	ApiInternalV2RunTemplatesGet(ctx echo.Context, templateId openapi_types.UUID, params ApiInternalV2RunTemplatesGetParams) error
+	// Update a run template
+	// (PUT /internal/v2/run_templates/{template_id})
+	ApiInternalV2RunTemplatesUpdate(ctx echo.Context, templateId openapi_types.UUID, params ApiInternalV2RunTemplatesUpdateParams) error
+	// Retry a Playbook Run
+	// (POST /internal/v2/runs/{run_id}/retry)
+	ApiInternalV2RunsRetry(ctx echo.Context, runId externalRef0.RunId) error
+	// Known services
+	// (GET /internal/v2/services)
+	ApiInternalV2Services(ctx echo.Context) error
+	// Per-tenant usage
+	// (GET /internal/v2/usage)
+	ApiInternalV2Usage(ctx echo.Context, params ApiInternalV2UsageParams) error
+	// Deployment details
+	// (GET /internal/v2/version)
+	ApiInternalV2Version(ctx echo.Context) error
+	// Dispatch a Playbook to multiple recipients
+	// (POST /internal/v3/dispatch)
+	ApiInternalV3RunsCreate(ctx echo.Context) error
+	// Status of a run group
+	// (GET /internal/v3/groups/{group_id})
+	ApiInternalV3GroupsGet(ctx echo.Context, groupId openapi_types.UUID, params ApiInternalV3GroupsGetParams) error
 	// Get Version
 	// (GET /internal/version)
 	ApiInternalVersion(ctx echo.Context) error
@@ -59,6 +120,58 @@ func (w *ServerInterfaceWrapper) ApiInternalRunsCreate(ctx echo.Context) error {
 	return err
 }
 
+// ApiInternalV2ApiTokensList converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2ApiTokensList(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiInternalV2ApiTokensListParams
+	// ------------- Required query parameter "org_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "org_id", ctx.QueryParams(), &params.OrgId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2ApiTokensList(ctx, params)
+	return err
+}
+
+// ApiInternalV2ApiTokensCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2ApiTokensCreate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2ApiTokensCreate(ctx)
+	return err
+}
+
+// ApiInternalV2ApiTokensDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2ApiTokensDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "token_id" -------------
+	var tokenId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token_id", ctx.Param("token_id"), &tokenId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token_id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiInternalV2ApiTokensDeleteParams
+	// ------------- Required query parameter "org_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "org_id", ctx.QueryParams(), &params.OrgId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2ApiTokensDelete(ctx, tokenId, params)
+	return err
+}
+
 // ApiInternalV2RunsCancel converts echo context to params.
 func (w *ServerInterfaceWrapper) ApiInternalV2RunsCancel(ctx echo.Context) error {
 	var err error
@@ -68,6 +181,15 @@ func (w *ServerInterfaceWrapper) ApiInternalV2RunsCancel(ctx echo.Context) error
 	return err
 }
 
+// ApiInternalV2RunsCancelFilter converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2RunsCancelFilter(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2RunsCancelFilter(ctx)
+	return err
+}
+
 // ApiInternalHighlevelConnectionStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) ApiInternalHighlevelConnectionStatus(ctx echo.Context) error {
 	var err error
@@ -77,6 +199,24 @@ func (w *ServerInterfaceWrapper) ApiInternalHighlevelConnectionStatus(ctx echo.C
 	return err
 }
 
+// ApiInternalV2DebugCaptures converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2DebugCaptures(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiInternalV2DebugCapturesParams
+	// ------------- Optional query parameter "org_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "org_id", ctx.QueryParams(), &params.OrgId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2DebugCaptures(ctx, params)
+	return err
+}
+
 // ApiInternalV2RunsCreate converts echo context to params.
 func (w *ServerInterfaceWrapper) ApiInternalV2RunsCreate(ctx echo.Context) error {
 	var err error
@@ -86,6 +226,15 @@ func (w *ServerInterfaceWrapper) ApiInternalV2RunsCreate(ctx echo.Context) error
 	return err
 }
 
+// ApiInternalV2RunsValidate converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2RunsValidate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2RunsValidate(ctx)
+	return err
+}
+
 // ApiInternalV2RecipientsStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) ApiInternalV2RecipientsStatus(ctx echo.Context) error {
 	var err error
@@ -134,6 +283,256 @@ func (w *ServerInterfaceWrapper) ApiInternalV2RunHostsList(ctx echo.Context) err
 	return err
 }
 
+// ApiInternalV2RunLimitsDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2RunLimitsDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org_id" -------------
+	var orgId OrgId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org_id", ctx.Param("org_id"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2RunLimitsDelete(ctx, orgId)
+	return err
+}
+
+// ApiInternalV2RunLimitsGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2RunLimitsGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org_id" -------------
+	var orgId OrgId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org_id", ctx.Param("org_id"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2RunLimitsGet(ctx, orgId)
+	return err
+}
+
+// ApiInternalV2RunLimitsUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2RunLimitsUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "org_id" -------------
+	var orgId OrgId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org_id", ctx.Param("org_id"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2RunLimitsUpdate(ctx, orgId)
+	return err
+}
+
+// ApiInternalV2RunTemplatesList converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2RunTemplatesList(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiInternalV2RunTemplatesListParams
+	// ------------- Required query parameter "org_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "org_id", ctx.QueryParams(), &params.OrgId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2RunTemplatesList(ctx, params)
+	return err
+}
+
+// ApiInternalV2RunTemplatesCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2RunTemplatesCreate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2RunTemplatesCreate(ctx)
+	return err
+}
+
+// ApiInternalV2RunTemplatesDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2RunTemplatesDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "template_id" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", ctx.Param("template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter template_id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiInternalV2RunTemplatesDeleteParams
+	// ------------- Required query parameter "org_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "org_id", ctx.QueryParams(), &params.OrgId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2RunTemplatesDelete(ctx, templateId, params)
+	return err
+}
+
+// ApiInternalV2RunTemplatesGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2RunTemplatesGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "template_id" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", ctx.Param("template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter template_id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiInternalV2RunTemplatesGetParams
+	// ------------- Required query parameter "org_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "org_id", ctx.QueryParams(), &params.OrgId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2RunTemplatesGet(ctx, templateId, params)
+	return err
+}
+
+// ApiInternalV2RunTemplatesUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2RunTemplatesUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "template_id" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", ctx.Param("template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter template_id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiInternalV2RunTemplatesUpdateParams
+	// ------------- Required query parameter "org_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "org_id", ctx.QueryParams(), &params.OrgId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2RunTemplatesUpdate(ctx, templateId, params)
+	return err
+}
+
+// ApiInternalV2RunsRetry converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2RunsRetry(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "run_id" -------------
+	var runId externalRef0.RunId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "run_id", ctx.Param("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter run_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2RunsRetry(ctx, runId)
+	return err
+}
+
+// ApiInternalV2Services converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2Services(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2Services(ctx)
+	return err
+}
+
+// ApiInternalV2Usage converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2Usage(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiInternalV2UsageParams
+	// ------------- Optional query parameter "org_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "org_id", ctx.QueryParams(), &params.OrgId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org_id: %s", err))
+	}
+
+	// ------------- Required query parameter "since" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "since", ctx.QueryParams(), &params.Since, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "until", ctx.QueryParams(), &params.Until, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2Usage(ctx, params)
+	return err
+}
+
+// ApiInternalV2Version converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2Version(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2Version(ctx)
+	return err
+}
+
+// ApiInternalV3RunsCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV3RunsCreate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV3RunsCreate(ctx)
+	return err
+}
+
+// ApiInternalV3GroupsGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV3GroupsGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "group_id" -------------
+	var groupId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", ctx.Param("group_id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiInternalV3GroupsGetParams
+	// ------------- Required query parameter "org_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "org_id", ctx.QueryParams(), &params.OrgId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter org_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV3GroupsGet(ctx, groupId, params)
+	return err
+}
+
 // ApiInternalVersion converts echo context to params.
 func (w *ServerInterfaceWrapper) ApiInternalVersion(ctx echo.Context) error {
 	var err error
@@ -191,11 +590,31 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	}
 
 	router.POST(options.BaseURL+"/internal/dispatch", wrapper.ApiInternalRunsCreate, options.OperationMiddlewares["api.internal.runs.create"]...)
+	router.GET(options.BaseURL+"/internal/v2/api_tokens", wrapper.ApiInternalV2ApiTokensList, options.OperationMiddlewares["api.internal.v2.api_tokens.list"]...)
+	router.POST(options.BaseURL+"/internal/v2/api_tokens", wrapper.ApiInternalV2ApiTokensCreate, options.OperationMiddlewares["api.internal.v2.api_tokens.create"]...)
+	router.DELETE(options.BaseURL+"/internal/v2/api_tokens/:token_id", wrapper.ApiInternalV2ApiTokensDelete, options.OperationMiddlewares["api.internal.v2.api_tokens.delete"]...)
 	router.POST(options.BaseURL+"/internal/v2/cancel", wrapper.ApiInternalV2RunsCancel, options.OperationMiddlewares["api.internal.v2.runs.cancel"]...)
+	router.POST(options.BaseURL+"/internal/v2/cancel/filter", wrapper.ApiInternalV2RunsCancelFilter, options.OperationMiddlewares["api.internal.v2.runs.cancel.filter"]...)
 	router.POST(options.BaseURL+"/internal/v2/connection_status", wrapper.ApiInternalHighlevelConnectionStatus, options.OperationMiddlewares["api.internal.highlevel.connection.status"]...)
+	router.GET(options.BaseURL+"/internal/v2/debug/captures", wrapper.ApiInternalV2DebugCaptures, options.OperationMiddlewares["api.internal.v2.debug.captures"]...)
 	router.POST(options.BaseURL+"/internal/v2/dispatch", wrapper.ApiInternalV2RunsCreate, options.OperationMiddlewares["api.internal.v2.runs.create"]...)
+	router.POST(options.BaseURL+"/internal/v2/dispatch/validate", wrapper.ApiInternalV2RunsValidate, options.OperationMiddlewares["api.internal.v2.runs.validate"]...)
 	router.POST(options.BaseURL+"/internal/v2/recipients/status", wrapper.ApiInternalV2RecipientsStatus, options.OperationMiddlewares["api.internal.v2.recipients.status"]...)
 	router.GET(options.BaseURL+"/internal/v2/run_hosts", wrapper.ApiInternalV2RunHostsList, options.OperationMiddlewares["api.internal.v2.run.hosts.list"]...)
+	router.DELETE(options.BaseURL+"/internal/v2/run_limits/:org_id", wrapper.ApiInternalV2RunLimitsDelete, options.OperationMiddlewares["api.internal.v2.run_limits.delete"]...)
+	router.GET(options.BaseURL+"/internal/v2/run_limits/:org_id", wrapper.ApiInternalV2RunLimitsGet, options.OperationMiddlewares["api.internal.v2.run_limits.get"]...)
+	router.PUT(options.BaseURL+"/internal/v2/run_limits/:org_id", wrapper.ApiInternalV2RunLimitsUpdate, options.OperationMiddlewares["api.internal.v2.run_limits.update"]...)
+	router.GET(options.BaseURL+"/internal/v2/run_templates", wrapper.ApiInternalV2RunTemplatesList, options.OperationMiddlewares["api.internal.v2.run_templates.list"]...)
+	router.POST(options.BaseURL+"/internal/v2/run_templates", wrapper.ApiInternalV2RunTemplatesCreate, options.OperationMiddlewares["api.internal.v2.run_templates.create"]...)
+	router.DELETE(options.BaseURL+"/internal/v2/run_templates/:template_id", wrapper.ApiInternalV2RunTemplatesDelete, options.OperationMiddlewares["api.internal.v2.run_templates.delete"]...)
+	router.GET(options.BaseURL+"/internal/v2/run_templates/:template_id", wrapper.ApiInternalV2RunTemplatesGet, options.OperationMiddlewares["api.internal.v2.run_templates.get"]...)
+	router.PUT(options.BaseURL+"/internal/v2/run_templates/:template_id", wrapper.ApiInternalV2RunTemplatesUpdate, options.OperationMiddlewares["api.internal.v2.run_templates.update"]...)
+	router.POST(options.BaseURL+"/internal/v2/runs/:run_id/retry", wrapper.ApiInternalV2RunsRetry, options.OperationMiddlewares["api.internal.v2.runs.retry"]...)
+	router.GET(options.BaseURL+"/internal/v2/services", wrapper.ApiInternalV2Services, options.OperationMiddlewares["api.internal.v2.services"]...)
+	router.GET(options.BaseURL+"/internal/v2/usage", wrapper.ApiInternalV2Usage, options.OperationMiddlewares["api.internal.v2.usage"]...)
+	router.GET(options.BaseURL+"/internal/v2/version", wrapper.ApiInternalV2Version, options.OperationMiddlewares["api.internal.v2.version"]...)
+	router.POST(options.BaseURL+"/internal/v3/dispatch", wrapper.ApiInternalV3RunsCreate, options.OperationMiddlewares["api.internal.v3.runs.create"]...)
+	router.GET(options.BaseURL+"/internal/v3/groups/:group_id", wrapper.ApiInternalV3GroupsGet, options.OperationMiddlewares["api.internal.v3.groups.get"]...)
 	router.GET(options.BaseURL+"/internal/version", wrapper.ApiInternalVersion, options.OperationMiddlewares["api.internal.version"]...)
 
 }
@@ -205,65 +624,165 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 // const string: with thousands of chunks the chained `+` fold is several
 // times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-	"1DtbUxs5s39FNec87FbZYAxkEz8dQnZPqC8JKQjZrdqlKM2ox1YyliaSxsCm+O9ftaTRXG2PE9jLG9jq",
-	"Vt9van+NErnMpQBhdDT7GuVU0SUYUO6/Is54cvOGL7nB/xnoRPHccCmiWfSW3vFlsSSiWMagiEyJAl1k",
-	"RhMjiQJTKBGNIo5HvxSg7qNRJOgSolmUWYSjSCcLWFKHOaVFZqLZ8WQULR3iaDad4H9cuP8ORpG5zxGe",
-	"CwNzUNHDw6ik8TxNNfQQeSYYT6gBTcwCiDZUGS7mJJea4wmkGr+wBBIFGTV8BcgAfoqyycAA0WDwJDew",
-	"RETUkCU1yaICXcOodFT1clpnbbKJtYtCvJba/MIhY7rL4StIuQBNUvs9kh6DFz8wwoUlUoHOpdCw9wfq",
-	"BO7yTDKIZkYV0E+5w9agPFcyB2U4OCKoafLze7SQ2vJqqCkQVBUiuh5FVmp4FATyGs7h17XT2jBZ4OcZ",
-	"F5+1FegKhJHq/oYzxOMlpI3iYh49hA+oUvTeCsx/IONPkBg8oc19hp8wgPw8fNqWa2ZAdeV6kmXyVpNU",
-	"KpLaI2g3MdXAiBRkRRWXhSaJ4vgVHSpVe9d6qTZ4nn2N/ldBGs2i/9mv3HTfwep9z8ZZCXLG3hVZRuMM",
-	"kEmU7uxrJMqPPFWt6+wlHcFmNIZMD7z/ohBv7Pn67RrUiicwEMWlO10h6NelNZSBGO3hbQi7xoGC865i",
-	"r3pJ2QV8KUDb0JJIYUDYP2meZxhYuBT7n7S0sq6UuonCn5WS6N8Po5bBvaSMlJc9jKJfpIo5YyCe/uaT",
-	"JAGty6g35ysQGDFkoRIgXBMhDaHoDsCsiDxCvO+UigSyM5EX5uO0a89SzQdY8rmanzHrmYqLhOc02wbx",
-	"Phx0pj7cXS4Kcca8or8UXAHDkORRjEqC66Rc99iOE2WH3SVoTefQDSaviyVFmVKG9kgAwUl5GkMHxUyD",
-	"SdWlBOKckWQg5maBOjiIOhGwxUOJro/e13y+eAMryC4g4TkHYS6DO4X4vEl6Ae5XbhanUghIkLUzkcpu",
-	"KB5FGFjPWE86ZiAMTzloQomCRCpWpmAEGYdgRsoIYrPkGyuGeglQBSuE00iVs6KOTjDbNPl8cpKW9O7M",
-	"XXbssrz/76ArqJ0cpKXwYKuOxT69B5ms5Rn5lGpOBf/TxhRX3vTEgRgyKeYYJSLLYRDAZKs83tfduknJ",
-	"lQaFybEUeaFBESyCFE1spXbLjauzKulX3vJp4eq57SoJ9nsqRcrnXUJUeWCsc0h4yhOS2KOFcnKR9qSO",
-	"2jlUU+M1uEbGquTtkhrIMm6AcKENBs6yPCsKzsjqaH91jPXGkpoGl5QexgcppePjZ+nh+IgdHI2fT4+f",
-	"j58dHLODA5hOJs8m0SjykDOkaMzZGJFGPaJAgiuz20Z0wzZQGVxUjDTIPJgeHh1v00RfIu6JSTTLztNo",
-	"9vsOQelcIXdt709cqAK2qTW4XYBZgCKUJCGyYcwFbWiccb0AVtlhMJRKtrGUGVDRcdDq8q5vXtcZ/2C/",
-	"2+KliMB1WR6K/B4UMSKvuILEkNPyyhF5JwVco4Z82a1rWmP2tD+M9akUNnEM9aKeLPC9ub+S6+BEHshp",
-	"wN8YL81BpmNF771iO7VB4I7mpisNAgz8VvXspo41KZRCVatCEAdROmbdDksVVwaHKtb1f9UiuRHS3JRB",
-	"Dfp7Kn2vyzQ5qC7wib6vG2vUVzViQ9pqaSzooCHXiqQgsutNMaQMBX+vOW5nv5eJQrh6GnrqmMT2lm1r",
-	"8TaBX1aG4XqIWmyeTqbdCcOjFM6WqoBpHVMKqHl8ng76eNqVn9H6ot0W+eRtT5V+JeAut57lS3lW2HI9",
-	"VxI7KVeRbK7WLetr5GXbqa60aJLIYrBBnvjTD6OqBN4YEf29tp7eeQrgRgCPEccNX4IsdoD+4AEeRlGh",
-	"soFwVyrb6KWlrB3OTXp6XQq3aTzn9g+aZfcjwoWrzbCsoLEsDLEaIVysZLaqpnTvM3ofS/nZRvuEChID",
-	"mtSKM2B7f4gPC64buLjGeplh454rGGOLjpkDwW/whlC8670/xFupQK5AjQg3JfISOrEO2qx/YjC3AAKb",
-	"ojY6QgWzLJAwr3KDxZAyWoYrNI8zsEh6emNEZHsAqslnIW8FknTiYBo3XHlyuSuM7q3QPB1ldlSQS2V0",
-	"OegsPRYlk/nB45Yipz2Da6fnsh3koU90nZLHXt2ZpvHRT5PpZEyfpWx89PyIjZ9P4uMxo5MJPaKHkzid",
-	"1uv2tQV7EQcKbpZU0DmoXtouawfJW3dwO5mHL+JDOpm+GB8fTl+MjybJT2PKptPxwfHRND5O49SV9VvI",
-	"7Cvs281u6TJ9k6K/NEa5cewgoNIn3yHI4Ia9fJX4zsHWo5XESeh6BxXFvkn+a6PxKLqFGCnVMoOb4cC/",
-	"QnzqgLYF9Z7hnqPSW8SaMK/rRdmwaVmtkOv3A12riQaj9CA9GOt9yb9nEtFqip5kGtG59CMozd3YvHmb",
-	"/6K86uT9WQPharo9dbRKL3tFriBxmnYvMNtYNCCotdbdZmz+am8jJz159oSgM2tDlzm5XYDTNwij7skt",
-	"1b4GYHX1MWpgjEDR+gv7np963p3Wgb8JAZwyxl3J9L6RGDqQLa4CGFmCoYwa6musdkW1R05rVU/zXS8v",
-	"VC416L2oJwSEZ3DxeQOlKc1054Et5aqv5AkPzRkXn8t3F3uW5HQO7Vdp+6rep4OMDsaOR3dDLuBuKHI8",
-	"uhvyXMGKy775R+8F5fFdLmklAqcKL7Pr9Wp+C+5he6OW2zVhu74P7+/o3RZy1Gl9Q4Soo+ouU5So6sHo",
-	"eNLX+hpp+kbs9uOeLQ27woDGX99iCFccHBz1LiU0e1jXIrmLN8h0cHwPwa+Ku8eHB8+nLybfGhAb9du2",
-	"d7n6S0TeCB1XVZ+lQdQfSOrnMIvCnQGF4cjPrcgPIUH9uNfg7Bd+R04VNzyhGTn9+LMenGAu3OP+I40H",
-	"Eqmc08ndxkGnFZyrcn0KuaFDiaiy1TdNbv4t1f/31vHftE2x887ERSH8A8z31v05280MrhzAyd/bNawL",
-	"Xh1L7z5iCv6lgHIsgeGsHKy4NbFbqT6XI0z3kFQtmWx08td+YNJtlbtEoImGe8OEhLr5iC+VO0OVaMD8",
-	"Y+t8IisLo2GWgky5WqpaUxoG+X0m7RfMupO6wuSFIbmSrEiAkfgeQ7nAzFjKK5SRUnQnKQMGIX3Mb9j9",
-	"KhW8pYjefpNev7c3qOlsWWJP47mL7oPSl77EGgBjq7F23WF58GhKEq43CmOY18qU0EbLMGQ6t3YNbpee",
-	"Zo2m+1i5qCeTbfWUdX4jye2CJwtCveEGFrkmlDEFWjcbv+28Xq55vTz175XVW2VHouVjpXeyaBTpwi6g",
-	"IQWUZ4Wy/abPJ6MoKScp1xsp+lBlrLCTevhsMuk0jEuse5AyDYkUTBOaGlBeRPaxqbCjdcwpnIHCZpHy",
-	"DBhhhVsNDqSFTeVnk6Pnky0bvYHYyyqdt+a37gu/gqP4fG5vryJOS5LDisX2duXsawtwaK/eWqqsbfR+",
-	"iyqH3loVB7tONGzL64uRXccaV6pvWenijfWosmkq1dFwHTtOXIe2WXX0XmCVn0suTNjI1P5Jxjv1LcTE",
-	"FzzItoJqcyrlgpGlVNDz5tRtaj7YqQNkDM1d+gcrEheGLPh8kd0TXcznoA2wvS6Lm9d8bB3htkISKQxN",
-	"rPpgSXkWzaJP8k9I/08BW1Czl8hld6wTLP0V1zkWUaBstCrXwOz8Zl2a1pin3RNbWIEgK07JaSYLVu7I",
-	"SGWnPdzYZqzvwjPhWzk3C1yVk8PoYG+yN7H9QA6C5jyaRYd7k71D7PKpWdi4uM899D7zGG0y7q3dwp26",
-	"xkOhkbcWyfbpTRupAHlTro5keBCjlltftFMsTDehYI1Ocl4yUw2fI5dVQZuXkt3vtGY8dGTtXrJ3WYl8",
-	"6OxgTyc/PdoKdH3y3rMIff4fpPVoMlmHJxC2X9sMtyvRxXJJ1X1Nl5Um7YHKHFbTfRcH19uDezyojIEg",
-	"3f0GsUnVH6fV68VTK7u5CP4P03h4i3kalTv8TW31KD28rd9UTUy//l8WPGOaZFybxsbfD/pHGwB4Z3Wx",
-	"vrBbP6yA0BXlLtNuMJXXGPBhBVm11XcZfsfzjXazbW+strXdawSTx7tt3fr7ExnEeWwoF6SSJbkM9XBD",
-	"P+H3RDQo25bsZ696DOiflUd8cPlLM8k/L7JsziU7J4ZgHHp/W4w4e/QY8HEa3EN/t/Pv/qsSt8C9qz4n",
-	"T0hVbRzaouMJg0ZtBUv3Bo0eq/ELWpazed/PYC/sI5KuhRk3WrD7Uu5JA32/u5ZW7zj1HrkSGWgEwkLf",
-	"ltUuuriXVF3+1tQtnRGdK6CM0ERJrcmyyAzPM2jjfCfJEtQc0UhFGLAiaBBL/hwUdh5uMGcWXFdraGPC",
-	"92CP8LQcc/5GeJP8er+jyYmNei+RSkHMrSS6iCtqb3mWEbjj2oyIFNCUzG9Vs2GR4AFsQV66RbPNUdIm",
-	"uzfcTgvrv7Be8/OG6sh+709VH0Y7w9kf8w6Hc7/4Hn7e//r64foJc3h7svl4Xoggh9tBqh9kNv0WFbvN",
-	"c9o+W+2gzLf/an3ODVGw4pr78fPJ+zM74IgLnhmSKrncnKn9bU+onPKKIXnw/8GQxnls6Ut/WLMLgF21",
-	"39WaRfvRw/XDfwMAAP//",
+
+	"7H1bd9s2t+BfwdLMg30WLcuX9OKnz3HS1tM0ydhJeta0WToQCUmoKYAFQDv6svzfZ23cSYISldhue2ae",
+	"4oi4bmxs7Pv+PMr5quKMMCVHZ59HFRZ4RRQR5n/1rKT59BVdUQX/L4jMBa0U5Wx0NvoFf6KreoVYvZoR",
+	"gfgcCSLrUkmkOBJE1YKNshGFpn/WRKxH2YjhFRmdjUo9YDaS+ZKssBl5jutSjc6eTbLRygw8OjuewP8o",
+	"M/87ykZqXUF/yhRZEDG6v8/cGt/M55IkFnnJCppjRSRSS4KkwkJRtkAVlxRawKrhg14gEqTEit4S2AD8",
+	"CrApiSJIEgUtqSIrGAgrtMIqX4auPRvlZlXJncZbm2za2lXNfuJS/UBJWcjuDl+QOWVEorn+DkufEQt+",
+	"UiDK9CIFkRVnkox/hzMhn6qSF2R0pkRN0is3ozVWXgleEaEoMYvAqrmf30ZLLvVeFVY1dBU1G33MRhpq",
+	"0JQw2OtvI1qMMtcY2kRdpCp4Db+XlN1IDdVbwhQX66nulWOWk3IK7ckoGxV0Pg/dppL+G34tsVTTuiqw",
+	"IsW0IKXCuqmsSrye6v199PCWSlC2GN37H7AQeD26Dz/w2R8kV9BCqnUJvxSEVG/8r+1TKhUR3VM6L0t+",
+	"J9GcCzTXTQALZ1iSAnGGbrGgvJYoFxQ+4aFnpOfqP6MG8M4+j/6nIPPR2eh/HIZLf2j6ykO7jUvX5bJ4",
+	"XZclnpVkdG+O6ezziLmf7Kpa0+lJOoAt8YyUcuD8VzV7pdvHs0sibmlOBg5xbVqHAdJnqTFu4Ii68bYB",
+	"u8gBgLMXT0/1HBdX5M+aSE2ocs4UYfpPXFUlkCnK2eEfkmtYh0PdtMKXQnCgFvdZC+Ge4wK5ye6z0Q9c",
+	"zGhREPb4M5/nOZHS0dAFvSUM6A+vRU4QlYhxhTBcB1LAyl5z9QOvWfH4C3u3JGEhBSdmKeQTleas7AAw",
+	"/nlF3/EbA60mkueCaLqC9SrnXKzgLyCH5EDRFRklSAv5VFFB5KY+7ZvVGYMWjb51relhp5khDYlbyMVi",
+	"ABV4IxaXhUXcP2sqSOEJth3ATpHFgGjs8GPicjhwXpg++nzL8s18dPbb5vW4jqP7rH0Qyp1P95D1J0DA",
+	"ShBJmHKv4HmtllzQf2usQkuCCyIyxFm5RuSWiPBq3i2J6WFGokCZzcphqxi4gtHZqCrU9HT20+Tu2c2f",
+	"k/9T/q+T1XH+n+xIfnf7v9ff43ffkqtv6stn/O1p+fPJHz8ed4+rBWazoy78PkYQvGRVrbpY2cSwFkTo",
+	"iiA8V0SguyW1XIvfmCAwCSmy6OfobsCwiM71/wwrMwzlHR62eRX434xIdAdMVGMhNbyFcy4aIL64RBWt",
+	"SEkZzLLCn14RtlDL0dmRZQ39/7OHRfkmtvfg9AciJOUJIiErktO5JV9dMLzFauk4zzcVYedvL1Gji/t4",
+	"ayeIQXKIK3oIrMyM85sDYGuAFSXi8Pb4kFeE4YqONcFMQOQ2LDgMeLsdM8M6mjtLweVCs2iGD9LY+uG4",
+	"C6C5Z5M2Hc1VzWQ83M6Hmo0qQVlOK1xu6/HWN+xFhTBW5jbQD4DerT/BBjS/Npznu6pZ4gbYIbLU/lPb",
+	"fkFm9eICV6oWJCEu1EKjzNSIAp6IUKa+OR11xZ9stCJqyYstb1nnkzAsz3TGi/XGBr39Db/WP0DgHLtr",
+	"BmooFV5Vw3mDWpSJadovgx83Oo5oJx5aZrxIoIrh3oJOe7PpQ61Kvl5Znqx5pLiiU0sY9P+9nLflQXdU",
+	"syNzZaCMSKoafqQKCXJLoZ95yd9eojss0aympUJzwVcp2M4JBmzcuqgfXDvPBE4jStl6wrDCILch09CR",
+	"aKsvKDSHeyeoUoQhvMCUSRWWFkv38fnafXdmz5pAjnaUOizD+HbOaUWkxIvEa/xTvcIMCYIL4DwRge7I",
+	"tY6fnF+MngIZyKJSv7mw0aOtL4cbLrXeH6Lj6aKWZgwS+o5fl0QtidAANwRMYwPOc1Ipqf+2Xf2UM85L",
+	"gjXG3RApSdk/6s/6O+yNMIBKsWGU6UoL6B1Rv8FlQhvD29SsJFIifkuE0KIYqjTPqa8kmq0RRvZ40bzE",
+	"i1HmFSZihvMDYFNH2WjG1fJA/0DYnIucSPejWVT8s/1F90ypPCz9F1iRaZlW8vVAm0oEvZDu1QMkWGT/",
+	"gBURKyo1XiMsCMqXJL8B1puqJbp6fn6B9jg0vKOSaO58jfz7A9NbCXI/OfUdpmo652Kac8ZInubC3EpE",
+	"zSQwXAWVtjkpkCA5rShhSiIYTCtujCLN/g7ihW2eWEL7LQVQeORr4k8WY3vqTNLbSV2on+hi+YrckvLK",
+	"rfLaP1aDqLPv9ytVyws/2SWb8xS5BoXXZZFQuhaEKTqnRCIMEOOicBwtdDnwSibkNDtbeXnoJ2FVhjHq",
+	"UAxQJzb3+ehLWuFPl2ayZ0YWsf876gLqQSQRs8XUuf/M+B27DkqyJmicKDZcdaZpg7+fXWBaIhmawGW4",
+	"peTOXBF7n+DvAMwuH6X1MLFeWD/mlI2AD2BzChSwsK9tgny1wGS1EtGy/RQpkHk06kUTWD4XC8wcJVdO",
+	"ZG2ptGak5GwhkeJtEXUrCr0Ri/fube6+gDkuywQqv/b2logg67ZohQuiKahVeFREUM0VDuC3d5RL4JSn",
+	"edDn9K1RY4Nt96VLs+r92VqRBDyu6b+JnQnBHUG8VlWtkFRcGJXCAyyi71I2wNBaaRadYgoH3woyL+li",
+	"qa4Ilql7dgFvosG7OaYlKcyiBTkw/cyjCXvHevdeGWCfUf15Gp6sPc2gnk6+34+ZC/d5yria+hcQ7pJV",
+	"MkxrUU5rJgjOl1pHGX0ySrSpvnNskeQy3sYSbHOD7yURcHEduaglEQhgLnCu7XR6G01CEtjSP5bGmred",
+	"VPt37cIQls5CPBAOnIIDGRpkxSfEdUv9QDc1PdiJkj2ERLi9XWNFypIqgiiTCrOcOLUk6HLR7enh7TNk",
+	"8TDeJcYns6M5xgfPvpmfHJwWR6cH3x0/++7gm6NnxdEROZ5MvpnEGCyxOqDFQZ+CGBYcrvq2RTcIoL04",
+	"fiONZR4dn5w+23YSKcNJglcZphtuMCtvxCKhIw7YvMEwfGf5QIwCe6UFAKnwrKTSX6cG/7ed6QuTp1W6",
+	"fv3v9LctTxEMYGzs7jb/5g8iQy+oILlCF27KDL3mjHyMrrmMTq3QrS8898o406/k0FuU4A6/Vs0V4DpY",
+	"Z+WX0+g/VRaag1BHg97eiu2r9QC/LFynYdv0Hf1+gxZpk79CXgsBRw3E3fRwFzPGQ3fEMe2OhZlRNhLL",
+	"3JF3TdQaSBkRh7V07PMgecEKACnreUP8iRYbqa8aJ+bPoAHXsCQPso+baIgjBX8tOm7ffnITNTOqY5KQ",
+	"b/KkqsHiBHwMiGFsvhFtPp4cp7iqnAvj8MJ30xVfhH6eFfxaZXNuJGE7Uh90Arf5kMA5elTgFGI9td4T",
+	"W0wdL8T6qmbB1jscmFm/jk/rBNEvCaXee0Y+VUbZYTR/Ra21e5XgOTHcXJbSjztWdbMtosnZJo+755Qt",
+	"GLraSu7FS3TH67IAP6fA8Vo7rjfhztboUHOSDJdgGnMtD29xSUEjP0bvIpXt8WQCZs7OBJa1z1BQRlEF",
+	"Hby+F/5wg9t+wKxrlsG4W7UsIbbtNF/W7GajfGfR1UymxX/EE2u0Ju6k6EQ+kbw2BgB7STpH6lUnfcto",
+	"z5ycqCEsBFEhaZ93bdH7q1fWNa0gBdrTXBZG8cUF4foOPZtM0oq+SnDFc54QLV5So9xb5mjP8DvlGgX9",
+	"nt7TPuICySQ7K5Z5Gv3do9VgLbsL86NOBVlQqYhIMaFBJjBKE1auM8+PtoQGicJIIDlca+2D9JrJDqve",
+	"0ldiiaiSGiCBz/2dJcGq6IrwuseXgNcqQoYMWQ9AibTLDimS6AEqTLlVJfuusWLrK5QHpraD+jekUqhm",
+	"ipaI+pZpzf8dmcHckpdkOsjo5nErwKM7SueGufuUdS56Gnt6QNN7oXqo5o+C19UFr9nmqxzr5hbQBTAJ",
+	"xkbeYth6WiOOJPFK6q8AvORnoIPWGtz9KGrGenvKWjuR9VtZLXYmPnKFy/QnADRliwQWbtH2mDHDksP6",
+	"wh5jJPFA650zBt3GE+1jefThTQc6hcGpd7HiSntrAzZoBPDImTm9BBdF0AT4z7DpYcaEwLFtExD8buxa",
+	"N4HEOFacpHyg2k/dAA7qpev0C/TZ1VvVuKrG/k4DOr21F/s1dBmseHV+7l/nF1IJygVV6wFn99Y1jZ+9",
+	"HUxJ9rgaUnowmRxNJtuMJtE1H8YK28cp8qoY0O+9KDdaDK13+xyXkrQdSq8sNQ3gMcpaLEjz5dK/OP2z",
+	"vV1pyqDpsFEREqxNVDMCXHlw05OEpDnbcc9jnnj4BsDlVzK7MJ00hIb4RJn30BpiIpTZdJuvYkG7x6S3",
+	"Bc00QdD2wYdVJOVeVzxIlWRVy/3C/0Yw1Akvwt3FwK/d/E5e8Vc1syrbpL9wrPXYpLqxEAja37Zs73ia",
+	"IdTGckD32Rf5ae/oY/1otL5hZNud4tZJ36o+jaNlakA+KLn5F7O1ZrOpRPZrhjy/AjKTpV1a9gi0S/Pn",
+	"DFneCMRp47chavsjASkPMyMg14IEofp3FukxN7FZW+3A233VPadrUWszz9HjcI1z3XsgGpzb1rG0vRNV",
+	"+0Ku5GvpwRM+wRt0pg7WZsxN5/RTWpXxRv+BS5CuKTP3G95aPANZ1qg3KLvl5W14nt3N1dibYwbSZiX4",
+	"LS1IMf6dvVtS2RjLebCbqIMDcE/KsRHspzCD9xeQ49/ZL1wQ8APLtNRqBne9Da42rVEzou4IYQh3h9P3",
+	"Sf/io70MI+BpRgtxmaSzkuhBUko2qZC2yGKJbsCtBJZ0bvo0Znhvl0uNmWrtdVQAQKvBEaTiQkkXdOh0",
+	"KwCZ0sb/bTE5tSPY2sYS+xVR781jnDPs6GHO+Xx2+u3keHKAv5kXB6ffnRYH301mzw4KPJngU3wymc2P",
+	"R9l22i/rmV/BdIUZXhCRXNt11BD9YhpuX+bJ97MTPDn+/uDZyfH3B6eT/NsDXBwfHxw9Oz2ePZvP5sbI",
+	"umWZKTNr+zlwVyblot7yG9jOCD8ncy6IV89Q2VDM5sGNQbUVPBGLzIrQJqUh1CFlGP308vyF95rcy7EQ",
+	"a3iKGr0kXTDjSUnnKNi89o3O1z1tlqU2yrLTyfd6CRgZFXd4m/r46oeRN5/0Pfh/REr9q1j/f4LMejnv",
+	"XsKmrBrd4gcRWcfoUjVJAuIsJ61l2PFk5vykDS3AN8TwpVohjp2fvbvR6I6ygt89peibNGb3iME9vIpP",
+	"qNBy1MefppFWdGu2hZp5/hyB1gEB9mVo4uL1gnP2pkQDOwsl1oGdbHYW15PDsUui0jYKgdUyPlWLt4gz",
+	"kjzMXtCk1duNuTQDqeFkQjLCEjNEx2Qc5ByDu06D2xZ21JJQscUJfKvPYHzOETjDDjehTY888mC4U1AJ",
+	"dgYZIDTamqgi3mW8kJ59vI3IuydWIwY8DdycVqykbRyZnMbo1yUtLQHxUQjQ4KLkdeG8oLhAS14WEtVV",
+	"oD0yix0BpNd+uydHnzbQOwyJPWrnOUAFAl7Y8CjEfDJxC4XA1Nqb74h2ytz75uzk7Ah+sJvbR5IbclaL",
+	"hXXnkV5JWJASr2EAsqSsQLO6vIErIMcNmXhJF8tRFoBU8rukB49+05RY/5Xhh9t1hT2I8Y6sqhIr8kBR",
+	"9zaAJ2mfHajq+UI+S2cgAVHtayL+/SAp8eKq6e4LbTMXpO0cGeBH38h6ECeEh60L+QqukZFPMSASgemw",
+	"UGjVFRwQjrZ052+8skhiG+NZa1c7wfhpgnG/VisL0KhLMoAddjfo2nVpZk9piajmgyFNjtWLQZwFStVg",
+	"3rQjwYwscTlPXZqICe45gIj5cHl6drnZu3PKXT5wC2ps1CoGZ/SY77O6Rn9aTRW44Qq77gOWwsTm4xB5",
+	"GF+fJlVpkoeWhjOC6RZK25dOIpBO/0AbMCUMYIAe2vcqDj9IXtdNIZV/V4n2n333n1YefSpD4/abtgXt",
+	"ryOotkJuBGeQ9kQQE9i2t6KsBkq45LXIUIE1I7riTC0z94/98Y6QG+1Rxpl3OP0XdAO1878KTPW/0Kpc",
+	"a07yX7p/uUZyyQWIQYXMELnFZe2E7vfvLlq60wk6Qf+B/gMdtWPNtgebdbJpJDZv0o+FMD5GjJbXpLvD",
+	"ZYn4PAOBoSTAXMBOoYlLO6e1ml0fIkubZlpLOJzMfyFJGPbeRcTK7faLolc8UK/r1QqLdYJ3jVyoNkfJ",
+	"uYZZjy/VgDHMW67d44B1mpHNo67sgbTkRS7s+Yc0hybhiZHW3ZBGTpKULcrYtzopusvhyV5Iwj88bMLz",
+	"sXrtm413MnaoH+y05BeR1JrLyCfrq/2gslEcU/LPiSJrBbQ8SiRZZ1IdKnulbUr9+SgHHYmPu00ciKQs",
+	"34FIaefToc1bWG2mcmOYaOckKn/oy0ZiPzggn7+9HGXt1E5bnoWWiVpPUQmSGxxPcX3dw1WEYabaT9LQ",
+	"qS39VFbybxNteGaaHvAYNRynDdG7I4IgqWhZesVWwybkc7pBD8gd42jKGJ3roRHOweJZkmLhwq90C9Dk",
+	"GEumG9P1dHbOPfPDFEMGi30/nl6W6Sm9VwQX3vshMMl2IirdBgwthehlykwEYmMvmK3v8LqpIbJr8F1D",
+	"tlS9rE0JQCx5Ok/I6OfI5x4KECRMibWBoY98HnZbklawhhrQxBdsSELiYGCcU5DRiJVrtCdqptkvyqzF",
+	"Uade2dN/74/RZeNnZ9J2x6NPYYkZHD1V1sF8hW9IhijLy7qwZ08F0klnM03DeK2gkf22GrfdWOAMYM5N",
+	"wPdG7BcmQ+3rdOY88xHF0dLO2g5/e0N5ph15gDmThDDA3USuuDF63VQV6aGWWFqmgTBUcg7pWIyHeGMG",
+	"tCbK7HSremVDUtmzz4O7v/J8IC4Kalw53jaIf6dnC4l9N7QiCgOZtb4fbU+PMbqIvDGa2XqrWlRcEjke",
+	"JSi0WyplNxtWai1y7Vx4IuWK4ZNRQypkl01Vt0UVXpB25mqdeXvUo0YcOHqJdx0cFBQDB4emuw1eQcov",
+	"XsuBE7jmu0zSepDNUViYfew/5l+IwltPue2r0vY78qFqhCmqe2Yp38fu7rsJ191Q8eP/LGnr8+ERzSH1",
+	"z4lM7pr/d8+eSz/tpzg6Oh2Q2sy4bpmJN8B0MCfpmQ2/jtGzk6Pvjr+ffCkD8hYLwpTxok1q2tXSvjbU",
+	"pA+C54/P0V7HF1vUTB5+Nlq5+0PdcH+MfuCQrMpzJHq2EHdRMy03+zeIMnMCSlBnBdpZZZ9SeW3L/xY/",
+	"LVWDJr4Pjm061jA4MMXtdCjRJwMLZMO20Z7n1PbHjSP7gX5CoG+gOS7RxYeXcjCnmvSV/mJ/zAcL9G2a",
+	"qAYMEriup3UmaiasDx7Tu7ubf6l1zD2SA/PB6+Z/ifeSvqnTneLbY1piAlQXwkayDdvuW9fjIbTHX5I4",
+	"/2sCAb5S49ywBQ1RPFdFuEJ/mb6670XrUIlu/iNG/6wJouGNc17AKxtOLm68r6N2Uwz1BDYSyJ+sd29K",
+	"E2mrZwwkUpFsfu8KbuxEYF7oLvetChw7VqOIZSNL5xKqUHjA6rZfMjbCjVU5dVyZ++NAhm/RXPW2r/IA",
+	"54JOnZKdpn2FpbI34IXuvTtt1cM4+jogTUXo+XVUwtZ56Xrqm9RtleBFnRuvGqfMcSfnxTXOIkYEzniM",
+	"zmXkUF9isSCZTdfQTA5B55GKBSqt0Jyqcm0YOlxKI1CYRWoZY7/hYRgngw6FZ3YC+rXuCKnrtpGQ6JHe",
+	"mqOhGcw9Rm8au9b6mwVRxhHO6+1rlpmgHC5MbxMYbi0KqRQWm4PC/1ZR3w8Vyf1x6xm9cKSxrbiZz10k",
+	"hEFoLcNgeSM7zLZzkwwojfaa2qxYTWW0ni4TAqR+3PcnHmYziBG8e2s4diSW+cYoEaDz6b2Aeqm9G3c1",
+	"VxyMqhki48UYYVRSqYw7MNj9Dk0JigpTYRgFLG96aLgT9LC8iZWoVguq1/ZFMQ8dqj3gQdbqZn0U+q9Y",
+	"WTQkXmQLxd5woyXJddCDNhIEJd2dU+/ZhwPtaWibVlSZbx5eJhXm/rA0m6lnYUNNKfcIbxVGtxD7SJuZ",
+	"IVlXTtMuAM29p+awE+8lsr1ZQ2Fq3lkIUFKXxHNnyMmvtE41R0sh8i6vvH/eV2Qwg6H1W23aqfdgh3FL",
+	"2EwWd7hhX36vWuXCdtES92Buaiv+NjR3o392Ckgf4WcKCZLCf6j73tBUTJvVoEBj67zuQ299zh3jbpHj",
+	"sgxUWPa4N1AVpQOqGbp8EaoC2tzzvFjbp6Ppa9kMAh6o9Ce3LlFE59OSSs1z75CG6qdkdGYjC1UYQ5Jy",
+	"nhw8EvUeQ8x7G0n5LTKz1O5x83QKL31USROfTsJSEZETplwAzdFkEkXOhKBqb1S0zJqvn3k0mWyL/Ugp",
+	"DgboYI19k9uCUthyLG8j2xwuCoBI0uN4w22+7olSv7CZMEMWTNwy3JwHiGJ5Yy6fT92vM18lojZ0tY50",
+	"VJPPKtVwSoZ5vPnXRUn5+2n6tPJupa3K48Eh7w+RWSgF6ndxki9rfj35BlCmZT1bgfQRsyTtYmIODpxJ",
+	"Wuj8ZDZddVGbWqp+/R41v5mcfjcYO68HeXQpQRcLPXvgoVvPyjAFc7uA5NnnVseh6vdW3cizz49/3kOX",
+	"FjRnu5r8Y+ZzV7v/e5FKAn71StMT98C5M2sQDlFuGLZJq5MTaAypOGXKP9fSXnRL0u7IDNknArYtSMhI",
+	"PqesQCsuSCJ7QNda8k7baUlZaL2DTT2AZrVCEMQDb2y9WGjVw7i7xc0OiFrLNOeuVCbO9fGRFabl6Gz0",
+	"B/83mf9LkGKJ1Tjnq64h3F+HF94VwJi0HO9gk+UnFS4SNC5tcfKW4nbU1VhjsCpJz4SewzHeSr7S0uho",
+	"PBlPYNG2jB3Ew48n45NRNqqwWupXIVjbHE2GX6ukPtDPKaM9GPm3tWSt/9D1AmBvwgacQ0MgbaZciLb7",
+	"e6YMWFsoAeg2ExwDQ5mt57aE2OCqpkPdCY3X/i4lSO47tWiPJ98+WMXV2CsyUXf1zc+w1tPJpG8cv7DD",
+	"qELuvVYIWZ9af5bhJHWDhvG1WTVqkSoH/oq6dKqhTlTKQzFDvCyIVMbhwtxp2xocfSQpb0kI4XMaNvOm",
+	"9+LIh2NXxVPCOkZZo9z6b5/TJcTjcm9GFDOUfdjRuJoyHzvHP3l41IyqtXbw7zGQonmGmDWOUL8PSbrw",
+	"i34FMAs4kEaBFcFM+YLqTl3MmQ19SsyJcAEMjVQCAyEMaIMWAjPNg+JC1+PS9cpClWQTZ8UKX8QkHcZs",
+	"iFerFtdeo+DYGXpOsCAC/V5PJie5nl3/Sfa9yxpmVv5Wa18FV2uRgPxfXHrjfcXLMhBB7RES78mp1Zu1",
+	"c6lzl7Dnh7BthsHBbGnq6CMqbX2W4Xfmq8nrENS1pPX+vn3huvTz6MEn30BD/aevvTMXNr1QhP2bKOnh",
+	"Z/0vuLOYi1QSlSzsC7+3KGv6VvnQa+tNAghGTc5En5EFCjoinUyMs+EIYhbRQ1aBgwhU1W1qI13dmmXn",
+	"iSn2aR/cvwwtoMvp9i6+PnsTj67ILb/ZhkdByZWmxMa6HNgzpAMKkyzaZhwIsR6PzX41q/v+zXiwED7z",
+	"KO+tGb95Wn2HfhhKPG8+e/v8aZGjiQepF7DhkGimcPYmrZTATFczYITX4L0og+7G536y9SadEscktmlV",
+	"CzBfUR7WuPLJH3itcm6sVN6Bjwaj2BidK7TiUjlFj1nleIU/jV3aDYn2Yv5yv7miqCxohv4L5M7/QjSq",
+	"CwM6w1qE2pWrKI7NTraNZnZiAR/nVU3VAh/0tk4e4V64AL0nvBwBXbE9mcR18bqcafCnSF+Z5zUtC+nt",
+	"ql7HuCf3NXbSTk2ruFxh3FgQhG8xLW05t15MgYqiJVQUDeWerl36zMdAmFaZzyTNfDjc6K2X+kgo8mam",
+	"MGUowBJde3V243xmWBpJIxjRtcb98kWC3hZQeP0wN5XX+0XfK82gy9jw49dsrPLIjmFcQWKyK40FSc/k",
+	"WrlofuM08+Ll8/c/Ti/O3757f/Vy+ubqx+nli2sdjjPntqQfqHyiiiZYgXLMCAxBrvl0IJYHiYiVAz33",
+	"gZvbSC3WZgX9ViYnZq7T67hJDE+pdIKerSQxrl8vd5TN/4ayeLydofJ4i55ZZHDgTGDe30oFZ1+1J1XC",
+	"/f1YwM1quJ11ap2ySRvO2qZ65vOe/PToZcQuuXrfjv2iKlXVCe1xsUCzkuc3QAizZmJQXQPc/N5KR5go",
+	"lLePsM5vrSnZXSqiEIbUjJyttQIomWn61azTM6xET6diuA6608yAzopnVEupW8C4A5JRkbiUZc1yHTVD",
+	"Bv4zItGS3/VAcBAr+MEd7v+/No/y8Dv4Bu7Qk87OjfP4Ig+38YOXD87vfTj2rJD8akZv95Lzporrrqgw",
+	"ecRVRYENT6DQtgxikpjFB5rAGpsXfDv/F1hK48miJdegiutmQ49t6HKM3pv0rIJIJWjkUmrkG9nyhJIV",
+	"qLwRzgWXEq3qUtGqJO0xX3O0ImJhqwsUpKj9CQIZrIgAXZxzdaLST4AOTPpO6r00/xPR5vJj46xE55rC",
+	"PjfKRXXHkaxnYbV3tCwR+aRfFM5IEzL/GSyjehBoAET++RASqwWbtNUnhSuhScsb0Irr99nO/UhZyB36",
+	"mRy1w9u/mc8lgcrGjyivtd0sH+4WQpeT7V1+4GJGi4Kw1r2Fg912c9J3VqculYefjSixUcd+RVbchYm6",
+	"pLFZI3OuHsyW4fOmpYb6bCfFuks5GxTrf2+FtCRRXl8bv8CsBNFnIdwqKq/SuXM7kM0xQ0t8S1JZdSPG",
+	"E/ql0/COUSQs4Rm/JdFmGtYRk6/++PvhB/gjUaPH1bFZcvE4z+KrHQ50u+3n4ewzVcqr/Zqoh8Mc837l",
+	"3oO0cdPHyXTNyWTbY6OO9DOU8CqvvQMDns9NqvbBGGX8xx5JC9jMdf30CuNHReY3lnTvSKpSj4fLrTnE",
+	"30U7x7r2A1xeBmCCy6b439SbJdrhU/H/V+1DGuzTYgRYaSoE+TGcV3Q70KyZZ9mHLziXZa1gUC47cy5A",
+	"DrEZM91QbgbndSuzpmNMHJCgMyM4Ba9f2i4I9qiuH510uE/s/dHAsyfw/GhgyBbCcvjZ/bmLB0g8QQbq",
+	"rIa5splO2r5M4YfG4wT1nXfClH8Eq2pm7pyE50YH7vXxubpNePnm578Acj8SlQDbAH+fgMV/b5efJEt5",
+	"RTQT2L1cxqchTp/v6DSoXMBkoskuj/SCroERBRm/GygJeqx7bM7vC2jxf2+cNwAfQrfbqadgyu13w+eQ",
+	"/zLkbSYFuv/Yx6HE0i3KebU2QVwhDE6HDGWa79CBy6b2TsjTifYkXkVsSmY0Lk2bUIZMUiJjTjS5DkAB",
+	"DpMycqen0/IzRlHmJ1uYjyoJZV7MreKCLqi2WTv/HrhPcLFklMbLJgdyLI+dwozgres5hO5T0y1KDu5L",
+	"c+wdT45cJkZ9cGjB4xDO/QxgcTo59Y3MkkB0M0UafcX22BeZFbqqHYWN+eSOPtqwZjb0aF6X5XqQmUhX",
+	"lnm8m98oXPP0TNgGG9C7noP5Qmrgw+2ebvE1a2YFN+nlujo02CNuODF1KY3l64dInUQrFiBTo+1kLaRi",
+	"jQ50g1lNS3VAmftu6zNxRmRc5g2SRb+8+nB58fJ6+vPrN7++1ti9R+fh56uXP1y9vP5pevn63curD+ev",
+	"bO2x/TBeQWXOb41xFh4/rej3QvdBW033M5GSlKgiYkVNMQCXWwhbh3vS9OKnIsqYsuEqXTv4PYUU+zPQ",
+	"hyiz185+IHoAfzxdbKh1Iu1+q5PJr1sRcdAKZYAsnTYrU5RQkAjKC4scLc2ZzbBnMOSWl7VxwjRGch85",
+	"4bI5OHfNMEgUMwGR83Ic/tTChhI6U7LBAFO4Qcdt1CUWVG0nkSaleIcdbQPE2M2coQzAo3HK5eOJoTTK",
+	"HtLvKOuoKhUWKjhKuMQb9gz2dOJjSW/Jfs86XP7yAfz0xqTo7XW9ZEX/qsgnt6oxemEIqbd15DZKHCYa",
+	"9yzaJVvfcZGPadeKE9s/kmbpLREHJnGquXnde3wbcswnb/IL72xy56r0FqQq+XoFELd5W6S9uQuqEKTj",
+	"1WSTmqw1mtRrymutVljhGZYEGTAguwBzkJZjuhNUKcJc2UZ79U3DkD8p3OsmCacCyYrkdG6PRXqiMCe6",
+	"PLAvSbT1brsM/I+IBS88NId5bwXgF0RhWnZo88kAB72m3tBk/gIgFYFbb+TIVtxoB9veVXGmV2lHECRX",
+	"5ToKFtb8umXGI7WkntUcrebwO974nlEuOJGet254Q8XlbH32I88VFyFzRVixGQgvFoIsQMBq+o0ZUFAZ",
+	"/GbALtOArm4iDz/rf0Hq2oZFJw/ioLiFE/wRVmO46JNhXPS3Dz79Bm70yqNZ4znXD7wu4WB/DmWA4Rpr",
+	"eiDxWoay3A8YU4wb+O2dVAKqdG9W5+wH+V33IVtct9bewSYvGpo75z+rN9WiM/mUE1IYZ0oqXLoNazS2",
+	"dwFL9/tWLNVHaLWL29UHDgL/qFC6yYOj/INHDnyd0ug6ypPjKXsbjbe8+JdRzFHrSXdxns13fbN79uO/",
+	"oG6KIc8naJIb7UHCSbPsvtqARXydK3h0OLr/eP9/BwA=",
 }
 
 // decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
@@ -4,11 +4,79 @@
 package private
 
 import (
+	"time"
+
 	externalRef0 "playbook-dispatcher/internal/api/controllers/public"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for FeaturesKesselMode.
+const (
+	BothKesselEnforces FeaturesKesselMode = "both-kessel-enforces"
+	BothRbacEnforces   FeaturesKesselMode = "both-rbac-enforces"
+	KesselOnly         FeaturesKesselMode = "kessel-only"
+	RbacOnly           FeaturesKesselMode = "rbac-only"
+)
+
+// Valid indicates whether the value is a known member of the FeaturesKesselMode enum.
+func (e FeaturesKesselMode) Valid() bool {
+	switch e {
+	case BothKesselEnforces:
+		return true
+	case BothRbacEnforces:
+		return true
+	case KesselOnly:
+		return true
+	case RbacOnly:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for KnownServiceSource.
+const (
+	Builtin  KnownServiceSource = "builtin"
+	Config   KnownServiceSource = "config"
+	Database KnownServiceSource = "database"
+)
+
+// Valid indicates whether the value is a known member of the KnownServiceSource enum.
+func (e KnownServiceSource) Valid() bool {
+	switch e {
+	case Builtin:
+		return true
+	case Config:
+		return true
+	case Database:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PreflightReason.
+const (
+	PlaybookHeaderMissing  PreflightReason = "playbook_header_missing"
+	PlaybookUrlUnreachable PreflightReason = "playbook_url_unreachable"
+	RecipientNotConnected  PreflightReason = "recipient_not_connected"
+)
+
+// Valid indicates whether the value is a known member of the PreflightReason enum.
+func (e PreflightReason) Valid() bool {
+	switch e {
+	case PlaybookHeaderMissing:
+		return true
+	case PlaybookUrlUnreachable:
+		return true
+	case RecipientNotConnected:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for RecipientType.
 const (
 	DirectConnect RecipientType = "directConnect"
@@ -51,23 +119,81 @@ func (e RecipientWithConnectionInfoStatus) Valid() bool {
 	}
 }
 
+// Defines values for RunGroupStatusStatus.
+const (
+	Failure RunGroupStatusStatus = "failure"
+	Running RunGroupStatusStatus = "running"
+	Success RunGroupStatusStatus = "success"
+)
+
+// Valid indicates whether the value is a known member of the RunGroupStatusStatus enum.
+func (e RunGroupStatusStatus) Valid() bool {
+	switch e {
+	case Failure:
+		return true
+	case Running:
+		return true
+	case Success:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RunPriority.
+const (
+	High   RunPriority = "high"
+	Low    RunPriority = "low"
+	Normal RunPriority = "normal"
+)
+
+// Valid indicates whether the value is a known member of the RunPriority enum.
+func (e RunPriority) Valid() bool {
+	switch e {
+	case High:
+		return true
+	case Low:
+		return true
+	case Normal:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ApiInternalV2RunHostsListParamsFieldsData.
 const (
-	Host        ApiInternalV2RunHostsListParamsFieldsData = "host"
-	InventoryId ApiInternalV2RunHostsListParamsFieldsData = "inventory_id"
-	Links       ApiInternalV2RunHostsListParamsFieldsData = "links"
-	Run         ApiInternalV2RunHostsListParamsFieldsData = "run"
-	Status      ApiInternalV2RunHostsListParamsFieldsData = "status"
-	Stdout      ApiInternalV2RunHostsListParamsFieldsData = "stdout"
+	CancelState      ApiInternalV2RunHostsListParamsFieldsData = "cancel_state"
+	Diffs            ApiInternalV2RunHostsListParamsFieldsData = "diffs"
+	DisplayName      ApiInternalV2RunHostsListParamsFieldsData = "display_name"
+	Host             ApiInternalV2RunHostsListParamsFieldsData = "host"
+	Id               ApiInternalV2RunHostsListParamsFieldsData = "id"
+	InventoryId      ApiInternalV2RunHostsListParamsFieldsData = "inventory_id"
+	LastUpdatedDelta ApiInternalV2RunHostsListParamsFieldsData = "last_updated_delta"
+	Links            ApiInternalV2RunHostsListParamsFieldsData = "links"
+	Run              ApiInternalV2RunHostsListParamsFieldsData = "run"
+	Status           ApiInternalV2RunHostsListParamsFieldsData = "status"
+	Stdout           ApiInternalV2RunHostsListParamsFieldsData = "stdout"
+	StdoutSize       ApiInternalV2RunHostsListParamsFieldsData = "stdout_size"
 )
 
 // Valid indicates whether the value is a known member of the ApiInternalV2RunHostsListParamsFieldsData enum.
 func (e ApiInternalV2RunHostsListParamsFieldsData) Valid() bool {
 	switch e {
+	case CancelState:
+		return true
+	case Diffs:
+		return true
+	case DisplayName:
+		return true
 	case Host:
 		return true
+	case Id:
+		return true
 	case InventoryId:
 		return true
+	case LastUpdatedDelta:
+		return true
 	case Links:
 		return true
 	case Run:
@@ -76,11 +202,69 @@ func (e ApiInternalV2RunHostsListParamsFieldsData) Valid() bool {
 		return true
 	case Stdout:
 		return true
+	case StdoutSize:
+		return true
 	default:
 		return false
 	}
 }
 
+// ApiToken defines model for ApiToken.
+type ApiToken struct {
+	CreatedAt time.Time          `json:"created_at"`
+	ExpiresAt *time.Time         `json:"expires_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+}
+
+// ApiTokenCreated defines model for ApiTokenCreated.
+type ApiTokenCreated struct {
+	CreatedAt time.Time          `json:"created_at"`
+	ExpiresAt *time.Time         `json:"expires_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Token The token to present in the Authorization header, only ever returned when the token is created
+	Token string `json:"token"`
+}
+
+// ApiTokenInput defines model for ApiTokenInput.
+type ApiTokenInput struct {
+	// ExpiresAt Time after which the token is rejected, the token does not expire if not set
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Name Describes what the token is used for
+	Name string `json:"name"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+}
+
+// ApiVersion defines model for ApiVersion.
+type ApiVersion struct {
+	// Specification Path of the OpenAPI specification of the version
+	Specification string `json:"specification"`
+	Version       string `json:"version"`
+}
+
+// CancelFilterInputV2 defines model for CancelFilterInputV2.
+type CancelFilterInputV2 struct {
+	// Filter Criteria the runs need to match all of, at least one needs to be given
+	Filter RunsCancelFilter `json:"filter"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Principal Username of the user interacting with the service
+	Principal Principal `json:"principal"`
+}
+
 // CancelInputV2 defines model for CancelInputV2.
 type CancelInputV2 struct {
 	// OrgId Identifies the organization that the given resource belongs to
@@ -93,12 +277,61 @@ type CancelInputV2 struct {
 	RunId externalRef0.RunId `json:"run_id"`
 }
 
+// DebugCapture defines model for DebugCapture.
+type DebugCapture struct {
+	DurationMs   int64     `json:"duration_ms"`
+	Method       string    `json:"method"`
+	OrgId        string    `json:"org_id"`
+	RequestBody  string    `json:"request_body"`
+	RequestId    string    `json:"request_id"`
+	ResponseBody string    `json:"response_body"`
+	Status       int       `json:"status"`
+	Timestamp    time.Time `json:"timestamp"`
+	Url          string    `json:"url"`
+}
+
+// Deployment defines model for Deployment.
+type Deployment struct {
+	ApiVersions []ApiVersion `json:"api_versions"`
+
+	// Commit Git revision the API was built from
+	Commit   string   `json:"commit"`
+	Features Features `json:"features"`
+
+	// SchemaVersion Database schema version the code is written against
+	SchemaVersion int `json:"schema_version"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Message Human readable error message
 	Message string `json:"message"`
 }
 
+// Features defines model for Features.
+type Features struct {
+	// ApiTokens Whether the public API accepts API tokens
+	ApiTokens bool `json:"api_tokens"`
+
+	// Kessel Whether Kessel is enabled
+	Kessel bool `json:"kessel"`
+
+	// KesselMode Authorization mode used unless overridden per request by a feature flag
+	KesselMode FeaturesKesselMode `json:"kessel_mode"`
+
+	// PublicRateLimit Whether the public API is rate limited
+	PublicRateLimit bool `json:"public_rate_limit"`
+
+	// Rbac Whether permissions are checked with RBAC (otherwise every principal is allowed)
+	Rbac bool `json:"rbac"`
+
+	// WaitForConnection Whether runs of disconnected recipients wait for the recipient to connect
+	WaitForConnection bool `json:"wait_for_connection"`
+}
+
+// FeaturesKesselMode Authorization mode used unless overridden per request by a feature flag
+type FeaturesKesselMode string
+
 // HighLevelRecipientStatus defines model for HighLevelRecipientStatus.
 type HighLevelRecipientStatus = []RecipientWithConnectionInfo
 
@@ -113,9 +346,40 @@ type HostsWithOrgId struct {
 	OrgId OrgId `json:"org_id"`
 }
 
+// KnownService defines model for KnownService.
+type KnownService struct {
+	// Name Service that triggered the given Playbook run
+	Name externalRef0.Service `json:"name"`
+
+	// Permission Kessel permission to view the runs of the service
+	Permission string             `json:"permission"`
+	Source     KnownServiceSource `json:"source"`
+}
+
+// KnownServiceSource defines model for KnownService.Source.
+type KnownServiceSource string
+
 // OrgId Identifies the organization that the given resource belongs to
 type OrgId = string
 
+// OrgUsage defines model for OrgUsage.
+type OrgUsage struct {
+	// ApiCalls Number of public API calls made within the period
+	ApiCalls int64 `json:"api_calls"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// RunsCreated Number of runs created within the period
+	RunsCreated int64 `json:"runs_created"`
+
+	// StdoutBytes Size of run host output stored for runs created within the period
+	StdoutBytes int64 `json:"stdout_bytes"`
+}
+
+// PreflightReason Check that failed the pre-flight check of a run dispatched with check_recipient (code 409)
+type PreflightReason string
+
 // Principal Username of the user interacting with the service
 type Principal = string
 
@@ -182,6 +446,9 @@ type RunCanceled struct {
 	// Code status code of the request
 	Code int `json:"code"`
 
+	// CorrelationId Unique identifier used to match work request with responses
+	CorrelationId *externalRef0.RunCorrelationId `json:"correlation_id,omitempty"`
+
 	// RunId Unique identifier of a Playbook run
 	RunId externalRef0.RunId `json:"run_id"`
 }
@@ -191,13 +458,150 @@ type RunCreated struct {
 	// Code status code of the request
 	Code int `json:"code"`
 
+	// CorrelationId Unique identifier used to match work request with responses
+	CorrelationId *externalRef0.RunCorrelationId `json:"correlation_id,omitempty"`
+
+	// DryRun How the run would be dispatched, only returned by /internal/v2/dispatch/validate. The code is 200 if the run would be created, otherwise it is the code the dispatch would fail with.
+	DryRun *RunDryRun `json:"dry_run,omitempty"`
+
 	// Id Unique identifier of a Playbook run
 	Id *externalRef0.RunId `json:"id,omitempty"`
 
 	// Message Error Message
 	Message *string `json:"message,omitempty"`
+
+	// Reason Check that failed the pre-flight check of a run dispatched with check_recipient (code 409)
+	Reason *PreflightReason `json:"reason,omitempty"`
+}
+
+// RunDryRun How the run would be dispatched, only returned by /internal/v2/dispatch/validate. The code is 200 if the run would be created, otherwise it is the code the dispatch would fail with.
+type RunDryRun struct {
+	// DispatchChunks Number of requests the hosts of the run would be sent in
+	DispatchChunks int    `json:"dispatch_chunks"`
+	ExecutionMode  string `json:"execution_mode"`
+
+	// Hosts Number of hosts of the run
+	Hosts int `json:"hosts"`
+
+	// PlaybookUrlReachable The playbook URL responded (with a status code below 500)
+	PlaybookUrlReachable bool `json:"playbook_url_reachable"`
+
+	// Protocol Either rhc (directly connected hosts) or satellite
+	Protocol           string `json:"protocol"`
+	RecipientConnected bool   `json:"recipient_connected"`
+
+	// SatelliteRegistered Satellite runs only, whether the Satellite is registered in Sources for the organization with the recipient as its rhc connection
+	SatelliteRegistered *bool `json:"satellite_registered,omitempty"`
+
+	// Timeout Timeout of the run, defaults applied
+	Timeout int `json:"timeout"`
+
+	// WaitsForConnection The recipient is not connected, the run would be kept until it connects
+	WaitsForConnection bool   `json:"waits_for_connection"`
+	WebConsoleUrl      string `json:"web_console_url"`
+}
+
+// RunGroupCounts Number of runs of the group in each status
+type RunGroupCounts struct {
+	Canceled             int `json:"canceled"`
+	Canceling            int `json:"canceling"`
+	Failure              int `json:"failure"`
+	Running              int `json:"running"`
+	Success              int `json:"success"`
+	Timeout              int `json:"timeout"`
+	Total                int `json:"total"`
+	WaitingForConnection int `json:"waiting_for_connection"`
+}
+
+// RunGroupCreated defines model for RunGroupCreated.
+type RunGroupCreated struct {
+	GroupId openapi_types.UUID `json:"group_id"`
+
+	// Runs Result of each recipient, in the order of the recipients
+	Runs []RunCreated `json:"runs"`
+}
+
+// RunGroupInputV3 defines model for RunGroupInputV3.
+type RunGroupInputV3 struct {
+	// ExecutionMode Whether the playbook is run normally (run) or in check mode (check). In check mode Ansible reports the changes it would make, including their diffs, without making them.
+	ExecutionMode *externalRef0.ExecutionMode `json:"execution_mode,omitempty"`
+
+	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
+	Labels *externalRef0.Labels `json:"labels,omitempty"`
+
+	// Name Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
+	Name externalRef0.PlaybookName `json:"name"`
+
+	// OrgId Identifier of the tenant
+	OrgId externalRef0.OrgId `json:"org_id"`
+
+	// Principal Username of the user interacting with the service
+	Principal Principal `json:"principal"`
+
+	// Priority Priority of the run. While the rate limit of Cloud Connector holds up dispatches, the requests of each priority wait in a queue of their own and the queues are drained by weight (6:3:1 by default) so that urgent runs are not delayed behind bulk ones.
+	Priority   *RunPriority        `json:"priority,omitempty"`
+	Recipients []RunGroupRecipient `json:"recipients"`
+
+	// Timeout Amount of seconds after which the run is considered failed due to timeout
+	Timeout *externalRef0.RunTimeout `json:"timeout,omitempty"`
+
+	// Url URL hosting the Playbook
+	Url externalRef0.Url `json:"url"`
+
+	// WaitForConnection Runs of recipients that are not connected are created in the waiting_for_connection state instead of being rejected, see /internal/v2/dispatch.
+	WaitForConnection *bool `json:"wait_for_connection,omitempty"`
+
+	// WebConsoleUrl URL that points to the section of the web console where the user find more information about the playbook run. The field is optional but highly suggested.
+	WebConsoleUrl *externalRef0.WebConsoleUrl `json:"web_console_url,omitempty"`
+}
+
+// RunGroupRecipient defines model for RunGroupRecipient.
+type RunGroupRecipient struct {
+	// Hosts Optionally, information about hosts involved in the Playbook run can be provided.
+	// This information is used to pre-allocate run_host resources.
+	// Moreover, it can be used to create a connection between a run_host resource and host inventory.
+	Hosts *RunInputHosts `json:"hosts,omitempty"`
+
+	// Recipient Identifier of the host to which a given Playbook is addressed
+	Recipient externalRef0.RunRecipient `json:"recipient"`
+
+	// RecipientConfig recipient-specific configuration options
+	RecipientConfig *RecipientConfig `json:"recipient_config,omitempty"`
+}
+
+// RunGroupRun defines model for RunGroupRun.
+type RunGroupRun struct {
+	// Id Unique identifier of a Playbook run
+	Id externalRef0.RunId `json:"id"`
+
+	// Recipient Identifier of the host to which a given Playbook is addressed
+	Recipient externalRef0.RunRecipient `json:"recipient"`
+
+	// Status Current status of a Playbook run. A run that asked to wait for its recipient to connect is waiting_for_connection until it is dispatched. A canceled run is canceling until the recipient acknowledges the cancel.
+	Status externalRef0.RunStatus `json:"status"`
+}
+
+// RunGroupStatus defines model for RunGroupStatus.
+type RunGroupStatus struct {
+	// Counts Number of runs of the group in each status
+	Counts    RunGroupCounts     `json:"counts"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Name Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
+	Name externalRef0.PlaybookName `json:"name"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId         `json:"org_id"`
+	Runs  []RunGroupRun `json:"runs"`
+
+	// Status running as long as any run is running, canceling or waiting for connection, then success if every run succeeded and failure otherwise
+	Status RunGroupStatusStatus `json:"status"`
 }
 
+// RunGroupStatusStatus running as long as any run is running, canceling or waiting for connection, then success if every run succeeded and failure otherwise
+type RunGroupStatusStatus string
+
 // RunInput defines model for RunInput.
 type RunInput struct {
 	// Account Identifier of the tenant
@@ -239,6 +643,12 @@ type RunInputHosts = []struct {
 
 // RunInputV2 defines model for RunInputV2.
 type RunInputV2 struct {
+	// CheckRecipient Before the run is dispatched, check that the recipient is connected and that the playbook URL responds to a HEAD request (carrying the playbook signature if configured). The run is rejected with 409 and a reason otherwise.
+	CheckRecipient *bool `json:"check_recipient,omitempty"`
+
+	// ExecutionMode Whether the playbook is run normally (run) or in check mode (check). In check mode Ansible reports the changes it would make, including their diffs, without making them.
+	ExecutionMode *externalRef0.ExecutionMode `json:"execution_mode,omitempty"`
+
 	// Hosts Optionally, information about hosts involved in the Playbook run can be provided.
 	// This information is used to pre-allocate run_host resources.
 	// Moreover, it can be used to create a connection between a run_host resource and host inventory.
@@ -256,6 +666,9 @@ type RunInputV2 struct {
 	// Principal Username of the user interacting with the service
 	Principal Principal `json:"principal"`
 
+	// Priority Priority of the run. While the rate limit of Cloud Connector holds up dispatches, the requests of each priority wait in a queue of their own and the queues are drained by weight (6:3:1 by default) so that urgent runs are not delayed behind bulk ones.
+	Priority *RunPriority `json:"priority,omitempty"`
+
 	// Recipient Identifier of the host to which a given Playbook is addressed
 	Recipient externalRef0.RunRecipient `json:"recipient"`
 
@@ -268,10 +681,147 @@ type RunInputV2 struct {
 	// Url URL hosting the Playbook
 	Url externalRef0.Url `json:"url"`
 
+	// WaitForConnection If the recipient is not connected the run is created in the waiting_for_connection state instead of being rejected. It is dispatched once the recipient connects, unless that takes longer than the configured window.
+	WaitForConnection *bool `json:"wait_for_connection,omitempty"`
+
+	// WebConsoleUrl URL that points to the section of the web console where the user find more information about the playbook run. The field is optional but highly suggested.
+	WebConsoleUrl *externalRef0.WebConsoleUrl `json:"web_console_url,omitempty"`
+}
+
+// RunLimit defines model for RunLimit.
+type RunLimit struct {
+	// MaxRunning Maximum number of runs running at a time, 0 if not limited
+	MaxRunning int `json:"max_running"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Override Whether the limit is set for the organization rather than the default one
+	Override bool `json:"override"`
+
+	// Running Number of runs of the organization counting against the limit, i.e. running, being canceled or waiting for their recipient to connect
+	Running int `json:"running"`
+}
+
+// RunLimitInput defines model for RunLimitInput.
+type RunLimitInput struct {
+	// MaxRunning Maximum number of runs running at a time, 0 disables the limit
+	MaxRunning int `json:"max_running"`
+}
+
+// RunPriority Priority of the run. While the rate limit of Cloud Connector holds up dispatches, the requests of each priority wait in a queue of their own and the queues are drained by weight (6:3:1 by default) so that urgent runs are not delayed behind bulk ones.
+type RunPriority string
+
+// RunRetryInputV2 defines model for RunRetryInputV2.
+type RunRetryInputV2 struct {
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Principal Username of the user interacting with the service
+	Principal Principal `json:"principal"`
+}
+
+// RunTemplate defines model for RunTemplate.
+type RunTemplate struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Enabled   bool               `json:"enabled"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
+	Labels    externalRef0.Labels `json:"labels"`
+	LastRunAt *time.Time          `json:"last_run_at"`
+
+	// LastRunId Run dispatched last, not set if the last dispatch failed
+	LastRunId *openapi_types.UUID `json:"last_run_id"`
+
+	// Name Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
+	Name externalRef0.PlaybookName `json:"name"`
+
+	// NextRunAt Time the next run is dispatched at, not set while the template is disabled
+	NextRunAt *time.Time `json:"next_run_at"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Principal Username of the user interacting with the service
+	Principal Principal `json:"principal"`
+
+	// Recipient Identifier of the host to which a given Playbook is addressed
+	Recipient externalRef0.RunRecipient `json:"recipient"`
+
+	// Schedule Cron expression (minute, hour, day of month, month, day of week) or one of the @hourly, @daily, @weekly and @monthly shorthands, evaluated in UTC
+	Schedule RunTemplateSchedule `json:"schedule"`
+
+	// Service Service that created the template, runs are dispatched on its behalf
+	Service   string    `json:"service"`
+	Timeout   *int      `json:"timeout"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Url URL hosting the Playbook
+	Url           externalRef0.Url `json:"url"`
+	WebConsoleUrl *string          `json:"web_console_url"`
+}
+
+// RunTemplateInput defines model for RunTemplateInput.
+type RunTemplateInput struct {
+	// Enabled Runs are only dispatched while the template is enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
+	Labels *externalRef0.Labels `json:"labels,omitempty"`
+
+	// Name Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
+	Name externalRef0.PlaybookName `json:"name"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Principal Username of the user interacting with the service
+	Principal Principal `json:"principal"`
+
+	// Recipient Identifier of the host to which a given Playbook is addressed
+	Recipient externalRef0.RunRecipient `json:"recipient"`
+
+	// Schedule Cron expression (minute, hour, day of month, month, day of week) or one of the @hourly, @daily, @weekly and @monthly shorthands, evaluated in UTC
+	Schedule RunTemplateSchedule `json:"schedule"`
+
+	// Timeout Amount of seconds after which the run is considered failed due to timeout
+	Timeout *externalRef0.RunTimeout `json:"timeout,omitempty"`
+
+	// Url URL hosting the Playbook
+	Url externalRef0.Url `json:"url"`
+
 	// WebConsoleUrl URL that points to the section of the web console where the user find more information about the playbook run. The field is optional but highly suggested.
 	WebConsoleUrl *externalRef0.WebConsoleUrl `json:"web_console_url,omitempty"`
 }
 
+// RunTemplateSchedule Cron expression (minute, hour, day of month, month, day of week) or one of the @hourly, @daily, @weekly and @monthly shorthands, evaluated in UTC
+type RunTemplateSchedule = string
+
+// RunsCancelFilter Criteria the runs need to match all of, at least one needs to be given
+type RunsCancelFilter struct {
+	CreatedBefore *time.Time `json:"created_before,omitempty"`
+
+	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
+	Labels *externalRef0.Labels `json:"labels,omitempty"`
+
+	// Service Service that dispatched the runs
+	Service *string `json:"service,omitempty"`
+}
+
+// RunsCancelSummary defines model for RunsCancelSummary.
+type RunsCancelSummary struct {
+	// Canceled Number of runs canceled
+	Canceled int `json:"canceled"`
+
+	// Failed Number of runs that could not be canceled
+	Failed int `json:"failed"`
+
+	// More More runs match the filter than canceled in a single request
+	More bool         `json:"more"`
+	Runs RunsCanceled `json:"runs"`
+}
+
 // RunsCanceled defines model for RunsCanceled.
 type RunsCanceled = []RunCanceled
 
@@ -284,6 +834,13 @@ type SatelliteId = string
 // SatelliteOrgId Identifier of the organization within Satellite
 type SatelliteOrgId = string
 
+// UsageReport defines model for UsageReport.
+type UsageReport struct {
+	Data  []OrgUsage `json:"data"`
+	Since time.Time  `json:"since"`
+	Until time.Time  `json:"until"`
+}
+
 // Version Version of the API
 type Version = string
 
@@ -293,15 +850,36 @@ type BadRequest = Error
 // Forbidden defines model for Forbidden.
 type Forbidden = Error
 
+// NotFound defines model for NotFound.
+type NotFound = Error
+
 // ApiInternalRunsCreateJSONBody defines parameters for ApiInternalRunsCreate.
 type ApiInternalRunsCreateJSONBody = []RunInput
 
+// ApiInternalV2ApiTokensListParams defines parameters for ApiInternalV2ApiTokensList.
+type ApiInternalV2ApiTokensListParams struct {
+	OrgId OrgId `form:"org_id" json:"org_id"`
+}
+
+// ApiInternalV2ApiTokensDeleteParams defines parameters for ApiInternalV2ApiTokensDelete.
+type ApiInternalV2ApiTokensDeleteParams struct {
+	OrgId OrgId `form:"org_id" json:"org_id"`
+}
+
 // ApiInternalV2RunsCancelJSONBody defines parameters for ApiInternalV2RunsCancel.
 type ApiInternalV2RunsCancelJSONBody = []CancelInputV2
 
+// ApiInternalV2DebugCapturesParams defines parameters for ApiInternalV2DebugCaptures.
+type ApiInternalV2DebugCapturesParams struct {
+	OrgId *OrgId `form:"org_id,omitempty" json:"org_id,omitempty"`
+}
+
 // ApiInternalV2RunsCreateJSONBody defines parameters for ApiInternalV2RunsCreate.
 type ApiInternalV2RunsCreateJSONBody = []RunInputV2
 
+// ApiInternalV2RunsValidateJSONBody defines parameters for ApiInternalV2RunsValidate.
+type ApiInternalV2RunsValidateJSONBody = []RunInputV2
+
 // ApiInternalV2RecipientsStatusJSONBody defines parameters for ApiInternalV2RecipientsStatus.
 type ApiInternalV2RecipientsStatusJSONBody = []RecipientWithOrg
 
@@ -323,17 +901,78 @@ type ApiInternalV2RunHostsListParams struct {
 // ApiInternalV2RunHostsListParamsFieldsData defines parameters for ApiInternalV2RunHostsList.
 type ApiInternalV2RunHostsListParamsFieldsData string
 
+// ApiInternalV2RunTemplatesListParams defines parameters for ApiInternalV2RunTemplatesList.
+type ApiInternalV2RunTemplatesListParams struct {
+	OrgId OrgId `form:"org_id" json:"org_id"`
+}
+
+// ApiInternalV2RunTemplatesDeleteParams defines parameters for ApiInternalV2RunTemplatesDelete.
+type ApiInternalV2RunTemplatesDeleteParams struct {
+	OrgId OrgId `form:"org_id" json:"org_id"`
+}
+
+// ApiInternalV2RunTemplatesGetParams defines parameters for ApiInternalV2RunTemplatesGet.
+type ApiInternalV2RunTemplatesGetParams struct {
+	OrgId OrgId `form:"org_id" json:"org_id"`
+}
+
+// ApiInternalV2RunTemplatesUpdateParams defines parameters for ApiInternalV2RunTemplatesUpdate.
+type ApiInternalV2RunTemplatesUpdateParams struct {
+	OrgId OrgId `form:"org_id" json:"org_id"`
+}
+
+// ApiInternalV2UsageParams defines parameters for ApiInternalV2Usage.
+type ApiInternalV2UsageParams struct {
+	// OrgId Restricts the report to a single organization
+	OrgId *OrgId `form:"org_id,omitempty" json:"org_id,omitempty"`
+
+	// Since Start of the reporting period (inclusive)
+	Since time.Time `form:"since" json:"since"`
+
+	// Until End of the reporting period (exclusive). Defaults to the current time.
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// ApiInternalV3GroupsGetParams defines parameters for ApiInternalV3GroupsGet.
+type ApiInternalV3GroupsGetParams struct {
+	OrgId OrgId `form:"org_id" json:"org_id"`
+}
+
 // ApiInternalRunsCreateJSONRequestBody defines body for ApiInternalRunsCreate for application/json ContentType.
 type ApiInternalRunsCreateJSONRequestBody = ApiInternalRunsCreateJSONBody
 
+// ApiInternalV2ApiTokensCreateJSONRequestBody defines body for ApiInternalV2ApiTokensCreate for application/json ContentType.
+type ApiInternalV2ApiTokensCreateJSONRequestBody = ApiTokenInput
+
 // ApiInternalV2RunsCancelJSONRequestBody defines body for ApiInternalV2RunsCancel for application/json ContentType.
 type ApiInternalV2RunsCancelJSONRequestBody = ApiInternalV2RunsCancelJSONBody
 
+// ApiInternalV2RunsCancelFilterJSONRequestBody defines body for ApiInternalV2RunsCancelFilter for application/json ContentType.
+type ApiInternalV2RunsCancelFilterJSONRequestBody = CancelFilterInputV2
+
 // ApiInternalHighlevelConnectionStatusJSONRequestBody defines body for ApiInternalHighlevelConnectionStatus for application/json ContentType.
 type ApiInternalHighlevelConnectionStatusJSONRequestBody = HostsWithOrgId
 
 // ApiInternalV2RunsCreateJSONRequestBody defines body for ApiInternalV2RunsCreate for application/json ContentType.
 type ApiInternalV2RunsCreateJSONRequestBody = ApiInternalV2RunsCreateJSONBody
 
+// ApiInternalV2RunsValidateJSONRequestBody defines body for ApiInternalV2RunsValidate for application/json ContentType.
+type ApiInternalV2RunsValidateJSONRequestBody = ApiInternalV2RunsValidateJSONBody
+
 // ApiInternalV2RecipientsStatusJSONRequestBody defines body for ApiInternalV2RecipientsStatus for application/json ContentType.
 type ApiInternalV2RecipientsStatusJSONRequestBody = ApiInternalV2RecipientsStatusJSONBody
+
+// ApiInternalV2RunLimitsUpdateJSONRequestBody defines body for ApiInternalV2RunLimitsUpdate for application/json ContentType.
+type ApiInternalV2RunLimitsUpdateJSONRequestBody = RunLimitInput
+
+// ApiInternalV2RunTemplatesCreateJSONRequestBody defines body for ApiInternalV2RunTemplatesCreate for application/json ContentType.
+type ApiInternalV2RunTemplatesCreateJSONRequestBody = RunTemplateInput
+
+// ApiInternalV2RunTemplatesUpdateJSONRequestBody defines body for ApiInternalV2RunTemplatesUpdate for application/json ContentType.
+type ApiInternalV2RunTemplatesUpdateJSONRequestBody = RunTemplateInput
+
+// ApiInternalV2RunsRetryJSONRequestBody defines body for ApiInternalV2RunsRetry for application/json ContentType.
+type ApiInternalV2RunsRetryJSONRequestBody = RunRetryInputV2
+
+// ApiInternalV3RunsCreateJSONRequestBody defines body for ApiInternalV3RunsCreate for application/json ContentType.
+type ApiInternalV3RunsCreateJSONRequestBody = RunGroupInputV3
@@ -0,0 +1,100 @@
+package private
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+type orgCount struct {
+	OrgId string
+	Count int64
+}
+
+func (this *controllers) ApiInternalV2Usage(ctx echo.Context, params ApiInternalV2UsageParams) error {
+	until := time.Now()
+	if params.Until != nil {
+		until = *params.Until
+	}
+
+	if !params.Since.Before(until) {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: "since must be before until"})
+	}
+
+	db := this.database.WithContext(ctx.Request().Context())
+
+	withOrg := func(query *gorm.DB, column string) *gorm.DB {
+		if params.OrgId != nil {
+			return query.Where(column+" = ?", string(*params.OrgId))
+		}
+		return query
+	}
+
+	var runs, stdout, apiCalls []orgCount
+
+	runsQuery := db.Table("runs").
+		Select("org_id, count(*) AS count").
+		Where("created_at >= ? AND created_at < ?", params.Since, until).
+		Group("org_id")
+
+	if err := withOrg(runsQuery, "org_id").Scan(&runs).Error; err != nil {
+		return err
+	}
+
+	stdoutQuery := db.Table("run_hosts").
+		Select("runs.org_id AS org_id, coalesce(sum(octet_length(run_hosts.log)), 0) AS count").
+		Joins("INNER JOIN runs on runs.id = run_hosts.run_id").
+		Where("runs.created_at >= ? AND runs.created_at < ?", params.Since, until).
+		Group("runs.org_id")
+
+	if err := withOrg(stdoutQuery, "runs.org_id").Scan(&stdout).Error; err != nil {
+		return err
+	}
+
+	apiCallsQuery := db.Table("api_usage").
+		Select("org_id, sum(calls) AS count").
+		Where("period >= ? AND period < ?", params.Since, until).
+		Group("org_id")
+
+	if err := withOrg(apiCallsQuery, "org_id").Scan(&apiCalls).Error; err != nil {
+		return err
+	}
+
+	usage := map[string]*OrgUsage{}
+	get := func(orgId string) *OrgUsage {
+		if _, ok := usage[orgId]; !ok {
+			usage[orgId] = &OrgUsage{OrgId: OrgId(orgId)}
+		}
+		return usage[orgId]
+	}
+
+	for _, value := range runs {
+		get(value.OrgId).RunsCreated = value.Count
+	}
+
+	for _, value := range stdout {
+		get(value.OrgId).StdoutBytes = value.Count
+	}
+
+	for _, value := range apiCalls {
+		get(value.OrgId).ApiCalls = value.Count
+	}
+
+	data := make([]OrgUsage, 0, len(usage))
+	for _, value := range usage {
+		data = append(data, *value)
+	}
+
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].OrgId < data[j].OrgId
+	})
+
+	return ctx.JSON(http.StatusOK, UsageReport{
+		Since: params.Since,
+		Until: until,
+		Data:  data,
+	})
+}
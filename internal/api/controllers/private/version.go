@@ -2,6 +2,10 @@ package private
 
 import (
 	"net/http"
+	"playbook-dispatcher/internal/api/controllers/public"
+	"playbook-dispatcher/internal/common/db"
+	"playbook-dispatcher/internal/common/unleash/features"
+	"playbook-dispatcher/internal/common/utils"
 
 	"github.com/labstack/echo/v4"
 )
@@ -9,3 +13,24 @@ import (
 func (this *controllers) ApiInternalVersion(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, Version(this.config.GetString("build.commit")))
 }
+
+func (this *controllers) ApiInternalV2Version(ctx echo.Context) error {
+	versions := make([]ApiVersion, len(public.ApiVersions))
+	for i, version := range public.ApiVersions {
+		versions[i] = ApiVersion{Version: version, Specification: public.SpecificationPath(version)}
+	}
+
+	return ctx.JSON(http.StatusOK, Deployment{
+		Commit:        this.config.GetString("build.commit"),
+		SchemaVersion: db.SchemaVersion,
+		ApiVersions:   versions,
+		Features: Features{
+			Rbac:              this.config.GetString("rbac.impl") == "impl",
+			Kessel:            this.config.GetBool("kessel.enabled"),
+			KesselMode:        FeaturesKesselMode(features.GetKesselAuthMode(this.config, utils.GetLogFromEcho(ctx))),
+			ApiTokens:         this.config.GetBool("public.api.tokens.enabled"),
+			PublicRateLimit:   this.config.GetBool("public.ratelimit.enabled"),
+			WaitForConnection: this.config.GetBool("wait.for.connection.enabled"),
+		},
+	})
+}
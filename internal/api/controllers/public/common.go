@@ -1,12 +1,16 @@
 package public
 
 import (
+	"errors"
 	"fmt"
-	"math"
-	"net/url"
+	"playbook-dispatcher/internal/api/middleware"
+	"playbook-dispatcher/internal/api/pagination"
 	"playbook-dispatcher/internal/common/utils"
-	"strconv"
+	"slices"
 	"strings"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
 )
 
 const defaultLimit = 50
@@ -28,6 +32,65 @@ func getOffset(offset *Offset) int {
 	return 0
 }
 
+// getCursor returns the cursor of the requested page and whether cursor-based pagination is requested at all
+func getCursor(cursor *Cursor, offset *Offset) (pagination.Cursor, bool, error) {
+	if cursor == nil {
+		return pagination.Cursor{}, false, nil
+	}
+
+	if offset != nil {
+		return pagination.Cursor{}, false, errors.New("cursor cannot be combined with offset")
+	}
+
+	parsed, err := pagination.ParseCursor(*cursor)
+	return parsed, true, err
+}
+
+// orderByKey orders the rows of the table by creation time and ID (newest first unless ascending) and restricts the
+// query to the page of the cursor. One row more than the page holds is fetched to tell whether more results follow,
+// see keysetPage.
+func orderByKey(queryBuilder *gorm.DB, table string, cursor pagination.Cursor, ascending bool, limit int) {
+	// the page before the key is read in reverse order, starting next to the key
+	if cursor.Backward {
+		ascending = !ascending
+	}
+
+	direction, comparison := "desc", "<"
+	if ascending {
+		direction, comparison = "asc", ">"
+	}
+
+	if cursor.Key != nil {
+		queryBuilder.Where(fmt.Sprintf("(%[1]s.created_at, %[1]s.id) %[2]s (?, ?)", table, comparison), cursor.Key.CreatedAt, cursor.Key.ID)
+	}
+
+	queryBuilder.Order(fmt.Sprintf("%s.created_at %s", table, direction))
+	queryBuilder.Order(fmt.Sprintf("%s.id %s", table, direction))
+	queryBuilder.Limit(limit + 1)
+}
+
+// keysetPage trims the rows fetched with orderByKey to the page, in list order, and returns the cursors of the pages
+// around it
+func keysetPage[T any](rows []T, cursor pagination.Cursor, limit int, key func(*T) pagination.Key) ([]T, *pagination.Cursor, *pagination.Cursor) {
+	more := len(rows) > limit
+	if more {
+		rows = rows[:limit]
+	}
+
+	if cursor.Backward {
+		slices.Reverse(rows)
+	}
+
+	var first, last *pagination.Key
+	if len(rows) > 0 {
+		firstKey, lastKey := key(&rows[0]), key(&rows[len(rows)-1])
+		first, last = &firstKey, &lastKey
+	}
+
+	previous, next := cursor.Neighbours(first, last, more)
+	return rows, previous, next
+}
+
 func parseFields(input map[string][]string, key string, knownFields map[string]string, defaults []string) ([]string, error) {
 	selectedFields, ok := input[key]
 
@@ -50,32 +113,21 @@ func parseFields(input map[string][]string, key string, knownFields map[string]s
 	return result, nil
 }
 
-func createLinks(base string, queryString string, limit, offset, total int) Links {
-	lastPage := int(math.Floor(float64(utils.Max(total-1, 0)) / float64(limit)))
-
-	links := Links{
-		First: createLink(base, queryString, limit, 0),
-		Last:  createLink(base, queryString, limit, lastPage*limit),
-	}
-
-	if offset > 0 {
-		previous := createLink(base, queryString, limit, utils.Max(offset-limit, 0))
-		links.Previous = &previous
-	}
-
-	if offset+limit < total {
-		next := createLink(base, queryString, limit, offset+limit)
-		links.Next = &next
-	}
-
-	return links
+// listView is a version of the representation of the rows (R) of a list as items of type T
+type listView[R, T any] struct {
+	// path of the list, used in its links
+	path string
+	// the selectable fields by their name in the representation, mapped to the name used by the queries and conversions
+	fields   map[string]string
+	defaults []string
+	convert  func(ctx echo.Context, row *R, fields []string) (T, error)
 }
 
-func createLink(base string, queryString string, limit, offset int) string {
-	query, _ := url.ParseQuery(queryString)
-
-	query.Set("limit", strconv.Itoa(limit))
-	query.Set("offset", strconv.Itoa(offset))
+// parseFields returns the fields selected using the fields parameter, by name in the representation and internally
+func (this listView[R, T]) parseFields(ctx echo.Context) (names []string, fields []string, err error) {
+	if names, err = parseFields(middleware.GetDeepObject(ctx, "fields"), "data", this.fields, this.defaults); err != nil {
+		return nil, nil, err
+	}
 
-	return fmt.Sprintf("%s?%s", base, query.Encode())
+	return names, utils.MapStrings(names, func(name string) string { return this.fields[name] }), nil
 }
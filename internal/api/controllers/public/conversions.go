@@ -1,8 +1,13 @@
 package public
 
 import (
+	"encoding/json"
+	"fmt"
 	dbModel "playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"github.com/labstack/echo/v4"
 )
 
 const (
@@ -22,13 +27,22 @@ const (
 	fieldCorrelationId = "correlation_id"
 	fieldLinks         = "links"
 	fieldInventoryId   = "inventory_id"
+	fieldCancelState   = "cancel_state"
+	fieldProgress      = "progress"
+	fieldExecutionMode = "execution_mode"
+	fieldParentRunId   = "parent_run_id"
+	fieldDiffs         = "diffs"
 	fieldName          = "name"
 	fieldWebConsoleUrl = "web_console_url"
+
+	fieldStdoutSize       = "stdout_size"
+	fieldLastUpdatedDelta = "last_updated_delta"
+	fieldDisplayName      = "display_name"
 )
 
 var (
-	runFields     = utils.IndexStrings(fieldId, fieldOrgId, fieldRecipient, fieldUrl, fieldLabels, fieldTimeout, fieldStatus, fieldCreatedAt, fieldUpdatedAt, fieldService, fieldCorrelationId, fieldName, fieldWebConsoleUrl)
-	runHostFields = utils.IndexStrings(fieldHost, fieldRun, fieldStatus, fieldStdout, fieldLinks, fieldInventoryId)
+	runFields     = utils.IndexStrings(fieldId, fieldOrgId, fieldRecipient, fieldUrl, fieldLabels, fieldTimeout, fieldStatus, fieldProgress, fieldExecutionMode, fieldParentRunId, fieldCreatedAt, fieldUpdatedAt, fieldService, fieldCorrelationId, fieldName, fieldWebConsoleUrl, fieldLinks)
+	runHostFields = utils.IndexStrings(fieldId, fieldHost, fieldRun, fieldStatus, fieldStdout, fieldLinks, fieldInventoryId, fieldCancelState, fieldDiffs, fieldStdoutSize, fieldLastUpdatedDelta, fieldDisplayName)
 )
 
 var defaultRunFields = []string{
@@ -47,7 +61,7 @@ var defaultRunHostFields = []string{
 	fieldStatus,
 }
 
-func dbRuntoApiRun(r *dbModel.Run, fields []string) *Run {
+func dbRuntoApiRun(ctx echo.Context, r *dbModel.Run, fields []string) *Run {
 	run := Run{}
 
 	for _, field := range fields {
@@ -70,6 +84,14 @@ func dbRuntoApiRun(r *dbModel.Run, fields []string) *Run {
 		case fieldStatus:
 			value := RunStatus(r.Status)
 			run.Status = &value
+		case fieldProgress:
+			value := RunProgress(r.Progress)
+			run.Progress = &value
+		case fieldExecutionMode:
+			value := ExecutionMode(r.ExecutionMode)
+			run.ExecutionMode = &value
+		case fieldParentRunId:
+			run.ParentRunId = r.ParentRunID
 		case fieldName:
 			if r.PlaybookName != nil {
 				value := PlaybookName(*r.PlaybookName)
@@ -90,6 +112,8 @@ func dbRuntoApiRun(r *dbModel.Run, fields []string) *Run {
 		case fieldCorrelationId:
 			value := RunCorrelationId(r.CorrelationID.String())
 			run.CorrelationId = &value
+		case fieldLinks:
+			run.Links = runLinks(ctx, r)
 		default:
 			panic("unknown field " + field)
 		}
@@ -97,3 +121,57 @@ func dbRuntoApiRun(r *dbModel.Run, fields []string) *Run {
 
 	return &run
 }
+
+func dbRunHostToApiRunHost(host *dbModel.RunHost, fields []string) (*RunHost, error) {
+	runHost := RunHost{}
+	runStatus := RunStatus(host.Status)
+
+	for _, field := range fields {
+		switch field {
+		case fieldId:
+			runHost.Id = &host.ID
+		case fieldHost:
+			runHost.Host = utils.StringRef(host.Host)
+		case fieldStdout:
+			runHost.Stdout = utils.StringRef(host.Log)
+		case fieldStatus:
+			runHost.Status = &runStatus
+		case fieldRun:
+			runHost.Run = &Run{
+				Id: &host.RunID,
+			}
+		case fieldLinks:
+			runHost.Links = &RunHostLinks{
+				InventoryHost: inventoryLink(host.InventoryID),
+				Stdout:        utils.StringRef(fmt.Sprintf("/api/playbook-dispatcher/v1/run_hosts/%s/stdout", host.ID)),
+			}
+		case fieldInventoryId:
+			if host.InventoryID != nil {
+				runHost.InventoryId = host.InventoryID
+			}
+		case fieldCancelState:
+			if host.CancelState != nil {
+				cancelState := CancelState(*host.CancelState)
+				runHost.CancelState = &cancelState
+			}
+		case fieldDiffs:
+			if host.Diffs != nil {
+				diffs := RunHostDiffs{}
+				if err := json.Unmarshal(host.Diffs, &diffs); err != nil {
+					return nil, err
+				}
+
+				runHost.Diffs = &diffs
+			}
+		case fieldStdoutSize:
+			runHost.StdoutSize = host.StdoutSize
+		case fieldLastUpdatedDelta:
+			delta := int64(time.Since(host.UpdatedAt).Seconds())
+			runHost.LastUpdatedDelta = &delta
+		case fieldDisplayName:
+			runHost.DisplayName = host.DisplayName
+		}
+	}
+
+	return &runHost, nil
+}
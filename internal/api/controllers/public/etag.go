@@ -0,0 +1,49 @@
+package public
+
+import (
+	"crypto/sha256"
+	"fmt"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+// runsEtag computes the (weak) entity tag of a representation of runs. It changes with the request (fields, filters,
+// pagination), with the version of each run (the time it was last updated and its status, which turns to timeout on
+// read without the run being updated) and with any other values the representation depends on.
+func runsEtag(ctx echo.Context, runs []dbModel.Run, other ...any) string {
+	hash := sha256.New()
+	fmt.Fprintln(hash, ctx.Request().URL.RequestURI())
+
+	for _, run := range runs {
+		fmt.Fprintln(hash, run.ID, run.UpdatedAt.UTC().Format(time.RFC3339Nano), run.Status)
+	}
+
+	fmt.Fprintln(hash, other...)
+
+	return fmt.Sprintf(`W/"%x"`, hash.Sum(nil)[:16])
+}
+
+// notModified sets the ETag header of the response and tells whether the client already holds the representation
+// (If-None-Match), in which case 304 is to be returned instead
+func notModified(ctx echo.Context, etag string) bool {
+	ctx.Response().Header().Set(headerETag, etag)
+
+	for _, value := range strings.Split(ctx.Request().Header.Get(headerIfNoneMatch), ",") {
+		value = strings.TrimSpace(value)
+
+		// weak comparison (RFC 9110, section 13.1.2)
+		if value == "*" || strings.TrimPrefix(value, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,140 @@
+package public
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// rows are flushed to the client in batches of this size
+const exportFlushInterval = 500
+
+// getExportFormat returns the format to export the results of a list in, if an export is requested.
+// Besides the format parameter, CSV can be requested using the Accept header.
+func getExportFormat(ctx echo.Context, format *Format) (ExportFormat, bool) {
+	if format != nil {
+		return *format, *format != ExportFormatJson
+	}
+
+	if strings.Contains(ctx.Request().Header.Get(echo.HeaderAccept), "text/csv") {
+		return ExportFormatCsv, true
+	}
+
+	return ExportFormatJson, false
+}
+
+// exportRows writes all the rows of the query in the given format, as a column (CSV) or attribute (NDJSON) per field.
+// Rows are converted and written as they are read from the database so memory use does not grow with the result set.
+// Errors occurring once the response has started can only be signaled by ending it early.
+func exportRows[T any](ctx echo.Context, query *gorm.DB, format ExportFormat, name string, fields []string, convert func(*T) (interface{}, error)) error {
+	rows, err := query.Rows()
+	if err != nil {
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	defer rows.Close()
+
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s.%s"`, name, format))
+
+	var write func(value interface{}) error
+	flush := response.Flush
+
+	if format == ExportFormatCsv {
+		writer := csv.NewWriter(response)
+		write = func(value interface{}) error {
+			record, err := csvRecord(value, fields)
+			if err != nil {
+				return err
+			}
+
+			return writer.Write(record)
+		}
+
+		flush = func() {
+			writer.Flush()
+			response.Flush()
+		}
+
+		response.Header().Set(echo.HeaderContentType, "text/csv")
+		response.WriteHeader(http.StatusOK)
+
+		if err := writer.Write(fields); err != nil {
+			return nil
+		}
+	} else {
+		write = json.NewEncoder(response).Encode
+
+		response.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+		response.WriteHeader(http.StatusOK)
+	}
+
+	defer flush()
+
+	for count := 1; rows.Next(); count++ {
+		var row T
+		if err := query.ScanRows(rows, &row); err != nil {
+			instrumentation.PlaybookRunReadError(ctx, err)
+			return nil
+		}
+
+		value, err := convert(&row)
+		if err != nil {
+			instrumentation.PlaybookRunReadError(ctx, err)
+			return nil
+		}
+
+		// write errors mean the client is gone
+		if err := write(value); err != nil {
+			return nil
+		}
+
+		if count%exportFlushInterval == 0 {
+			flush()
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		instrumentation.PlaybookRunReadError(ctx, err)
+	}
+
+	return nil
+}
+
+// csvRecord returns the values of the fields of the JSON representation of value.
+// Nested values (e.g. labels) are written as JSON.
+func csvRecord(value interface{}, fields []string) ([]string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return nil, err
+	}
+
+	record := make([]string, len(fields))
+	for i, field := range fields {
+		attribute, ok := attributes[field]
+		if !ok || string(attribute) == "null" {
+			continue
+		}
+
+		var text string
+		if err := json.Unmarshal(attribute, &text); err == nil {
+			record[i] = text
+		} else {
+			record[i] = string(attribute)
+		}
+	}
+
+	return record, nil
+}
@@ -2,15 +2,21 @@ package public
 
 import (
 	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/common/encryption"
+	"playbook-dispatcher/internal/common/runevents"
 
+	"github.com/spf13/viper"
 	"gorm.io/gorm"
 )
 
-func CreateController(database *gorm.DB, cloudConnectorClient connectors.CloudConnectorClient) ServerInterfaceWrapper {
+func CreateController(database *gorm.DB, cloudConnectorClient connectors.CloudConnectorClient, labelCipher *encryption.LabelCipher, config *viper.Viper, runEvents *runevents.Broker) ServerInterfaceWrapper {
 	return ServerInterfaceWrapper{
 		Handler: &controllers{
 			database:             database,
 			cloudConnectorClient: cloudConnectorClient,
+			labelCipher:          labelCipher,
+			config:               config,
+			runEvents:            runEvents,
 		},
 	}
 }
@@ -19,4 +25,7 @@ func CreateController(database *gorm.DB, cloudConnectorClient connectors.CloudCo
 type controllers struct {
 	database             *gorm.DB
 	cloudConnectorClient connectors.CloudConnectorClient
+	labelCipher          *encryption.LabelCipher
+	config               *viper.Viper
+	runEvents            *runevents.Broker
 }
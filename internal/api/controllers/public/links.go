@@ -0,0 +1,45 @@
+package public
+
+import (
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+
+	"github.com/labstack/echo/v4"
+)
+
+// names of the routes the links of runs are generated from, which are the IDs of the operations they serve as found in
+// the embedded specification
+const (
+	RouteRunGet       = "ApiRunGet"
+	RouteRunEvents    = "ApiRunEvents"
+	RouteRunHistory   = "ApiRunHistoryGet"
+	RouteRunHostsList = "ApiRunHostsList"
+	// served by the internal API
+	RouteRunsCancel = "ApiInternalV2RunsCancel"
+)
+
+// runLinks links the resources related to the run using the routes of the server so that the links follow the routes.
+// Requires the ID, status and web console URL of the run.
+func runLinks(ctx echo.Context, run *dbModel.Run) *RunLinks {
+	router := ctx.Echo()
+	id := run.ID.String()
+
+	links := RunLinks{
+		Self:    utils.StringRef(router.Reverse(RouteRunGet, id)),
+		Hosts:   utils.StringRef(router.Reverse(RouteRunHostsList) + "?filter[run][id]=" + id),
+		History: utils.StringRef(router.Reverse(RouteRunHistory, id)),
+		Events:  utils.StringRef(router.Reverse(RouteRunEvents, id)),
+	}
+
+	if status.Status(run.Status) == status.Running {
+		links.Cancel = utils.StringRef(router.Reverse(RouteRunsCancel))
+	}
+
+	if run.PlaybookRunUrl != "" {
+		webConsole := WebConsoleUrl(run.PlaybookRunUrl)
+		links.WebConsole = &webConsole
+	}
+
+	return &links
+}
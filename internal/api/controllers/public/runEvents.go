@@ -0,0 +1,133 @@
+package public
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/runevents"
+	"playbook-dispatcher/pkg/status"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+func (this *controllers) ApiRunEvents(ctx echo.Context, runId RunId) error {
+	db := this.database.WithContext(ctx.Request().Context())
+
+	// subscribe before reading the current state so that no change made in between is missed
+	changes, unsubscribe := this.runEvents.Subscribe(runId)
+	defer unsubscribe()
+
+	runStatus, err := currentRunStatus(ctx, db, runId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, &Error{Message: "Run not found"})
+		}
+
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	var hosts []dbModel.RunHost
+	if err := db.Select("host", "inventory_id", "status").Where("run_id = ?", runId).Order("host").Find(&hosts).Error; err != nil {
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	// disables response buffering of nginx-based proxies
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+
+	snapshot := []runevents.Change{{Type: runevents.TypeRun, RunID: runId, Status: string(runStatus)}}
+	for _, host := range hosts {
+		name := host.Host
+		if host.InventoryID != nil {
+			name = host.InventoryID.String()
+		}
+
+		snapshot = append(snapshot, runevents.Change{Type: runevents.TypeHost, RunID: runId, Host: name, Status: host.Status})
+	}
+
+	if err := writeEvents(response, snapshot...); err != nil || runStatus.IsTerminal() {
+		return nil
+	}
+
+	heartbeat := time.NewTicker(this.config.GetDuration("run.events.heartbeat.interval") * time.Second)
+	defer heartbeat.Stop()
+
+	deadline := time.NewTimer(this.config.GetDuration("run.events.max.duration") * time.Second)
+	defer deadline.Stop()
+
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-deadline.C:
+			return nil
+		case change, ok := <-changes:
+			// the subscriber fell behind or the API is shutting down, the client is expected to reconnect
+			if !ok {
+				return nil
+			}
+
+			if err := writeEvents(response, change); err != nil {
+				return nil
+			}
+
+			if change.Type == runevents.TypeRun && status.Status(change.Status).IsTerminal() {
+				return nil
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(response, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+
+			response.Flush()
+
+			// runs that time out or are canceled reach their final status without a change being published
+			current, err := currentRunStatus(ctx, db, runId)
+			if err != nil {
+				instrumentation.PlaybookRunReadError(ctx, err)
+				return nil
+			}
+
+			if current.IsTerminal() {
+				_ = writeEvents(response, runevents.Change{Type: runevents.TypeRun, RunID: runId, Status: string(current)})
+				return nil
+			}
+		}
+	}
+}
+
+// currentRunStatus returns the status of the run as reported by the API, i.e. including timeouts
+func currentRunStatus(ctx echo.Context, db *gorm.DB, runId RunId) (status.Status, error) {
+	var dbRun dbModel.Run
+	if err := visibleRun(ctx, db, runId, []string{fieldStatus}).First(&dbRun).Error; err != nil {
+		return "", err
+	}
+
+	return status.Status(dbRun.Status), nil
+}
+
+func writeEvents(response *echo.Response, changes ...runevents.Change) error {
+	for _, change := range changes {
+		data, err := json.Marshal(change)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(response, "event: %s\ndata: %s\n\n", change.Type, data); err != nil {
+			return err
+		}
+	}
+
+	response.Flush()
+	return nil
+}
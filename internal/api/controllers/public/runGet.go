@@ -0,0 +1,104 @@
+package public
+
+import (
+	"errors"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/api/middleware"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+
+	"github.com/labstack/echo/v4"
+	identityMiddleware "github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"gorm.io/gorm"
+)
+
+func (this *controllers) ApiRunGet(ctx echo.Context, runId RunId) error {
+	db := this.database.WithContext(ctx.Request().Context())
+
+	// the run is returned in full
+	fields := utils.MapKeysString(runFields)
+
+	var dbRun dbModel.Run
+	if err := visibleRun(ctx, db, runId, fields).First(&dbRun).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, &Error{Message: "Run not found"})
+		}
+
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	var err error
+	if dbRun.Labels, err = this.labelCipher.Decrypt(dbRun.Labels); err != nil {
+		utils.GetLogFromEcho(ctx).Warnw("Error decrypting run labels", "run_id", dbRun.ID, "error", err)
+	}
+
+	var counts []struct {
+		Status string
+		Count  int
+	}
+
+	err = db.Table("run_hosts").
+		Select("status, count(*) as count").
+		Where("run_id = ?", dbRun.ID).
+		Group("status").
+		Scan(&counts).Error
+	if err != nil {
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	hosts := RunHostCounts{}
+	for _, count := range counts {
+		hosts.Total += count.Count
+
+		switch status.Status(count.Status) {
+		case status.Running:
+			hosts.Running += count.Count
+		case status.Success:
+			hosts.Success += count.Count
+		case status.Failure:
+			hosts.Failure += count.Count
+		case status.Timeout:
+			hosts.Timeout += count.Count
+		case status.Canceled:
+			hosts.Canceled += count.Count
+		}
+	}
+
+	if notModified(ctx, runsEtag(ctx, []dbModel.Run{dbRun}, hosts)) {
+		return ctx.NoContent(http.StatusNotModified)
+	}
+
+	run := dbRuntoApiRun(ctx, &dbRun, fields)
+	run.Hosts = &hosts
+
+	return ctx.JSON(http.StatusOK, run)
+}
+
+// visibleRun selects the given fields of the run if it is visible to the requester
+func visibleRun(ctx echo.Context, db *gorm.DB, runId RunId, fields []string) *gorm.DB {
+	return visibleRuns(ctx, db).
+		Select(utils.MapStrings(fields, mapFieldsToSql)).
+		Where("runs.id = ?", runId)
+}
+
+// visibleRuns restricts the query to the runs visible to the requester
+func visibleRuns(ctx echo.Context, db *gorm.DB) *gorm.DB {
+	identity := identityMiddleware.GetIdentity(ctx.Request().Context())
+
+	queryBuilder := db.Table("runs").Where("org_id = ?", identity.Identity.OrgID)
+
+	if allowedServices := middleware.GetAllowedServices(ctx); len(allowedServices) > 0 {
+		queryBuilder.Where("service IN ?", allowedServices)
+	}
+
+	// users without access to any service may access the runs they own
+	if ownedRuns, ok := middleware.GetOwnedRuns(ctx); ok {
+		queryBuilder.Where("runs.id IN ?", ownedRuns)
+	}
+
+	return queryBuilder
+}
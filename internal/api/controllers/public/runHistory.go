@@ -0,0 +1,44 @@
+package public
+
+import (
+	"errors"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+func (this *controllers) ApiRunHistoryGet(ctx echo.Context, runId RunId) error {
+	db := this.database.WithContext(ctx.Request().Context())
+
+	var dbRun dbModel.Run
+	if err := visibleRun(ctx, db, runId, []string{fieldId}).First(&dbRun).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, &Error{Message: "Run not found"})
+		}
+
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	var events []dbModel.RunEvent
+	if err := db.Where("run_id = ?", dbRun.ID).Order("created_at").Order("id").Find(&events).Error; err != nil {
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	history := RunHistory{Data: make([]RunStatusTransition, len(events))}
+	for i, event := range events {
+		history.Data[i] = RunStatusTransition{
+			PreviousStatus: (*RunStatus)(event.PreviousStatus),
+			Status:         RunStatus(event.Status),
+			Source:         RunStatusTransitionSource(event.Source),
+			SourceEventId:  event.SourceEventID,
+			CreatedAt:      event.CreatedAt,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, &history)
+}
@@ -0,0 +1,65 @@
+package public
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/api/middleware"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+
+	"github.com/labstack/echo/v4"
+	identityMiddleware "github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"gorm.io/gorm"
+)
+
+func (this *controllers) ApiRunHostArtifactsGet(ctx echo.Context, runId RunId, host string) error {
+	identity := identityMiddleware.GetIdentity(ctx.Request().Context())
+
+	queryBuilder := this.database.
+		WithContext(ctx.Request().Context()).
+		Table("run_hosts").
+		Select("run_hosts.artifacts").
+		Joins("INNER JOIN runs on runs.id = run_hosts.run_id").
+		Where("runs.org_id = ?", identity.Identity.OrgID).
+		Where("run_hosts.run_id = ?", runId).
+		Where("run_hosts.host = ?", host)
+
+	if allowedServices := middleware.GetAllowedServices(ctx); len(allowedServices) > 0 {
+		queryBuilder.Where("runs.service IN ?", allowedServices)
+	}
+
+	// users without access to any service may access the runs they own
+	if ownedRuns, ok := middleware.GetOwnedRuns(ctx); ok {
+		queryBuilder.Where("runs.id IN ?", ownedRuns)
+	}
+
+	var runHost dbModel.RunHost
+	if err := queryBuilder.First(&runHost).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, &Error{Message: "Run host not found"})
+		}
+
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	// hosts that have not reported yet (or reported before artifacts were captured) have none
+	artifacts := RunHostArtifacts{
+		Facts: map[string]interface{}{},
+		Stats: map[string]interface{}{},
+	}
+
+	if runHost.Artifacts != nil {
+		if err := json.Unmarshal(runHost.Artifacts, &artifacts); err != nil {
+			instrumentation.PlaybookRunReadError(ctx, err)
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+	}
+
+	if artifacts.Results == nil {
+		artifacts.Results = []RunHostTaskResult{}
+	}
+
+	return ctx.JSON(http.StatusOK, &artifacts)
+}
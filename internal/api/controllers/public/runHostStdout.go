@@ -0,0 +1,74 @@
+package public
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/api/middleware"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"slices"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	identityMiddleware "github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"gorm.io/gorm"
+)
+
+func (this *controllers) ApiRunHostStdoutGet(ctx echo.Context, runHostId RunHostId) error {
+	identity := identityMiddleware.GetIdentity(ctx.Request().Context())
+
+	queryBuilder := this.database.
+		WithContext(ctx.Request().Context()).
+		Table("run_hosts").
+		Select("run_hosts.log", "run_hosts.updated_at").
+		Joins("INNER JOIN runs on runs.id = run_hosts.run_id").
+		Where("runs.org_id = ?", identity.Identity.OrgID).
+		Where("run_hosts.id = ?", runHostId)
+
+	if allowedServices := middleware.GetAllowedServices(ctx); len(allowedServices) > 0 {
+		queryBuilder.Where("runs.service IN ?", allowedServices)
+	}
+
+	// users without access to any service may access the runs they own
+	if ownedRuns, ok := middleware.GetOwnedRuns(ctx); ok {
+		queryBuilder.Where("runs.id IN ?", ownedRuns)
+	}
+
+	var runHost dbModel.RunHost
+	if err := queryBuilder.First(&runHost).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, &Error{Message: "Run host not found"})
+		}
+
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	request, response := ctx.Request(), ctx.Response()
+	// the compression middleware, if enabled, varies on Accept-Encoding already
+	if !slices.Contains(response.Header().Values(echo.HeaderVary), echo.HeaderAcceptEncoding) {
+		response.Header().Add(echo.HeaderVary, echo.HeaderAcceptEncoding)
+	}
+
+	// ranges refer to the uncompressed output, which is what clients following the output keep track of
+	gzipped := middleware.NegotiateEncoding(request.Header.Get(echo.HeaderAcceptEncoding), "gzip") != ""
+	if request.Header.Get("Range") == "" && gzipped {
+		response.Header().Set(echo.HeaderContentType, "text/plain; charset=utf-8")
+		response.Header().Set(echo.HeaderContentEncoding, "gzip")
+		response.Header().Set(echo.HeaderLastModified, runHost.UpdatedAt.UTC().Format(http.TimeFormat))
+		response.WriteHeader(http.StatusOK)
+
+		writer := gzip.NewWriter(response)
+		if _, err := io.WriteString(writer, runHost.Log); err != nil {
+			return nil
+		}
+
+		return writer.Close()
+	}
+
+	// handles range requests along with the conditional headers based on the modification time
+	http.ServeContent(response, request, "stdout.txt", runHost.UpdatedAt, strings.NewReader(runHost.Log))
+	return nil
+}
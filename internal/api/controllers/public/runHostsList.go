@@ -5,21 +5,55 @@ import (
 	"net/http"
 	"playbook-dispatcher/internal/api/instrumentation"
 	"playbook-dispatcher/internal/api/middleware"
+	"playbook-dispatcher/internal/api/pagination"
 	dbModel "playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	identityMiddleware "github.com/redhatinsights/platform-go-middlewares/v2/identity"
 )
 
+var runHostsV1 = listView[dbModel.RunHost, RunHost]{
+	path:     "/api/playbook-dispatcher/v1/run_hosts",
+	fields:   runHostFields,
+	defaults: defaultRunHostFields,
+	convert: func(ctx echo.Context, host *dbModel.RunHost, fields []string) (RunHost, error) {
+		runHost, err := dbRunHostToApiRunHost(host, fields)
+		if err != nil {
+			return RunHost{}, err
+		}
+
+		return *runHost, nil
+	},
+}
+
 func (this *controllers) ApiRunHostsList(ctx echo.Context, params ApiRunHostsListParams) error {
+	return listRunHosts(this, ctx, params, runHostsV1, func(data []RunHost, meta Meta, links Links) error {
+		return ctx.JSON(http.StatusOK, &RunHosts{
+			Data:  data,
+			Meta:  meta,
+			Links: links,
+		})
+	})
+}
+
+// listRunHosts lists the run hosts matching the parameters in the representation of the view, shared by the versions
+// of the API
+func listRunHosts[T any](this *controllers, ctx echo.Context, params ApiRunHostsListParams, view listView[dbModel.RunHost, T], respond func(data []T, meta Meta, links Links) error) error {
 	identity := identityMiddleware.GetIdentity(ctx.Request().Context())
 
 	limit := getLimit(params.Limit)
 	offset := getOffset(params.Offset)
 
-	fields, err := parseFields(middleware.GetDeepObject(ctx, "fields"), "data", runHostFields, defaultRunHostFields)
+	names, fields, err := view.parseFields(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	cursor, cursorMode, err := getCursor(params.Cursor, params.Offset)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
@@ -37,17 +71,27 @@ func (this *controllers) ApiRunHostsList(ctx echo.Context, params ApiRunHostsLis
 		queryBuilder.Where("runs.service IN ?", allowedServices)
 	}
 
+	// users without access to any service may access the runs they own
+	if ownedRuns, ok := middleware.GetOwnedRuns(ctx); ok {
+		queryBuilder.Where("runs.id IN ?", ownedRuns)
+	}
+
+	// display names are cached from the inventory, hosts not looked up yet have none
+	if slices.Contains(fields, fieldDisplayName) {
+		queryBuilder.Joins("LEFT JOIN inventory_hosts ON inventory_hosts.org_id = runs.org_id AND inventory_hosts.id = run_hosts.inventory_id")
+	}
+
 	if params.Filter != nil {
 		if params.Filter.Status != nil {
-			status := *params.Filter.Status
-			switch status {
-			case dbModel.RunStatusTimeout:
-				queryBuilder.Where("runs.status = 'timeout' OR runs.status = 'running' AND runs.created_at + runs.timeout * interval '1 second' <= NOW()")
-			case dbModel.RunStatusRunning:
-				queryBuilder.Where("run_hosts.status = ?", status)
+			filterStatus := status.Status(*params.Filter.Status)
+			switch filterStatus {
+			case status.Timeout:
+				queryBuilder.Where("runs.status = ? OR runs.status IN ? AND runs.created_at + runs.timeout * interval '1 second' <= NOW()", status.Timeout, status.Strings(status.Active()...))
+			case status.Running:
+				queryBuilder.Where("run_hosts.status = ?", filterStatus)
 				queryBuilder.Where("runs.created_at + runs.timeout * interval '1 second' > NOW()")
 			default:
-				queryBuilder.Where("run_hosts.status = ?", status)
+				queryBuilder.Where("run_hosts.status = ?", filterStatus)
 			}
 		}
 
@@ -62,7 +106,7 @@ func (this *controllers) ApiRunHostsList(ctx echo.Context, params ApiRunHostsLis
 		}
 
 		if labelFilters := middleware.GetDeepObject(ctx, "filter", "run", "labels"); len(labelFilters) > 0 {
-			queryBuilder, err = addLabelFilterToQueryAsWhereClause(queryBuilder, labelFilters)
+			queryBuilder, err = addLabelFilterToQueryAsWhereClause(queryBuilder, labelFilters, this.labelCipher)
 			if err != nil {
 				instrumentation.PlaybookApiRequestError(ctx, err)
 				return echo.NewHTTPError(http.StatusInternalServerError, "Unable to handle labels query!")
@@ -80,6 +124,14 @@ func (this *controllers) ApiRunHostsList(ctx echo.Context, params ApiRunHostsLis
 		}
 	}
 
+	if format, export := getExportFormat(ctx, params.Format); export {
+		queryBuilder.Order("run_hosts.created_at desc").Order("run_hosts.id desc").Select(runHostColumns(fields))
+
+		return exportRows(ctx, queryBuilder, format, "run_hosts", names, func(host *dbModel.RunHost) (interface{}, error) {
+			return view.convert(ctx, host, fields)
+		})
+	}
+
 	var total int64
 	countResult := queryBuilder.Count(&total)
 
@@ -88,10 +140,17 @@ func (this *controllers) ApiRunHostsList(ctx echo.Context, params ApiRunHostsLis
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
-	queryBuilder.Limit(limit)
-	queryBuilder.Offset(offset)
+	columns := runHostColumns(fields)
+
+	if cursorMode {
+		columns = append(columns, "run_hosts.created_at", "run_hosts.id")
+		orderByKey(queryBuilder, "run_hosts", cursor, false, limit)
+	} else {
+		queryBuilder.Limit(limit)
+		queryBuilder.Offset(offset)
+	}
 
-	queryBuilder.Select(utils.MapStrings(fields, mapHostFieldsToSql))
+	queryBuilder.Select(columns)
 
 	var dbRunHosts []dbModel.RunHost
 	dbResult := queryBuilder.Find(&dbRunHosts)
@@ -101,51 +160,50 @@ func (this *controllers) ApiRunHostsList(ctx echo.Context, params ApiRunHostsLis
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
-	hosts := []RunHost{}
+	var previous, next *pagination.Cursor
+	if cursorMode {
+		dbRunHosts, previous, next = keysetPage(dbRunHosts, cursor, limit, func(host *dbModel.RunHost) pagination.Key {
+			return pagination.Key{CreatedAt: host.CreatedAt, ID: host.ID}
+		})
+	}
 
-	for _, host := range dbRunHosts {
+	hosts := []T{}
 
-		runHost := RunHost{}
-		runStatus := RunStatus(host.Status)
-
-		for _, field := range fields {
-			switch field {
-			case fieldHost:
-				runHost.Host = utils.StringRef(host.Host)
-			case fieldStdout:
-				runHost.Stdout = utils.StringRef(host.Log)
-			case fieldStatus:
-				runHost.Status = &runStatus
-			case fieldRun:
-				runHost.Run = &Run{
-					Id: &host.RunID,
-				}
-			case fieldLinks:
-				runHost.Links = &RunHostLinks{
-					InventoryHost: inventoryLink(host.InventoryID),
-				}
-			case fieldInventoryId:
-				if host.InventoryID != nil {
-					runHost.InventoryId = host.InventoryID
-				}
-			}
+	for _, host := range dbRunHosts {
+		runHost, err := view.convert(ctx, &host, fields)
+		if err != nil {
+			instrumentation.PlaybookRunReadError(ctx, err)
+			return ctx.NoContent(http.StatusInternalServerError)
 		}
 
 		hosts = append(hosts, runHost)
 	}
 
-	return ctx.JSON(http.StatusOK, &RunHosts{
-		Data: hosts,
-		Meta: Meta{
-			Count: len(hosts),
-			Total: int(total),
-		},
-		Links: createLinks("/api/playbook-dispatcher/v1/run_hosts", middleware.GetQueryString(ctx), getLimit(params.Limit), getOffset(params.Offset), int(total)),
-	})
+	page := pagination.Page{Base: view.path, Query: middleware.GetQueryString(ctx), Limit: getLimit(params.Limit)}
+	meta, links := page.Offset(getOffset(params.Offset), len(hosts), int(total))
+	if cursorMode {
+		meta, links = page.Cursor(cursor, previous, next, len(hosts), int(total))
+	}
+
+	return respond(hosts, Meta(meta), Links(links))
+}
+
+// runHostColumns returns the columns needed to represent the given fields of run hosts
+func runHostColumns(fields []string) []string {
+	columns := utils.MapStrings(fields, mapHostFieldsToSql)
+
+	// the stdout link is made of the ID
+	if slices.Contains(fields, fieldLinks) && !slices.Contains(fields, fieldId) {
+		columns = append(columns, "run_hosts.id")
+	}
+
+	return columns
 }
 
 func mapHostFieldsToSql(field string) string {
 	switch field {
+	case fieldId:
+		return "run_hosts.id"
 	case "host":
 		return "run_hosts.host"
 	case "run":
@@ -158,6 +216,16 @@ func mapHostFieldsToSql(field string) string {
 		return "run_hosts.inventory_id"
 	case fieldInventoryId:
 		return "run_hosts.inventory_id"
+	case fieldCancelState:
+		return "run_hosts.cancel_state"
+	case fieldDiffs:
+		return "run_hosts.diffs"
+	case fieldStdoutSize:
+		return "octet_length(run_hosts.log) AS stdout_size"
+	case fieldLastUpdatedDelta:
+		return "run_hosts.updated_at"
+	case fieldDisplayName:
+		return "inventory_hosts.display_name"
 	default:
 		panic("unknown field " + field)
 	}
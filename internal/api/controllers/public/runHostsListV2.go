@@ -0,0 +1,74 @@
+package public
+
+import (
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+
+	"github.com/labstack/echo/v4"
+)
+
+// the fields of run hosts are named as in the internal v2 API
+var runHostsV2 = listView[dbModel.RunHost, RunHostV2]{
+	path: "/api/playbook-dispatcher/v2/run_hosts",
+	fields: map[string]string{
+		"id":                 fieldId,
+		"run_id":             fieldRun,
+		"ansible_host":       fieldHost,
+		"inventory_id":       fieldInventoryId,
+		"status":             fieldStatus,
+		"stdout":             fieldStdout,
+		"links":              fieldLinks,
+		"cancel_state":       fieldCancelState,
+		"diffs":              fieldDiffs,
+		"stdout_size":        fieldStdoutSize,
+		"last_updated_delta": fieldLastUpdatedDelta,
+		"display_name":       fieldDisplayName,
+	},
+	defaults: []string{"id", "run_id", "ansible_host", "status"},
+	convert:  dbRunHostToApiRunHostV2,
+}
+
+func (this *controllers) ApiRunHostsListV2(ctx echo.Context, params ApiRunHostsListV2Params) error {
+	v1Params := ApiRunHostsListParams{
+		Filter: params.Filter,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		Cursor: params.Cursor,
+		Format: params.Format,
+	}
+
+	return listRunHosts(this, ctx, v1Params, runHostsV2, func(data []RunHostV2, meta Meta, links Links) error {
+		return ctx.JSON(http.StatusOK, &RunHostsV2{
+			Data:  data,
+			Meta:  meta,
+			Links: links,
+		})
+	})
+}
+
+func dbRunHostToApiRunHostV2(ctx echo.Context, host *dbModel.RunHost, fields []string) (RunHostV2, error) {
+	v1, err := dbRunHostToApiRunHost(host, fields)
+	if err != nil {
+		return RunHostV2{}, err
+	}
+
+	result := RunHostV2{
+		Id:               v1.Id,
+		AnsibleHost:      v1.Host,
+		InventoryId:      v1.InventoryId,
+		Status:           v1.Status,
+		Stdout:           v1.Stdout,
+		Links:            v1.Links,
+		CancelState:      v1.CancelState,
+		Diffs:            v1.Diffs,
+		StdoutSize:       v1.StdoutSize,
+		LastUpdatedDelta: v1.LastUpdatedDelta,
+		DisplayName:      v1.DisplayName,
+	}
+
+	if v1.Run != nil {
+		result.RunId = v1.Run.Id
+	}
+
+	return result, nil
+}
@@ -0,0 +1,126 @@
+package public
+
+import (
+	"fmt"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/api/middleware"
+	"playbook-dispatcher/internal/api/pagination"
+
+	"github.com/labstack/echo/v4"
+)
+
+// the number of hosts of each run in each status, joined to the runs being grouped
+const runHostCountsSql = `LEFT JOIN LATERAL (
+	SELECT
+		count(*) AS total,
+		count(*) FILTER (WHERE run_hosts.status = 'running') AS running,
+		count(*) FILTER (WHERE run_hosts.status = 'success') AS success,
+		count(*) FILTER (WHERE run_hosts.status = 'failure') AS failure,
+		count(*) FILTER (WHERE run_hosts.status = 'timeout') AS timeout,
+		count(*) FILTER (WHERE run_hosts.status = 'canceled') AS canceled
+	FROM run_hosts WHERE run_hosts.run_id = runs.id
+) hosts ON true`
+
+type runGroupRow struct {
+	Value string
+
+	RunsTotal                int
+	RunsRunning              int
+	RunsSuccess              int
+	RunsFailure              int
+	RunsTimeout              int
+	RunsCanceled             int
+	RunsWaitingForConnection int
+	RunsCanceling            int
+
+	HostsTotal    int
+	HostsRunning  int
+	HostsSuccess  int
+	HostsFailure  int
+	HostsTimeout  int
+	HostsCanceled int
+}
+
+func (this *controllers) ApiRunsGroups(ctx echo.Context, params ApiRunsGroupsParams) error {
+	if this.labelCipher.IsEncrypted(params.Label) {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("cannot group by encrypted label: %s", params.Label))
+	}
+
+	db := this.database.WithContext(ctx.Request().Context())
+	limit, offset := getLimit(params.Limit), getOffset(params.Offset)
+
+	var total int64
+	err := visibleRuns(ctx, db).
+		Select("count(DISTINCT runs.labels ->> ?)", params.Label).
+		Where("runs.labels ->> ? IS NOT NULL", params.Label).
+		Scan(&total).Error
+	if err != nil {
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	// groups are referred to by the output column as each occurrence of the parameter would be a different expression
+	var rows []runGroupRow
+	err = visibleRuns(ctx, db).
+		Select(`runs.labels ->> ? AS value,
+			count(*) AS runs_total,
+			count(*) FILTER (WHERE `+RunStatusSql+` = 'running') AS runs_running,
+			count(*) FILTER (WHERE runs.status = 'success') AS runs_success,
+			count(*) FILTER (WHERE runs.status = 'failure') AS runs_failure,
+			count(*) FILTER (WHERE `+RunStatusSql+` = 'timeout') AS runs_timeout,
+			count(*) FILTER (WHERE runs.status = 'canceled') AS runs_canceled,
+			count(*) FILTER (WHERE runs.status = 'waiting_for_connection') AS runs_waiting_for_connection,
+			count(*) FILTER (WHERE `+RunStatusSql+` = 'canceling') AS runs_canceling,
+			coalesce(sum(hosts.total), 0) AS hosts_total,
+			coalesce(sum(hosts.running), 0) AS hosts_running,
+			coalesce(sum(hosts.success), 0) AS hosts_success,
+			coalesce(sum(hosts.failure), 0) AS hosts_failure,
+			coalesce(sum(hosts.timeout), 0) AS hosts_timeout,
+			coalesce(sum(hosts.canceled), 0) AS hosts_canceled`, params.Label).
+		Joins(runHostCountsSql).
+		Where("runs.labels ->> ? IS NOT NULL", params.Label).
+		Group("value").
+		Order("value").
+		Limit(limit).
+		Offset(offset).
+		Scan(&rows).Error
+	if err != nil {
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	groups := make([]RunGroup, len(rows))
+	for i, row := range rows {
+		groups[i] = RunGroup{
+			Value: row.Value,
+			Runs: RunCounts{
+				Total:                row.RunsTotal,
+				Running:              row.RunsRunning,
+				Success:              row.RunsSuccess,
+				Failure:              row.RunsFailure,
+				Timeout:              row.RunsTimeout,
+				Canceled:             row.RunsCanceled,
+				WaitingForConnection: row.RunsWaitingForConnection,
+				Canceling:            row.RunsCanceling,
+			},
+			Hosts: RunHostCounts{
+				Total:    row.HostsTotal,
+				Running:  row.HostsRunning,
+				Success:  row.HostsSuccess,
+				Failure:  row.HostsFailure,
+				Timeout:  row.HostsTimeout,
+				Canceled: row.HostsCanceled,
+			},
+		}
+	}
+
+	page := pagination.Page{Base: "/api/playbook-dispatcher/v1/runs/groups", Query: middleware.GetQueryString(ctx), Limit: limit}
+	meta, links := page.Offset(offset, len(groups), int(total))
+
+	return ctx.JSON(http.StatusOK, &RunGroups{
+		Data:  groups,
+		Meta:  Meta(meta),
+		Links: Links(links),
+	})
+}
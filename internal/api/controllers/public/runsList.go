@@ -6,9 +6,14 @@ import (
 	"net/http"
 	"playbook-dispatcher/internal/api/instrumentation"
 	"playbook-dispatcher/internal/api/middleware"
+	"playbook-dispatcher/internal/api/pagination"
+	"playbook-dispatcher/internal/common/encryption"
 	dbModel "playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -16,22 +21,13 @@ import (
 	"gorm.io/gorm"
 )
 
-func getOrderBy(params ApiRunsListParams) string {
-	if params.SortBy == nil || len(*params.SortBy) == 0 {
-		return "created_at desc"
-	}
-
-	if parts := strings.Split(string(*params.SortBy), ":"); len(parts) == 1 {
-		return fmt.Sprintf("%s %s", parts[0], "desc")
-	} else {
-		return fmt.Sprintf("%s %s", parts[0], parts[1])
-	}
-}
+// RunStatusSql is the status of a run, "timeout" if the run has expired
+const RunStatusSql = `CASE WHEN runs.status IN ('running', 'canceling') AND runs.created_at + runs.timeout * interval '1 second' <= NOW() THEN 'timeout' ELSE runs.status END`
 
 func mapFieldsToSql(field string) string {
 	// set status to "timeout" on read if the run has expired
 	if field == fieldStatus {
-		return `CASE WHEN runs.status='running' AND runs.created_at + runs.timeout * interval '1 second' <= NOW() THEN 'timeout' ELSE runs.status END as status`
+		return RunStatusSql + " as status"
 	}
 
 	// column names for these fields are different in the db
@@ -39,14 +35,46 @@ func mapFieldsToSql(field string) string {
 		return "playbook_name"
 	}
 
-	if field == fieldWebConsoleUrl {
+	// links are derived from the ID and status as well, see runColumns
+	if field == fieldWebConsoleUrl || field == fieldLinks {
 		return "playbook_run_url"
 	}
 
 	return field
 }
 
+// runColumns selects the fields along with the columns their values are derived from
+func runColumns(fields []string) []string {
+	columns := utils.MapStrings(fields, mapFieldsToSql)
+
+	if slices.Contains(fields, fieldLinks) {
+		columns = append(columns, "runs.id", mapFieldsToSql(fieldStatus))
+	}
+
+	return columns
+}
+
+var runsV1 = listView[dbModel.Run, Run]{
+	path:     "/api/playbook-dispatcher/v1/runs",
+	fields:   runFields,
+	defaults: defaultRunFields,
+	convert: func(ctx echo.Context, run *dbModel.Run, fields []string) (Run, error) {
+		return *dbRuntoApiRun(ctx, run, fields), nil
+	},
+}
+
 func (this *controllers) ApiRunsList(ctx echo.Context, params ApiRunsListParams) error {
+	return listRuns(this, ctx, params, runsV1, func(data []Run, meta Meta, links Links) error {
+		return ctx.JSON(http.StatusOK, &Runs{
+			Data:  data,
+			Meta:  meta,
+			Links: links,
+		})
+	})
+}
+
+// listRuns lists the runs matching the parameters in the representation of the view, shared by the versions of the API
+func listRuns[T any](this *controllers, ctx echo.Context, params ApiRunsListParams, view listView[dbModel.Run, T], respond func(data []T, meta Meta, links Links) error) error {
 	var dbRuns []dbModel.Run
 
 	identity := identityMiddleware.GetIdentity(ctx.Request().Context())
@@ -62,23 +90,41 @@ func (this *controllers) ApiRunsList(ctx echo.Context, params ApiRunsListParams)
 		queryBuilder.Where("service IN ?", allowedServices)
 	}
 
-	fields, err := parseFields(middleware.GetDeepObject(ctx, "fields"), "data", runFields, defaultRunFields)
+	// users without access to any service may access the runs they own
+	if ownedRuns, ok := middleware.GetOwnedRuns(ctx); ok {
+		queryBuilder.Where("runs.id IN ?", ownedRuns)
+	}
+
+	names, fields, err := view.parseFields(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	cursor, cursorMode, err := getCursor(params.Cursor, params.Offset)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
+	sortKeys, err := parseSortBy(params.SortBy, this.labelCipher)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var ascending bool
+	if cursorMode {
+		if ascending, err = cursorSortAscending(sortKeys); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+	}
+
 	if params.Filter != nil {
 		if params.Filter.Status != nil {
-			status := *params.Filter.Status
-			switch status {
-			case dbModel.RunStatusTimeout:
-				queryBuilder.Where("runs.status = 'timeout' OR runs.status = 'running' AND runs.created_at + runs.timeout * interval '1 second' <= NOW()")
-			case dbModel.RunStatusRunning:
-				queryBuilder.Where("runs.status = ?", status)
-				queryBuilder.Where("runs.created_at + runs.timeout * interval '1 second' > NOW()")
-			default:
-				queryBuilder.Where("runs.status = ?", status)
+			statuses, err := parseStatuses(*params.Filter.Status)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 			}
+
+			queryBuilder.Where(statusCondition(queryBuilder, statuses))
 		}
 
 		if params.Filter.Recipient != nil {
@@ -94,16 +140,71 @@ func (this *controllers) ApiRunsList(ctx echo.Context, params ApiRunsListParams)
 		if params.Filter.Service != nil {
 			queryBuilder.Where("runs.service = ?", *params.Filter.Service)
 		}
+
+		if params.Filter.CorrelationId != nil {
+			correlationId, err := uuid.Parse(*params.Filter.CorrelationId)
+			if err != nil {
+				instrumentation.PlaybookApiRequestError(ctx, err)
+				return echo.NewHTTPError(http.StatusBadRequest, "Unable to parse correlation_id!")
+			}
+
+			queryBuilder.Where("runs.correlation_id = ?", correlationId)
+		}
+
+		if params.Filter.CreatedAfter != nil {
+			createdAfter, err := time.Parse(time.RFC3339, *params.Filter.CreatedAfter)
+			if err != nil {
+				instrumentation.PlaybookApiRequestError(ctx, err)
+				return echo.NewHTTPError(http.StatusBadRequest, "Unable to parse created_after!")
+			}
+
+			queryBuilder.Where("runs.created_at >= ?", createdAfter)
+		}
+
+		if params.Filter.CreatedBefore != nil {
+			createdBefore, err := time.Parse(time.RFC3339, *params.Filter.CreatedBefore)
+			if err != nil {
+				instrumentation.PlaybookApiRequestError(ctx, err)
+				return echo.NewHTTPError(http.StatusBadRequest, "Unable to parse created_before!")
+			}
+
+			queryBuilder.Where("runs.created_at < ?", createdBefore)
+		}
 	}
 
 	if labelFilters := middleware.GetDeepObject(ctx, "filter", "labels"); len(labelFilters) > 0 {
-		queryBuilder, err = addLabelFilterToQueryAsWhereClause(queryBuilder, labelFilters)
+		addLabelFilter := addLabelFilterToQueryAsWhereClause
+		if params.Filter != nil && params.Filter.LabelsOperator != nil && *params.Filter.LabelsOperator == LabelsOperatorOr {
+			addLabelFilter = addAnyLabelFilterToQueryAsWhereClause
+		}
+
+		queryBuilder, err = addLabelFilter(queryBuilder, labelFilters, this.labelCipher)
 		if err != nil {
 			instrumentation.PlaybookApiRequestError(ctx, err)
 			return echo.NewHTTPError(http.StatusInternalServerError, "Unable to handle labels query!")
 		}
 	}
 
+	if params.Search != nil {
+		if term := strings.TrimSpace(*params.Search); term != "" {
+			queryBuilder.Where(searchCondition(queryBuilder, term))
+		}
+	}
+
+	if format, export := getExportFormat(ctx, params.Format); export {
+		orderBySortKeys(queryBuilder, sortKeys)
+		queryBuilder.Select(runColumns(fields))
+
+		return exportRows(ctx, queryBuilder, format, "runs", names, func(run *dbModel.Run) (interface{}, error) {
+			var err error
+			if run.Labels, err = this.labelCipher.Decrypt(run.Labels); err != nil {
+				utils.GetLogFromEcho(ctx).Warnw("Error decrypting run labels", "run_id", run.ID, "error", err)
+			}
+
+			return view.convert(ctx, run, fields)
+		})
+	}
+
 	var total int64
 	countResult := queryBuilder.Count(&total)
 
@@ -112,13 +213,21 @@ func (this *controllers) ApiRunsList(ctx echo.Context, params ApiRunsListParams)
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
-	queryBuilder.Select(utils.MapStrings(fields, mapFieldsToSql))
+	// the version of the results is needed for the entity tag regardless of the selected fields
+	columns := append(runColumns(fields), "runs.id", "runs.updated_at", mapFieldsToSql(fieldStatus))
 
-	queryBuilder.Order(getOrderBy(params))
-	queryBuilder.Order("id") // secondary criteria to guarantee stable sorting
+	if cursorMode {
+		// the key of the results is needed for the cursors regardless of the selected fields
+		columns = append(columns, "runs.created_at", "runs.id")
+		orderByKey(queryBuilder, "runs", cursor, ascending, getLimit(params.Limit))
+	} else {
+		orderBySortKeys(queryBuilder, sortKeys)
 
-	queryBuilder.Limit(getLimit(params.Limit))
-	queryBuilder.Offset(getOffset(params.Offset))
+		queryBuilder.Limit(getLimit(params.Limit))
+		queryBuilder.Offset(getOffset(params.Offset))
+	}
+
+	queryBuilder.Select(columns)
 
 	dbResult := queryBuilder.Find(&dbRuns)
 
@@ -127,23 +236,89 @@ func (this *controllers) ApiRunsList(ctx echo.Context, params ApiRunsListParams)
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
-	response := make([]Run, len(dbRuns))
+	var previous, next *pagination.Cursor
+	if cursorMode {
+		dbRuns, previous, next = keysetPage(dbRuns, cursor, getLimit(params.Limit), func(run *dbModel.Run) pagination.Key {
+			return pagination.Key{CreatedAt: run.CreatedAt, ID: run.ID}
+		})
+	}
+
+	if notModified(ctx, runsEtag(ctx, dbRuns, total)) {
+		return ctx.NoContent(http.StatusNotModified)
+	}
+
+	response := make([]T, len(dbRuns))
 
 	for i, v := range dbRuns {
-		response[i] = *dbRuntoApiRun(&v, fields)
+		if v.Labels, err = this.labelCipher.Decrypt(v.Labels); err != nil {
+			utils.GetLogFromEcho(ctx).Warnw("Error decrypting run labels", "run_id", v.ID, "error", err)
+		}
+
+		if response[i], err = view.convert(ctx, &v, fields); err != nil {
+			instrumentation.PlaybookRunReadError(ctx, err)
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
 	}
 
-	return ctx.JSON(http.StatusOK, &Runs{
-		Data: response,
-		Meta: Meta{
-			Count: len(response),
-			Total: int(total),
-		},
-		Links: createLinks("/api/playbook-dispatcher/v1/runs", middleware.GetQueryString(ctx), getLimit(params.Limit), getOffset(params.Offset), int(total)),
-	})
+	page := pagination.Page{Base: view.path, Query: middleware.GetQueryString(ctx), Limit: getLimit(params.Limit)}
+	meta, links := page.Offset(getOffset(params.Offset), len(response), int(total))
+	if cursorMode {
+		meta, links = page.Cursor(cursor, previous, next, len(response), int(total))
+	}
+
+	return respond(response, Meta(meta), Links(links))
+}
+
+// parseStatuses parses the comma-separated statuses of the status filter
+func parseStatuses(value string) ([]status.Status, error) {
+	statuses := []status.Status{}
+
+	for _, item := range strings.Split(value, ",") {
+		parsed, err := status.Parse(item)
+		if err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, parsed)
+	}
+
+	return statuses, nil
+}
+
+// statusCondition matches runs in any of the given statuses, taking into account that runs still running after their
+// timeout elapsed are reported as timeout (see mapFieldsToSql)
+func statusCondition(queryBuilder *gorm.DB, statuses []status.Status) *gorm.DB {
+	conditions := queryBuilder.Session(&gorm.Session{NewDB: true})
+
+	for _, filterStatus := range statuses {
+		switch filterStatus {
+		case status.Timeout:
+			conditions = conditions.Or("runs.status = ? OR runs.status IN ? AND runs.created_at + runs.timeout * interval '1 second' <= NOW()", status.Timeout, status.Strings(status.Active()...))
+		case status.Running, status.Canceling:
+			conditions = conditions.Or("runs.status = ? AND runs.created_at + runs.timeout * interval '1 second' > NOW()", filterStatus)
+		default:
+			conditions = conditions.Or("runs.status = ?", filterStatus)
+		}
+	}
+
+	return conditions
 }
 
-func addLabelFilterToQueryAsWhereClause(queryBuilder *gorm.DB, labelFilters map[string][]string) (*gorm.DB, error) {
+// searchCondition matches runs whose playbook name, web console URL or any (unencrypted) label value contains the term,
+// ignoring case. Each expression is backed by a trigram index.
+func searchCondition(queryBuilder *gorm.DB, term string) *gorm.DB {
+	pattern := "%" + likeEscaper.Replace(term) + "%"
+
+	return queryBuilder.Session(&gorm.Session{NewDB: true}).
+		Where("runs.playbook_name ILIKE ?", pattern).
+		Or("runs.playbook_run_url ILIKE ?", pattern).
+		Or("jsonb_path_query_array(runs.labels, '$.*')::text ILIKE ?", pattern)
+}
+
+// escapes the wildcards of LIKE patterns so that they match literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func addLabelFilterToQueryAsWhereClause(queryBuilder *gorm.DB, labelFilters map[string][]string, labelCipher *encryption.LabelCipher) (*gorm.DB, error) {
 	labels := make(map[string]string)
 
 	for key, values := range labelFilters {
@@ -158,6 +333,25 @@ func addLabelFilterToQueryAsWhereClause(queryBuilder *gorm.DB, labelFilters map[
 		}
 	}
 
+	// encrypted values are matched in any of their stored representations
+	for key, value := range labels {
+		if labelCipher.IsEncrypted(key) {
+			delete(labels, key)
+
+			conditions := queryBuilder.Session(&gorm.Session{NewDB: true})
+			for _, representation := range labelCipher.FilterValues(key, value) {
+				labelJson, err := json.Marshal(map[string]string{key: representation})
+				if err != nil {
+					return queryBuilder, fmt.Errorf("unable to marshal labels into json: %w", err)
+				}
+
+				conditions = conditions.Or("runs.labels @> ?", string(labelJson))
+			}
+
+			queryBuilder.Where(conditions)
+		}
+	}
+
 	if len(labels) == 0 {
 		return queryBuilder, nil
 	}
@@ -171,3 +365,31 @@ func addLabelFilterToQueryAsWhereClause(queryBuilder *gorm.DB, labelFilters map[
 
 	return queryBuilder, nil
 }
+
+// addAnyLabelFilterToQueryAsWhereClause matches runs having any of the given labels. Unlike with
+// addLabelFilterToQueryAsWhereClause, every value of a repeated label is an alternative.
+func addAnyLabelFilterToQueryAsWhereClause(queryBuilder *gorm.DB, labelFilters map[string][]string, labelCipher *encryption.LabelCipher) (*gorm.DB, error) {
+	conditions := queryBuilder.Session(&gorm.Session{NewDB: true})
+
+	for key, values := range labelFilters {
+		for _, value := range values {
+			representations := []string{value}
+			if labelCipher.IsEncrypted(key) {
+				representations = labelCipher.FilterValues(key, value)
+			}
+
+			for _, representation := range representations {
+				labelJson, err := json.Marshal(map[string]string{key: representation})
+				if err != nil {
+					return queryBuilder, fmt.Errorf("unable to marshal labels into json: %w", err)
+				}
+
+				conditions = conditions.Or("runs.labels @> ?", string(labelJson))
+			}
+		}
+	}
+
+	queryBuilder.Where(conditions)
+
+	return queryBuilder, nil
+}
@@ -0,0 +1,71 @@
+package public
+
+import (
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+)
+
+const fieldPrincipal = "principal"
+
+var runsV2 = listView[dbModel.Run, RunV2]{
+	path:     "/api/playbook-dispatcher/v2/runs",
+	fields:   utils.IndexStrings(fieldId, fieldOrgId, fieldRecipient, fieldUrl, fieldLabels, fieldTimeout, fieldStatus, fieldProgress, fieldExecutionMode, fieldParentRunId, fieldCreatedAt, fieldUpdatedAt, fieldService, fieldCorrelationId, fieldName, fieldWebConsoleUrl, fieldPrincipal, fieldLinks),
+	defaults: defaultRunFields,
+	convert:  dbRunToApiRunV2,
+}
+
+func (this *controllers) ApiRunsListV2(ctx echo.Context, params ApiRunsListV2Params) error {
+	v1Params := ApiRunsListParams{
+		Filter: params.Filter,
+		SortBy: params.SortBy,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		Cursor: params.Cursor,
+		Search: params.Search,
+		Format: params.Format,
+	}
+
+	return listRuns(this, ctx, v1Params, runsV2, func(data []RunV2, meta Meta, links Links) error {
+		return ctx.JSON(http.StatusOK, &RunsV2{
+			Data:  data,
+			Meta:  meta,
+			Links: links,
+		})
+	})
+}
+
+func dbRunToApiRunV2(ctx echo.Context, run *dbModel.Run, fields []string) (RunV2, error) {
+	v1 := dbRuntoApiRun(ctx, run, slices.DeleteFunc(slices.Clone(fields), func(field string) bool {
+		return field == fieldPrincipal
+	}))
+
+	result := RunV2{
+		Id:            v1.Id,
+		OrgId:         v1.OrgId,
+		Recipient:     v1.Recipient,
+		CorrelationId: v1.CorrelationId,
+		Name:          v1.Name,
+		WebConsoleUrl: v1.WebConsoleUrl,
+		Service:       v1.Service,
+		Url:           v1.Url,
+		Labels:        v1.Labels,
+		Timeout:       v1.Timeout,
+		Status:        v1.Status,
+		Progress:      v1.Progress,
+		ExecutionMode: v1.ExecutionMode,
+		ParentRunId:   v1.ParentRunId,
+		CreatedAt:     v1.CreatedAt,
+		UpdatedAt:     v1.UpdatedAt,
+		Links:         v1.Links,
+	}
+
+	if slices.Contains(fields, fieldPrincipal) {
+		result.Principal = (*Principal)(run.Principal)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,123 @@
+package public
+
+import (
+	"fmt"
+	"playbook-dispatcher/internal/common/encryption"
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const (
+	labelSortPrefix = "labels."
+	maxSortKeys     = 5
+)
+
+// expressions runs are sorted by for each of the fields allowed in sort_by
+var runSortColumns = map[string]string{
+	fieldCreatedAt: "runs.created_at",
+	fieldUpdatedAt: "runs.updated_at",
+	fieldStatus:    RunStatusSql,
+	fieldName:      "runs.playbook_name",
+	fieldService:   "runs.service",
+}
+
+// label keys are written into the ORDER BY clause as literals so they are limited to characters that need no escaping
+var labelSortKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_\-./]{1,100}$`)
+
+type sortKey struct {
+	field      string
+	descending bool
+	// column or expression to sort by
+	expression string
+	// set for the keys that sort by the value of a label
+	label bool
+}
+
+func (this sortKey) orderBy() string {
+	direction := "asc"
+	if this.descending {
+		direction = "desc"
+	}
+
+	// runs without the label come last regardless of the direction
+	if this.label {
+		return fmt.Sprintf("%s %s nulls last", this.expression, direction)
+	}
+
+	return fmt.Sprintf("%s %s", this.expression, direction)
+}
+
+// parseSortBy parses sort_by: a comma-separated list of fields, each optionally followed by :asc or :desc.
+// Fields are sorted in descending order unless specified otherwise, newest runs first if sort_by is not set.
+func parseSortBy(sortBy *RunsSortBy, labelCipher *encryption.LabelCipher) ([]sortKey, error) {
+	if sortBy == nil || len(*sortBy) == 0 {
+		return []sortKey{{expression: runSortColumns[fieldCreatedAt], field: fieldCreatedAt, descending: true}}, nil
+	}
+
+	values := strings.Split(*sortBy, ",")
+	if len(values) > maxSortKeys {
+		return nil, fmt.Errorf("sort_by: at most %d fields are allowed", maxSortKeys)
+	}
+
+	keys := make([]sortKey, 0, len(values))
+	seen := map[string]bool{}
+
+	for _, value := range values {
+		field, direction, _ := strings.Cut(value, ":")
+		key := sortKey{field: field, descending: true}
+
+		switch direction {
+		case "", "desc":
+		case "asc":
+			key.descending = false
+		default:
+			return nil, fmt.Errorf("sort_by: invalid direction: %s", direction)
+		}
+
+		if seen[key.field] {
+			return nil, fmt.Errorf("sort_by: duplicate field: %s", key.field)
+		}
+
+		seen[key.field] = true
+
+		if labelKey, ok := strings.CutPrefix(key.field, labelSortPrefix); ok {
+			if !labelSortKeyPattern.MatchString(labelKey) {
+				return nil, fmt.Errorf("sort_by: invalid label key: %s", labelKey)
+			}
+
+			if labelCipher.IsEncrypted(labelKey) {
+				return nil, fmt.Errorf("sort_by: cannot sort by encrypted label: %s", labelKey)
+			}
+
+			key.expression, key.label = fmt.Sprintf("runs.labels ->> '%s'", labelKey), true
+		} else if expression, ok := runSortColumns[key.field]; ok {
+			key.expression = expression
+		} else {
+			return nil, fmt.Errorf("sort_by: unknown field: %s", key.field)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// cursorSortAscending tells the direction of the sort order when paginating using cursors, which only supports
+// sorting by creation time
+func cursorSortAscending(keys []sortKey) (bool, error) {
+	if len(keys) != 1 || keys[0].field != fieldCreatedAt {
+		return false, fmt.Errorf("cursor pagination only supports sorting by %s", fieldCreatedAt)
+	}
+
+	return !keys[0].descending, nil
+}
+
+func orderBySortKeys(queryBuilder *gorm.DB, keys []sortKey) {
+	for _, key := range keys {
+		queryBuilder.Order(key.orderBy())
+	}
+
+	queryBuilder.Order("runs.id") // secondary criteria to guarantee stable sorting
+}
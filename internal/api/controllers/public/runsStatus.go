@@ -0,0 +1,90 @@
+package public
+
+import (
+	"fmt"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// the number of run IDs and correlation IDs that can be looked up at once
+const maxStatusLookups = 200
+
+func (this *controllers) ApiRunsStatus(ctx echo.Context) error {
+	var input RunStatusLookup
+	if err := utils.ReadRequestBody(ctx, &input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var ids, correlationIds []uuid.UUID
+	if input.Ids != nil {
+		ids = *input.Ids
+	}
+
+	if input.CorrelationIds != nil {
+		correlationIds = *input.CorrelationIds
+	}
+
+	if len(ids)+len(correlationIds) > maxStatusLookups {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("at most %d run IDs and correlation IDs can be looked up at once", maxStatusLookups))
+	}
+
+	result := RunStatuses{
+		Ids:            map[string]RunStatus{},
+		CorrelationIds: map[string]RunStatus{},
+	}
+
+	if len(ids)+len(correlationIds) == 0 {
+		return ctx.JSON(http.StatusOK, result)
+	}
+
+	db := this.database.WithContext(ctx.Request().Context())
+
+	// the run is looked up by either identifier
+	lookup := db.Session(&gorm.Session{NewDB: true}).Where("runs.id IN ?", ids)
+	if len(correlationIds) > 0 {
+		lookup = lookup.Or("runs.correlation_id IN ?", correlationIds)
+	}
+
+	var runs []struct {
+		ID            uuid.UUID
+		CorrelationID uuid.UUID
+		Status        string
+	}
+
+	// oldest first so that the latest run of a correlation ID is the one reported
+	err := visibleRuns(ctx, db).
+		Select("runs.id", "runs.correlation_id", mapFieldsToSql(fieldStatus)).
+		Where(lookup).
+		Order("runs.created_at").
+		Scan(&runs).Error
+	if err != nil {
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	requestedIds, requestedCorrelationIds := map[uuid.UUID]bool{}, map[uuid.UUID]bool{}
+	for _, id := range ids {
+		requestedIds[id] = true
+	}
+
+	for _, id := range correlationIds {
+		requestedCorrelationIds[id] = true
+	}
+
+	for _, run := range runs {
+		if requestedIds[run.ID] {
+			result.Ids[run.ID.String()] = RunStatus(run.Status)
+		}
+
+		if requestedCorrelationIds[run.CorrelationID] {
+			result.CorrelationIds[run.CorrelationID.String()] = RunStatus(run.Status)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
@@ -23,9 +23,36 @@ type ServerInterface interface {
 	// List hosts involved in Playbook runs
 	// (GET /api/playbook-dispatcher/v1/run_hosts)
 	ApiRunHostsList(ctx echo.Context, params ApiRunHostsListParams) error
+	// Get the output of a host of a Playbook run
+	// (GET /api/playbook-dispatcher/v1/run_hosts/{run_host_id}/stdout)
+	ApiRunHostStdoutGet(ctx echo.Context, runHostId RunHostId) error
 	// List Playbook runs
 	// (GET /api/playbook-dispatcher/v1/runs)
 	ApiRunsList(ctx echo.Context, params ApiRunsListParams) error
+	// Group Playbook runs by label
+	// (GET /api/playbook-dispatcher/v1/runs/groups)
+	ApiRunsGroups(ctx echo.Context, params ApiRunsGroupsParams) error
+	// Look up the status of Playbook runs
+	// (POST /api/playbook-dispatcher/v1/runs/status)
+	ApiRunsStatus(ctx echo.Context) error
+	// Get a Playbook run
+	// (GET /api/playbook-dispatcher/v1/runs/{run_id})
+	ApiRunGet(ctx echo.Context, runId RunId) error
+	// Stream the status changes of a Playbook run
+	// (GET /api/playbook-dispatcher/v1/runs/{run_id}/events)
+	ApiRunEvents(ctx echo.Context, runId RunId) error
+	// Get the status history of a Playbook run
+	// (GET /api/playbook-dispatcher/v1/runs/{run_id}/history)
+	ApiRunHistoryGet(ctx echo.Context, runId RunId) error
+	// Get the artifacts of a host of a Playbook run
+	// (GET /api/playbook-dispatcher/v1/runs/{run_id}/hosts/{host}/artifacts)
+	ApiRunHostArtifactsGet(ctx echo.Context, runId RunId, host string) error
+	// List hosts involved in Playbook runs
+	// (GET /api/playbook-dispatcher/v2/run_hosts)
+	ApiRunHostsListV2(ctx echo.Context, params ApiRunHostsListV2Params) error
+	// List Playbook runs
+	// (GET /api/playbook-dispatcher/v2/runs)
+	ApiRunsListV2(ctx echo.Context, params ApiRunsListV2Params) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -67,11 +94,41 @@ func (w *ServerInterfaceWrapper) ApiRunHostsList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", ctx.QueryParams(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ApiRunHostsList(ctx, params)
 	return err
 }
 
+// ApiRunHostStdoutGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunHostStdoutGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "run_host_id" -------------
+	var runHostId RunHostId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "run_host_id", ctx.Param("run_host_id"), &runHostId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter run_host_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunHostStdoutGet(ctx, runHostId)
+	return err
+}
+
 // ApiRunsList converts echo context to params.
 func (w *ServerInterfaceWrapper) ApiRunsList(ctx echo.Context) error {
 	var err error
@@ -113,11 +170,265 @@ func (w *ServerInterfaceWrapper) ApiRunsList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "search", ctx.QueryParams(), &params.Search, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", ctx.QueryParams(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ApiRunsList(ctx, params)
 	return err
 }
 
+// ApiRunsGroups converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunsGroups(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiRunsGroupsParams
+	// ------------- Required query parameter "label" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "label", ctx.QueryParams(), &params.Label, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter label: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunsGroups(ctx, params)
+	return err
+}
+
+// ApiRunsStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunsStatus(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunsStatus(ctx)
+	return err
+}
+
+// ApiRunGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "run_id" -------------
+	var runId RunId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "run_id", ctx.Param("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter run_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunGet(ctx, runId)
+	return err
+}
+
+// ApiRunEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "run_id" -------------
+	var runId RunId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "run_id", ctx.Param("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter run_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunEvents(ctx, runId)
+	return err
+}
+
+// ApiRunHistoryGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunHistoryGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "run_id" -------------
+	var runId RunId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "run_id", ctx.Param("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter run_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunHistoryGet(ctx, runId)
+	return err
+}
+
+// ApiRunHostArtifactsGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunHostArtifactsGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "run_id" -------------
+	var runId RunId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "run_id", ctx.Param("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter run_id: %s", err))
+	}
+
+	// ------------- Path parameter "host" -------------
+	var host string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "host", ctx.Param("host"), &host, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter host: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunHostArtifactsGet(ctx, runId, host)
+	return err
+}
+
+// ApiRunHostsListV2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunHostsListV2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiRunHostsListV2Params
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameterWithOptions("deepObject", true, false, "filter", ctx.QueryParams(), &params.Filter, runtime.BindQueryParameterOptions{Type: "object", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameterWithOptions("deepObject", true, false, "fields", ctx.QueryParams(), &params.Fields, runtime.BindQueryParameterOptions{Type: "object", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", ctx.QueryParams(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunHostsListV2(ctx, params)
+	return err
+}
+
+// ApiRunsListV2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunsListV2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiRunsListV2Params
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameterWithOptions("deepObject", true, false, "filter", ctx.QueryParams(), &params.Filter, runtime.BindQueryParameterOptions{Type: "object", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameterWithOptions("deepObject", true, false, "fields", ctx.QueryParams(), &params.Fields, runtime.BindQueryParameterOptions{Type: "object", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "sort_by", ctx.QueryParams(), &params.SortBy, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort_by: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "search", ctx.QueryParams(), &params.Search, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", ctx.QueryParams(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunsListV2(ctx, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -166,7 +477,16 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	}
 
 	router.GET(options.BaseURL+"/api/playbook-dispatcher/v1/run_hosts", wrapper.ApiRunHostsList, options.OperationMiddlewares["api.run.hosts.list"]...)
+	router.GET(options.BaseURL+"/api/playbook-dispatcher/v1/run_hosts/:run_host_id/stdout", wrapper.ApiRunHostStdoutGet, options.OperationMiddlewares["api.run.host.stdout.get"]...)
 	router.GET(options.BaseURL+"/api/playbook-dispatcher/v1/runs", wrapper.ApiRunsList, options.OperationMiddlewares["api.runs.list"]...)
+	router.GET(options.BaseURL+"/api/playbook-dispatcher/v1/runs/groups", wrapper.ApiRunsGroups, options.OperationMiddlewares["api.runs.groups"]...)
+	router.POST(options.BaseURL+"/api/playbook-dispatcher/v1/runs/status", wrapper.ApiRunsStatus, options.OperationMiddlewares["api.runs.status"]...)
+	router.GET(options.BaseURL+"/api/playbook-dispatcher/v1/runs/:run_id", wrapper.ApiRunGet, options.OperationMiddlewares["api.run.get"]...)
+	router.GET(options.BaseURL+"/api/playbook-dispatcher/v1/runs/:run_id/events", wrapper.ApiRunEvents, options.OperationMiddlewares["api.run.events"]...)
+	router.GET(options.BaseURL+"/api/playbook-dispatcher/v1/runs/:run_id/history", wrapper.ApiRunHistoryGet, options.OperationMiddlewares["api.run.history.get"]...)
+	router.GET(options.BaseURL+"/api/playbook-dispatcher/v1/runs/:run_id/hosts/:host/artifacts", wrapper.ApiRunHostArtifactsGet, options.OperationMiddlewares["api.run.host.artifacts.get"]...)
+	router.GET(options.BaseURL+"/api/playbook-dispatcher/v2/run_hosts", wrapper.ApiRunHostsListV2, options.OperationMiddlewares["api.run.hosts.list.v2"]...)
+	router.GET(options.BaseURL+"/api/playbook-dispatcher/v2/runs", wrapper.ApiRunsListV2, options.OperationMiddlewares["api.runs.list.v2"]...)
 
 }
 
@@ -175,45 +495,113 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 // const string: with thousands of chunks the chained `+` fold is several
 // times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-	"1Frdcxu3Ef9XMGgf2hmGpGwnk/KpkhKPNVWsjBS1mXE1MnhYkrBxwBkflFgP//fOAvd9oI5y0o7zZh13",
-	"F4v9/O3Cn2mm80IrUM7SxWdaMMNycGDCX5ciFw7/wcFmRhROaEUX9Cf2KHKfE+XzJRiiV8SA9dJZ4jQx",
-	"4LxRdEIFkn7yYHZ0QhXLgS6oDAIn1GYbyFmUvGJeOrr4dj6heRRMFy/m+JdQ8a+TCXW7AvmFcrAGQ/f7",
-	"Cb1arSwktLtQXGTMgSVuA8Q6ZpxQa1JoK5AC1cUfgmbEgGRObAE1x69oDQkOiAWHlMJBjoKYIzlz2aZh",
-	"PXBDHbVKXrF9p3nyTtdevdHWvRYguR1e7QdYCQWWrMLvqPMSSoMDJ0IF7QzYQisL03+jF+CxkJoDXTjj",
-	"Ia1ylNZRuTC6AOMERCWY617kHd1oGy7pmPPIaryidxMazIWkoPCSNR3+3KK2jmuP36VQH22w5BaU02Z3",
-	"LzjKKU1jnRFqTff1B2YM2wVLlR/08gNkDims20n8wgGKq/prbVDpwAwNeiqlfrBkpQ1ZBRKMlCWzwIlW",
-	"ZMuM0N6SzAj8iR1rznDWYXN2Lrv4TP9sYEUX9E+zJhVnkdfOLiraC/7WS8mWEvBaaM/FZ6qqT6U6vXOC",
-	"9IEpJVuCtGMHX3t1GQjbx1owW5HBGO9NJGs40/4KwTAmKlCNSTrgefv155HgdEK1Wd+HfxjIRCFAYWp4",
-	"I2ntrAl1IoeYM6XhUtl2WFqmTSx1WsUfx8Q3zi7vN6EPsLzPtLJawn1kzwwwB/yeBYULXv3xO2ew/arS",
-	"9zfkT+ORVGJ+aXb9f3LpRht3thv6AL8TbXiwWcqgVht3v9ylW2IrhBYoF31UBnMnuFpkLFD1+YYhtw8G",
-	"jzkcbHPG+DV88mCD+TOtXOkJVhQSIYPQavbB6lBaG12fMumPxmgTj+pa5YxxUh22n9DX2iwF56D+9yef",
-	"ZhlYW+GZtdiCwlKmvcmACEuUdoRh3gAPIVAKxPNOs0x7VUKqwgCiKF7lSg9kcVBOrESEf3iSA8VCqcnZ",
-	"4yWotdvQxUlEPPWfibJwHv14mgBypwSrknUsL8jDBmJVBuXMjjwwTOvASSd0pU3OMJiwAH2DTDRxUrTY",
-	"IJ1zsJatIZGRIX4+eWHQCO9qwrtE2qTadKI/D3S6rGsJ4zzAUyZ/7qg3YOnZqGYjOTiGHYawpfYuGOtn",
-	"yXZLrT8S49WUnDOFfc5jeeyWzMKbQluwU5q422XAaAdVXDFpB9BjJYxNeLRG24j7qhANtKRga+hD8zBT",
-	"pFwp2dHSkfR5whU8HiscSZ8nvDCwxaZ05AEV+XMO6YVtdEVps1Ts/gQRlTzp3v6IFVMOx6kYbTV4wrIQ",
-	"OPsh0SotbVHDGbISFdo1w3EsDof9cWlCnXZMDkWGz4nhNAxwGO7tGa4+4uTkVXIka9sy3qE6OGXMK7O+",
-	"4ImZ9HC5rBWg3748+f7F3+bPLqFVlr8N/bZ/9BufM+wAjGMlItiUKx2KTnm4xbrgNMacBeVa7aNNh+gY",
-	"Hh0YLDl2Z8N8/Jcb5kBK4eCv086VXotHcm6EExmT5PyfP2JYjNzmOo423eBhTWd6qilWDWw/ALzjaO28",
-	"YbjgQUKDP0a4mx62n9CjDosnHIckyx6xrwDV09SdYNjX08AIVwzbPkIducR1Tfts8Ho8aL32KuLWkPDl",
-	"lDLO80tJue/MJSN8t5Ey+hEnnDF6I5GyPxeNcP0LlueROvCnUPggHAdZfavEJw9ENHXFl+kb11QP2nwk",
-	"JgJQ8iDchjRQOJ10b3Tsqd3E2+hUp8Xgqg8sddgRRpA6nCYUOVVWYMGptx2pc/urkBrJeR+m1GHnr8DI",
-	"iP/xNhG4NNuSEZYvjMlylzWw0ZV3hXekMJr7DDhZ7rB8Kuw/lWlqeKZVq9qWK7NhV0+FSXPPJ9ZMlRNH",
-	"4OgTR9jDy4x6CXGER4Z7gCM9WrsyL8HKU8QB0PRbd1C35K8OvUtf+Lh80yvCOgC7PYgcCt/hVuA50P+A",
-	"/zrKX7cL+BgICfnqNHnYiGxDWBmA9aWEJYxzA9Z2x6wnbndTJ1D37HNvDIKKmGBJ41Vzf5kldEKtD7Ms",
-	"Hs2E9AY6i6qMqQwkpPfFrRbQXje8/G4+H4xQOWIGVMlCphW3hK0cmNIoYROIsMcSrPGCg8HxiQkJnHAf",
-	"Hw5qneoHjO/mr76fj+z7417lt2fWHyCrbhp00NsexR/iC4szYr0O9m2qYS9IRsBjfzeG832HY3Qi7y3J",
-	"WsvVL4nL0eMayPHc9UcYbEtsc/QO5NYk5qXb68tQCarRqDJ5J+XDvncgr4tlkpKDZwstlKuXUhay9kvc",
-	"AyxJiZ/wogbCV2/BkJVQnOTaIIzoj5zDCeaXsE0AyTFbdVHuRZbekY1Yb+SOWL9eg3XAp8O7PRlZ+4BV",
-	"Vrpa37EsOAxyJiRd0A/6P7D6uwG+YW6a6Xy4p6nD+AdhC0RoYEJ5JSVuDguZQ/jAIkBAD4UapCBzwMlW",
-	"MHIutefkPH7TJqxvhAuTV+JAOqFbMDYqdDKdT+dhQChAsULQBX05nU9f4tzO3CbUjhkrxKwy8Te8FjTb",
-	"nsyMVwFXBMJ16h32OszxeEcpbKivsR7ghF/OmHjZeC+htlpu4+NLO+XtlNwqCRaZ0Bnh6t4iY1xf2eqt",
-	"Jiw4LbEFjrqEZUZbS3IvnSgk9GW+1SQHs0Yx2hAO3Nd7WHRLAQajI6I2txG22aB+Q8QUpkSsKrj7KzK0",
-	"1W/HpCWnhClOzlBLRdyDJtYvG20DModHYd2EaAVdy/zaBEQQggQYJmfxRQpbRj0f0NNCVFjtUgQA2X7K",
-	"f5eu7Q3JrPtQup8czxAevY5giP+Z4AjC8mF/f9fb37+Yz3+39XmNaxMb9Kt/YF68iqelhNRazVpPCoHl",
-	"5ThL8xQQtu8+z5nZ0QVFr40lQ2AZycrnJGRHeIitpvGWK5dYVQNDFvfHMe/qNESO9/Hbe1J7sVWMbeJl",
-	"tYzymHClXPSr0VKGWRYlv4/sbakHA/+Lg94+K+Lt8eHeejP7AybH15YY/TQoN06Vn7t6xpk7xFYkKt+7",
-	"F3TjXGEXs1mGjXPaadgH9/HYDmsBM7q/2/83AAD//w==",
+
+	"7H1bc9w28u9XQfH8H6RT9EiWs6ldPR1Fjne169guyXa2KuujwZA9M1hxAC4ASpo4893/1d0AL0PORb4k",
+	"9iZP0pC4NtC3XzfA90lmFqXRoL1LTt8nc5A5WPr3+9dyhn9zcJlVpVdGJ6fJRQ7aq6kCJ/wcxC1Yp4wW",
+	"Zko/LZQWHGgvsfhIXIH2YiKzG6E0FbiYPnphNDz6QfpsLrg3obyw4KrCOyz25PgbIZ0ojJ7h336zYi6d",
+	"0MaLbC71DPLRv3SSJi6bw0LigP2yhOQ0cd4qPUtWq1WalNLKBfgws/PKOmP7c3tlnPKt2ZRyBnHgYYAp",
+	"DmlqKp3HF4XSN66uYeFWmcpRVZx+AZl3IqMOH02kgxxfKU0TScXdXGVzYWEhlXZiKp0XU2NFIe0sdikc",
+	"YLdKOw8yx47MdOrA91obiTMtYFH6pbiVRYX1/1OB80zCqbLOh2FdBmJLC8LYHCzkYrIUmQWmr1cLEFLn",
+	"4uLpSJxLjbSegMjMYqI05OJO+bkY8zDGTH2F9PtPBXaZpImWC1wAnvXWpUmTZ8YupO+vxff3pbE4xqJo",
+	"018scOMoPQuTKnBNcU3Or96KAykyU1QLLUqwwhHxIRdTBUV+KIwVGu4KpeFRDoVaKHz396uXL9q0teAr",
+	"q7F9ycvPC7sYiVc1oUWzm4iEaqYNkvBuDloAjVvp2YiGlEktZOGMmNTrAbmoXJzB+CzLoPSnwsO9P8rc",
+	"7TgwxWayTplibbL+j4Vpcpr8n6OGmY/4rTtiQgYyI8Wf49T7BP9B3qtFtRC6WkzAMi2Y5N4EsmwYENGy",
+	"M54cprIqfHL6p+M0WXDDyenJMf5Smn89TuNuUNrDDCwN7iVtqgGxo3OVSR+kjvOSaCzKNY6lkQkLhfTq",
+	"FnDk+BSpUoAHZCUsqTwssCHpeTs1VTfMkLf68BTbczoenNNlpf9mnH+G29D1p/YUpkqD421K1J5AIDjk",
+	"LfFTGu2AtwXcl4XJITn1toINu4R7aw+5tKYE6xXwIKTvTuSnZG4cTdJLX2FVW+nkXZoQubAoaJzkT4nK",
+	"kzQWxjKtKs7npsLnJBaJnLegvbHLa6qVSZ1BcY3lIUmTXE2nTbVrp37Gp4V0/roqc+khv86h8JKKurKQ",
+	"y2ua37t0XZTUD6S1cpmsmgdm8m/IPJZwflngkxygfFk/7SzP25MveYGIhLbSTEupnZoUcN1dto0Ltqne",
+	"2gptXsovee1QD/RX7qwozJ0jlcqqAmUG602jxa20pKszq/CV3HfdqK/N69ah5w7hfBHLXuQvqqKQkwKS",
+	"FTPV6ftEx0dhOGv95AMaFRdgAoXb1fFlpZ9TwXa3DuytymBX3Ssu1tQcXi/aRruaolK7Wtqw8u7Ll6jE",
+	"UcbOmLUsZKpUoH2SJpUtknqx0gRNLua2XWw82FpmLCs9E3h8V/O0mWYWnKPJQ1ZR3QXSgMxl0P66lhhx",
+	"YwRapMkdTK4zo50p4Jq7IuMR8muyTCL/y0Z8fGKud1+NuP4tVr+0SmeqlMXvYyd8QcJ/bSmGBHRNn+ng",
+	"sF/qYilspZ0IBYX0wlhBxWnvztQtBCft4PLZuXjy5MlfDpN0c08TmBoL+3TFJR/Wy0coHK56jQSU3thd",
+	"bXADL0PpdkMNNwxR/EP12sO02HPl/Idqsitj/XfL/grhc3bRhXQC3dvFQj5ygB4orlehHHk0LJ1SATKb",
+	"C0O1ZVEsxdQgE7B7Pz6VLhvjVhqfYi9jcYDrHOTV4Uhc0k6QGuWlM9aHag0/j1MxbhgafzF98D9kEHrC",
+	"RBwTfkAIEWERZiqkoOUWB2P660b/qo6Pn2Q3sKR/YHyYChjNRrFVHG7adM5jHgkGb1q4hzC4jV1VMmDg",
+	"2P1eH3oU9BJdQdodm/rY4ANiu9eT5bATmPTbWMj756Bnfo5e8HE6AH1cgbTZvL/ol6SrAvKFa3I3Nw4E",
+	"WtATY24EDigVdzARQfCKN5fPSUToZaAxEz0z2ksVWgr8DPc+ZcQCiZRJByPxFksThgU6s8uSdhatEeEb",
+	"2njhaKwRaxukD8+mTZ4WCU6GSECMy3qYeOw7mV8yRsKiVPvA0bIsC3T/ldFH/3aGjOM90Q9rjeWuukT+",
+	"TuYidsYw1ETlOejP3zMiPs5FbIKXxYIzlc1AKEY2JbMtjuyF8c8Qb/z8A3s9h2YguQEeCtwrJtEL438w",
+	"OUK/eX/Pvt6J0gqndAYd0JjnrnQXFSbeCIPFjs6yzFQ6YEKlhQwZLSrpDeC0jYCQBy3JRmrtxccM2dQ/",
+	"B3TaObm6V+Tp9oUyeGHYjEQX2rFwu5IeikJ54lkGl+7AgnBeFQU+0xHzqxmZAMPA5eJOkvDNoIB8JM6o",
+	"aSGzG23uCshnAfniEijcLASgsfUcqUxyTRwEZ11mN5Af1u3RsLimE67inYgGklRFZQHx6ALaHSkXJ1CD",
+	"l1OllZtD3p2L1Ms7uQxSNlisYQx11QZDoGENWoLnLEjPBjDAM7JGnJeLsiEdaG+XTDyumaQRIT1Fmxwe",
+	"YaUhu4X5oGfBLcA5OYNhtBqnoixuv5/qgu8GNP330Y7+gQzKtqZgwKw7sx/n4OdguxRVjvaFxsmgLj+w",
+	"lSYUW2mRzSG7EWijiwP6/3AkLjqPzxjkqReb1pQ40QnlxZ2pilws5A2kQumsqPKwk5QVBOykhPKbCiHS",
+	"m/Bu0V1engn1ObiUHeR5QMt1pAUbCMr5kRijPBsHl821gPg6BjMmoBwtjLHOuTTj7uvhgu6AsSSO2N0m",
+	"acIV+wNPk/tHWOHRrbSo2hzWbE/l79xK+9G5u1178iK0vkqTGuN5yijYC9KXPa+VX5Jyj8KLWDY4rDWq",
+	"RBEoRNmEA9AoCeKOeYQwG0pQsCPxgpS2F6rVVJTIE6xYGHODoYiy14NYgmfCrQNQvSUewq9O3++u97z2",
+	"GWSeKzZXX3XYsFdlTRbU1cQCvETnW8gJblecyqvIQ7bSFMFCk7ZCz6/rDZaVLY0DN0oGeHiDt9FhZqnz",
+	"jcxMppsGFJQmxBlwdx6Mpc7Hh9FeOxgbi794mdh64wG6kfiRA212nCIfg/QcmOJS1CQ4aiVUZ4VKxt/a",
+	"1ueBGov7ffvqDO7/Li3OqLHus5eWNvtzcvE3rutUFq4HZFJoss8RdRQHYYNoLjVhzPWQD/H7sH+6d+uF",
+	"fGjjGu73bRyLPqzxGE3es4NO8HnPTtZ0Gi9FoNmQYvsBvNy5vOuhO9bHKOWZRWswDrRXVDPtwSi1xddu",
+	"qh+bjE21fbs/DYTh0sQbL4t+k/R4IOjZiTNHV6fu4vHjbwZDfW1a8hxix0PEfGlnF/mWFIu+FVsPIPnT",
+	"k8d/PvnL8YMt21cE6F1WeqjnS7Jcg+GhUPVaQPPKTMWBAxBHOFerZXF0e3KE8u3oPSODqyMqeDgSzwhx",
+	"qE1d6g3rEzKBzaKMqs0RpZnm3qoosWrjraoIcdypS6KwH9arf6sWUgsLMsdGOuq17GiJN44ldbBKWvK0",
+	"XQ6VJdwzEYRbOookH9S2/+Gos0jP1L04t8qrTBbi/O33Ltm9PjV025vKGwe2Pf7K4Q7RAR6YwFwW0447",
+	"UdsD+ZB0ueQgU5fxZONsbfMho0+2SgcQzx0w4HlT4SLvAKI7u22cg1UPq96ZBtG2yFccv94HtcT44jlO",
+	"1mGtvWbI09oPFw2W0Cqi43vAqFRuFYGX7eU7zLGq4wc7arFgWq3D/7v6aomWVSussHtKr2LRdSh3R73L",
+	"uuyDUd790d3LSjPAi1Vi+GR3ndeh5KoTBNlR702ZNxu8ssXO8rZIVv0gzI5aP8LknEtT/SGousenfWmk",
+	"1X8qEKpRVpVr27p3xt5E1IAzxhqsb1gaBS7r9fSiUc6VphRBUibt+FXbdAhISMuJaFkB/Bb7HHwdcJDh",
+	"lwHDGX4ZwJThl61ds8Uw6b+6kwpN/uupsbi+GjImyftdtge32Qy5GV8zx3YssCbaxj7bpHs3vGH+ak1V",
+	"9lXKhwlaXOq9lEmsQF5Pf/O8jeGHxr1ycxkyHmuEPfpPNINdRjL3FIYYFck2mrjN0eI6yrtjnkzbXqR0",
+	"T61Rq4xFMN+3FSYTf33ONN5Qvx/I7Uz5b8ohIvDxc2ap+9pKzUl+g4Hi3iA3jco43x9SJ6Vpl/HRwoRX",
+	"Mf1pv239lMqu1hKh9s0NauNGwXAZEJJoGEYJHITyUkgGflD8Kl1jgjXSMySIVb7npFjLr2c7rZvvg+74",
+	"WoLYfv09l84H/fiUqj3AZqL6kQlCdtWOKh9oIISsuX6svfJl5UVpTV5lLH1iTCAuS41aGd3yPnABR+KM",
+	"UNsQnKX08BQfKMfRzya9ZdpC6jGhQWXKF0t23ygRmfNncZAEHhyy19VbpnZW3370vaIaV1hhk0mBxc6s",
+	"V1OZ+QGhWD8eRheGIj7PsIqYST+nLPZWWEYcxDxHavZwCOKLsMgAOE0vav9buht2ilsdpEJpjs0n6d4y",
+	"DSnwWrob7mBIoOOeeyARnkovCewNKq12WDnP3IG/5lYHSOBtpUNEbSjOpaad7HuKaBUw9QL3TzidIeOK",
+	"CrjPAPIQGsKtI2JueOh3YkwBUvdxJ6yexMk3C7NFmO+2Fjk41zUXR+Jlh18ojjQDH5BVpFdBNkFK4YWp",
+	"sVybLINogjLLPMTs/KLsyk9lK25ZnKdROa4HOaZTF0JSjQXG3LUOyiDpu1JQHHQjX+2QFsdbKag1AbGQ",
+	"ORzWS930xjsiWLX1OQw7z3hFayZes1vUdDo8F+SA9dlEab4weVVASGmRdaYOZ1gdcTZXKZVlt1K6mw06",
+	"vSWC2uHbwGQ0tiGDtc/oa3Km0eJ7uHYUnaM1oP/a8ZUByG7IuRvU4FuY10FmdO5aWQNsycTgV7AgxAHR",
+	"l0spz+9qChlSuoftISrtv/0mGQKIO2bClqzuaH/tBCZ3mAKtIF8a05doO+KOrg9v7be4fRXcl+YojkOf",
+	"pjcCFJOTpQf3IFq1NFmPYHALQxB+ZI/LSmuwgkqt5TKw8A+cY6nctdHXaBjZ1m+UTcPIpq2HtL8O5Wkw",
+	"QwfdMMjQgzp0L+7d6VeGQky4ehZbpOzbkz7ZO4c7Pp+X8DU6Tr8Lz+b6AQj1H+7Np3dvPgHWg818JVAP",
+	"TnhICn3IlN+efB2TfoDF9AF20lqS/EMyZDYYJJ3B19ZNd/z0OOYRxBxQxwdpIa9fVJv8nqEDuyFIysn9",
+	"ymhRA8ixsTQczslkUTT6NsRx2NhtQphChTBCqCwunjaHeTjKMDH5Mlj9DnwrmTGEs4NE2jO3ifTwMKXn",
+	"DcTZfxeFwFocOjijt6a4bR1DqoalExTTwcZbgZ5PE+R51QrSrdmLc2lr+6XOc43UpMUZzAQlWKQEm4H2",
+	"I3FBQvzx8bEw0Y7H6uRhQl7nngaXuj4q/vh4x7HqNOmE//bIoeD8VxOuXJBB/bxqZVvKPEdSQL4nq17V",
+	"6rPb93llKeshpOL2JAFm49Y0lO6GGQzjLuT0KkprCTPDN8FfxSEOB2dEpb0qgsJsOAb7qfOCAws0PMh1",
+	"mH1iZxvSjXtpn581pjRA4+fG3FTlZmE4mFbWTUxwHXW0c3kX8v6CC7dPLtRaab29vcytrW1u4M9eDKQf",
+	"wuhkT+yX+hwzxa4bC1AWxctpcvrT3rbgu3UP6qre7pHLW6fJfD2DNJwn8TXCE/ikkwjPkyKB35PVKCBJ",
+	"QfVZ70dkqXjgoGn7lP6v7zdpDfEgssthSowXf7bEVc0dDW50YCH8OEyFrAPbdcN1lYP4ihO3vROBP8SB",
+	"uwMowR52eCt2zwc8uYukOSWTpEmoNsgvTJVrUlzBD1iTik+bIbLKbOaPA6wnEnLb2+P3w6SNOryXTlyY",
+	"mdtP0T7YDVmz3ZobC3hXdDKK3m3jK3C7TnBuzVHe22vawSiObt9p+hUXT4dghl9zNLYaHsUa6RUdiV4n",
+	"2Qaav27Q6jqD+sm3x8frIzpbmEr7NgjIaClr7pY9lxntFF9fxGCQyCu+9KVWQrVF8e3xN3/ey6gYcme+",
+	"1hy3P7LVHpCt1sq73FqvLvhHjttXn+P2CdCarwO0+CQozVeB0Fw1LLIezm7hCt6q2Yw0RwNFrqE1OxK1",
+	"128LOH2/VmOn3TNwbUDfl9xw4N8F+yWNx39YOYYzQZ0k9OCvpY2Ptj6yUnoPFrv7/weh9C/Bt/sl1Pol",
+	"CIVfolf3y7BPd3iQfnQTh//3f5KN5GqT6rN7pDuXsJFlDz2t2g5i7n1k9Y0dOhZw+ZzAjQisxW3cbpVv",
+	"Zum11xWSgy0Tt5RGaV/jg46JFU3G9kUEd3Ow0BxOmCqdi4WxIFTvEFD/BMZrOt8FRU64f7jEQkwqL+Zq",
+	"Nqc7HmYzgv5H/blt5dYVhXOmJp6hlxktGCykKpLT5N/mZ5j+Pwv5XPpRZhZ9I7kWDU/rI5Z8PiaCldMQ",
+	"mhwKeDhhdC/14FZJcV6YKhfn/MzYEW1WT0w70GGSJuHgfHKaPB4dj45xnKYELUuVnCZPRsejJ3Rlj5+T",
+	"PD6SpToaOBx6dPv4CG2kGqmcgd98C0WTusAy1jXn/HGy8x6u2RajbiTe6AIcVsLFaGVdxLs7u4izKy3I",
+	"XMjMGufEoiq8KgtYb/OFEQuwM2zGWJFDXtWXIeCylGBxd0RIWbm6A/FIqBGMMI4Ugp7/FKo7/PaedOKM",
+	"0oC+w1Fq4e+McNWkGS3h0XRBQiqMhi5l/tlsCGrEaN4m3zGcVuPiGE9IzkoV4ymoEJLulbUbUJmmyFH3",
+	"IrxVun8FutZqjwp8begeBcMVnnuUDPfw7lEyXl/6bu3GkJPj4092L0akP1ke7WbuH+m839RAVka4wnV7",
+	"uZ5sefkP5ONvjo83DbCe8VHrghSq8mR3leZikxVlky0WEgMXCe6yXcxLVfaSIkfv4790GLCJEm8VLa1U",
+	"lKHAcUdD9ILGQjp8q/hOG7yf1zYhitBqCCgPXbx7SVk2fNduSDXxJtyXtJYjI+sRcbD6PF4nW1OZrslB",
+	"8nP8IGZNZkVA1TMovROzn1UpQGcmr4NQm4QAB5v/CgOCQCEFUcY3V++0aJ+0jWY2Wh60+9EZ3oPLaKsT",
+	"9T9os58cf/upGsRlV3i6MrT167AS1vhmd4367h6s8PjbAU6gXYh3NVxJr9xU1ZeHNZz6V/C9DbkhFW8P",
+	"fn2Iwn/VUYXdhMxwUJStNqoQmI31eveSa342bi7Obhl7buBuxs7NIKFd3CzWFAXY0PKYq7db3chTH6xU",
+	"3YM0qttfnbZuffvSlW+4p+zLUdO/hYpOhz6NMDTOUOyIylBbT/YUFfXtWr+VOfBg5e+OZvX5tkGZwsff",
+	"eneyuJjrcds+nceChc/oocFsW2aC7p4BxddcS9nBPH/O3L8FGz6GkLY/dEBPwn2H8Zaj5nigbB1x2CJR",
+	"eGp9mdIlwD9g2T186E04S9CKtmy6Vx8rbFXonasfdt398DnEzGdm9EDjL8ZopvH0dzIv1F780sDxpXFb",
+	"lHArLh4vz+hwSWcMaZN4ljfBO2FsP644Em9K3IMnx8dNJVtf+1nfyEeHQgKLcCKWaW4DRGbip/F6yFvF",
+	"kEedQcYGt0073NQEn8Ply+Ji2vj4RE06myvk2qjXyMHc5MF5cqpVY0Js4der+qsGPLjvTL78lHu1k6my",
+	"Wq3W+Xb1eVmlDmh/OR4mnUAr19buA3RMvGNmt+UaLn8LduVGhhGSPi5UZxM2ykV5N3xsbOO+eoiP9nHu",
+	"2Z6u2Uftot+N8fNgB67nkn2A69XcltTklg5u6CtvQS46WiAedNuyqXXe3cLSETwN9pED7fmojWvn/VU6",
+	"pnA6dsgc9Svo8zqu4Y+sl9BYp09JMbaVHnPjh3EMUV81YzkY499Qzh1yd3RrXyxObxhUp48yMdrMg8B2",
+	"xmjOjFN2KJmO8Ve4hRp7T8nw63QWCvGjg+5Nh5wQ1VzdSoM9JIhnQdRCbUTUK8Eqg98gwqs4vRE3ACza",
+	"WmmYslC3sFlUfM+L/kVJC3KIiE6PePG/VPDy4xiWGWoDP30UJ7dSwXdinU0SZM1EXZbqc3UqTJGD83zx",
+	"Ydp8+apmzh3Zk/Fa9/4F/2HkVMNChv5Rzsdt5vIWegPGM7V8znILdMlN/nfpxDCpr3j3RwQxbJW48B+9",
+	"9Rn5xz+rI9m+1mEnJzhvq8xX6I83NzmGQ7sDB797I+WE3mnn8geW83m8C2Ht7oP6ewTcX6128NDlw0+K",
+	"b8Lt68stfmUWSLcdPo3REjr32bvsd/3cPR2lq6txPJ5hl6Adk3RoIvFzcBunsa5KfoVQXr0a/wW8W/PX",
+	"RwQATj4o7N+c/9ke5Vc3IG4fp81OIeOpBvM7EQHeVrh1at0XrxYVtyfi7NXFSDDy7tL2pz6QicNN33S/",
+	"HFZ0uNXv5BK3uNLi9vEe8fW3J79yhP3tyT5Vfjcx9rcnvwGE/3VG2U8+LmoXA+J2JrX6OXwouMutH8eo",
+	"xJMRRW8+0ME13Wdh449g4Q+K57092bf8HxG9D4zo/SYC4fcY0wuHCIajVZyFgz8CchS+H3iazL0v3enR",
+	"UYYpi6NOquTGu+mDgcgNHCWrd6v/HQA=",
 }
 
 // decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
@@ -0,0 +1,48 @@
+package public
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ApiVersions are the versions of the public API, each served along with a specification of its own
+var ApiVersions = []string{"v1", "v2"}
+
+// SpecificationPath is where the specification of the version of the public API is served
+func SpecificationPath(version string) string {
+	return fmt.Sprintf("/api/playbook-dispatcher/%s/openapi.json", version)
+}
+
+// VersionSpecification derives the specification of a version of the public API from the embedded one, annotated
+// with the git revision the API was built from (x-build-commit). The v1 specification keeps describing every
+// operation as client generators have been built against it before the versions were served separately.
+func VersionSpecification(spec *openapi3.T, version, commit string) *openapi3.T {
+	served := *spec
+
+	info := *spec.Info
+	info.Extensions = maps.Clone(spec.Info.Extensions)
+	if info.Extensions == nil {
+		info.Extensions = map[string]any{}
+	}
+
+	info.Extensions["x-build-commit"] = commit
+	served.Info = &info
+
+	if version == ApiVersions[0] {
+		return &served
+	}
+
+	prefix := fmt.Sprintf("/api/playbook-dispatcher/%s/", version)
+	served.Paths = openapi3.NewPaths()
+
+	for path, item := range spec.Paths.Map() {
+		if strings.HasPrefix(path, prefix) {
+			served.Paths.Set(path, item)
+		}
+	}
+
+	return &served
+}
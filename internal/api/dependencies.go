@@ -0,0 +1,42 @@
+package api
+
+import (
+	"fmt"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/kessel"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// registerDependencies adds the HTTP/gRPC services the API talks to to the dependency report.
+// These are not critical - the API keeps serving (some) requests while they are unavailable - except for Kessel once
+// it is enabled.
+func registerDependencies(cfg *viper.Viper, ready *utils.ProbeHandler) {
+	timeout := cfg.GetDuration("health.check.timeout") * time.Second
+
+	services := []struct {
+		name   string
+		prefix string
+	}{
+		{"cloud-connector", "cloud.connector"},
+		{"inventory", "inventory.connector"},
+		{"sources", "sources"},
+		{"rbac", "rbac"},
+	}
+
+	for _, service := range services {
+		if cfg.GetString(service.prefix+".impl") != "impl" {
+			continue
+		}
+
+		url := fmt.Sprintf("%s://%s:%s", cfg.GetString(service.prefix+".scheme"), cfg.GetString(service.prefix+".host"), cfg.GetString(service.prefix+".port"))
+		utils.DieOnError(ready.RegisterDependency(service.name, false, utils.HttpReachable(url, timeout)))
+	}
+
+	// requests cannot be authorized while Kessel is unreachable unless the failure policy lets them through
+	if cfg.GetBool("kessel.enabled") {
+		utils.DieOnError(ready.RegisterDependency("kessel", cfg.GetString("kessel.failure_policy") != config.KesselFailOpen, kessel.HealthCheck(timeout)))
+	}
+}
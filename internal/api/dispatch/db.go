@@ -3,9 +3,12 @@ package dispatch
 import (
 	dbModel "playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/runevents"
+	"playbook-dispatcher/pkg/status"
 
 	"github.com/google/uuid"
 	"github.com/spf13/viper"
+	"gorm.io/gorm"
 )
 
 func newRun(input *generic.RunInput, correlationId uuid.UUID, responseFull bool, service string, cfg *viper.Viper) dbModel.Run {
@@ -14,7 +17,7 @@ func newRun(input *generic.RunInput, correlationId uuid.UUID, responseFull bool,
 		OrgID:          input.OrgId,
 		CorrelationID:  correlationId,
 		URL:            input.Url,
-		Status:         dbModel.RunStatusRunning,
+		Status:         string(status.Running),
 		Recipient:      input.Recipient,
 		Labels:         input.Labels,
 		ResponseFull:   responseFull,
@@ -25,12 +28,52 @@ func newRun(input *generic.RunInput, correlationId uuid.UUID, responseFull bool,
 		Principal:      input.Principal,
 		SatId:          input.SatId,
 		SatOrgId:       input.SatOrgId,
+		ExecutionMode:  *input.ExecutionMode, // defaulted
+		GroupID:        input.GroupId,
+		ParentRunID:    input.ParentRunId,
 	}
 
 	return run
 }
 
-func newHostRun(runHosts []generic.RunHostsInput, entityId uuid.UUID) []dbModel.RunHost {
+// log of the hosts of a chunk of a run that could not be dispatched
+const undispatchedHostLog = "The playbook was not dispatched to this host as the request addressing it failed"
+
+// chunkHosts splits the hosts of a run into chunks of at most size hosts (0 = a single chunk)
+func chunkHosts(hosts []generic.RunHostsInput, size int) [][]generic.RunHostsInput {
+	if size <= 0 || len(hosts) <= size {
+		return [][]generic.RunHostsInput{hosts}
+	}
+
+	chunks := make([][]generic.RunHostsInput, 0, (len(hosts)+size-1)/size)
+	for start := 0; start < len(hosts); start += size {
+		chunks = append(chunks, hosts[start:min(start+size, len(hosts))])
+	}
+
+	return chunks
+}
+
+// failUndispatchedHosts marks the hosts of the chunks that could not be dispatched as failed
+func failUndispatchedHosts(hosts []dbModel.RunHost, chunks [][]generic.RunHostsInput, undispatched []bool) {
+	offset := 0
+	for i, chunk := range chunks {
+		if undispatched[i] {
+			for j := offset; j < offset+len(chunk); j++ {
+				hosts[j].Status = string(status.Failure)
+				hosts[j].Log = undispatchedHostLog
+			}
+		}
+
+		offset += len(chunk)
+	}
+}
+
+func withHosts(run generic.RunInput, hosts []generic.RunHostsInput) generic.RunInput {
+	run.Hosts = hosts
+	return run
+}
+
+func newHostRun(runHosts []generic.RunHostsInput, entityId uuid.UUID, runStatus status.Status) []dbModel.RunHost {
 	newHosts := make([]dbModel.RunHost, len(runHosts))
 
 	for i, inputHost := range runHosts {
@@ -39,7 +82,7 @@ func newHostRun(runHosts []generic.RunHostsInput, entityId uuid.UUID) []dbModel.
 			RunID:                 entityId,
 			InventoryID:           inputHost.InventoryId,
 			SubscriptionManagerID: inputHost.SubscriptionManagerId,
-			Status:                dbModel.RunStatusRunning,
+			Status:                string(runStatus),
 		}
 
 		if inputHost.AnsibleHost != nil {
@@ -51,3 +94,57 @@ func newHostRun(runHosts []generic.RunHostsInput, entityId uuid.UUID) []dbModel.
 
 	return newHosts
 }
+
+// runInputFromDb rebuilds the dispatch request of a run that was not dispatched yet
+func runInputFromDb(run dbModel.Run, hosts []dbModel.RunHost) generic.RunInput {
+	input := generic.RunInput{
+		Recipient:     run.Recipient,
+		OrgId:         run.OrgID,
+		Url:           run.URL,
+		Hosts:         make([]generic.RunHostsInput, len(hosts)),
+		Timeout:       &run.Timeout,
+		SatId:         run.SatId,
+		SatOrgId:      run.SatOrgId,
+		Name:          run.PlaybookName,
+		WebConsoleUrl: &run.PlaybookRunUrl,
+		Principal:     run.Principal,
+		ExecutionMode: &run.ExecutionMode,
+	}
+
+	for i := range hosts {
+		input.Hosts[i] = generic.RunHostsInput{
+			AnsibleHost:           &hosts[i].Host,
+			InventoryId:           hosts[i].InventoryID,
+			SubscriptionManagerId: hosts[i].SubscriptionManagerID,
+		}
+	}
+
+	return input
+}
+
+// setCanceling moves the run to canceling unless a response finished it in the meantime, the hosts still running are
+// marked as cancel requested
+func setCanceling(database *gorm.DB, run dbModel.Run, requestId string) error {
+	return database.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&dbModel.Run{}).
+			Where("id = ?", run.ID).
+			Where("status", status.Running).
+			Update("status", status.Canceling)
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected > 0 {
+			transition := runevents.Transition{RunID: run.ID, Previous: string(status.Running), Status: string(status.Canceling), Source: runevents.SourceCancel, SourceEventID: requestId}
+			if err := runevents.Record(tx, transition); err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&dbModel.RunHost{}).
+			Where("run_id = ?", run.ID).
+			Where("status", status.Running).
+			Update("cancel_state", status.CancelRequested).Error
+	})
+}
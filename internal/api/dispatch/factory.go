@@ -2,17 +2,44 @@ package dispatch
 
 import (
 	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/common/ansible"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/encryption"
+	"playbook-dispatcher/internal/common/kessel"
+	"playbook-dispatcher/internal/common/utils"
 
 	"github.com/spf13/viper"
 	"golang.org/x/time/rate"
 	"gorm.io/gorm"
 )
 
-func NewDispatchManager(config *viper.Viper, cloudConnector connectors.CloudConnectorClient, rateLimiter *rate.Limiter, db *gorm.DB) DispatchManager {
+func NewDispatchManager(config *viper.Viper, cloudConnector connectors.CloudConnectorClient, rateLimiter *rate.Limiter, db *gorm.DB, labelCipher *encryption.LabelCipher, tupleWriter kessel.TupleWriter, playbookVerifier *ansible.PlaybookVerifier) DispatchManager {
 	return &dispatchManager{
 		config:         config,
 		cloudConnector: cloudConnector,
 		db:             db,
-		rateLimiter:    rateLimiter,
+		rateLimiter:    NewPriorityLimiter(config, rateLimiter),
+		labelCipher:    labelCipher,
+		tupleWriter:    tupleWriter,
+
+		playbookVerifier: playbookVerifier,
+		playbookClient:   utils.NewTimeoutHttpRequestDoer(config, "playbook.signature.fetch.timeout"),
+
+		playbookUrlClient: utils.NewTimeoutHttpRequestDoer(config, "dispatch.preflight.url.timeout"),
 	}
 }
+
+// returns a rate limiter reference that uses the token-bucket algorithm
+// the limits follow config reloads
+func NewRateLimiter(cfg *viper.Viper) *rate.Limiter {
+	limit := rate.Limit(cfg.GetInt("cloud.connector.rps"))
+	bucket := cfg.GetInt("cloud.connector.req.bucket")
+	limiter := rate.NewLimiter(limit, bucket)
+
+	config.OnReload(func(cfg *viper.Viper) {
+		limiter.SetLimit(rate.Limit(cfg.GetInt("cloud.connector.rps")))
+		limiter.SetBurst(cfg.GetInt("cloud.connector.req.bucket"))
+	})
+
+	return limiter
+}
@@ -2,16 +2,23 @@ package dispatch
 
 import (
 	"context"
+	"playbook-dispatcher/internal/api/audit"
 	"playbook-dispatcher/internal/api/connectors"
 	"playbook-dispatcher/internal/api/dispatch/protocols"
 	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/ansible"
+	"playbook-dispatcher/internal/common/encryption"
+	"playbook-dispatcher/internal/common/kessel"
 	"playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/runevents"
 	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/redhatinsights/platform-go-middlewares/v2/request_id"
 	"github.com/spf13/viper"
-	"golang.org/x/time/rate"
 	"gorm.io/gorm"
 )
 
@@ -19,7 +26,16 @@ type dispatchManager struct {
 	config         *viper.Viper
 	cloudConnector connectors.CloudConnectorClient
 	db             *gorm.DB
-	rateLimiter    *rate.Limiter
+	rateLimiter    *PriorityLimiter
+	labelCipher    *encryption.LabelCipher
+	tupleWriter    kessel.TupleWriter
+
+	// verifies the insights signatures of the playbooks of services that require signed playbooks
+	playbookVerifier *ansible.PlaybookVerifier
+	playbookClient   utils.HttpRequestDoer
+
+	// checks the playbook URL of runs dispatched with CheckRecipient
+	playbookUrlClient utils.HttpRequestDoer
 }
 
 func (dm *dispatchManager) newCorrelationId() uuid.UUID {
@@ -38,6 +54,10 @@ func (dm *dispatchManager) applyDefaults(run *generic.RunInput) {
 	if run.Timeout == nil {
 		run.Timeout = utils.IntRef(dm.config.GetInt("default.run.timeout"))
 	}
+
+	if run.ExecutionMode == nil {
+		run.ExecutionMode = utils.StringRef(generic.ExecutionModeRun)
+	}
 }
 
 func getProtocol(runInput generic.RunInput) protocols.Protocol {
@@ -49,20 +69,118 @@ func getProtocol(runInput generic.RunInput) protocols.Protocol {
 }
 
 func (dm *dispatchManager) ProcessRun(ctx context.Context, orgID string, service string, run generic.RunInput) (runID, correlationID uuid.UUID, err error) {
+	start := time.Now()
 	correlationID = dm.newCorrelationId()
 	ctx = utils.WithCorrelationId(ctx, correlationID.String())
 
 	dm.applyDefaults(&run)
 
+	if err := dm.verifyPlaybook(ctx, service, run); err != nil {
+		return uuid.UUID{}, correlationID, err
+	}
+
+	if run.CheckRecipient {
+		if err := dm.preflight(ctx, orgID, run); err != nil {
+			return uuid.UUID{}, correlationID, err
+		}
+	}
+
 	protocol := getProtocol(run)
+	chunks := chunkHosts(run.Hosts, protocol.GetHostsPerRequest(dm.config))
+
+	// the entity is only set once the run is dispatched
+	var entity db.Run
+	err = dm.withRunLimit(ctx, orgID, func(database *gorm.DB) error {
+		runStatus := status.Running
+		undispatched := make([]bool, len(chunks))
+
+		if err := dm.sendRunRequest(ctx, orgID, withHosts(run, chunks[0]), correlationID, protocol); err != nil {
+			if !dm.waitsForConnection(run, err) {
+				return err
+			}
+
+			// nothing is sent until the recipient connects, see ProcessReconnect
+			instrumentation.RunWaitingForConnection(ctx, run.Recipient)
+			runStatus = status.WaitingForConnection
+		} else {
+			dm.sendRemainingChunks(ctx, orgID, run, correlationID, protocol, chunks, undispatched)
+			instrumentation.RunDispatched(ctx, service, protocol.GetLabel(), time.Since(start))
+		}
+
+		entity = newRun(&run, correlationID, protocol.GetResponseFull(dm.config), service, dm.config)
+		entity.Status = string(runStatus)
+		entity.DispatchChunks = len(chunks)
+		entity.Labels = dm.labelCipher.Encrypt(entity.Labels)
+
+		return database.Transaction(func(tx *gorm.DB) error {
+			if dbResult := tx.Create(&entity); dbResult.Error != nil {
+				instrumentation.PlaybookRunCreateError(ctx, dbResult.Error, &entity, protocol.GetLabel())
+				return dbResult.Error
+			}
+
+			transition := runevents.Transition{RunID: entity.ID, Status: entity.Status, Source: runevents.SourceDispatch, SourceEventID: request_id.GetReqID(ctx)}
+			if err := runevents.Record(tx, transition); err != nil {
+				return err
+			}
+
+			if len(run.Hosts) > 0 {
+				newHosts := newHostRun(run.Hosts, entity.ID, runStatus)
+
+				failUndispatchedHosts(newHosts, chunks, undispatched)
+
+				if dbResult := tx.Create(newHosts); dbResult.Error != nil {
+					instrumentation.PlaybookRunHostCreateError(ctx, dbResult.Error, newHosts, protocol.GetLabel())
+					return dbResult.Error
+				}
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return entity.ID, correlationID, err
+	}
+
+	kesselRun := kessel.Run{ID: entity.ID, OrgID: orgID, Service: service}
+	if run.Principal != nil {
+		kesselRun.Owner = *run.Principal
+	}
+
+	// the run is dispatched already, it only cannot be checked on its own in Kessel
+	if token, err := dm.tupleWriter.WriteRun(ctx, kesselRun); err != nil {
+		instrumentation.KesselTupleWriteError(ctx, err, entity.ID)
+	} else if token != "" {
+		// checks of the run need to be at least as fresh as the write, replicas may lag behind
+		if err := dm.db.WithContext(ctx).Model(&db.Run{}).Where("id = ?", entity.ID).Update("kessel_token", token).Error; err != nil {
+			instrumentation.KesselTupleWriteError(ctx, err, entity.ID)
+		}
+	}
+
+	instrumentation.RunCreated(ctx, run.Recipient, entity.ID, run.Url, entity.Service, protocol.GetLabel())
+	return entity.ID, correlationID, nil
+}
+
+// once the first chunk is dispatched the run exists, the hosts of any other chunk that cannot be dispatched fail
+func (dm *dispatchManager) sendRemainingChunks(ctx context.Context, orgID string, run generic.RunInput, correlationID uuid.UUID, protocol protocols.Protocol, chunks [][]generic.RunHostsInput, undispatched []bool) {
+	for i := 1; i < len(chunks); i++ {
+		if err := dm.sendRunRequest(ctx, orgID, withHosts(run, chunks[i]), correlationID, protocol); err != nil {
+			utils.GetLogFromContext(ctx).Errorw("Error dispatching chunk of run hosts", "chunk", i, "hosts", len(chunks[i]), "error", err)
+			undispatched[i] = true
+		}
+	}
+}
 
+func (dm *dispatchManager) waitsForConnection(run generic.RunInput, err error) bool {
+	_, notConnected := err.(*RecipientNotFoundError)
+	return notConnected && run.WaitForConnection && dm.config.GetBool("wait.for.connection.enabled")
+}
+
+func (dm *dispatchManager) sendRunRequest(ctx context.Context, orgID string, run generic.RunInput, correlationID uuid.UUID, protocol protocols.Protocol) error {
 	signalMetadata := protocol.BuildMetaData(run, correlationID, dm.config)
 
 	// take from the rate limit bucket
-	rateErr := dm.rateLimiter.Wait(ctx)
-
-	if rateErr != nil {
-		return uuid.UUID{}, correlationID, rateErr
+	if err := dm.rateLimiter.Wait(ctx, run.Priority); err != nil {
+		return err
 	}
 
 	messageId, notFound, err := dm.cloudConnector.SendCloudConnectorRequest(
@@ -76,40 +194,14 @@ func (dm *dispatchManager) ProcessRun(ctx context.Context, orgID string, service
 
 	if err != nil {
 		instrumentation.CloudConnectorRequestError(ctx, err, run.Recipient, protocol.GetLabel())
-		return uuid.UUID{}, correlationID, err
+		return err
 	} else if notFound {
 		instrumentation.CloudConnectorNoConnection(ctx, run.Recipient, protocol.GetLabel())
-		return uuid.UUID{}, correlationID, &RecipientNotFoundError{recipient: run.Recipient, err: err}
+		return &RecipientNotFoundError{recipient: run.Recipient, err: err}
 	}
 
 	instrumentation.CloudConnectorOK(ctx, run.Recipient, messageId)
-
-	entity := newRun(&run, correlationID, protocol.GetResponseFull(dm.config), service, dm.config)
-
-	err = dm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if dbResult := tx.Create(&entity); dbResult.Error != nil {
-			instrumentation.PlaybookRunCreateError(ctx, dbResult.Error, &entity, protocol.GetLabel())
-			return dbResult.Error
-		}
-
-		if len(run.Hosts) > 0 {
-			newHosts := newHostRun(run.Hosts, entity.ID)
-
-			if dbResult := tx.Create(newHosts); dbResult.Error != nil {
-				instrumentation.PlaybookRunHostCreateError(ctx, dbResult.Error, newHosts, protocol.GetLabel())
-				return dbResult.Error
-			}
-		}
-
-		return nil
-	})
-
-	if err != nil {
-		return entity.ID, correlationID, err
-	}
-
-	instrumentation.RunCreated(ctx, run.Recipient, entity.ID, run.Url, entity.Service, protocol.GetLabel())
-	return entity.ID, correlationID, nil
+	return nil
 }
 
 func (dm *dispatchManager) ProcessCancel(ctx context.Context, orgID string, cancel generic.CancelInput) (runID, correlationID uuid.UUID, err error) {
@@ -126,20 +218,25 @@ func (dm *dispatchManager) ProcessCancel(ctx context.Context, orgID string, canc
 		return uuid.UUID{}, run.CorrelationID, &RunOrgIdMismatchError{err: err, runID: cancel.RunId}
 	}
 
+	var protocol protocols.Protocol = protocols.SatelliteProtocol
 	if run.SatId == nil || run.SatOrgId == nil {
-		instrumentation.PlaybookRunCancelRunTypeError(ctx, run.ID)
-		return uuid.UUID{}, run.CorrelationID, &RunCancelTypeError{err, run.ID}
+		// older versions of rhc-worker-playbook do not understand the cancel signal
+		if !dm.config.GetBool("cancel.rhc.enabled") {
+			instrumentation.PlaybookRunCancelRunTypeError(ctx, run.ID)
+			return uuid.UUID{}, run.CorrelationID, &RunCancelTypeError{err, run.ID}
+		}
+
+		protocol = protocols.RunnerProtocol
 	}
 
-	if run.Status != db.RunStatusRunning {
+	if run.Status != string(status.Running) {
 		return uuid.UUID{}, run.CorrelationID, &RunCancelNotCancelableError{run.ID}
 	}
 
-	protocol := *protocols.SatelliteProtocol
 	signalMetadata := protocol.BuildCancelMetaData(cancel, run.CorrelationID, dm.config)
 
-	// take from the rate limit bucket
-	rateErr := dm.rateLimiter.Wait(ctx)
+	// take from the rate limit bucket, a cancel is not held up behind queued dispatches
+	rateErr := dm.rateLimiter.Wait(ctx, generic.PriorityHigh)
 
 	if rateErr != nil {
 		return uuid.UUID{}, correlationID, rateErr
@@ -165,5 +262,28 @@ func (dm *dispatchManager) ProcessCancel(ctx context.Context, orgID string, canc
 	instrumentation.CloudConnectorOK(ctx, run.Recipient, messageId)
 	instrumentation.RunCanceled(ctx, run.ID)
 
+	// the run and the hosts still running the playbook are expected to acknowledge the cancel, see the response consumer
+	if err := setCanceling(dm.db.WithContext(ctx), run, request_id.GetReqID(ctx)); err != nil {
+		utils.GetLogFromContext(ctx).Errorw("Error marking run as canceling", "run_id", run.ID, "error", err)
+	}
+
+	if dm.config.GetBool("audit.enabled") {
+		event := audit.Event{
+			Type:      audit.EventRunCanceled,
+			OrgId:     orgID,
+			Principal: cancel.Principal,
+			RequestId: request_id.GetReqID(ctx),
+			Details: map[string]string{
+				"run_id":         run.ID.String(),
+				"correlation_id": run.CorrelationID.String(),
+				"recipient":      run.Recipient.String(),
+			},
+		}
+
+		if err := audit.Record(ctx, dm.db, event); err != nil {
+			utils.GetLogFromContext(ctx).Errorw("Error recording audit event", "event_type", event.Type, "error", err)
+		}
+	}
+
 	return cancel.RunId, run.CorrelationID, nil
 }
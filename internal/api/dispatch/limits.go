@@ -0,0 +1,88 @@
+package dispatch
+
+import (
+	"context"
+	"errors"
+	"playbook-dispatcher/internal/api/instrumentation"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/pkg/status"
+
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+// GetRunLimit returns the maximum number of concurrently running runs of the org (0 = no limit) and whether it is
+// overridden for the org rather than taken from run.concurrency.limit
+func GetRunLimit(db *gorm.DB, cfg *viper.Viper, orgID string) (limit int, override bool, err error) {
+	var runLimit dbModel.RunLimit
+
+	err = db.Where("org_id = ?", orgID).Take(&runLimit).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return cfg.GetInt("run.concurrency.limit"), false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+
+	return runLimit.MaxRunning, true, nil
+}
+
+// CountActiveRuns returns the number of runs of the org counting against its limit: runs running or being canceled and
+// runs waiting for their recipient to connect, which start running as soon as it does
+func CountActiveRuns(db *gorm.DB, orgID string) (count int64, err error) {
+	statuses := status.Strings(append(status.Active(), status.WaitingForConnection)...)
+	err = db.Model(&dbModel.Run{}).Where("org_id = ? AND status IN ?", orgID, statuses).Count(&count).Error
+	return
+}
+
+// checkRunLimit rejects a new run if the org already has as many active runs as it is allowed to.
+// It does not prevent runs dispatched at the same time from exceeding the limit together, see withRunLimit.
+func (dm *dispatchManager) checkRunLimit(ctx context.Context, orgID string) error {
+	database := dm.db.WithContext(ctx)
+
+	limit, _, err := GetRunLimit(database, dm.config, orgID)
+	if err != nil || limit == 0 {
+		return err
+	}
+
+	return checkActiveRuns(ctx, database, orgID, limit)
+}
+
+// withRunLimit calls create (which dispatches and stores the run) unless the org already has as many active runs as it
+// is allowed to. If the org is limited, the check and create share a transaction holding a lock on the org so that the
+// runs of the org are created one at a time and cannot exceed the limit together.
+func (dm *dispatchManager) withRunLimit(ctx context.Context, orgID string, create func(database *gorm.DB) error) error {
+	database := dm.db.WithContext(ctx)
+
+	limit, _, err := GetRunLimit(database, dm.config, orgID)
+	if err != nil {
+		return err
+	} else if limit == 0 {
+		return create(database)
+	}
+
+	return database.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "run_limit:"+orgID).Error; err != nil {
+			return err
+		}
+
+		if err := checkActiveRuns(ctx, tx, orgID, limit); err != nil {
+			return err
+		}
+
+		return create(tx)
+	})
+}
+
+func checkActiveRuns(ctx context.Context, db *gorm.DB, orgID string, limit int) error {
+	active, err := CountActiveRuns(db, orgID)
+	if err != nil {
+		return err
+	}
+
+	if active >= int64(limit) {
+		instrumentation.RunLimitExceeded(ctx, orgID, limit)
+		return &RunLimitExceededError{orgID: orgID, limit: limit}
+	}
+
+	return nil
+}
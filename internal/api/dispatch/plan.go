@@ -0,0 +1,71 @@
+package dispatch
+
+import (
+	"context"
+	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/common/model/generic"
+)
+
+// RunPlan describes how a run would be dispatched, see PlanRun
+type RunPlan struct {
+	// the run with the defaults applied
+	Run                generic.RunInput
+	Satellite          bool
+	DispatchChunks     int
+	RecipientConnected bool
+	// the recipient is not connected but the run would wait for it
+	WaitsForConnection bool
+}
+
+// PlanRun describes how the run would be dispatched without sending anything to cloud connector or storing the run.
+// A RecipientNotFoundError is returned along with the plan if the run would be rejected as the recipient is not connected,
+// a PreflightError if the run would fail its pre-flight check and a PlaybookSignatureError if the service requires signed
+// playbooks and the signature of the playbook could not be verified.
+func (dm *dispatchManager) PlanRun(ctx context.Context, orgID string, service string, run generic.RunInput) (plan RunPlan, err error) {
+	dm.applyDefaults(&run)
+
+	protocol := getProtocol(run)
+	plan = RunPlan{
+		Run:            run,
+		Satellite:      run.SatId != nil,
+		DispatchChunks: len(chunkHosts(run.Hosts, protocol.GetHostsPerRequest(dm.config))),
+	}
+
+	if err := dm.checkRunLimit(ctx, orgID); err != nil {
+		return plan, err
+	}
+
+	if err := dm.verifyPlaybook(ctx, service, run); err != nil {
+		return plan, err
+	}
+
+	// take from the rate limit bucket shared with the dispatch of runs
+	if err := dm.rateLimiter.Wait(ctx, run.Priority); err != nil {
+		return plan, err
+	}
+
+	connectionStatus, err := dm.cloudConnector.GetConnectionStatus(ctx, orgID, run.Recipient.String())
+	if err != nil {
+		return plan, err
+	}
+
+	plan.RecipientConnected = connectionStatus == connectors.Connected
+	if !plan.RecipientConnected {
+		notFound := &RecipientNotFoundError{recipient: run.Recipient}
+		if !dm.waitsForConnection(run, notFound) {
+			if run.CheckRecipient {
+				return plan, dm.preflightFailed(ctx, run, PreflightReasonRecipientNotConnected)
+			}
+
+			return plan, notFound
+		}
+
+		plan.WaitsForConnection = true
+	}
+
+	if run.CheckRecipient {
+		return plan, dm.checkPlaybookUrl(ctx, run)
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,76 @@
+package dispatch
+
+import (
+	"context"
+	"net/http"
+	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/utils"
+)
+
+// reasons of a failed pre-flight check, see PreflightError
+const (
+	PreflightReasonRecipientNotConnected = "recipient_not_connected"
+	PreflightReasonPlaybookUnreachable   = "playbook_url_unreachable"
+	PreflightReasonPlaybookHeaderMissing = "playbook_header_missing"
+)
+
+// preflight fails fast on a run that would not get executed, before anything is sent to cloud connector.
+// A recipient that is not connected is fine if the run waits for it to connect.
+// Unlike PlanRun, the check does not take from the rate limit bucket as the dispatch that follows does.
+func (dm *dispatchManager) preflight(ctx context.Context, orgID string, run generic.RunInput) error {
+	connectionStatus, err := dm.cloudConnector.GetConnectionStatus(ctx, orgID, run.Recipient.String())
+	if err != nil {
+		return err
+	}
+
+	if connectionStatus != connectors.Connected && !dm.waitsForConnection(run, &RecipientNotFoundError{recipient: run.Recipient}) {
+		return dm.preflightFailed(ctx, run, PreflightReasonRecipientNotConnected)
+	}
+
+	return dm.checkPlaybookUrl(ctx, run)
+}
+
+// checkPlaybookUrl sends a HEAD request to the playbook URL. The URL may require authentication the dispatcher does not
+// have, so any status below 500 passes unless a response header is required (dispatch.preflight.required.header), in
+// which case the request has to succeed and the response carry the header. Only the presence of the header is checked,
+// the signature of the playbook itself is verified by verifyPlaybook.
+func (dm *dispatchManager) checkPlaybookUrl(ctx context.Context, run generic.RunInput) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, run.Url, nil)
+	if err != nil {
+		return dm.preflightFailed(ctx, run, PreflightReasonPlaybookUnreachable)
+	}
+
+	res, err := dm.playbookUrlClient.Do(req)
+	if err != nil {
+		utils.GetLogFromContext(ctx).Debugw("Playbook URL not reachable", "url", run.Url, "error", err)
+		return dm.preflightFailed(ctx, run, PreflightReasonPlaybookUnreachable)
+	}
+
+	res.Body.Close()
+
+	if res.StatusCode >= http.StatusInternalServerError {
+		return dm.preflightFailed(ctx, run, PreflightReasonPlaybookUnreachable)
+	}
+
+	header := dm.config.GetString("dispatch.preflight.required.header")
+	if header == "" {
+		return nil
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return dm.preflightFailed(ctx, run, PreflightReasonPlaybookUnreachable)
+	}
+
+	if res.Header.Get(header) == "" {
+		return dm.preflightFailed(ctx, run, PreflightReasonPlaybookHeaderMissing)
+	}
+
+	return nil
+}
+
+func (dm *dispatchManager) preflightFailed(ctx context.Context, run generic.RunInput, reason string) error {
+	instrumentation.DispatchPreflightFailed(ctx, run.Recipient, run.Url, reason)
+	return &PreflightError{reason: reason}
+}
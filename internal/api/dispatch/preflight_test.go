@@ -0,0 +1,70 @@
+package dispatch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+var _ = Describe("Pre-flight check", func() {
+	var (
+		cfg      *viper.Viper
+		limiter  *rate.Limiter
+		dm       *dispatchManager
+		playbook *httptest.Server
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		cfg = viper.New()
+		cfg.Set("dispatch.preflight.url.timeout", 5)
+
+		limiter = rate.NewLimiter(rate.Every(time.Hour), 1)
+		dm = &dispatchManager{
+			config:            cfg,
+			cloudConnector:    connectors.NewConnectorClientMock(),
+			rateLimiter:       NewPriorityLimiter(cfg, limiter),
+			playbookUrlClient: utils.NewTimeoutHttpRequestDoer(cfg, "dispatch.preflight.url.timeout"),
+		}
+
+		playbook = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Playbook-Signature", "signature")
+		}))
+
+		ctx = utils.SetLog(context.Background(), zap.NewNop().Sugar())
+	})
+
+	AfterEach(func() {
+		playbook.Close()
+	})
+
+	run := func() generic.RunInput {
+		return generic.RunInput{Recipient: uuid.New(), Url: playbook.URL, CheckRecipient: true}
+	}
+
+	It("does not take from the rate limit bucket", func() {
+		Expect(dm.preflight(ctx, "12345", run())).To(Succeed())
+		Expect(limiter.Tokens()).To(BeNumerically(">=", 1))
+	})
+
+	It("only checks the presence of the required header", func() {
+		cfg.Set("dispatch.preflight.required.header", "X-Playbook-Signature")
+		Expect(dm.preflight(ctx, "12345", run())).To(Succeed())
+
+		cfg.Set("dispatch.preflight.required.header", "X-Insights-Signature")
+		err := dm.preflight(ctx, "12345", run())
+		Expect(err).To(BeAssignableToTypeOf(&PreflightError{}))
+		Expect(err.(*PreflightError).Reason()).To(Equal(PreflightReasonPlaybookHeaderMissing))
+	})
+})
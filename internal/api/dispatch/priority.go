@@ -0,0 +1,172 @@
+package dispatch
+
+import (
+	"context"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/model/generic"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	"golang.org/x/time/rate"
+)
+
+var priorities = []string{generic.PriorityHigh, generic.PriorityNormal, generic.PriorityLow}
+
+// PriorityLimiter hands out the tokens of the cloud connector rate limiter to the requests waiting for one.
+// Each priority has a queue of its own. While requests are waiting the queues are drained in proportion to the weight of
+// their priority (cloud.connector.priority.weight.*) so that low priority requests cannot hold up urgent ones but are
+// not starved by them either.
+type PriorityLimiter struct {
+	config  *viper.Viper
+	limiter *rate.Limiter
+
+	lock     sync.Mutex
+	queues   map[string][]chan struct{}
+	current  map[string]int
+	draining bool
+}
+
+func NewPriorityLimiter(config *viper.Viper, limiter *rate.Limiter) *PriorityLimiter {
+	return &PriorityLimiter{
+		config:  config,
+		limiter: limiter,
+		queues:  make(map[string][]chan struct{}, len(priorities)),
+		current: make(map[string]int, len(priorities)),
+	}
+}
+
+func normalizePriority(priority string) string {
+	switch priority {
+	case generic.PriorityHigh, generic.PriorityLow:
+		return priority
+	default:
+		return generic.PriorityNormal
+	}
+}
+
+// Wait blocks until a token is handed out to the request or the context is done
+func (this *PriorityLimiter) Wait(ctx context.Context, priority string) error {
+	priority = normalizePriority(priority)
+	start := time.Now()
+
+	this.lock.Lock()
+	// nothing is queued, no need to wait for a turn
+	if !this.draining && this.limiter.Allow() {
+		this.lock.Unlock()
+		instrumentation.DispatchQueueWait(priority, time.Since(start))
+		return nil
+	}
+
+	ready := make(chan struct{})
+	this.queues[priority] = append(this.queues[priority], ready)
+
+	if !this.draining {
+		this.draining = true
+		go this.drain()
+	}
+	this.lock.Unlock()
+
+	select {
+	case <-ready:
+		instrumentation.DispatchQueueWait(priority, time.Since(start))
+		return nil
+	case <-ctx.Done():
+		this.lock.Lock()
+		defer this.lock.Unlock()
+
+		queue := this.queues[priority]
+		for i := range queue {
+			if queue[i] == ready {
+				this.queues[priority] = append(queue[:i:i], queue[i+1:]...)
+				return ctx.Err()
+			}
+		}
+
+		// the token got handed out in the meantime
+		instrumentation.DispatchQueueWait(priority, time.Since(start))
+		return nil
+	}
+}
+
+// drain hands out tokens to the queued requests as they become available, it returns once all the queues are empty
+func (this *PriorityLimiter) drain() {
+	for {
+		this.lock.Lock()
+		if !this.queued() {
+			this.draining = false
+			this.lock.Unlock()
+			return
+		}
+		this.lock.Unlock()
+
+		if err := this.limiter.Wait(context.Background()); err != nil {
+			// the burst is misconfigured, retry once the config gets reloaded
+			time.Sleep(time.Second)
+			continue
+		}
+
+		this.lock.Lock()
+		ready := this.next()
+		if ready == nil {
+			// the requests waiting got canceled
+			this.draining = false
+			this.lock.Unlock()
+			return
+		}
+
+		close(ready)
+		this.lock.Unlock()
+	}
+}
+
+func (this *PriorityLimiter) queued() bool {
+	for _, queue := range this.queues {
+		if len(queue) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// next pops the request of the priority whose turn it is using smooth weighted round-robin
+func (this *PriorityLimiter) next() chan struct{} {
+	selected := ""
+	total := 0
+
+	for _, priority := range priorities {
+		if len(this.queues[priority]) == 0 {
+			// a priority that has nothing queued does not build up credit
+			this.current[priority] = 0
+			continue
+		}
+
+		weight := this.weight(priority)
+		total += weight
+		this.current[priority] += weight
+
+		if selected == "" || this.current[priority] > this.current[selected] {
+			selected = priority
+		}
+	}
+
+	if selected == "" {
+		return nil
+	}
+
+	this.current[selected] -= total
+
+	ready := this.queues[selected][0]
+	this.queues[selected] = this.queues[selected][1:]
+	return ready
+}
+
+func (this *PriorityLimiter) weight(priority string) int {
+	weight := this.config.GetInt("cloud.connector.priority.weight." + priority)
+	if weight < 1 {
+		return 1
+	}
+
+	return weight
+}
@@ -0,0 +1,95 @@
+package dispatch
+
+import (
+	"context"
+	"playbook-dispatcher/internal/common/model/generic"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"golang.org/x/time/rate"
+)
+
+var _ = Describe("Priority limiter", func() {
+	var (
+		limiter *PriorityLimiter
+	)
+
+	BeforeEach(func() {
+		cfg := viper.New()
+		cfg.Set("cloud.connector.priority.weight.high", 6)
+		cfg.Set("cloud.connector.priority.weight.normal", 3)
+		cfg.Set("cloud.connector.priority.weight.low", 1)
+
+		limiter = NewPriorityLimiter(cfg, rate.NewLimiter(rate.Limit(100), 1))
+	})
+
+	It("does not hold up requests within the rate limit", func() {
+		start := time.Now()
+		Expect(limiter.Wait(context.Background(), generic.PriorityLow)).To(Succeed())
+		Expect(time.Since(start)).To(BeNumerically("<", 5*time.Millisecond))
+	})
+
+	It("drains the queues by weight", func() {
+		Expect(limiter.Wait(context.Background(), "")).To(Succeed())
+
+		var (
+			lock  sync.Mutex
+			order []string
+			wg    sync.WaitGroup
+		)
+
+		wait := func(priority string) {
+			defer wg.Done()
+			Expect(limiter.Wait(context.Background(), priority)).To(Succeed())
+
+			lock.Lock()
+			defer lock.Unlock()
+			order = append(order, priority)
+		}
+
+		// the requests are queued before the next token is available
+		limiter.limiter.SetLimit(rate.Every(200 * time.Millisecond))
+
+		for range 7 {
+			wg.Add(2)
+			go wait(generic.PriorityLow)
+			go wait(generic.PriorityHigh)
+		}
+
+		Eventually(func() int {
+			limiter.lock.Lock()
+			defer limiter.lock.Unlock()
+			return len(limiter.queues[generic.PriorityLow]) + len(limiter.queues[generic.PriorityHigh])
+		}).Should(Equal(14))
+
+		limiter.limiter.SetLimit(rate.Limit(100))
+		wg.Wait()
+
+		Expect(order).To(HaveLen(14))
+		high := 0
+		for _, priority := range order[:7] {
+			if priority == generic.PriorityHigh {
+				high++
+			}
+		}
+
+		Expect(high).To(BeNumerically(">=", 5))
+	})
+
+	It("gives up waiting once the context is done", func() {
+		Expect(limiter.Wait(context.Background(), generic.PriorityNormal)).To(Succeed())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		Expect(limiter.Wait(ctx, generic.PriorityNormal)).To(MatchError(context.Canceled))
+		Expect(limiter.Wait(context.Background(), generic.PriorityNormal)).To(Succeed())
+
+		limiter.lock.Lock()
+		defer limiter.lock.Unlock()
+		Expect(limiter.queued()).To(BeFalse())
+	})
+})
@@ -1,6 +1,10 @@
 package protocols
 
-import "github.com/spf13/viper"
+import (
+	"playbook-dispatcher/internal/common/model/generic"
+
+	"github.com/spf13/viper"
+)
 
 func buildCommonSignal(cfg *viper.Viper) map[string]string {
 	return map[string]string{
@@ -8,3 +12,11 @@ func buildCommonSignal(cfg *viper.Viper) map[string]string {
 		"response_interval": cfg.GetString("response.interval"),
 	}
 }
+
+// the executor runs ansible-playbook with --check --diff if execution_mode is set to check
+// the key is left out for regular runs so that older executors are not affected
+func addExecutionMode(metadata map[string]string, runInput generic.RunInput) {
+	if runInput.ExecutionMode != nil && *runInput.ExecutionMode == generic.ExecutionModeCheck {
+		metadata["execution_mode"] = generic.ExecutionModeCheck
+	}
+}
@@ -21,9 +21,23 @@ func (rp *runnerProtocol) GetResponseFull(cfg *viper.Viper) bool {
 	return true
 }
 
+func (rp *runnerProtocol) GetHostsPerRequest(cfg *viper.Viper) int {
+	return 0
+}
+
 func (rp *runnerProtocol) BuildMetaData(runInput generic.RunInput, correlationID uuid.UUID, cfg *viper.Viper) map[string]string {
 	metadata := buildCommonSignal(cfg)
 	metadata["crc_dispatcher_correlation_id"] = correlationID.String()
+	addExecutionMode(metadata, runInput)
+
+	return metadata
+}
+
+// rhc-worker-playbook stops the playbook run of the correlation id and reports the executor_on_canceled event
+func (rp *runnerProtocol) BuildCancelMetaData(cancelInput generic.CancelInput, correlationID uuid.UUID, cfg *viper.Viper) map[string]string {
+	metadata := buildCommonSignal(cfg)
+	metadata["operation"] = "cancel"
+	metadata["crc_dispatcher_correlation_id"] = correlationID.String()
 
 	return metadata
 }
@@ -29,5 +29,33 @@ var _ = Describe("Runner Protocol", func() {
 			Expect(metadata["response_interval"]).To(Equal("3"))
 			Expect(metadata["return_url"]).To(Equal("https://example.com"))
 		})
+
+		It("asks for the playbook to be run in check mode", func() {
+			mode := generic.ExecutionModeCheck
+			run := generic.RunInput{ExecutionMode: &mode}
+
+			metadata := RunnerProtocol.BuildMetaData(run, uuid.New(), viper.New())
+			Expect(metadata).To(HaveLen(4))
+			Expect(metadata["execution_mode"]).To(Equal("check"))
+		})
+
+		It("produces correct cancel metadata", func() {
+			cancel := generic.CancelInput{
+				OrgId:     "24601",
+				RunId:     uuid.New(),
+				Principal: "jharting",
+			}
+
+			correlationID := uuid.New()
+			cfg := viper.New()
+			cfg.Set("response.interval", "3")
+			cfg.Set("return.url", "https://example.com")
+
+			metadata := RunnerProtocol.BuildCancelMetaData(cancel, correlationID, cfg)
+			Expect(metadata).To(HaveLen(4))
+			Expect(metadata["operation"]).To(Equal("cancel"))
+			Expect(metadata["crc_dispatcher_correlation_id"]).To(Equal(correlationID.String()))
+			Expect(metadata["return_url"]).To(Equal("https://example.com"))
+		})
 	})
 })
@@ -25,6 +25,10 @@ func (sp *satelliteProtocol) GetResponseFull(cfg *viper.Viper) bool {
 	return cfg.GetBool("satellite.response.full")
 }
 
+func (sp *satelliteProtocol) GetHostsPerRequest(cfg *viper.Viper) int {
+	return cfg.GetInt("satellite.hosts.per.request")
+}
+
 func (sp *satelliteProtocol) GetPrincipalHash(principal string) string {
 	return fmt.Sprintf("%x", sha256.Sum256([]byte(principal)))
 }
@@ -75,6 +79,7 @@ func (sp *satelliteProtocol) BuildMetaData(runInput generic.RunInput, correlatio
 		metadata["subscription_manager_ids"] = submanIDs
 	}
 	metadata["response_full"] = strconv.FormatBool(sp.GetResponseFull(cfg))
+	addExecutionMode(metadata, runInput)
 
 	return metadata
 }
@@ -16,6 +16,14 @@ var _ = Describe("Satellite Protocol", func() {
 		Expect(string(SatelliteProtocol.GetDirective())).To(Equal("foreman_rh_cloud"))
 	})
 
+	It("limits the number of hosts per request", func() {
+		cfg := viper.New()
+		cfg.Set("satellite.hosts.per.request", 2)
+
+		Expect(SatelliteProtocol.GetHostsPerRequest(cfg)).To(Equal(2))
+		Expect(RunnerProtocol.GetHostsPerRequest(cfg)).To(Equal(0))
+	})
+
 	Describe("metadata", func() {
 		It("produces correct metadata", func() {
 			satID := uuid.New()
@@ -20,6 +20,12 @@ type Protocol interface {
 
 	GetResponseFull(cfg *viper.Viper) bool
 
+	// the maximum number of hosts addressed by a single request, larger runs are split into several requests (0 = no limit)
+	GetHostsPerRequest(cfg *viper.Viper) int
+
 	// build the metadata dictionary in a format that the given rhc worker understands
 	BuildMetaData(runInput generic.RunInput, correlationID uuid.UUID, cfg *viper.Viper) map[string]string
+
+	// build the metadata dictionary of a signal canceling the run with the given correlation id
+	BuildCancelMetaData(cancelInput generic.CancelInput, correlationID uuid.UUID, cfg *viper.Viper) map[string]string
 }
@@ -0,0 +1,144 @@
+package dispatch
+
+import (
+	"context"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/runevents"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ProcessReconnect dispatches the runs created while the recipient was not connected, oldest first.
+// Runs waiting for longer than wait.for.connection.window are left to the timeout sweeper.
+func (dm *dispatchManager) ProcessReconnect(ctx context.Context, orgID string, recipient uuid.UUID) (dispatched int, err error) {
+	window := dm.config.GetDuration("wait.for.connection.window") * time.Second
+
+	var runs []db.Run
+	err = dm.db.WithContext(ctx).
+		Where("org_id = ? AND recipient = ?", orgID, recipient).
+		Where("status", status.WaitingForConnection).
+		Where("created_at > ?", time.Now().Add(-window)).
+		Order("created_at").
+		Find(&runs).Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	for _, run := range runs {
+		sent, err := dm.redispatch(ctx, run)
+		if err != nil {
+			instrumentation.RunReconnectError(ctx, err, run.ID)
+
+			// the recipient disconnected again, the remaining runs wait for the next connection
+			if _, ok := err.(*RecipientNotFoundError); ok {
+				return dispatched, nil
+			}
+
+			continue
+		}
+
+		if sent {
+			instrumentation.RunReconnectDispatched(ctx, run.ID)
+			dispatched++
+		}
+	}
+
+	return dispatched, nil
+}
+
+// redispatch sends a waiting run to its recipient. The run is moved to running before it is sent so that a response
+// arriving right away finds it running and so that a connection event delivered twice does not dispatch it twice.
+func (dm *dispatchManager) redispatch(ctx context.Context, run db.Run) (sent bool, err error) {
+	ctx = utils.WithCorrelationId(ctx, run.CorrelationID.String())
+	database := dm.db.WithContext(ctx)
+
+	var hosts []db.RunHost
+	if err := database.Where("run_id = ?", run.ID).Order("id").Find(&hosts).Error; err != nil {
+		return false, err
+	}
+
+	// the timeout of the run counts from its dispatch
+	claimed, err := setWaitingRunStatus(database, run, status.WaitingForConnection, status.Running, run.Timeout+int(time.Since(run.CreatedAt).Seconds()))
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	input := runInputFromDb(run, hosts)
+	protocol := getProtocol(input)
+	chunks := chunkHosts(input.Hosts, protocol.GetHostsPerRequest(dm.config))
+
+	if err := dm.sendRunRequest(ctx, run.OrgID, withHosts(input, chunks[0]), run.CorrelationID, protocol); err != nil {
+		if _, revertErr := setWaitingRunStatus(database, run, status.Running, status.WaitingForConnection, run.Timeout); revertErr != nil {
+			utils.GetLogFromContext(ctx).Errorw("Error returning run to the waiting state", "run_id", run.ID, "error", revertErr)
+		}
+
+		return false, err
+	}
+
+	undispatched := make([]bool, len(chunks))
+	dm.sendRemainingChunks(ctx, run.OrgID, input, run.CorrelationID, protocol, chunks, undispatched)
+
+	failUndispatchedHosts(hosts, chunks, undispatched)
+
+	var failed []uuid.UUID
+	for _, host := range hosts {
+		if host.Status == string(status.Failure) {
+			failed = append(failed, host.ID)
+		}
+	}
+
+	err = database.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&db.Run{}).Where("id = ?", run.ID).Update("dispatch_chunks", len(chunks)).Error; err != nil {
+			return err
+		}
+
+		if len(failed) == 0 {
+			return nil
+		}
+
+		return tx.Model(&db.RunHost{}).
+			Where("id IN ?", failed).
+			Updates(map[string]interface{}{"status": status.Failure, "log": undispatchedHostLog}).Error
+	})
+
+	// the run has been sent either way
+	if err != nil {
+		utils.GetLogFromContext(ctx).Errorw("Error recording chunks of dispatched run", "run_id", run.ID, "error", err)
+	}
+
+	return true, nil
+}
+
+// setWaitingRunStatus moves a run and its hosts between the waiting_for_connection and running statuses
+func setWaitingRunStatus(database *gorm.DB, run db.Run, from, to status.Status, timeout int) (updated bool, err error) {
+	err = database.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&db.Run{}).
+			Where("id = ?", run.ID).
+			Where("status", from).
+			Updates(map[string]interface{}{"status": to, "timeout": timeout})
+
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		updated = true
+
+		transition := runevents.Transition{RunID: run.ID, Previous: string(from), Status: string(to), Source: runevents.SourceReconnect}
+		if err := runevents.Record(tx, transition); err != nil {
+			return err
+		}
+
+		return tx.Model(&db.RunHost{}).
+			Where("run_id = ?", run.ID).
+			Where("status", from).
+			Update("status", to).Error
+	})
+
+	return updated && err == nil, err
+}
@@ -0,0 +1,70 @@
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/model/generic"
+)
+
+// requiresSignedPlaybook tells whether the playbooks of the service's runs are verified on dispatch
+// (playbook.signature.verify.service.<service>, playbook.signature.verify.default if not set for the service)
+func (dm *dispatchManager) requiresSignedPlaybook(service string) bool {
+	if key := "playbook.signature.verify.service." + service; dm.config.IsSet(key) {
+		return dm.config.GetBool(key)
+	}
+
+	return dm.config.GetBool("playbook.signature.verify.default")
+}
+
+// verifyPlaybook fetches the playbook of the run and verifies its insights signature, so that a playbook the host would
+// refuse to run is rejected before the run is dispatched
+func (dm *dispatchManager) verifyPlaybook(ctx context.Context, service string, run generic.RunInput) error {
+	if !dm.requiresSignedPlaybook(service) {
+		return nil
+	}
+
+	playbook, err := dm.fetchPlaybook(ctx, run.Url)
+	if err == nil {
+		err = dm.playbookVerifier.Verify(playbook)
+	}
+
+	if err != nil {
+		instrumentation.PlaybookSignatureInvalid(ctx, service, run.Url, err)
+		return &PlaybookSignatureError{url: run.Url, err: err}
+	}
+
+	return nil
+}
+
+func (dm *dispatchManager) fetchPlaybook(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := dm.playbookClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("playbook could not be fetched: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("playbook could not be fetched: unexpected status code %d", res.StatusCode)
+	}
+
+	maxSize := dm.config.GetInt64("playbook.signature.max.size")
+	playbook, err := io.ReadAll(io.LimitReader(res.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("playbook could not be fetched: %w", err)
+	}
+
+	if int64(len(playbook)) > maxSize {
+		return nil, fmt.Errorf("playbook exceeds %d bytes", maxSize)
+	}
+
+	return playbook, nil
+}
@@ -12,6 +12,10 @@ import (
 type DispatchManager interface {
 	ProcessRun(ctx context.Context, orgID string, service string, run generic.RunInput) (runID, correlationID uuid.UUID, err error)
 	ProcessCancel(ctx context.Context, orgID string, cancel generic.CancelInput) (runID, correlationID uuid.UUID, err error)
+	// dispatches the runs waiting for the given recipient to connect
+	ProcessReconnect(ctx context.Context, orgID string, recipient uuid.UUID) (dispatched int, err error)
+	// describes how the run would be dispatched, without dispatching it
+	PlanRun(ctx context.Context, orgID string, service string, run generic.RunInput) (RunPlan, error)
 }
 
 // Indicates that the recipient is not connected
@@ -38,6 +42,23 @@ type RunCancelNotCancelableError struct {
 	runID uuid.UUID
 }
 
+// Indicates that the org has as many runs running as allowed, see GetRunLimit
+type RunLimitExceededError struct {
+	orgID string
+	limit int
+}
+
+// Indicates that the pre-flight check of a run dispatched with CheckRecipient failed, see preflight
+type PreflightError struct {
+	reason string
+}
+
+// Indicates that the playbook of a run could not be fetched or its insights signature is not valid, see verifyPlaybook
+type PlaybookSignatureError struct {
+	url string
+	err error
+}
+
 func (this *RecipientNotFoundError) Error() string {
 	return fmt.Sprintf("Recipient not found: %s", this.recipient)
 }
@@ -57,3 +78,24 @@ func (this *RunCancelTypeError) Error() string {
 func (this *RunCancelNotCancelableError) Error() string {
 	return fmt.Sprintf("Run has finished running and cannot be canceled: %s", this.runID)
 }
+
+func (this *RunLimitExceededError) Error() string {
+	return fmt.Sprintf("Org %s has reached the limit of %d running runs", this.orgID, this.limit)
+}
+
+func (this *PreflightError) Error() string {
+	return fmt.Sprintf("Pre-flight check failed: %s", this.reason)
+}
+
+func (this *PlaybookSignatureError) Error() string {
+	return fmt.Sprintf("Playbook signature not valid (%s): %s", this.url, this.err)
+}
+
+func (this *PlaybookSignatureError) Unwrap() error {
+	return this.err
+}
+
+// Reason is the machine-readable code of the failed check, one of the PreflightReason constants
+func (this *PreflightError) Reason() string {
+	return this.reason
+}
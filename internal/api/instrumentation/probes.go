@@ -3,7 +3,9 @@ package instrumentation
 import (
 	"context"
 	api "playbook-dispatcher/internal/api/utils"
+	commonInstrumentation "playbook-dispatcher/internal/common/instrumentation"
 	"playbook-dispatcher/internal/common/utils"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -30,6 +32,16 @@ const (
 	labelKesselError           = "error"
 	labelKesselRbacMatch       = "match"
 	labelKesselRbacMismatch    = "mismatch"
+	labelPskOk                 = "ok"
+	labelPskExpired            = "expired"
+	labelJwtInvalid            = "invalid"
+	labelJwtUnknownClient      = "unknown_client"
+	labelRateLimited           = "rate"
+	labelConcurrencyLimited    = "concurrency"
+	labelReconnectDispatched   = "dispatched"
+	labelReconnectError        = "error"
+	labelTemplateDispatched    = "dispatched"
+	labelTemplateError         = "error"
 )
 
 var (
@@ -73,6 +85,89 @@ var (
 		Help: "The total number of RBAC and Kessel permission comparisons",
 	}, []string{"result"})
 
+	kesselShadowTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_kessel_shadow_comparison_total",
+		Help: "The total number of shadow Kessel decisions compared to the RBAC ones by route",
+	}, []string{"route", "result"})
+
+	kesselFailOpenTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "api_kessel_fail_open_total",
+		Help: "The total number of requests granted access to all applications as Kessel could not be consulted",
+	})
+
+	kesselTupleErrorTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "api_kessel_tuple_error_total",
+		Help: "The total number of runs whose relationships could not be written to Kessel",
+	})
+
+	pskAuthTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_psk_auth_total",
+		Help: "The total number of internal API requests presenting a known pre-shared key",
+	}, []string{"principal", "key", "result"})
+
+	pskExpiry = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "api_psk_expiry_timestamp_seconds",
+		Help: "The time at which a configured pre-shared key expires",
+	}, []string{"principal", "key"})
+
+	jwtAuthTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_jwt_auth_total",
+		Help: "The total number of internal API requests presenting a service account token",
+	}, []string{"result"})
+
+	apiTokenAuthTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_public_token_auth_total",
+		Help: "The total number of public API requests presenting an API token",
+	}, []string{"result"})
+
+	internalThrottledTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_internal_throttled_total",
+		Help: "The total number of internal API requests rejected due to the rate or concurrency limit of the caller",
+	}, []string{"principal", "limit"})
+
+	internalInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "api_internal_requests_in_flight",
+		Help: "The number of internal API requests currently being processed, per caller",
+	}, []string{"principal"})
+
+	publicThrottledTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "api_public_throttled_total",
+		Help: "The total number of public API requests rejected due to the rate limit of the org and principal",
+	})
+
+	publicRateLimitErrorTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "api_public_ratelimit_error_total",
+		Help: "The total number of public API requests let through because the rate limit could not be checked",
+	})
+
+	publicCompressionRatio = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "api_public_response_compression_ratio",
+		Help:    "The size of compressed public API responses before compression relative to their size after, per encoding",
+		Buckets: []float64{1, 1.5, 2, 3, 5, 10, 20, 50, 100},
+	}, []string{"encoding"})
+
+	publicCompressionBytesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_public_response_compression_bytes_total",
+		Help: "The total size of compressed public API responses before (uncompressed) and after (compressed) compression, per encoding",
+	}, []string{"encoding", "stage"})
+
+	deprecatedUsageTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_deprecated_usage_total",
+		Help: "The total number of requests using a deprecated endpoint or field (see api.deprecations), per caller",
+	}, []string{"deprecation", "caller"})
+
+	runDispatchDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "api_run_dispatch_duration_seconds",
+		Help:    "Time from receiving a run request until the signal is accepted by cloud connector",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.2, 0.5, 1, 2, 5, 10, 30, 60},
+	}, []string{"dispatching_service", "request"})
+
+	dispatchQueueWaitDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "api_dispatch_queue_wait_seconds",
+		Help:    "Time requests to cloud connector wait for the rate limit, per priority",
+		Buckets: []float64{0.001, 0.01, 0.05, 0.1, 0.5, 1, 2, 5, 10, 30, 60},
+	}, []string{"priority"})
+
 	runCreatedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "api_run_created_total",
 		Help: "The total number of created playbook runs",
@@ -83,10 +178,46 @@ var (
 		Help: "The total number of canceled playbook runs",
 	})
 
+	runWaitingForConnectionTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "api_run_waiting_for_connection_total",
+		Help: "The total number of playbook runs kept until their recipient connects",
+	})
+
+	runReconnectDispatchTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_run_reconnect_dispatch_total",
+		Help: "The total number of waiting playbook runs dispatched once their recipient connected",
+	}, []string{"result"})
+
+	dispatchPreflightFailedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_dispatch_preflight_failed_total",
+		Help: "The total number of playbook runs rejected by the pre-flight check of their recipient and playbook URL",
+	}, []string{"reason"})
+
+	playbookSignatureInvalidTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_playbook_signature_invalid_total",
+		Help: "The total number of playbook runs rejected as the insights signature of their playbook could not be verified",
+	}, []string{"service"})
+
+	runLimitExceededTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "api_run_limit_exceeded_total",
+		Help: "The total number of playbook runs rejected as their org reached the limit of concurrently running runs",
+	})
+
+	runTemplateTriggerTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_run_template_trigger_total",
+		Help: "The total number of run template triggers, by whether the run got dispatched",
+	}, []string{"result"})
+
 	runCanceledErrorTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "app_run_canceled_error_total",
 		Help: "The total number of errors from the run cancel endpoint",
 	})
+
+	// the dispatching service is taken from the request
+	runDispatchDurationServices = commonInstrumentation.NewLabelGuard("api_run_dispatch_duration_seconds", "dispatching_service", commonInstrumentation.DefaultLabelLimit)
+	runCreatedTotalServices     = commonInstrumentation.NewLabelGuard("api_run_created_total", "dispatching_service", commonInstrumentation.DefaultLabelLimit)
+	// public API callers are identified by their org
+	deprecatedUsageCallers = commonInstrumentation.NewLabelGuard("api_deprecated_usage_total", "caller", commonInstrumentation.DefaultLabelLimit)
 )
 
 func TenantAnemic(ctx echo.Context, orgID string) {
@@ -167,6 +298,10 @@ func KesselAuthorizationError(ctx echo.Context) {
 	kesselRequestTotal.WithLabelValues(labelKesselError).Inc()
 }
 
+func KesselFailOpen(ctx echo.Context) {
+	kesselFailOpenTotal.Inc()
+}
+
 func KesselRbacMatch(ctx echo.Context) {
 	kesselRbacAgreementTotal.WithLabelValues(labelKesselRbacMatch).Inc()
 }
@@ -175,9 +310,150 @@ func KesselRbacMismatch(ctx echo.Context) {
 	kesselRbacAgreementTotal.WithLabelValues(labelKesselRbacMismatch).Inc()
 }
 
+func KesselTupleWriteError(ctx context.Context, err error, runId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Errorw("Error writing run relationships to Kessel", "error", err, "run_id", runId)
+	kesselTupleErrorTotal.Inc()
+}
+
+func KesselShadowMatch(ctx echo.Context) {
+	kesselShadowTotal.WithLabelValues(ctx.Path(), labelKesselRbacMatch).Inc()
+}
+
+func KesselShadowMismatch(ctx echo.Context) {
+	kesselShadowTotal.WithLabelValues(ctx.Path(), labelKesselRbacMismatch).Inc()
+}
+
+func PskAuthenticated(ctx echo.Context, principal, key string) {
+	pskAuthTotal.WithLabelValues(principal, key, labelPskOk).Inc()
+}
+
+func PskExpired(ctx echo.Context, principal, key string) {
+	utils.GetLogFromEcho(ctx).Warnw("Rejected expired pre-shared key", "principal", principal, "key", key)
+	pskAuthTotal.WithLabelValues(principal, key, labelPskExpired).Inc()
+}
+
+func PskExpires(principal, key string, expires time.Time) {
+	pskExpiry.WithLabelValues(principal, key).Set(float64(expires.Unix()))
+}
+
+func ServiceAccountAuthenticated(ctx echo.Context, principal, clientId string) {
+	utils.GetLogFromEcho(ctx).Debugw("Authenticated service account", "principal", principal, "client_id", clientId)
+	jwtAuthTotal.WithLabelValues(labelPskOk).Inc()
+}
+
+func ServiceAccountRejected(ctx echo.Context, err error) {
+	utils.GetLogFromEcho(ctx).Warnw("Rejected service account token", "error", err)
+	jwtAuthTotal.WithLabelValues(labelJwtInvalid).Inc()
+}
+
+func ServiceAccountUnknown(ctx echo.Context, clientId string) {
+	utils.GetLogFromEcho(ctx).Warnw("Rejected token of unknown service account", "client_id", clientId)
+	jwtAuthTotal.WithLabelValues(labelJwtUnknownClient).Inc()
+}
+
+func ApiTokenAuthenticated(ctx echo.Context, orgId, tokenId string) {
+	utils.GetLogFromEcho(ctx).Debugw("Authenticated API token", "org_id", orgId, "token_id", tokenId)
+	apiTokenAuthTotal.WithLabelValues(labelPskOk).Inc()
+}
+
+func ApiTokenRejected(ctx echo.Context) {
+	utils.GetLogFromEcho(ctx).Warnw("Rejected invalid or expired API token")
+	apiTokenAuthTotal.WithLabelValues(labelJwtInvalid).Inc()
+}
+
+func InternalRateLimited(ctx echo.Context, principal string) {
+	utils.GetLogFromEcho(ctx).Warnw("Rejected request exceeding the rate limit", "principal", principal)
+	internalThrottledTotal.WithLabelValues(principal, labelRateLimited).Inc()
+}
+
+func InternalConcurrencyLimited(ctx echo.Context, principal string) {
+	utils.GetLogFromEcho(ctx).Warnw("Rejected request exceeding the concurrency limit", "principal", principal)
+	internalThrottledTotal.WithLabelValues(principal, labelConcurrencyLimited).Inc()
+}
+
+func PublicRateLimited(ctx echo.Context, orgId, principal string) {
+	utils.GetLogFromEcho(ctx).Warnw("Rejected request exceeding the rate limit", "org_id", orgId, "principal", principal)
+	publicThrottledTotal.Inc()
+}
+
+func PublicRateLimitError(ctx echo.Context, err error) {
+	utils.GetLogFromEcho(ctx).Errorw("Error checking the rate limit", "error", err)
+	publicRateLimitErrorTotal.Inc()
+}
+
+func PublicResponseCompressed(encoding string, uncompressed, compressed int) {
+	if compressed > 0 {
+		publicCompressionRatio.WithLabelValues(encoding).Observe(float64(uncompressed) / float64(compressed))
+	}
+
+	publicCompressionBytesTotal.WithLabelValues(encoding, "uncompressed").Add(float64(uncompressed))
+	publicCompressionBytesTotal.WithLabelValues(encoding, "compressed").Add(float64(compressed))
+}
+
+func DeprecatedUsage(ctx echo.Context, deprecation, caller string) {
+	utils.GetLogFromEcho(ctx).Infow("Deprecated API used", "deprecation", deprecation, "caller", caller)
+	deprecatedUsageTotal.WithLabelValues(deprecation, deprecatedUsageCallers.Value(caller)).Inc()
+}
+
+func InternalRequestStarted(principal string) {
+	internalInFlight.WithLabelValues(principal).Inc()
+}
+
+func InternalRequestFinished(principal string) {
+	internalInFlight.WithLabelValues(principal).Dec()
+}
+
 func RunCreated(ctx context.Context, recipient uuid.UUID, runId uuid.UUID, payload string, service string, requestType string) {
 	utils.GetLogFromContext(ctx).Infow("Created new playbook run", "recipient", recipient.String(), "run_id", runId.String(), "payload", string(payload), "service", service)
-	runCreatedTotal.WithLabelValues(service, requestType, api.GetApiVersion(ctx)).Inc()
+	runCreatedTotal.WithLabelValues(runCreatedTotalServices.Value(service), requestType, api.GetApiVersion(ctx)).Inc()
+}
+
+func RunDispatched(ctx context.Context, service string, requestType string, duration time.Duration) {
+	runDispatchDuration.WithLabelValues(runDispatchDurationServices.Value(service), requestType).Observe(duration.Seconds())
+}
+
+func DispatchQueueWait(priority string, duration time.Duration) {
+	dispatchQueueWaitDuration.WithLabelValues(priority).Observe(duration.Seconds())
+}
+
+func RunWaitingForConnection(ctx context.Context, recipient uuid.UUID) {
+	utils.GetLogFromContext(ctx).Infow("Recipient not connected, keeping the run until it connects", "recipient", recipient.String())
+	runWaitingForConnectionTotal.Inc()
+}
+
+func RunReconnectDispatched(ctx context.Context, runId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Infow("Dispatched run waiting for its recipient", "run_id", runId.String())
+	runReconnectDispatchTotal.WithLabelValues(labelReconnectDispatched).Inc()
+}
+
+func RunReconnectError(ctx context.Context, err error, runId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Errorw("Error dispatching run waiting for its recipient", "run_id", runId.String(), "error", err)
+	runReconnectDispatchTotal.WithLabelValues(labelReconnectError).Inc()
+}
+
+func RunLimitExceeded(ctx context.Context, orgID string, limit int) {
+	utils.GetLogFromContext(ctx).Warnw("Rejecting run as the org reached the limit of running runs", "org_id", orgID, "limit", limit)
+	runLimitExceededTotal.Inc()
+}
+
+func DispatchPreflightFailed(ctx context.Context, recipient uuid.UUID, url string, reason string) {
+	utils.GetLogFromContext(ctx).Warnw("Rejecting run as its pre-flight check failed", "recipient", recipient.String(), "url", url, "reason", reason)
+	dispatchPreflightFailedTotal.WithLabelValues(reason).Inc()
+}
+
+func PlaybookSignatureInvalid(ctx context.Context, service string, url string, err error) {
+	utils.GetLogFromContext(ctx).Warnw("Rejecting run as the signature of its playbook could not be verified", "service", service, "url", url, "error", err)
+	playbookSignatureInvalidTotal.WithLabelValues(service).Inc()
+}
+
+func RunTemplateDispatched(ctx context.Context, templateId uuid.UUID, runId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Infow("Dispatched run of run template", "template_id", templateId.String(), "run_id", runId.String())
+	runTemplateTriggerTotal.WithLabelValues(labelTemplateDispatched).Inc()
+}
+
+func RunTemplateError(ctx context.Context, err error, templateId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Errorw("Error dispatching run of run template", "template_id", templateId.String(), "error", err)
+	runTemplateTriggerTotal.WithLabelValues(labelTemplateError).Inc()
 }
 
 func RunCanceled(ctx context.Context, runId uuid.UUID) {
@@ -208,6 +484,10 @@ func Start() {
 	connectorErrorTotal.WithLabelValues(labelNoConnection, LabelAnsibleRequest)
 	connectorErrorTotal.WithLabelValues(labelNoConnection, LabelSatRequest)
 
+	jwtAuthTotal.WithLabelValues(labelPskOk)
+	jwtAuthTotal.WithLabelValues(labelJwtInvalid)
+	jwtAuthTotal.WithLabelValues(labelJwtUnknownClient)
+
 	kesselRequestTotal.WithLabelValues(labelKesselPassed)
 	kesselRequestTotal.WithLabelValues(labelKesselFailed)
 	kesselRequestTotal.WithLabelValues(labelKesselError)
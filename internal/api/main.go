@@ -2,18 +2,30 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"playbook-dispatcher/internal/api/capture"
 	"playbook-dispatcher/internal/api/connectors"
 	"playbook-dispatcher/internal/api/connectors/inventory"
 	"playbook-dispatcher/internal/api/connectors/sources"
 	"playbook-dispatcher/internal/api/controllers/private"
 	"playbook-dispatcher/internal/api/controllers/public"
+	"playbook-dispatcher/internal/api/dispatch"
 	"playbook-dispatcher/internal/api/instrumentation"
 	"playbook-dispatcher/internal/api/middleware"
-	"playbook-dispatcher/internal/api/rbac"
+	"playbook-dispatcher/internal/api/pact"
+	"playbook-dispatcher/internal/api/reconnect"
+	"playbook-dispatcher/internal/api/scheduler"
+	"playbook-dispatcher/internal/api/services"
+	"playbook-dispatcher/internal/api/usage"
+	"playbook-dispatcher/internal/common/ansible"
 	"playbook-dispatcher/internal/common/constants"
 	"playbook-dispatcher/internal/common/db"
+	"playbook-dispatcher/internal/common/encryption"
+	"playbook-dispatcher/internal/common/kessel"
+	"playbook-dispatcher/internal/common/runevents"
 	"playbook-dispatcher/internal/common/utils"
 	"sync"
 	"time"
@@ -30,9 +42,6 @@ import (
 	"github.com/spf13/viper"
 )
 
-const specFile = "/api/playbook-dispatcher/v1/openapi.json"
-const apiShutdownTimeout = 10 * time.Second
-
 func init() {
 	openapi3.DefineStringFormatValidator("uuid", openapi3.NewRegexpFormatValidator(`^[a-f0-9]{8}-[a-f0-9]{4}-4[a-f0-9]{3}-[89aAbB][a-f0-9]{3}-[a-f0-9]{12}$`))
 	openapi3.DefineStringFormatValidator("sat-id-uuid", openapi3.NewRegexpFormatValidator(`^[a-f0-9]{8}-[a-f0-9]{4}-[45][a-f0-9]{3}-[89aAbB][a-f0-9]{3}-[a-f0-9]{12}$`))
@@ -48,10 +57,9 @@ func Start(
 ) {
 	log := utils.GetLogFromContext(ctx)
 	instrumentation.Start()
-	db, sql := db.Connect(ctx, cfg)
-
-	ready.Register(sql.Ping)
-	live.Register(sql.Ping)
+	// dedicated connection the run events are received on
+	connectListener := db.Connector(cfg)
+	db, release := db.Shared(ctx, cfg, ready, live)
 
 	publicSpec, err := public.GetSwagger()
 	utils.DieOnError(err)
@@ -59,6 +67,8 @@ func Start(
 	privateSpec, err := private.GetSwaggerWithExternalRefs()
 	utils.DieOnError(err)
 
+	captures := capture.NewBuffer(cfg.GetInt("debug.capture.buffer.size"))
+
 	server := echo.New()
 	server.HideBanner = true
 	server.Debug = false
@@ -70,12 +80,32 @@ func Start(
 		middleware.ContextLogger,
 		middleware.RequestLogger,
 		echoMiddleware.Recover(),
-		echoMiddleware.BodyLimit(cfg.GetString("http.max.body.size")),
+		middleware.SecurityHeaders(cfg),
+		// registered globally as preflight requests do not match any route
+		middleware.Cors(cfg),
+		middleware.BodyLimit(cfg),
+		middleware.Deprecation(cfg),
+		middleware.DefaultIdentity(cfg),
 	)
 
-	server.GET(specFile, func(ctx echo.Context) error {
-		return ctx.JSON(http.StatusOK, publicSpec)
-	})
+	if cfg.GetBool("audit.enabled") {
+		// the events are delivered by the jobs module
+		server.Use(middleware.Audit(db))
+	}
+
+	if cfg.GetBool("pact.provider.states.enabled") {
+		log.Warn("Pact provider state endpoint enabled, it modifies the data of arbitrary orgs")
+		server.POST(pact.StatesPath, pact.StateHandler(db))
+	}
+
+	for _, version := range public.ApiVersions {
+		spec, err := json.Marshal(public.VersionSpecification(publicSpec, version, cfg.GetString("build.commit")))
+		utils.DieOnError(err)
+
+		server.GET(public.SpecificationPath(version), func(ctx echo.Context) error {
+			return ctx.JSONBlob(http.StatusOK, spec)
+		})
+	}
 
 	var cloudConnectorClient connectors.CloudConnectorClient
 
@@ -120,27 +150,100 @@ func Start(
 		log.Warn("Using mock TenantIDTranslator")
 	}
 
-	authConfig := middleware.BuildPskAuthConfigFromEnv()
-	log.Infow("Authentication required for internal API", "principals", utils.MapKeysString(authConfig))
+	registerDependencies(cfg, ready)
+
+	authConfig, err := middleware.BuildPskAuthConfigFromEnv()
+	utils.DieOnError(err)
+	principals := map[string][]string{}
+	for principal, keys := range authConfig {
+		for _, key := range keys {
+			principals[principal] = append(principals[principal], key.Id)
+		}
+	}
+	log.Infow("Authentication required for internal API", "principals", principals)
+	clientCert := middleware.RequireClientCertificate(cfg)
+	internalAuth := middleware.RelaxInternalAuth(cfg, middleware.CheckInternalAuth(cfg, authConfig))
+	if cfg.GetBool("dev.auth.relaxed") {
+		log.Warnw("Relaxed authentication enabled, requests without credentials are accepted", "org_id", cfg.GetString("dev.org.id"))
+	}
+	rateLimit := middleware.LimitInternalRequests(cfg)
+
+	labelCipher, err := encryption.NewLabelCipher(ctx, cfg)
+	utils.DieOnError(err)
+
+	// shared by the controllers and the dispatch of runs waiting for their recipient
+	rateLimiter := dispatch.NewRateLimiter(cfg)
+	playbookVerifier, err := ansible.NewPlaybookVerifier(cfg.GetString("playbook.signature.public.keys"))
+	utils.DieOnError(err)
+	dispatchManager := dispatch.NewDispatchManager(cfg, cloudConnectorClient, rateLimiter, db, labelCipher, kessel.NewTupleWriter(), playbookVerifier)
+
+	privateController := private.CreateController(db, cloudConnectorClient, inventoryConnectorClient, sourcesConnectorClient, cfg, translator, captures, dispatchManager, rateLimiter, labelCipher)
 
-	privateController := private.CreateController(db, cloudConnectorClient, inventoryConnectorClient, sourcesConnectorClient, cfg, translator)
-	internal := server.Group("/internal")
-	internal.GET("/v2/run_hosts", privateController.ApiInternalV2RunHostsList, middleware.CheckPskAuth(authConfig), echo.WrapMiddleware(identity.EnforceIdentity), middleware.ExtractHeaders(constants.HeaderIdentity), middleware.CaptureQueryString(), middleware.Hack("filter", "labels"), middleware.Hack("filter", "run"), middleware.Hack("filter", "run", "labels"), middleware.Hack("fields"), oapiMiddleware.OapiRequestValidator(privateSpec))
+	if cfg.GetBool("wait.for.connection.enabled") {
+		reconnect.Start(ctx, cfg, dispatchManager, errors, ready, wg)
+	}
+
+	if cfg.GetBool("run.templates.scheduler.enabled") {
+		scheduler.Start(ctx, cfg, db, dispatchManager, labelCipher, wg)
+	}
+	internal := server.Group("/internal", middleware.AllowSourceNetworks(cfg))
+	internal.GET("/v2/run_hosts", privateController.ApiInternalV2RunHostsList, clientCert, internalAuth, rateLimit, echo.WrapMiddleware(identity.EnforceIdentity), middleware.DebugCapture(cfg, captures), middleware.ForcedDebugCapture(cfg, captures), middleware.ExtractHeaders(constants.HeaderIdentity), middleware.CaptureQueryString(), middleware.Hack("filter", "labels"), middleware.Hack("filter", "run"), middleware.Hack("filter", "run", "labels"), middleware.Hack("fields"), oapiMiddleware.OapiRequestValidator(privateSpec))
 	internal.Use(oapiMiddleware.OapiRequestValidator(privateSpec))
-	// Authorization header not required for GET /internal/version
+	// Authorization header not required for GET /internal/version and /internal/v2/version
 	internal.GET("/version", privateController.ApiInternalVersion)
+	internal.GET("/v2/version", privateController.ApiInternalV2Version)
 	internal.POST("/v2/connection_status", privateController.ApiInternalHighlevelConnectionStatus, echo.WrapMiddleware(identity.EnforceIdentity), middleware.ExtractHeaders(constants.HeaderIdentity))
-	internal.Use(middleware.CheckPskAuth(authConfig))
+	internal.Use(clientCert)
+	internal.Use(internalAuth)
+	internal.Use(middleware.DebugCapture(cfg, captures))
+	internal.Use(middleware.ForcedDebugCapture(cfg, captures))
+	internal.Use(rateLimit)
 	internal.Use(echo.WrapMiddleware(middleware.StoreAPIVersion))
-	internal.POST("/dispatch", privateController.ApiInternalRunsCreate)
+	maintenance := middleware.Maintenance(cfg)
+	internal.POST("/dispatch", privateController.ApiInternalRunsCreate, maintenance)
 	internal.POST("/v2/recipients/status", privateController.ApiInternalV2RecipientsStatus)
-	internal.POST("/v2/dispatch", privateController.ApiInternalV2RunsCreate)
-	internal.POST("/v2/cancel", privateController.ApiInternalV2RunsCancel)
+	internal.POST("/v2/dispatch", privateController.ApiInternalV2RunsCreate, maintenance)
+	internal.POST("/v2/dispatch/validate", privateController.ApiInternalV2RunsValidate)
+	internal.POST("/v3/dispatch", privateController.ApiInternalV3RunsCreate, maintenance)
+	internal.GET("/v3/groups/:group_id", privateController.ApiInternalV3GroupsGet)
+	internal.POST("/v2/cancel", privateController.ApiInternalV2RunsCancel, maintenance).Name = public.RouteRunsCancel
+	internal.POST("/v2/cancel/filter", privateController.ApiInternalV2RunsCancelFilter, maintenance)
+	internal.POST("/v2/runs/:run_id/retry", privateController.ApiInternalV2RunsRetry, maintenance)
+	internal.GET("/v2/usage", privateController.ApiInternalV2Usage)
+	internal.GET("/v2/debug/captures", privateController.ApiInternalV2DebugCaptures)
+	internal.GET("/v2/services", privateController.ApiInternalV2Services)
+	internal.GET("/v2/api_tokens", privateController.ApiInternalV2ApiTokensList)
+	internal.POST("/v2/api_tokens", privateController.ApiInternalV2ApiTokensCreate)
+	internal.DELETE("/v2/api_tokens/:token_id", privateController.ApiInternalV2ApiTokensDelete)
+	internal.GET("/v2/run_limits/:org_id", privateController.ApiInternalV2RunLimitsGet)
+	internal.PUT("/v2/run_limits/:org_id", privateController.ApiInternalV2RunLimitsUpdate)
+	internal.DELETE("/v2/run_limits/:org_id", privateController.ApiInternalV2RunLimitsDelete)
+	internal.GET("/v2/run_templates", privateController.ApiInternalV2RunTemplatesList)
+	internal.POST("/v2/run_templates", privateController.ApiInternalV2RunTemplatesCreate)
+	internal.GET("/v2/run_templates/:template_id", privateController.ApiInternalV2RunTemplatesGet)
+	internal.PUT("/v2/run_templates/:template_id", privateController.ApiInternalV2RunTemplatesUpdate)
+	internal.DELETE("/v2/run_templates/:template_id", privateController.ApiInternalV2RunTemplatesDelete)
 
-	publicController := public.CreateController(db, cloudConnectorClient)
+	utils.DieOnError(services.Start(ctx, cfg, db, wg))
+
+	usageRecorder := usage.NewRecorder(db)
+	usageRecorder.Start(ctx, cfg.GetDuration("usage.flush.interval")*time.Second, wg)
+
+	runEvents := runevents.NewBroker()
+	runEvents.Start(ctx, connectListener, wg)
+
+	publicBuckets, err := middleware.NewTokenBuckets(cfg)
+	utils.DieOnError(err)
+
+	publicController := public.CreateController(db, cloudConnectorClient, labelCipher, cfg, runEvents)
 	public := server.Group("/api/playbook-dispatcher")
+	public.Use(middleware.PublicCompression(cfg))
+	public.Use(middleware.AuthenticateApiTokens(cfg, db))
+	public.Use(middleware.RejectConflictingIdentity)
 	public.Use(echo.WrapMiddleware(identity.EnforceIdentity))
 	public.Use(echo.WrapMiddleware(middleware.EnforceIdentityType))
+	public.Use(middleware.DebugCapture(cfg, captures))
+	public.Use(middleware.LimitPublicRequests(cfg, publicBuckets))
 	public.Use(middleware.CaptureQueryString())
 	public.Use(middleware.Hack("filter", "labels"))
 	public.Use(middleware.Hack("filter", "run"))
@@ -148,28 +251,52 @@ func Start(
 	public.Use(middleware.Hack("fields"))
 	public.Use(oapiMiddleware.OapiRequestValidator(publicSpec))
 	public.Use(middleware.ExtractHeaders(constants.HeaderIdentity))
-	public.Use(middleware.EnforcePermissions(cfg, rbac.DispatcherPermission("run", "read")))
+	public.Use(middleware.RecordUsage(usageRecorder))
 
-	public.GET("/v1/run_hosts", publicController.ApiRunHostsList)
-	public.GET("/v1/runs", publicController.ApiRunsList)
+	routes := publicRoutes(publicController, db)
+	utils.DieOnError(validateRoutes(publicSpec, routes))
+	registerRoutes(cfg, public, routes)
 
 	wg.Add(1)
 	go func() {
 		errors <- server.Start(fmt.Sprintf("0.0.0.0:%d", cfg.GetInt("web.port")))
 	}()
 
+	if port := cfg.GetInt("web.tls.port"); port != 0 {
+		cert, err := tls.LoadX509KeyPair(cfg.GetString("web.tls.cert"), cfg.GetString("web.tls.key"))
+		utils.DieOnError(err)
+
+		server.TLSServer.Addr = fmt.Sprintf("0.0.0.0:%d", port)
+		server.TLSServer.TLSConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+			// verified by middleware.RequireClientCertificate on the routes that need it
+			ClientAuth: tls.RequestClientCert,
+		}
+
+		go func() {
+			errors <- server.StartServer(server.TLSServer)
+		}()
+	} else if cfg.GetBool("internal.mtls.enabled") {
+		log.Warn("Client certificates required on internal API but web.tls.port is not set, PSK-authenticated requests will be rejected")
+	}
+
 	go func() {
 		defer wg.Done()
 		defer log.Debug("API stopped")
 		<-ctx.Done()
 
 		log.Info("Shutting down API")
-		ctx, cancel := context.WithTimeout(utils.SetLog(context.Background(), log), apiShutdownTimeout)
+		ctx, cancel := context.WithTimeout(utils.SetLog(context.Background(), log), cfg.GetDuration("shutdown.api.timeout")*time.Second)
 		defer cancel()
 
+		// stops accepting new connections and waits for in-flight requests to finish
 		utils.StopServer(ctx, server)
-		if sqlConnection, err := db.DB(); err != nil {
-			sqlConnection.Close()
+
+		if err := usageRecorder.Flush(ctx); err != nil {
+			log.Errorw("Error flushing API usage", "error", err)
 		}
+
+		release()
 	}()
 }
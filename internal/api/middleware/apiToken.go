@@ -0,0 +1,121 @@
+package middleware
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"playbook-dispatcher/internal/api/apitokens"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/api/rbac"
+	"playbook-dispatcher/internal/common/constants"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+type apiTokenKeyType int
+
+const apiTokenKey apiTokenKeyType = iota
+
+// the only permission granted by API tokens
+var apiTokenPermission = rbac.DispatcherPermission("run", "read")
+
+// AuthenticateApiTokens lets requests presenting an API token (Authorization: Bearer pdt_...) instead of an identity
+// header through as a service account of the organization of the token. Unknown and expired tokens are rejected with
+// 401. Needs to be placed before identity.EnforceIdentity. What the token grants is enforced by AuthorizeApiTokens.
+func AuthenticateApiTokens(cfg *viper.Viper, db *gorm.DB) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if !cfg.GetBool("public.api.tokens.enabled") {
+			return next
+		}
+
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			token, ok := strings.CutPrefix(req.Header.Get(echo.HeaderAuthorization), "Bearer ")
+			if !ok || !apitokens.IsApiToken(token) {
+				return next(c)
+			}
+
+			if req.Header.Get(constants.HeaderIdentity) != "" {
+				return echo.NewHTTPError(http.StatusBadRequest, "API tokens cannot be combined with an identity header")
+			}
+
+			apiToken, err := apitokens.Verify(req.Context(), db, token)
+			if errors.Is(err, apitokens.ErrInvalid) {
+				instrumentation.ApiTokenRejected(c)
+				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid API token")
+			} else if err != nil {
+				return err
+			}
+
+			header, err := apiTokenIdentity(apiToken)
+			if err != nil {
+				return err
+			}
+
+			req.Header.Set(constants.HeaderIdentity, header)
+			utils.SetRequestContextValue(c, apiTokenKey, apiToken)
+			instrumentation.ApiTokenAuthenticated(c, apiToken.OrgID, apiToken.ID.String())
+
+			return next(c)
+		}
+	}
+}
+
+// apiTokenIdentity is the identity header requests authenticated by the token are processed with
+func apiTokenIdentity(token *dbModel.ApiToken) (string, error) {
+	principal := "api-token-" + token.ID.String()
+
+	data, err := json.Marshal(identity.XRHID{Identity: identity.Identity{
+		OrgID:    token.OrgID,
+		Type:     "ServiceAccount",
+		AuthType: "api-token",
+		Internal: identity.Internal{OrgID: token.OrgID},
+		ServiceAccount: &identity.ServiceAccount{
+			ClientId: principal,
+			Username: principal,
+		},
+	}})
+
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// GetApiToken returns the API token the request was authenticated by, if any
+func GetApiToken(c echo.Context) (*dbModel.ApiToken, bool) {
+	token, ok := c.Request().Context().Value(apiTokenKey).(*dbModel.ApiToken)
+	return token, ok
+}
+
+// AuthorizeApiTokens authorizes requests authenticated by an API token by what tokens grant (reading runs) as the
+// identity of the token is unknown to RBAC and Kessel. Other requests are authorized by the given middleware.
+func AuthorizeApiTokens(permission rbac.RequiredPermission, authorization ...echo.MiddlewareFunc) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		authorized := next
+		for i := len(authorization) - 1; i >= 0; i-- {
+			authorized = authorization[i](authorized)
+		}
+
+		return func(c echo.Context) error {
+			if _, ok := GetApiToken(c); !ok {
+				return authorized(c)
+			}
+
+			if permission != apiTokenPermission {
+				return echo.NewHTTPError(http.StatusForbidden, "API tokens grant read-only access")
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/audit"
+	"playbook-dispatcher/internal/common/utils"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"github.com/redhatinsights/platform-go-middlewares/v2/request_id"
+	"gorm.io/gorm"
+)
+
+// Audit stores authorization denials and calls to the internal API as audit events
+func Audit(db *gorm.DB) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			err := next(c)
+
+			status := c.Response().Status
+			if httpError, ok := err.(*echo.HTTPError); ok {
+				status = httpError.Code
+			}
+
+			// the inner middleware may have replaced the request with one carrying the identity or PSK principal
+			req := c.Request()
+
+			eventType := auditEventType(req.URL.Path, status)
+			if eventType == "" {
+				return err
+			}
+
+			event := audit.Event{
+				Type:      eventType,
+				RequestId: request_id.GetReqID(req.Context()),
+				Method:    req.Method,
+				Path:      req.URL.Path,
+				Status:    status,
+			}
+
+			xrhid := identity.GetIdentity(req.Context())
+			event.OrgId = xrhid.Identity.OrgID
+			event.Principal = xrhid.Identity.User.Username
+
+			if principal, ok := req.Context().Value(pskPrincipal).(string); ok {
+				event.Principal = principal
+			}
+
+			if auditErr := audit.Record(req.Context(), db, event); auditErr != nil {
+				utils.GetLogFromEcho(c).Errorw("Error recording audit event", "event_type", eventType, "error", auditErr)
+			}
+
+			return err
+		}
+	}
+}
+
+func auditEventType(path string, status int) string {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return audit.EventAuthzDenied
+	case strings.HasPrefix(path, "/internal/") && path != "/internal/version":
+		return audit.EventInternalCall
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"playbook-dispatcher/internal/api/audit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit middleware", func() {
+	DescribeTable("auditEventType",
+		func(path string, status int, expected string) {
+			Expect(auditEventType(path, status)).To(Equal(expected))
+		},
+
+		Entry("public request", "/api/playbook-dispatcher/v1/runs", 200, ""),
+		Entry("public request denied", "/api/playbook-dispatcher/v1/runs", 403, audit.EventAuthzDenied),
+		Entry("internal request", "/internal/v2/dispatch", 207, audit.EventInternalCall),
+		Entry("internal request with invalid key", "/internal/v2/dispatch", 401, audit.EventAuthzDenied),
+		Entry("version", "/internal/version", 200, ""),
+		Entry("health", "/health", 200, ""),
+	)
+})
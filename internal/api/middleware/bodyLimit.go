@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/bytes"
+	"github.com/spf13/viper"
+)
+
+const publicPathPrefix = "/api/playbook-dispatcher/"
+
+// routes accepting a larger (or smaller) body than the other internal endpoints
+var bodyLimitKeys = map[string]string{
+	"/internal/dispatch":             "http.body.limit.bulk",
+	"/internal/v2/dispatch":          "http.body.limit.bulk",
+	"/internal/v2/cancel":            "http.body.limit.bulk",
+	"/internal/v2/recipients/status": "http.body.limit.bulk",
+	"/internal/v2/connection_status": "http.body.limit.connection.status",
+}
+
+type bodyLimitReader struct {
+	io.ReadCloser
+	remaining int64
+	exceeded  bool
+}
+
+func (this *bodyLimitReader) Read(p []byte) (int, error) {
+	n, err := this.ReadCloser.Read(p)
+	this.remaining -= int64(n)
+
+	if this.remaining < 0 {
+		this.exceeded = true
+		return n, fmt.Errorf("request body too large")
+	}
+
+	return n, err
+}
+
+// BodyLimit rejects requests with a body larger than the limit of the matched route with 413.
+// It needs to run before any middleware that reads the body (e.g. the request validator) so that
+// oversized bodies are never decoded.
+func BodyLimit(cfg *viper.Viper) echo.MiddlewareFunc {
+	parse := func(key string) (limit int64, formatted string) {
+		formatted = cfg.GetString(key)
+		limit, err := bytes.Parse(formatted)
+		if err != nil {
+			panic(fmt.Sprintf("invalid body size limit %s: %s", key, formatted))
+		}
+
+		return
+	}
+
+	type routeLimit struct {
+		limit     int64
+		formatted string
+	}
+
+	limits := map[string]routeLimit{}
+	for route, key := range bodyLimitKeys {
+		limit, formatted := parse(key)
+		limits[route] = routeLimit{limit, formatted}
+	}
+
+	publicLimit, publicFormatted := parse("http.body.limit.public")
+	defaultLimit, defaultFormatted := parse("http.max.body.size")
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if req.Body == nil || req.Body == http.NoBody {
+				return next(c)
+			}
+
+			limit, formatted := defaultLimit, defaultFormatted
+			if route, ok := limits[c.Path()]; ok {
+				limit, formatted = route.limit, route.formatted
+			} else if strings.HasPrefix(c.Path(), publicPathPrefix) {
+				limit, formatted = publicLimit, publicFormatted
+			}
+
+			tooLarge := echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("Request body exceeds the limit of %s", formatted))
+
+			if req.ContentLength > limit {
+				return tooLarge
+			}
+
+			// the content length may be missing (chunked encoding) or wrong
+			reader := &bodyLimitReader{ReadCloser: req.Body, remaining: limit}
+			req.Body = reader
+
+			err := next(c)
+			if reader.exceeded && !c.Response().Committed {
+				return tooLarge
+			}
+
+			return err
+		}
+	}
+}
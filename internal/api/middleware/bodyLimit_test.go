@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+func testBodyLimit(path string, size int, chunked bool) (int, error) {
+	cfg := viper.New()
+	cfg.Set("http.max.body.size", "10B")
+	cfg.Set("http.body.limit.public", "5B")
+	cfg.Set("http.body.limit.bulk", "20B")
+	cfg.Set("http.body.limit.connection.status", "15B")
+
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(strings.Repeat("a", size)))
+	if chunked {
+		req.ContentLength = -1
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := echo.New().NewContext(req, recorder)
+	ctx.SetPath(path)
+
+	handler := BodyLimit(cfg)(func(ctx echo.Context) error {
+		if _, err := io.ReadAll(ctx.Request().Body); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	if err := handler(ctx); err != nil {
+		return err.(*echo.HTTPError).Code, err
+	}
+
+	return recorder.Code, nil
+}
+
+var _ = Describe("Body limit middleware", func() {
+	DescribeTable("applies the limit of the route",
+		func(path string, size int, chunked bool, expected int) {
+			code, _ := testBodyLimit(path, size, chunked)
+			Expect(code).To(Equal(expected))
+		},
+
+		Entry("default limit", "/internal/v2/usage", 10, false, http.StatusOK),
+		Entry("default limit exceeded", "/internal/v2/usage", 11, false, http.StatusRequestEntityTooLarge),
+		Entry("public limit exceeded", "/api/playbook-dispatcher/v1/runs", 6, false, http.StatusRequestEntityTooLarge),
+		Entry("bulk limit", "/internal/v2/dispatch", 20, false, http.StatusOK),
+		Entry("bulk limit exceeded", "/internal/v2/dispatch", 21, false, http.StatusRequestEntityTooLarge),
+		Entry("connection status limit", "/internal/v2/connection_status", 15, false, http.StatusOK),
+		Entry("connection status limit exceeded", "/internal/v2/connection_status", 16, false, http.StatusRequestEntityTooLarge),
+		Entry("limit exceeded without content length", "/internal/v2/dispatch", 21, true, http.StatusRequestEntityTooLarge),
+	)
+
+	It("describes the limit in the error", func() {
+		_, err := testBodyLimit("/internal/v2/dispatch", 100, false)
+		Expect(err.(*echo.HTTPError).Message).To(Equal("Request body exceeds the limit of 20B"))
+	})
+})
@@ -0,0 +1,154 @@
+package middleware
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA() testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+
+	return testCA{cert: cert, key: key}
+}
+
+func (this testCA) issue(usage x509.ExtKeyUsage, dnsNames ...string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     dnsNames,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, this.cert, &key.PublicKey, this.key)
+	Expect(err).ToNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+
+	return cert
+}
+
+func (this testCA) write(dir string) string {
+	path := filepath.Join(dir, "ca.pem")
+	Expect(os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: this.cert.Raw}), 0600)).To(Succeed())
+	return path
+}
+
+var _ = Describe("Client certificate middleware", func() {
+	var ca testCA
+	var cfg *viper.Viper
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "ca")
+		Expect(err).ToNot(HaveOccurred())
+
+		ca = newTestCA()
+
+		cfg = viper.New()
+		cfg.Set("internal.mtls.enabled", true)
+		cfg.Set("internal.mtls.ca", ca.write(dir))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	test := func(certs ...*x509.Certificate) error {
+		req := newReqInternal()
+		if certs != nil {
+			req.TLS = &tls.ConnectionState{PeerCertificates: certs}
+		}
+
+		handler := RequireClientCertificate(cfg)(func(ctx echo.Context) error {
+			return ctx.NoContent(http.StatusOK)
+		})
+
+		return handler(echo.New().NewContext(req, httptest.NewRecorder()))
+	}
+
+	It("passthrough when disabled", func() {
+		cfg.Set("internal.mtls.enabled", false)
+		Expect(test()).To(Succeed())
+	})
+
+	It("401s without a client certificate", func() {
+		err := test()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("code=401, message=Client certificate required"))
+	})
+
+	It("passthrough with a certificate issued by the CA", func() {
+		Expect(test(ca.issue(x509.ExtKeyUsageClientAuth, "cloud-connector"))).To(Succeed())
+	})
+
+	It("403s on a certificate issued by another CA", func() {
+		err := test(newTestCA().issue(x509.ExtKeyUsageClientAuth, "cloud-connector"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("code=403, message=Invalid client certificate"))
+	})
+
+	It("403s on a certificate not meant for client authentication", func() {
+		err := test(ca.issue(x509.ExtKeyUsageServerAuth, "cloud-connector"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("code=403, message=Invalid client certificate"))
+	})
+
+	Describe("allowed SANs", func() {
+		BeforeEach(func() {
+			cfg.Set("internal.mtls.allowed.sans", "remediations, cloud-connector")
+		})
+
+		It("passthrough on an allowed SAN", func() {
+			Expect(test(ca.issue(x509.ExtKeyUsageClientAuth, "cloud-connector"))).To(Succeed())
+		})
+
+		It("403s on a SAN not allowed", func() {
+			err := test(ca.issue(x509.ExtKeyUsageClientAuth, "sources"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("code=403, message=Client certificate not allowed"))
+		})
+	})
+})
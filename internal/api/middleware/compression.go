@@ -0,0 +1,281 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+)
+
+const (
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+)
+
+// supported encodings, in order of preference when the client accepts several equally
+var compressionEncodings = []string{encodingBrotli, encodingGzip}
+
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(io.Writer)
+}
+
+// PublicCompression compresses responses of the public API using the encoding negotiated with the client
+// (Accept-Encoding). Responses shorter than public.compression.min.length are sent as they are, as are responses the
+// handler encoded itself (e.g. the stdout of run hosts) and streams of events.
+func PublicCompression(cfg *viper.Viper) echo.MiddlewareFunc {
+	if !cfg.GetBool("public.compression.enabled") {
+		return func(next echo.HandlerFunc) echo.HandlerFunc {
+			return next
+		}
+	}
+
+	gzipLevel, brotliLevel := cfg.GetInt("public.compression.gzip.level"), cfg.GetInt("public.compression.brotli.level")
+	if gzipLevel < gzip.HuffmanOnly || gzipLevel > gzip.BestCompression {
+		panic(fmt.Sprintf("invalid gzip compression level: %d", gzipLevel))
+	}
+
+	if brotliLevel < brotli.BestSpeed || brotliLevel > brotli.BestCompression {
+		panic(fmt.Sprintf("invalid brotli compression level: %d", brotliLevel))
+	}
+
+	pools := map[string]*sync.Pool{
+		encodingBrotli: {New: func() any { return brotli.NewWriterLevel(nil, brotliLevel) }},
+		encodingGzip: {New: func() any {
+			writer, _ := gzip.NewWriterLevel(nil, gzipLevel)
+			return writer
+		}},
+	}
+
+	minLength := cfg.GetInt("public.compression.min.length")
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Response().Header().Add(echo.HeaderVary, echo.HeaderAcceptEncoding)
+
+			encoding := NegotiateEncoding(c.Request().Header.Get(echo.HeaderAcceptEncoding), compressionEncodings...)
+			if encoding == "" || c.Request().Method == http.MethodHead {
+				return next(c)
+			}
+
+			writer := &compressionWriter{
+				ResponseWriter: c.Response().Writer,
+				encoding:       encoding,
+				pool:           pools[encoding],
+				minLength:      minLength,
+			}
+
+			c.Response().Writer = writer
+			defer func() {
+				// anything the error handler writes afterwards is not compressed
+				c.Response().Writer = writer.ResponseWriter
+
+				if err := writer.Close(); err != nil {
+					c.Logger().Error(err)
+				} else if writer.encoder != nil {
+					instrumentation.PublicResponseCompressed(encoding, writer.uncompressed, writer.compressed.n)
+				}
+			}()
+
+			return next(c)
+		}
+	}
+}
+
+// NegotiateEncoding picks the encoding of the highest quality in the Accept-Encoding header among the given ones, which
+// are listed by preference, if any
+func NegotiateEncoding(acceptEncoding string, encodings ...string) string {
+	quality := map[string]float64{}
+
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(part, ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			if value, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if q, _ = strconv.ParseFloat(value, 64); q < 0 {
+					q = 0
+				}
+			}
+		}
+
+		quality[name] = q
+	}
+
+	best, bestQuality := "", 0.0
+	for _, encoding := range encodings {
+		q, ok := quality[encoding]
+		if !ok {
+			q = quality["*"]
+		}
+
+		if q > bestQuality {
+			best, bestQuality = encoding, q
+		}
+	}
+
+	return best
+}
+
+type countingWriter struct {
+	io.Writer
+	n int
+}
+
+func (this *countingWriter) Write(p []byte) (int, error) {
+	n, err := this.Writer.Write(p)
+	this.n += n
+	return n, err
+}
+
+// compressionWriter holds the response back until it is known whether it is long enough to be compressed
+type compressionWriter struct {
+	http.ResponseWriter
+	encoding  string
+	pool      *sync.Pool
+	minLength int
+
+	status  int
+	buffer  bytes.Buffer
+	decided bool
+
+	encoder      encoder
+	compressed   *countingWriter
+	uncompressed int
+}
+
+func (this *compressionWriter) WriteHeader(status int) {
+	if this.status == 0 {
+		this.status = status
+	}
+}
+
+func (this *compressionWriter) Write(p []byte) (int, error) {
+	if this.status == 0 {
+		this.status = http.StatusOK
+	}
+
+	if !this.decided {
+		if !this.compressible() {
+			if err := this.decide(false); err != nil {
+				return 0, err
+			}
+		} else {
+			this.buffer.Write(p)
+			if this.buffer.Len() < this.minLength {
+				return len(p), nil
+			}
+
+			return len(p), this.decide(true)
+		}
+	}
+
+	if this.encoder != nil {
+		this.uncompressed += len(p)
+		return this.encoder.Write(p)
+	}
+
+	return this.ResponseWriter.Write(p)
+}
+
+func (this *compressionWriter) Flush() {
+	if !this.decided {
+		if this.status == 0 {
+			this.status = http.StatusOK
+		}
+
+		if err := this.decide(this.buffer.Len() > 0); err != nil {
+			return
+		}
+	}
+
+	if this.encoder != nil {
+		if err := this.encoder.Flush(); err != nil {
+			return
+		}
+	}
+
+	_ = http.NewResponseController(this.ResponseWriter).Flush()
+}
+
+func (this *compressionWriter) Unwrap() http.ResponseWriter {
+	return this.ResponseWriter
+}
+
+// Close sends what is held back and finishes the compressed stream
+func (this *compressionWriter) Close() error {
+	if !this.decided {
+		// nothing was written, e.g. the handler returned an error
+		if this.status == 0 {
+			return nil
+		}
+
+		if err := this.decide(this.buffer.Len() > 0 && this.buffer.Len() >= this.minLength); err != nil {
+			return err
+		}
+	}
+
+	if this.encoder == nil {
+		return nil
+	}
+
+	err := this.encoder.Close()
+	this.encoder.Reset(nil)
+	this.pool.Put(this.encoder)
+	return err
+}
+
+func (this *compressionWriter) compressible() bool {
+	header := this.Header()
+
+	switch {
+	case this.status == http.StatusNoContent || this.status == http.StatusNotModified || this.status < http.StatusOK:
+		return false
+	case header.Get(echo.HeaderContentEncoding) != "" || header.Get("Content-Range") != "":
+		return false
+	case strings.HasPrefix(header.Get(echo.HeaderContentType), "text/event-stream"):
+		return false
+	}
+
+	return true
+}
+
+// decide writes the header, choosing whether the body is compressed, followed by what has been held back
+func (this *compressionWriter) decide(compress bool) error {
+	this.decided = true
+
+	if compress && this.compressible() {
+		header := this.Header()
+		header.Del(echo.HeaderContentLength)
+		header.Set(echo.HeaderContentEncoding, this.encoding)
+
+		this.compressed = &countingWriter{Writer: this.ResponseWriter}
+		this.encoder = this.pool.Get().(encoder)
+		this.encoder.Reset(this.compressed)
+	}
+
+	this.ResponseWriter.WriteHeader(this.status)
+
+	if this.buffer.Len() == 0 {
+		return nil
+	}
+
+	held := this.buffer.Bytes()
+	this.buffer = bytes.Buffer{}
+	_, err := this.Write(held)
+	return err
+}
@@ -0,0 +1,164 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+func testCompression(acceptEncoding string, handler echo.HandlerFunc) *httptest.ResponseRecorder {
+	cfg := viper.New()
+	cfg.Set("public.compression.enabled", true)
+	cfg.Set("public.compression.gzip.level", 5)
+	cfg.Set("public.compression.brotli.level", 4)
+	cfg.Set("public.compression.min.length", 100)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/playbook-dispatcher/v1/runs", nil)
+	if acceptEncoding != "" {
+		req.Header.Set(echo.HeaderAcceptEncoding, acceptEncoding)
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := echo.New().NewContext(req, recorder)
+
+	err := PublicCompression(cfg)(handler)(ctx)
+	Expect(err).ToNot(HaveOccurred())
+
+	return recorder
+}
+
+func decompress(recorder *httptest.ResponseRecorder) string {
+	var reader io.Reader = recorder.Body
+
+	switch recorder.Header().Get(echo.HeaderContentEncoding) {
+	case encodingBrotli:
+		reader = brotli.NewReader(recorder.Body)
+	case encodingGzip:
+		gzipReader, err := gzip.NewReader(recorder.Body)
+		Expect(err).ToNot(HaveOccurred())
+		reader = gzipReader
+	}
+
+	body, err := io.ReadAll(reader)
+	Expect(err).ToNot(HaveOccurred())
+	return string(body)
+}
+
+var _ = Describe("Compression middleware", func() {
+	long := strings.Repeat(`{"id": "5a9d54f5-06c2-46fe-a85e-dcc278cdce44", "status": "success"}`, 100)
+
+	respond := func(body string) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			return ctx.String(http.StatusOK, body)
+		}
+	}
+
+	DescribeTable("negotiates the encoding",
+		func(acceptEncoding, expected string) {
+			Expect(NegotiateEncoding(acceptEncoding, compressionEncodings...)).To(Equal(expected))
+		},
+
+		Entry("none", "", ""),
+		Entry("identity", "identity", ""),
+		Entry("gzip", "gzip", encodingGzip),
+		Entry("brotli", "br", encodingBrotli),
+		Entry("brotli preferred", "gzip, deflate, br", encodingBrotli),
+		Entry("quality", "br;q=0.5, gzip", encodingGzip),
+		Entry("rejected", "br;q=0, gzip;q=0", ""),
+		Entry("wildcard", "*", encodingBrotli),
+		Entry("wildcard with exclusion", "br;q=0, *;q=0.1", encodingGzip),
+		Entry("case insensitive", "GZIP", encodingGzip),
+	)
+
+	DescribeTable("compresses long responses",
+		func(acceptEncoding, expected string) {
+			recorder := testCompression(acceptEncoding, respond(long))
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get(echo.HeaderContentEncoding)).To(Equal(expected))
+			Expect(recorder.Header().Get(echo.HeaderVary)).To(Equal(echo.HeaderAcceptEncoding))
+			Expect(recorder.Body.Len()).To(BeNumerically("<", len(long)))
+			Expect(decompress(recorder)).To(Equal(long))
+		},
+
+		Entry("gzip", "gzip", encodingGzip),
+		Entry("brotli", "gzip, br", encodingBrotli),
+	)
+
+	It("compresses responses written in parts", func() {
+		recorder := testCompression("gzip", func(ctx echo.Context) error {
+			ctx.Response().WriteHeader(http.StatusOK)
+			for i := 0; i < 10; i++ {
+				if _, err := ctx.Response().Write([]byte(long[i*50 : (i+1)*50])); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+
+		Expect(recorder.Header().Get(echo.HeaderContentEncoding)).To(Equal(encodingGzip))
+		Expect(decompress(recorder)).To(Equal(long[:500]))
+	})
+
+	It("does not compress short responses", func() {
+		recorder := testCompression("gzip", respond("short"))
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get(echo.HeaderContentEncoding)).To(BeEmpty())
+		Expect(recorder.Body.String()).To(Equal("short"))
+	})
+
+	It("does not compress unless accepted", func() {
+		recorder := testCompression("", respond(long))
+		Expect(recorder.Header().Get(echo.HeaderContentEncoding)).To(BeEmpty())
+		Expect(recorder.Body.String()).To(Equal(long))
+	})
+
+	It("leaves responses encoded by the handler as they are", func() {
+		var encoded bytes.Buffer
+		writer := gzip.NewWriter(&encoded)
+		_, err := writer.Write([]byte(long))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writer.Close()).To(Succeed())
+
+		recorder := testCompression("br, gzip", func(ctx echo.Context) error {
+			ctx.Response().Header().Set(echo.HeaderContentEncoding, encodingGzip)
+			return ctx.Blob(http.StatusOK, "text/plain", encoded.Bytes())
+		})
+
+		Expect(recorder.Header().Get(echo.HeaderContentEncoding)).To(Equal(encodingGzip))
+		Expect(decompress(recorder)).To(Equal(long))
+	})
+
+	It("does not compress responses without a body", func() {
+		recorder := testCompression("gzip", func(ctx echo.Context) error {
+			return ctx.NoContent(http.StatusNotModified)
+		})
+
+		Expect(recorder.Code).To(Equal(http.StatusNotModified))
+		Expect(recorder.Header().Get(echo.HeaderContentEncoding)).To(BeEmpty())
+		Expect(recorder.Body.Len()).To(Equal(0))
+	})
+
+	It("passes responses through when disabled", func() {
+		cfg := viper.New()
+		cfg.Set("public.compression.enabled", false)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/playbook-dispatcher/v1/runs", nil)
+		req.Header.Set(echo.HeaderAcceptEncoding, "gzip")
+		recorder := httptest.NewRecorder()
+
+		Expect(PublicCompression(cfg)(respond(long))(echo.New().NewContext(req, recorder))).To(Succeed())
+		Expect(recorder.Header().Get(echo.HeaderContentEncoding)).To(BeEmpty())
+		Expect(recorder.Body.String()).To(Equal(long))
+	})
+})
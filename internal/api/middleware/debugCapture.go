@@ -0,0 +1,184 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"playbook-dispatcher/internal/api/capture"
+	"playbook-dispatcher/internal/common/constants"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"github.com/redhatinsights/platform-go-middlewares/v2/request_id"
+	"github.com/spf13/viper"
+)
+
+// set on the echo context of a request that is being captured
+const debugCaptureKey = "debug.capture"
+
+// DebugCapture records full request/response bodies of the orgs listed in debug.capture.org.ids into the given buffer.
+// It is only to be used after the identity or the internal authentication so that the org of the request is known.
+func DebugCapture(cfg *viper.Viper, buffer *capture.Buffer) echo.MiddlewareFunc {
+	maxBodySize := cfg.GetInt("debug.capture.max.body.size")
+	orgIds := map[string]bool{}
+	for _, orgId := range strings.Split(cfg.GetString("debug.capture.org.ids"), ",") {
+		if orgId = strings.TrimSpace(orgId); orgId != "" {
+			orgIds[orgId] = true
+		}
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if len(orgIds) == 0 {
+				return next(c)
+			}
+
+			requestBody, err := readCaptureBody(c.Request(), maxBodySize)
+			if err != nil {
+				return err
+			}
+
+			orgId := captureOrgId(c.Request(), requestBody)
+			if !orgIds[orgId] {
+				return next(c)
+			}
+
+			return captureRequest(c, next, buffer, maxBodySize, orgId, requestBody)
+		}
+	}
+}
+
+// ForcedDebugCapture records the request/response bodies of requests sent with the debug capture header.
+// It is only to be used after the internal authentication so that unauthenticated requests cannot fill the buffer.
+func ForcedDebugCapture(cfg *viper.Viper, buffer *capture.Buffer) echo.MiddlewareFunc {
+	maxBodySize := cfg.GetInt("debug.capture.max.body.size")
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			// already captured as the org is listed
+			if c.Request().Header.Get(constants.HeaderDebugCapture) != "true" || c.Get(debugCaptureKey) != nil {
+				return next(c)
+			}
+
+			requestBody, err := readCaptureBody(c.Request(), maxBodySize)
+			if err != nil {
+				return err
+			}
+
+			return captureRequest(c, next, buffer, maxBodySize, captureOrgId(c.Request(), requestBody), requestBody)
+		}
+	}
+}
+
+// readCaptureBody reads as much of the body as is captured, the handler reads it followed by the rest of the body
+func readCaptureBody(req *http.Request, limit int) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), req.Body), Closer: req.Body}
+	return body, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func captureRequest(c echo.Context, next echo.HandlerFunc, buffer *capture.Buffer, maxBodySize int, orgId string, requestBody []byte) error {
+	c.Set(debugCaptureKey, true)
+	req := c.Request()
+
+	recorder := &captureWriter{ResponseWriter: c.Response().Writer, limit: maxBodySize}
+	c.Response().Writer = recorder
+
+	start := time.Now()
+	err := next(c)
+
+	status := c.Response().Status
+	if httpError, ok := err.(*echo.HTTPError); ok {
+		status = httpError.Code
+	}
+
+	buffer.Add(capture.Entry{
+		Timestamp:    start,
+		OrgId:        orgId,
+		RequestId:    request_id.GetReqID(req.Context()),
+		Method:       req.Method,
+		Url:          req.RequestURI,
+		Status:       status,
+		DurationMs:   time.Since(start).Milliseconds(),
+		RequestBody:  string(requestBody),
+		ResponseBody: recorder.body.String(),
+	})
+
+	return err
+}
+
+// the org is taken from the identity authenticated by the preceding middlewares,
+// requests without an identity (e.g. internal dispatch) carry the org_id in the body instead
+func captureOrgId(req *http.Request, body []byte) string {
+	if orgId := identity.GetIdentity(req.Context()).Identity.OrgID; orgId != "" {
+		return orgId
+	}
+
+	// only the beginning of the body is read, the org_id of the first item is looked for token by token
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	for _, delim := range []json.Delim{'[', '{'} {
+		if token, err := decoder.Token(); err != nil || token != delim {
+			return ""
+		}
+	}
+
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return ""
+		}
+
+		if key == "org_id" {
+			token, _ := decoder.Token()
+			orgId, _ := token.(string)
+			return orgId
+		}
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return ""
+		}
+	}
+
+	return ""
+}
+
+type captureWriter struct {
+	http.ResponseWriter
+	body  bytes.Buffer
+	limit int
+}
+
+func (this *captureWriter) Write(b []byte) (int, error) {
+	if remaining := this.limit - this.body.Len(); remaining > 0 {
+		if len(b) > remaining {
+			this.body.Write(b[:remaining])
+		} else {
+			this.body.Write(b)
+		}
+	}
+
+	return this.ResponseWriter.Write(b)
+}
+
+func (this *captureWriter) Flush() {
+	if flusher, ok := this.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"playbook-dispatcher/internal/api/capture"
+	"playbook-dispatcher/internal/common/constants"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"github.com/spf13/viper"
+)
+
+func testDebugCapture(orgIds string, req *http.Request) *capture.Buffer {
+	return testDebugCaptureChain(orgIds, req, false)
+}
+
+func testDebugCaptureChain(orgIds string, req *http.Request, authenticated bool) *capture.Buffer {
+	cfg := viper.New()
+	cfg.Set("debug.capture.org.ids", orgIds)
+	cfg.Set("debug.capture.max.body.size", 24)
+
+	buffer := capture.NewBuffer(10)
+	handler := func(ctx echo.Context) error {
+		return ctx.String(http.StatusCreated, "response body")
+	}
+
+	if authenticated {
+		handler = ForcedDebugCapture(cfg, buffer)(handler)
+	}
+
+	handler = DebugCapture(cfg, buffer)(handler)
+
+	err := handler(echo.New().NewContext(req, httptest.NewRecorder()))
+	Expect(err).ToNot(HaveOccurred())
+
+	return buffer
+}
+
+func newCaptureRequest(path, body string) *http.Request {
+	req, err := http.NewRequest("POST", path, strings.NewReader(body))
+	Expect(err).ToNot(HaveOccurred())
+	return req
+}
+
+var _ = Describe("Debug capture middleware", func() {
+	It("captures requests of a listed org", func() {
+		buffer := testDebugCapture("12345", newCaptureRequest("/internal/v2/dispatch", `[{"org_id": "12345", "recipient": "dd018b96"}]`))
+
+		entries := buffer.Get("12345")
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Method).To(Equal("POST"))
+		Expect(entries[0].Status).To(Equal(http.StatusCreated))
+		Expect(entries[0].RequestBody).To(Equal(`[{"org_id": "12345", "re`))
+		Expect(entries[0].ResponseBody).To(Equal("response body"))
+	})
+
+	It("ignores requests of other orgs", func() {
+		buffer := testDebugCapture("12345", newCaptureRequest("/internal/v2/dispatch", `[{"org_id": "54321"}]`))
+		Expect(buffer.Get("")).To(BeEmpty())
+	})
+
+	It("captures authenticated requests with the debug capture header", func() {
+		req := newCaptureRequest("/internal/v2/dispatch", `[{"org_id": "54321"}]`)
+		req.Header.Set(constants.HeaderDebugCapture, "true")
+
+		buffer := testDebugCaptureChain("", req, true)
+		Expect(buffer.Get("54321")).To(HaveLen(1))
+	})
+
+	It("ignores the debug capture header before authentication", func() {
+		req := newCaptureRequest("/internal/v2/dispatch", `[{"org_id": "54321"}]`)
+		req.Header.Set(constants.HeaderDebugCapture, "true")
+
+		buffer := testDebugCapture("", req)
+		Expect(buffer.Get("")).To(BeEmpty())
+	})
+
+	It("captures a listed org sending the debug capture header once", func() {
+		req := newCaptureRequest("/internal/v2/dispatch", `[{"org_id": "12345"}]`)
+		req.Header.Set(constants.HeaderDebugCapture, "true")
+
+		buffer := testDebugCaptureChain("12345", req, true)
+		Expect(buffer.Get("12345")).To(HaveLen(1))
+	})
+
+	It("takes the org from the authenticated identity", func() {
+		req := newCaptureRequest("/api/playbook-dispatcher/v1/runs", `[{"org_id": "54321"}]`)
+		req = req.WithContext(identity.WithIdentity(req.Context(), identity.XRHID{Identity: identity.Identity{OrgID: "12345"}}))
+
+		Expect(testDebugCapture("54321", req).Get("")).To(BeEmpty())
+		Expect(testDebugCapture("12345", req).Get("12345")).To(HaveLen(1))
+	})
+
+	It("ignores an identity header that was not authenticated", func() {
+		req := newCaptureRequest("/api/playbook-dispatcher/v1/runs", "")
+		req.Header.Set(constants.HeaderIdentity, base64.StdEncoding.EncodeToString([]byte(`{"identity": {"org_id": "12345"}}`)))
+
+		buffer := testDebugCapture("12345", req)
+		Expect(buffer.Get("")).To(BeEmpty())
+	})
+
+	It("reads no more of the body than is captured", func() {
+		cfg := viper.New()
+		cfg.Set("debug.capture.org.ids", "12345")
+		cfg.Set("debug.capture.max.body.size", 8)
+
+		body := `[{"org_id": "12345", "recipient": "dd018b96-da04-4651-84d1-187fa5c23f6c"}]`
+		req := newCaptureRequest("/internal/v2/dispatch", body)
+		req = req.WithContext(identity.WithIdentity(req.Context(), identity.XRHID{Identity: identity.Identity{OrgID: "12345"}}))
+
+		var read []byte
+		buffer := capture.NewBuffer(10)
+		handler := DebugCapture(cfg, buffer)(func(ctx echo.Context) error {
+			var err error
+			read, err = io.ReadAll(ctx.Request().Body)
+			return err
+		})
+
+		Expect(handler(echo.New().NewContext(req, httptest.NewRecorder()))).To(Succeed())
+		Expect(string(read)).To(Equal(body))
+		Expect(buffer.Get("12345")[0].RequestBody).To(Equal(`[{"org_i`))
+	})
+})
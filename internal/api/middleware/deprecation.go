@@ -0,0 +1,235 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"github.com/spf13/viper"
+)
+
+// deprecation is an entry of api.deprecations. It applies either to the endpoints matching method and path or, if
+// field is set, to requests using the given field (in the request body, a filter or a sparse fieldset).
+type deprecation struct {
+	// the entry without its metadata, identifies the deprecation in metrics
+	name       string
+	method     string
+	path       string
+	prefix     bool
+	field      string
+	deprecated *time.Time
+	sunset     *time.Time
+	link       string
+}
+
+// parseDeprecations parses a comma-separated list of entries of the form
+// <method> <path>[*];deprecated=<date>;sunset=<date>;link=<url> or field:<name>;deprecated=<date>;...
+// All metadata is optional. The method may be * to match any method, a path ending with * matches by prefix.
+func parseDeprecations(value string) (result []deprecation, err error) {
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+
+		fields := strings.Split(entry, ";")
+		item := deprecation{name: strings.TrimSpace(fields[0])}
+
+		if field, found := strings.CutPrefix(item.name, "field:"); found {
+			item.field = field
+		} else if method, path, found := strings.Cut(item.name, " "); found && strings.HasPrefix(path, "/") {
+			item.method = strings.ToUpper(method)
+			item.path, item.prefix = strings.CutSuffix(path, "*")
+		} else {
+			return nil, fmt.Errorf("invalid deprecation %q, expected <method> <path> or field:<name>", item.name)
+		}
+
+		for _, field := range fields[1:] {
+			name, value, found := strings.Cut(strings.TrimSpace(field), "=")
+			if !found {
+				return nil, fmt.Errorf("invalid deprecation metadata %q", field)
+			}
+
+			switch name {
+			case "deprecated":
+				if item.deprecated, err = parsePskTime(value); err != nil {
+					return nil, err
+				}
+			case "sunset":
+				if item.sunset, err = parsePskTime(value); err != nil {
+					return nil, err
+				}
+			case "link":
+				item.link = value
+			default:
+				return nil, fmt.Errorf("unknown deprecation metadata %q", name)
+			}
+		}
+
+		result = append(result, item)
+	}
+
+	return
+}
+
+func (this deprecation) matchesEndpoint(method, path string) bool {
+	if this.field != "" || (this.method != "*" && this.method != method) {
+		return false
+	}
+
+	if this.prefix {
+		return strings.HasPrefix(path, this.path)
+	}
+
+	return path == this.path
+}
+
+// Deprecation signals the use of deprecated endpoints and fields listed in api.deprecations to callers using the
+// Deprecation (RFC 9745), Sunset (RFC 8594) and Link headers, and counts the use per caller so that it is known when
+// they can be removed.
+func Deprecation(cfg *viper.Viper) echo.MiddlewareFunc {
+	deprecations, err := parseDeprecations(cfg.GetString("api.deprecations"))
+	if err != nil {
+		panic(fmt.Sprintf("invalid api.deprecations: %s", err))
+	}
+
+	fields := map[string]deprecation{}
+	for _, item := range deprecations {
+		if item.field != "" {
+			fields[item.field] = item
+		}
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			matched := []deprecation{}
+
+			for _, item := range deprecations {
+				if item.matchesEndpoint(c.Request().Method, c.Request().URL.Path) {
+					matched = append(matched, item)
+				}
+			}
+
+			if len(fields) > 0 {
+				for field := range usedFields(c) {
+					if item, ok := fields[field]; ok {
+						matched = append(matched, item)
+					}
+				}
+			}
+
+			if len(matched) == 0 {
+				return next(c)
+			}
+
+			setDeprecationHeaders(c.Response().Header(), matched)
+
+			err := next(c)
+
+			caller := deprecationCaller(c)
+			for _, item := range matched {
+				instrumentation.DeprecatedUsage(c, item.name, caller)
+			}
+
+			return err
+		}
+	}
+}
+
+func setDeprecationHeaders(header http.Header, matched []deprecation) {
+	var deprecated, sunset *time.Time
+
+	for _, item := range matched {
+		// the earliest dates apply if several deprecations match
+		if item.deprecated != nil && (deprecated == nil || item.deprecated.Before(*deprecated)) {
+			deprecated = item.deprecated
+		}
+
+		if item.sunset != nil && (sunset == nil || item.sunset.Before(*sunset)) {
+			sunset = item.sunset
+		}
+
+		if item.link != "" {
+			header.Add("Link", fmt.Sprintf(`<%s>; rel="deprecation"; type="text/html"`, item.link))
+		}
+	}
+
+	if deprecated != nil {
+		header.Set("Deprecation", "@"+strconv.FormatInt(deprecated.Unix(), 10))
+	}
+
+	if sunset != nil {
+		header.Set("Sunset", sunset.UTC().Format(http.TimeFormat))
+	}
+}
+
+// usedFields returns the names of the fields used in sparse fieldsets, filters and the (JSON) request body
+func usedFields(c echo.Context) map[string]bool {
+	result := map[string]bool{}
+
+	for key, values := range c.QueryParams() {
+		if name, found := strings.CutPrefix(key, "filter["); found {
+			result[strings.SplitN(name, "]", 2)[0]] = true
+		}
+
+		if strings.HasPrefix(key, "fields[") {
+			for _, value := range values {
+				for _, field := range strings.Split(value, ",") {
+					result[field] = true
+				}
+			}
+		}
+	}
+
+	req := c.Request()
+	if req.Body == nil || !strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		return result
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return result
+	}
+
+	var value interface{}
+	if json.Unmarshal(body, &value) != nil {
+		return result
+	}
+
+	// dispatch and cancel requests are arrays of objects
+	items, ok := value.([]interface{})
+	if !ok {
+		items = []interface{}{value}
+	}
+
+	for _, item := range items {
+		if object, ok := item.(map[string]interface{}); ok {
+			for key := range object {
+				result[key] = true
+			}
+		}
+	}
+
+	return result
+}
+
+// identifies the caller by the pre-shared key principal (internal API) or the org (public API)
+func deprecationCaller(c echo.Context) string {
+	if principal, ok := c.Request().Context().Value(pskPrincipal).(string); ok {
+		return principal
+	}
+
+	if orgId := identity.GetIdentity(c.Request().Context()).Identity.OrgID; orgId != "" {
+		return "org:" + orgId
+	}
+
+	return "unknown"
+}
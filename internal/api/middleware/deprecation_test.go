@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"playbook-dispatcher/internal/common/utils"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("Deprecation", func() {
+	var cfg *viper.Viper
+
+	BeforeEach(func() {
+		cfg = viper.New()
+		cfg.Set("api.deprecations", "GET /api/playbook-dispatcher/v1/*;deprecated=2026-01-01;sunset=2026-07-01;link=https://example.com/v2,POST /internal/dispatch,field:account;deprecated=2025-06-01")
+	})
+
+	serve := func(req *http.Request) (*httptest.ResponseRecorder, string) {
+		var body string
+
+		server := echo.New()
+		server.Use(Deprecation(cfg))
+
+		handler := func(ctx echo.Context) error {
+			data, _ := io.ReadAll(ctx.Request().Body)
+			body = string(data)
+			return ctx.NoContent(http.StatusOK)
+		}
+
+		server.GET("/api/playbook-dispatcher/v1/runs", handler)
+		server.GET("/internal/v2/run_hosts", handler)
+		server.POST("/internal/dispatch", handler)
+		server.POST("/internal/v2/dispatch", handler)
+
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, req.WithContext(utils.SetLog(context.Background(), zap.NewNop().Sugar())))
+		return recorder, body
+	}
+
+	It("signals deprecated endpoints", func() {
+		res, _ := serve(httptest.NewRequest(http.MethodGet, "/api/playbook-dispatcher/v1/runs", nil))
+
+		Expect(res.Header().Get("Deprecation")).To(Equal("@1767225600"))
+		Expect(res.Header().Get("Sunset")).To(Equal("Wed, 01 Jul 2026 00:00:00 GMT"))
+		Expect(res.Header().Get("Link")).To(Equal(`<https://example.com/v2>; rel="deprecation"; type="text/html"`))
+	})
+
+	It("leaves other endpoints alone", func() {
+		res, _ := serve(httptest.NewRequest(http.MethodGet, "/internal/v2/run_hosts", nil))
+
+		Expect(res.Header().Get("Deprecation")).To(BeEmpty())
+		Expect(res.Header().Get("Link")).To(BeEmpty())
+	})
+
+	It("omits the headers of entries without dates", func() {
+		res, _ := serve(httptest.NewRequest(http.MethodPost, "/internal/dispatch", strings.NewReader(`[]`)))
+
+		Expect(res.Code).To(Equal(http.StatusOK))
+		Expect(res.Header().Get("Deprecation")).To(BeEmpty())
+	})
+
+	It("signals deprecated fields in the request body and keeps the body readable", func() {
+		req := httptest.NewRequest(http.MethodPost, "/internal/v2/dispatch", strings.NewReader(`[{"account":"901578","org_id":"5318290"}]`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res, body := serve(req)
+
+		Expect(res.Header().Get("Deprecation")).To(Equal("@1748736000"))
+		Expect(body).To(Equal(`[{"account":"901578","org_id":"5318290"}]`))
+	})
+
+	It("signals deprecated fields used in filters and fieldsets", func() {
+		res, _ := serve(httptest.NewRequest(http.MethodGet, "/internal/v2/run_hosts?filter[run][account]=1", nil))
+		Expect(res.Header().Get("Deprecation")).To(BeEmpty())
+
+		res, _ = serve(httptest.NewRequest(http.MethodGet, "/internal/v2/run_hosts?filter[account]=1", nil))
+		Expect(res.Header().Get("Deprecation")).To(Equal("@1748736000"))
+
+		res, _ = serve(httptest.NewRequest(http.MethodGet, "/internal/v2/run_hosts?fields[data]=id,account", nil))
+		Expect(res.Header().Get("Deprecation")).To(Equal("@1748736000"))
+	})
+
+	It("uses the earliest dates if several deprecations apply", func() {
+		res, _ := serve(httptest.NewRequest(http.MethodGet, "/api/playbook-dispatcher/v1/runs?fields[data]=account", nil))
+
+		Expect(res.Header().Get("Deprecation")).To(Equal("@1748736000"))
+		Expect(res.Header().Get("Sunset")).To(Equal("Wed, 01 Jul 2026 00:00:00 GMT"))
+	})
+
+	It("rejects invalid entries", func() {
+		for _, value := range []string{"runs", "GET runs", "field:account;deprecated=soon", "field:account;removed=2026-01-01"} {
+			_, err := parseDeprecations(value)
+			Expect(err).To(HaveOccurred(), value)
+		}
+	})
+})
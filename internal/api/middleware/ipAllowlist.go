@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"playbook-dispatcher/internal/common/utils"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+)
+
+// AllowSourceNetworks rejects requests whose remote address is not within one of the CIDR ranges in
+// internal.allowed.cidrs. Like AllowLocalhostOrPsk it looks at the address of the connection only, X-Forwarded-For
+// is ignored. Does nothing if no ranges are configured.
+func AllowSourceNetworks(cfg *viper.Viper) echo.MiddlewareFunc {
+	networks := parseNetworks(cfg.GetString("internal.allowed.cidrs"))
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if len(networks) == 0 {
+			return next
+		}
+
+		return func(c echo.Context) error {
+			remoteAddr := c.Request().RemoteAddr
+			host, _, err := net.SplitHostPort(remoteAddr)
+			if err != nil {
+				host = remoteAddr
+			}
+
+			if ip := net.ParseIP(host); ip != nil {
+				for _, network := range networks {
+					if network.Contains(ip) {
+						return next(c)
+					}
+				}
+			}
+
+			utils.GetLogFromEcho(c).Warnw("Rejecting request from address outside of the allowed networks", "remote_addr", remoteAddr)
+			return echo.NewHTTPError(http.StatusForbidden, "Source address not allowed")
+		}
+	}
+}
+
+func parseNetworks(value string) (result []*net.IPNet) {
+	for _, cidr := range strings.Split(value, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr == "" {
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(fmt.Sprintf("invalid CIDR in internal.allowed.cidrs: %s", cidr))
+		}
+
+		result = append(result, network)
+	}
+
+	return
+}
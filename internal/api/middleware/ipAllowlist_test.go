@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+func testSourceNetworks(cidrs, remoteAddr string) error {
+	cfg := viper.New()
+	cfg.Set("internal.allowed.cidrs", cidrs)
+
+	req := newReqInternal()
+	req.RemoteAddr = remoteAddr
+	req.Header.Set(echo.HeaderXForwardedFor, "10.0.0.1")
+
+	handler := AllowSourceNetworks(cfg)(func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	return handler(echo.New().NewContext(req, httptest.NewRecorder()))
+}
+
+var _ = Describe("Source network middleware", func() {
+	DescribeTable("allows addresses within the configured networks",
+		func(cidrs, remoteAddr string) {
+			Expect(testSourceNetworks(cidrs, remoteAddr)).To(Succeed())
+		},
+
+		Entry("no networks configured", "", "192.168.1.1:45678"),
+		Entry("IPv4", "10.0.0.0/8, 172.16.0.0/12", "172.20.1.1:45678"),
+		Entry("IPv6", "fd00::/8", "[fd00::1]:45678"),
+	)
+
+	DescribeTable("rejects addresses outside of the configured networks",
+		func(cidrs, remoteAddr string) {
+			err := testSourceNetworks(cidrs, remoteAddr)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("code=403, message=Source address not allowed"))
+		},
+
+		Entry("IPv4", "10.0.0.0/8", "192.168.1.1:45678"),
+		Entry("IPv6", "10.0.0.0/8", "[fd00::1]:45678"),
+		Entry("spoofed X-Forwarded-For", "10.0.0.0/8", "192.168.1.1:45678"),
+		Entry("unparseable address", "10.0.0.0/8", "unknown"),
+	)
+
+	It("panics on an invalid network", func() {
+		Expect(func() { testSourceNetworks("10.0.0.0/33", "10.0.0.1:45678") }).To(Panic())
+	})
+})
@@ -0,0 +1,212 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// keySet holds the signing keys published by the SSO as a JSON Web Key Set.
+// Keys are cached for maxAge. An unknown key id triggers a refresh so that keys rotated in by the SSO are picked up
+// without waiting for the cache to expire. Refreshes are attempted at most once per minRefresh, whether they succeed
+// or not, and concurrent requests share a single fetch.
+type keySet struct {
+	url        string
+	client     *http.Client
+	maxAge     time.Duration
+	minRefresh time.Duration
+
+	group singleflight.Group
+
+	lock      sync.Mutex
+	keys      map[string]interface{}
+	fetched   time.Time
+	attempted time.Time
+	// error of the last attempt
+	err error
+}
+
+func newKeySet(url string, timeout, maxAge time.Duration) *keySet {
+	return &keySet{
+		url:        url,
+		client:     &http.Client{Timeout: timeout},
+		maxAge:     maxAge,
+		minRefresh: time.Minute,
+	}
+}
+
+func (this *keySet) key(ctx context.Context, kid string) (interface{}, error) {
+	this.lock.Lock()
+	key, ok := this.keys[kid]
+	fresh := time.Since(this.fetched) < this.maxAge
+	this.lock.Unlock()
+
+	if ok && fresh {
+		return key, nil
+	}
+
+	// the fetch is not canceled with the request that happened to start it as other requests may be waiting for it
+	result := this.group.DoChan("", func() (interface{}, error) {
+		return nil, this.refresh(context.WithoutCancel(ctx))
+	})
+
+	var err error
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-result:
+		err = res.Err
+	}
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	// keep serving the cached keys if the SSO is temporarily unavailable
+	if key, ok := this.keys[kid]; ok {
+		return key, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// refresh fetches the keys unless they were attempted to be fetched less than minRefresh ago,
+// in which case the error of that attempt is returned
+func (this *keySet) refresh(ctx context.Context) error {
+	this.lock.Lock()
+	if time.Since(this.attempted) < this.minRefresh {
+		defer this.lock.Unlock()
+		return this.err
+	}
+
+	this.attempted = time.Now()
+	this.lock.Unlock()
+
+	keys, err := this.fetch(ctx)
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.err = err
+	if err == nil {
+		this.keys = keys
+		this.fetched = time.Now()
+	}
+
+	return err
+}
+
+func (this *keySet) fetch(ctx context.Context) (map[string]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, this.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := this.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching JWKS: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching JWKS: unexpected status code %d", res.StatusCode)
+	}
+
+	var body struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("error decoding JWKS: %w", err)
+	}
+
+	keys := make(map[string]interface{}, len(body.Keys))
+	for _, jwk := range body.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		// keys of unsupported types are skipped rather than failing the whole set
+		if key, err := jwk.publicKey(); err == nil {
+			keys[jwk.Kid] = key
+		}
+	}
+
+	return keys, nil
+}
+
+func (this jsonWebKey) publicKey() (interface{}, error) {
+	switch this.Kty {
+	case "RSA":
+		n, err := decodeBigInt(this.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeBigInt(this.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch this.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", this.Crv)
+		}
+
+		x, err := decodeBigInt(this.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeBigInt(this.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", this.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(bytes), nil
+}
@@ -0,0 +1,139 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/kessel"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/unleash/features"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ResourceExtractor returns the ID of the resource a request operates on
+type ResourceExtractor func(c echo.Context, log *zap.SugaredLogger) (string, error)
+
+// KesselCheck is the Kessel permission a route requires: the relation on the resource returned by the extractor
+type KesselCheck struct {
+	ResourceType string
+	Relation     string
+	Extractor    ResourceExtractor
+}
+
+// Validate reports an incomplete check
+func (this KesselCheck) Validate() error {
+	if this.ResourceType == "" || this.Relation == "" || this.Extractor == nil {
+		return errors.New("resource type, relation and extractor are required")
+	}
+
+	return nil
+}
+
+// OrgWorkspace extracts the default workspace of the organization of the requester
+func OrgWorkspace(c echo.Context, log *zap.SugaredLogger) (string, error) {
+	orgID := identity.GetIdentity(c.Request().Context()).Identity.OrgID
+	return kessel.GetWorkspaceID(c.Request().Context(), orgID, log)
+}
+
+// PathParam extracts the resource ID from a path parameter of the route, e.g. the run of /runs/:run_id
+func PathParam(name string) ResourceExtractor {
+	return func(c echo.Context, log *zap.SugaredLogger) (string, error) {
+		if value := c.Param(name); value != "" {
+			return value, nil
+		}
+
+		return "", errors.New("missing path parameter " + name)
+	}
+}
+
+// RunWithConsistency extracts the run of a path parameter like PathParam. The consistency token of the write of the
+// relationships of the run is attached to the request so that a check following the creation of the run does not hit a
+// replica that has not seen them yet (with kessel.check.consistency=at_least_as_fresh).
+func RunWithConsistency(db *gorm.DB, name string) ResourceExtractor {
+	return func(c echo.Context, log *zap.SugaredLogger) (string, error) {
+		runID, err := uuid.Parse(c.Param(name))
+		if err != nil {
+			return "", fmt.Errorf("invalid path parameter %s: %w", name, err)
+		}
+
+		orgID := identity.GetIdentity(c.Request().Context()).Identity.OrgID
+
+		var tokens []*string
+		err = db.WithContext(c.Request().Context()).Model(&dbModel.Run{}).Where("id = ? AND org_id = ?", runID, orgID).Pluck("kessel_token", &tokens).Error
+		if err != nil {
+			return "", err
+		}
+
+		if len(tokens) > 0 && tokens[0] != nil {
+			c.SetRequest(c.Request().WithContext(kessel.WithConsistencyToken(c.Request().Context(), *tokens[0])))
+		}
+
+		return runID.String(), nil
+	}
+}
+
+// EnforceKesselPermission requires the permission described by check in the modes in which Kessel is authoritative.
+// It complements the application permissions checked by EnforcePermissions and is only applied with
+// kessel.route.checks.enabled. If Kessel cannot be consulted the request is rejected unless the failure policy is
+// fail_open.
+func EnforceKesselPermission(cfg *viper.Viper, check KesselCheck) echo.MiddlewareFunc {
+	failOpen := cfg.GetString("kessel.failure_policy") == config.KesselFailOpen
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if !cfg.GetBool("kessel.route.checks.enabled") {
+			return next
+		}
+
+		return func(c echo.Context) error {
+			log := utils.GetLogFromEcho(c)
+
+			switch features.GetKesselAuthModeWithContext(c.Request().Context(), cfg, log) {
+			case config.KesselModeBothKesselEnforces, config.KesselModeKesselOnly:
+			default:
+				return next(c)
+			}
+
+			allowed, err := checkRoutePermission(c, check, log)
+			if err != nil {
+				log.Errorw("Kessel route authorization error",
+					"error", err,
+					"resource_type", check.ResourceType,
+					"relation", check.Relation)
+				instrumentation.KesselAuthorizationError(c)
+
+				if failOpen {
+					instrumentation.KesselFailOpen(c)
+					return next(c)
+				}
+
+				return echo.NewHTTPError(http.StatusForbidden)
+			}
+
+			if !allowed {
+				instrumentation.KesselAuthorizationFailed(c)
+				return echo.NewHTTPError(http.StatusForbidden)
+			}
+
+			instrumentation.KesselAuthorizationPassed(c)
+			return next(c)
+		}
+	}
+}
+
+func checkRoutePermission(c echo.Context, check KesselCheck, log *zap.SugaredLogger) (bool, error) {
+	resourceID, err := check.Extractor(c, log)
+	if err != nil {
+		return false, err
+	}
+
+	return kessel.CheckResourcePermission(c.Request().Context(), check.ResourceType, resourceID, check.Relation, log)
+}
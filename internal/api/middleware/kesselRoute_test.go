@@ -0,0 +1,157 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/kessel"
+	"playbook-dispatcher/internal/common/utils"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func kesselRouteConfig(mode string) *viper.Viper {
+	cfg := viper.New()
+	cfg.Set("kessel.enabled", true)
+	cfg.Set("kessel.auth.mode", mode)
+	cfg.Set("kessel.route.checks.enabled", true)
+	return cfg
+}
+
+var runView = KesselCheck{ResourceType: kessel.ResourceTypeRun, Relation: "view", Extractor: PathParam("run_id")}
+
+func testKesselRoute(t *testing.T, cfg *viper.Viper, check KesselCheck) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	ctx := utils.SetLog(req.Context(), zap.NewNop().Sugar())
+	ctx = identity.WithIdentity(ctx, identity.XRHID{
+		Identity: identity.Identity{Type: "User", User: &identity.User{UserID: "user-123"}, OrgID: "org-456"},
+	})
+	req = req.WithContext(ctx)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("run_id")
+	c.SetParamValues("c1ec0a98-9b7d-4b6a-9d8d-7e1f4c7e2d1a")
+
+	handler := EnforceKesselPermission(cfg, check)(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	return rec, handler(c)
+}
+
+func TestEnforceKesselPermission_Disabled(t *testing.T) {
+	service := kessel.NewMockKesselClient(false)
+	defer service.Install()()
+
+	cfg := kesselRouteConfig(config.KesselModeKesselOnly)
+	cfg.Set("kessel.route.checks.enabled", false)
+
+	rec, err := testKesselRoute(t, cfg, runView)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, service.Checks())
+}
+
+func TestEnforceKesselPermission_RbacMode(t *testing.T) {
+	service := kessel.NewMockKesselClient(false)
+	defer service.Install()()
+
+	rec, err := testKesselRoute(t, kesselRouteConfig(config.KesselModeBothRBACEnforces), runView)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, service.Checks())
+}
+
+func TestEnforceKesselPermission_Allowed(t *testing.T) {
+	service := kessel.NewMockKesselClient(true)
+	defer service.Install()()
+
+	rec, err := testKesselRoute(t, kesselRouteConfig(config.KesselModeKesselOnly), runView)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	checks := service.Checks()
+	assert.Len(t, checks, 1)
+	assert.Equal(t, kessel.ResourceTypeRun, checks[0].ResourceType)
+	assert.Equal(t, "c1ec0a98-9b7d-4b6a-9d8d-7e1f4c7e2d1a", checks[0].ResourceID)
+	assert.Equal(t, kessel.ReporterTypePlaybookDispatcher, checks[0].Reporter)
+	assert.Equal(t, "view", checks[0].Relation)
+}
+
+func TestEnforceKesselPermission_Denied(t *testing.T) {
+	service := kessel.NewMockKesselClient(false)
+	defer service.Install()()
+
+	_, err := testKesselRoute(t, kesselRouteConfig(config.KesselModeBothKesselEnforces), runView)
+
+	var httpError *echo.HTTPError
+	assert.True(t, errors.As(err, &httpError))
+	assert.Equal(t, http.StatusForbidden, httpError.Code)
+}
+
+func TestEnforceKesselPermission_PartialPermission(t *testing.T) {
+	service := kessel.NewMockKesselClientWithRules(
+		kessel.MockRule{ResourceType: kessel.ResourceTypeRun, Relation: "view", ResourceID: "c1ec0a98-*", Allowed: true},
+	)
+	defer service.Install()()
+
+	rec, err := testKesselRoute(t, kesselRouteConfig(config.KesselModeKesselOnly), runView)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	edit := KesselCheck{ResourceType: kessel.ResourceTypeRun, Relation: "edit", Extractor: PathParam("run_id")}
+	_, err = testKesselRoute(t, kesselRouteConfig(config.KesselModeKesselOnly), edit)
+
+	var httpError *echo.HTTPError
+	assert.True(t, errors.As(err, &httpError))
+	assert.Equal(t, http.StatusForbidden, httpError.Code)
+	assert.Len(t, service.Checks(), 2)
+}
+
+func TestEnforceKesselPermission_Unavailable(t *testing.T) {
+	service := kessel.NewMockKesselClientWithRules(kessel.MockRule{Err: kessel.ErrMockUnavailable})
+	defer service.Install()()
+
+	_, err := testKesselRoute(t, kesselRouteConfig(config.KesselModeKesselOnly), runView)
+
+	var httpError *echo.HTTPError
+	assert.True(t, errors.As(err, &httpError))
+	assert.Equal(t, http.StatusForbidden, httpError.Code)
+}
+
+func TestEnforceKesselPermission_FailurePolicy(t *testing.T) {
+	service := kessel.NewMockKesselClient(false)
+	defer service.Install()()
+
+	failing := KesselCheck{ResourceType: kessel.ResourceTypeRun, Relation: "view", Extractor: PathParam("missing")}
+
+	_, err := testKesselRoute(t, kesselRouteConfig(config.KesselModeKesselOnly), failing)
+
+	var httpError *echo.HTTPError
+	assert.True(t, errors.As(err, &httpError))
+	assert.Equal(t, http.StatusForbidden, httpError.Code)
+
+	cfg := kesselRouteConfig(config.KesselModeKesselOnly)
+	cfg.Set("kessel.failure_policy", config.KesselFailOpen)
+
+	rec, err := testKesselRoute(t, cfg, failing)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, service.Checks())
+}
+
+func TestKesselCheck_Validate(t *testing.T) {
+	assert.NoError(t, runView.Validate())
+	assert.Error(t, KesselCheck{ResourceType: kessel.ResourceTypeWorkspace, Relation: kessel.PermissionRunRead}.Validate())
+}
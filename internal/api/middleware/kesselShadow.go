@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/unleash/features"
+	"playbook-dispatcher/internal/common/utils"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+)
+
+const shadowAllRoutes = "*"
+
+// KesselShadow consults Kessel on the routes listed in kessel.shadow_mode while RBAC is authoritative (rbac-only mode)
+// and compares its decision to the one EnforcePermissions made based on RBAC. Mismatches are logged and counted per
+// route so that Kessel can be validated before kessel.enabled is flipped. The response never depends on Kessel.
+//
+// Routes are matched by their registered path, e.g. /api/playbook-dispatcher/v1/runs/:run_id/hosts/:host/artifacts.
+// Must be registered after EnforcePermissions.
+func KesselShadow(cfg *viper.Viper) echo.MiddlewareFunc {
+	routes := map[string]bool{}
+	for _, route := range strings.Split(cfg.GetString("kessel.shadow_mode"), ",") {
+		if route = strings.TrimSpace(route); route != "" {
+			routes[route] = true
+		}
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if len(routes) == 0 {
+			return next
+		}
+
+		return func(c echo.Context) error {
+			if !routes[shadowAllRoutes] && !routes[c.Path()] {
+				return next(c)
+			}
+
+			log := utils.GetLogFromEcho(c)
+
+			// the other modes consult Kessel already
+			if mode := features.GetKesselAuthModeWithContext(c.Request().Context(), cfg, log); mode != config.KesselModeRBACOnly {
+				return next(c)
+			}
+
+			rbacServices := GetAllowedServices(c)
+			kesselServices, _ := getKesselAllowedServices(c, log)
+
+			if logComparison(c, rbacServices, kesselServices, log) {
+				instrumentation.KesselShadowMatch(c)
+			} else {
+				instrumentation.KesselShadowMismatch(c)
+			}
+
+			return next(c)
+		}
+	}
+}
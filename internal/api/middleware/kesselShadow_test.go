@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"playbook-dispatcher/internal/common/utils"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func shadowComparisons(t *testing.T, route, result string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	assert.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != "api_kessel_shadow_comparison_total" {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+
+			if labels["route"] == route && labels["result"] == result {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+
+	return 0
+}
+
+func testKesselShadow(t *testing.T, cfg *viper.Viper, route string, rbacServices []string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req = req.WithContext(utils.SetLog(req.Context(), zap.NewNop().Sugar()))
+
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(req, rec)
+	ctx.SetPath(route)
+	utils.SetRequestContextValue(ctx, allowedServicesKey, rbacServices)
+
+	handler := KesselShadow(cfg)(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	assert.NoError(t, handler(ctx))
+	return rec
+}
+
+func TestKesselShadow_RouteNotListed(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("kessel.shadow_mode", "/api/playbook-dispatcher/v1/runs")
+
+	route := "/api/playbook-dispatcher/v1/run_hosts"
+	rec := testKesselShadow(t, cfg, route, []string{"remediations"})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Zero(t, shadowComparisons(t, route, "match"))
+	assert.Zero(t, shadowComparisons(t, route, "mismatch"))
+}
+
+func TestKesselShadow_MismatchDoesNotAffectResponse(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("kessel.shadow_mode", " /api/playbook-dispatcher/v1/runs , /api/playbook-dispatcher/v1/run_hosts")
+
+	route := "/api/playbook-dispatcher/v1/runs"
+	before := shadowComparisons(t, route, "mismatch")
+
+	// Kessel is not initialized so it allows no services at all
+	rec := testKesselShadow(t, cfg, route, []string{"remediations"})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, before+1, shadowComparisons(t, route, "mismatch"))
+}
+
+func TestKesselShadow_AllRoutes(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("kessel.shadow_mode", "*")
+
+	route := "/api/playbook-dispatcher/v1/runs/:run_id/hosts/:host/artifacts"
+	before := shadowComparisons(t, route, "match")
+
+	rec := testKesselShadow(t, cfg, route, []string{})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, before+1, shadowComparisons(t, route, "match"))
+}
+
+func TestKesselShadow_SkippedWhenKesselConsulted(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("kessel.shadow_mode", "*")
+	cfg.Set("kessel.enabled", true)
+	cfg.Set("kessel.auth.mode", "both-rbac-enforces")
+
+	route := "/api/playbook-dispatcher/v2/runs"
+	rec := testKesselShadow(t, cfg, route, []string{"remediations"})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Zero(t, shadowComparisons(t, route, "match"))
+	assert.Zero(t, shadowComparisons(t, route, "mismatch"))
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"net"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AllowLocalhostOrPsk lets requests originating from the loopback interface through unauthenticated.
+// Any other request has to present a valid PSK (see CheckPskAuth).
+func AllowLocalhostOrPsk(authKeys map[string][]PskKey) echo.MiddlewareFunc {
+	pskAuth := CheckPskAuth(authKeys)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		withPsk := pskAuth(next)
+
+		return func(c echo.Context) error {
+			if isLoopback(c.Request().RemoteAddr) {
+				return next(c)
+			}
+
+			return withPsk(c)
+		}
+	}
+}
+
+// the remote address is used on purpose instead of echo's RealIP() as X-Forwarded-For can be spoofed
+func isLoopback(remoteAddr string) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
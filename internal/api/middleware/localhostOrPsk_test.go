@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func testLocalhostOrPsk(req *http.Request) (*httptest.ResponseRecorder, error) {
+	recorder := httptest.NewRecorder()
+
+	handler := AllowLocalhostOrPsk(map[string][]PskKey{"principal1": {{Id: "env-0", Value: key}}})(func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	c := echo.New().NewContext(req, recorder)
+
+	return recorder, handler(c)
+}
+
+var _ = Describe("Localhost or PSK middleware", func() {
+	DescribeTable("allows loopback addresses without a key",
+		func(remoteAddr string) {
+			req := newReqInternal()
+			req.RemoteAddr = remoteAddr
+			res, err := testLocalhostOrPsk(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Result().StatusCode).To(Equal(200))
+		},
+
+		Entry("IPv4", "127.0.0.1:45678"),
+		Entry("IPv6", "[::1]:45678"),
+	)
+
+	It("401s for remote address without a key", func() {
+		req := newReqInternal()
+		req.RemoteAddr = "10.0.0.5:45678"
+		_, err := testLocalhostOrPsk(req)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("code=401, message=Unauthorized"))
+	})
+
+	It("ignores spoofed X-Forwarded-For", func() {
+		req := newReqInternal()
+		req.RemoteAddr = "10.0.0.5:45678"
+		req.Header.Set(echo.HeaderXForwardedFor, "127.0.0.1")
+		_, err := testLocalhostOrPsk(req)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("code=401, message=Unauthorized"))
+	})
+
+	It("passthrough for remote address with a known key", func() {
+		req := newReqInternal()
+		req.RemoteAddr = "10.0.0.5:45678"
+		req.Header.Set("authorization", fmt.Sprintf("PSK %s", key))
+		res, err := testLocalhostOrPsk(req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Result().StatusCode).To(Equal(200))
+	})
+})
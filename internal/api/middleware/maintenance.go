@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/unleash/features"
+	"sync/atomic"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+)
+
+// Maintenance rejects requests with 503 while the service is in maintenance mode.
+// Maintenance mode is turned on by maintenance.mode (followed on config reload) or the Unleash maintenance flag.
+func Maintenance(cfg *viper.Viper) echo.MiddlewareFunc {
+	var enabled atomic.Bool
+	enabled.Store(cfg.GetBool("maintenance.mode"))
+
+	config.OnReload(func(cfg *viper.Viper) {
+		enabled.Store(cfg.GetBool("maintenance.mode"))
+	})
+
+	retryAfter := cfg.GetString("maintenance.retry.after")
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if enabled.Load() || features.IsMaintenanceFlagEnabled(cfg) {
+				c.Response().Header().Set(echo.HeaderRetryAfter, retryAfter)
+				return echo.NewHTTPError(http.StatusServiceUnavailable, "Service is in maintenance mode")
+			}
+
+			return next(c)
+		}
+	}
+}
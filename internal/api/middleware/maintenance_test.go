@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+func testMaintenance(enabled bool) (*httptest.ResponseRecorder, error) {
+	cfg := viper.New()
+	cfg.Set("maintenance.mode", enabled)
+	cfg.Set("maintenance.retry.after", 300)
+
+	recorder := httptest.NewRecorder()
+	handler := Maintenance(cfg)(func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	return recorder, handler(echo.New().NewContext(newReqInternal(), recorder))
+}
+
+var _ = Describe("Maintenance middleware", func() {
+	It("passes requests through", func() {
+		res, err := testMaintenance(false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Result().StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("503s in maintenance mode", func() {
+		res, err := testMaintenance(true)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*echo.HTTPError).Code).To(Equal(http.StatusServiceUnavailable))
+		Expect(res.Header().Get(echo.HeaderRetryAfter)).To(Equal("300"))
+	})
+})
@@ -2,11 +2,15 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"os"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/secrets"
 	"playbook-dispatcher/internal/common/utils"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
@@ -19,7 +23,84 @@ const pskPrincipal principalKey = iota
 var headerMatcher = regexp.MustCompile(`^PSK\s+([0-9a-zA-Z]+)$`)
 var envMatcher = regexp.MustCompile(`^PSK_AUTH_(.+?)=(.+?)$`)
 
-func CheckPskAuth(authKeys map[string]string) echo.MiddlewareFunc {
+// PskKey is one of the pre-shared keys of a principal.
+// A principal may hold several valid keys at the same time so that keys can be rotated without a coordinated deploy.
+type PskKey struct {
+	// identifies the key in logs and metrics without revealing it
+	Id      string
+	Value   string
+	Created *time.Time
+	Expires *time.Time
+}
+
+func (this PskKey) expired(now time.Time) bool {
+	return this.Expires != nil && !now.Before(*this.Expires)
+}
+
+// ParsePskKeys parses a comma-separated list of keys. Each key may be followed by metadata, e.g.
+// <key>;id=2026-01;created=2026-01-01;expires=2026-07-01
+// Keys without an id are identified by the given prefix and their position in the list.
+func ParsePskKeys(value, defaultIdPrefix string) (result []PskKey, err error) {
+	for i, entry := range strings.Split(value, ",") {
+		fields := strings.Split(strings.TrimSpace(entry), ";")
+
+		key := PskKey{
+			Id:    fmt.Sprintf("%s%d", defaultIdPrefix, i),
+			Value: strings.TrimSpace(fields[0]),
+		}
+
+		if key.Value == "" {
+			return nil, fmt.Errorf("empty key at position %d", i)
+		}
+
+		for _, field := range fields[1:] {
+			name, value, found := strings.Cut(strings.TrimSpace(field), "=")
+			if !found {
+				return nil, fmt.Errorf("invalid key metadata %q", field)
+			}
+
+			switch name {
+			case "id":
+				key.Id = value
+			case "created":
+				if key.Created, err = parsePskTime(value); err != nil {
+					return nil, err
+				}
+			case "expires":
+				if key.Expires, err = parsePskTime(value); err != nil {
+					return nil, err
+				}
+			default:
+				return nil, fmt.Errorf("unknown key metadata %q", name)
+			}
+		}
+
+		result = append(result, key)
+	}
+
+	return
+}
+
+func parsePskTime(value string) (*time.Time, error) {
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return &parsed, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid key timestamp %q", value)
+}
+
+// CheckPskAuth accepts the given keys (see BuildPskAuthConfigFromEnv) as well as keys loaded from the secret manager
+func CheckPskAuth(authKeys map[string][]PskKey) echo.MiddlewareFunc {
+	for principal, keys := range authKeys {
+		for _, key := range keys {
+			if key.Expires != nil {
+				instrumentation.PskExpires(principal, key.Id, *key.Expires)
+			}
+		}
+	}
+
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			checkIdentityHeader(c.Request(), utils.GetLogFromEcho(c))
@@ -35,18 +116,51 @@ func CheckPskAuth(authKeys map[string]string) echo.MiddlewareFunc {
 				return echo.NewHTTPError(http.StatusUnauthorized, "Unsupported authentication key format")
 			}
 
-			for principal, key := range authKeys {
-				if key == match[1] {
-					utils.SetRequestContextValue(c, pskPrincipal, principal)
-					return next(c)
-				}
+			principal, key, found := findPskKey(c, authKeys, match[1])
+			if !found {
+				return echo.NewHTTPError(http.StatusForbidden)
 			}
 
-			return echo.NewHTTPError(http.StatusForbidden)
+			if key.expired(time.Now()) {
+				instrumentation.PskExpired(c, principal, key.Id)
+				return echo.NewHTTPError(http.StatusForbidden, "Pre-shared key expired")
+			}
+
+			instrumentation.PskAuthenticated(c, principal, key.Id)
+			utils.SetRequestContextValue(c, pskPrincipal, principal)
+			return next(c)
 		}
 	}
 }
 
+func findPskKey(c echo.Context, authKeys map[string][]PskKey, value string) (string, PskKey, bool) {
+	for principal, keys := range authKeys {
+		for _, key := range keys {
+			if key.Value == value {
+				return principal, key, true
+			}
+		}
+	}
+
+	for principal, values := range secrets.GetPskKeys() {
+		for i, secretValue := range values {
+			keys, err := ParsePskKeys(secretValue, fmt.Sprintf("secret-%d-", i))
+			if err != nil {
+				utils.GetLogFromEcho(c).Warnw("Ignoring invalid pre-shared key from secret manager", "principal", principal, "error", err)
+				continue
+			}
+
+			for _, key := range keys {
+				if key.Value == value {
+					return principal, key, true
+				}
+			}
+		}
+	}
+
+	return "", PskKey{}, false
+}
+
 func GetPSKPrincipal(ctx context.Context) string {
 	principal := ctx.Value(pskPrincipal)
 
@@ -57,8 +171,9 @@ func GetPSKPrincipal(ctx context.Context) string {
 	return principal.(string)
 }
 
-func BuildPskAuthConfigFromEnv() map[string]string {
-	result := map[string]string{}
+// BuildPskAuthConfigFromEnv reads the keys of each principal from PSK_AUTH_<principal> variables (see ParsePskKeys)
+func BuildPskAuthConfigFromEnv() (map[string][]PskKey, error) {
+	result := map[string][]PskKey{}
 
 	for _, param := range os.Environ() {
 		match := envMatcher.FindStringSubmatch(param)
@@ -68,10 +183,15 @@ func BuildPskAuthConfigFromEnv() map[string]string {
 		}
 
 		principal := strings.ToLower(match[1])
-		result[principal] = match[2]
+		keys, err := ParsePskKeys(match[2], "env-")
+		if err != nil {
+			return nil, fmt.Errorf("invalid PSK_AUTH_%s: %w", match[1], err)
+		}
+
+		result[principal] = keys
 	}
 
-	return result
+	return result, nil
 }
 
 // TODO: enable x509 for auth in the future
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"playbook-dispatcher/internal/common/utils"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	. "github.com/onsi/ginkgo"
@@ -15,12 +16,17 @@ import (
 )
 
 const key = "secret"
+const rotatedKey = "rotated"
+const expiredKey = "expired"
 
 func testPskAuth(req *http.Request) (*httptest.ResponseRecorder, error) {
 	recorder := httptest.NewRecorder()
 
-	config := map[string]string{
-		"principal1": key,
+	expired := time.Now().Add(-time.Hour)
+
+	config := map[string][]PskKey{
+		"principal1": {{Id: "env-0", Value: key}, {Id: "env-1", Value: rotatedKey}},
+		"principal2": {{Id: "env-0", Value: expiredKey, Expires: &expired}},
 	}
 
 	handler := CheckPskAuth(config)(func(ctx echo.Context) error {
@@ -80,4 +86,61 @@ var _ = Describe("PSK auth middleware", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(body).To(BeEquivalentTo("principal1"))
 	})
+
+	It("accepts any of the keys of a principal", func() {
+		req := newReqInternal()
+		req.Header.Set("authorization", fmt.Sprintf("PSK %s", rotatedKey))
+		res, err := testPskAuth(req)
+		Expect(err).ToNot(HaveOccurred())
+		body, err := io.ReadAll(res.Result().Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(BeEquivalentTo("principal1"))
+	})
+
+	It("403s on an expired key", func() {
+		req := newReqInternal()
+		req.Header.Set("authorization", fmt.Sprintf("PSK %s", expiredKey))
+		_, err := testPskAuth(req)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("code=403, message=Pre-shared key expired"))
+	})
+
+	Describe("key parsing", func() {
+		It("parses a single key", func() {
+			keys, err := ParsePskKeys("abc", "env-")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keys).To(Equal([]PskKey{{Id: "env-0", Value: "abc"}}))
+		})
+
+		It("parses multiple keys with metadata", func() {
+			keys, err := ParsePskKeys("abc;id=old;expires=2026-07-01, def;created=2026-06-01T10:00:00Z", "env-")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keys).To(HaveLen(2))
+
+			Expect(keys[0].Id).To(Equal("old"))
+			Expect(keys[0].Value).To(Equal("abc"))
+			Expect(keys[0].Created).To(BeNil())
+			Expect(*keys[0].Expires).To(Equal(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)))
+
+			Expect(keys[1].Id).To(Equal("env-1"))
+			Expect(keys[1].Value).To(Equal("def"))
+			Expect(*keys[1].Created).To(Equal(time.Date(2026, 6, 1, 10, 0, 0, 0, time.UTC)))
+			Expect(keys[1].Expires).To(BeNil())
+		})
+
+		It("rejects unknown metadata", func() {
+			_, err := ParsePskKeys("abc;foo=bar", "env-")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("rejects an invalid timestamp", func() {
+			_, err := ParsePskKeys("abc;expires=tomorrow", "env-")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("rejects an empty key", func() {
+			_, err := ParsePskKeys("abc,,def", "env-")
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
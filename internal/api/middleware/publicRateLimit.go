@@ -0,0 +1,217 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/inmemorydb"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+	"golang.org/x/time/rate"
+)
+
+const (
+	rateLimitBackendMemory = "memory"
+	rateLimitBackendRedis  = "redis"
+
+	rateLimitKeyPrefix = "playbook-dispatcher:ratelimit:"
+
+	// how often idle buckets are dropped from memory
+	bucketPruneInterval = time.Minute
+)
+
+// BucketLimit is the rate tokens are added to a bucket at (per second) and the number of tokens it holds when full
+type BucketLimit struct {
+	Rate  int
+	Burst int
+}
+
+// TokenBuckets keeps a token bucket for each key
+type TokenBuckets interface {
+	// Take removes a token from the bucket of the key. If the bucket is empty no token is taken and the time until the
+	// next token is added is returned instead.
+	Take(ctx context.Context, key string, limit BucketLimit) (time.Duration, error)
+}
+
+// NewTokenBuckets creates the token buckets of the backend set by public.ratelimit.backend: memory keeps the buckets
+// of each replica separately while redis shares them between replicas using the in-memory database (inmemorydb.*)
+func NewTokenBuckets(cfg *viper.Viper) (TokenBuckets, error) {
+	switch backend := cfg.GetString("public.ratelimit.backend"); backend {
+	case rateLimitBackendMemory:
+		return NewMemoryTokenBuckets(), nil
+	case rateLimitBackendRedis:
+		client, err := inmemorydb.Connect(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewRedisTokenBuckets(client), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit backend: %s", backend)
+	}
+}
+
+// LimitPublicRequests enforces a rate limit of public.ratelimit.*.rate requests per second with bursts of up to
+// public.ratelimit.*.burst requests per principal of an org. The limits of an org are read from
+// public.ratelimit.org.<org_id>.* falling back to public.ratelimit.default.*. A rate of 0 disables the limit.
+// Requests exceeding the limit are rejected with 429. Requests are let through if the buckets cannot be reached.
+// Needs to be placed after identity.EnforceIdentity.
+func LimitPublicRequests(cfg *viper.Viper, buckets TokenBuckets) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if !cfg.GetBool("public.ratelimit.enabled") {
+			return next
+		}
+
+		return func(c echo.Context) error {
+			xrhid := identity.GetIdentity(c.Request().Context())
+			orgId, principal := xrhid.Identity.OrgID, publicPrincipal(xrhid)
+
+			limit := orgLimit(cfg, orgId)
+			if limit.Rate <= 0 {
+				return next(c)
+			}
+
+			delay, err := buckets.Take(c.Request().Context(), fmt.Sprintf("%s:%s", orgId, principal), limit)
+			if err != nil {
+				instrumentation.PublicRateLimitError(c, err)
+				return next(c)
+			}
+
+			if delay > 0 {
+				instrumentation.PublicRateLimited(c, orgId, principal)
+				c.Response().Header().Set(echo.HeaderRetryAfter, fmt.Sprint(math.Ceil(delay.Seconds())))
+				return echo.NewHTTPError(http.StatusTooManyRequests, "Rate limit exceeded")
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func orgLimit(cfg *viper.Viper, orgId string) BucketLimit {
+	get := func(key string) int {
+		if value := cfg.GetInt(fmt.Sprintf("public.ratelimit.org.%s.%s", orgId, key)); value > 0 {
+			return value
+		}
+
+		return cfg.GetInt("public.ratelimit.default." + key)
+	}
+
+	return BucketLimit{Rate: get("rate"), Burst: max(get("burst"), 1)}
+}
+
+// publicPrincipal identifies the user or service account making the request within its org
+func publicPrincipal(xrhid identity.XRHID) string {
+	switch {
+	case xrhid.Identity.User != nil && xrhid.Identity.User.Username != "":
+		return xrhid.Identity.User.Username
+	case xrhid.Identity.ServiceAccount != nil && xrhid.Identity.ServiceAccount.ClientId != "":
+		return xrhid.Identity.ServiceAccount.ClientId
+	default:
+		return ""
+	}
+}
+
+type memoryTokenBuckets struct {
+	lock       sync.Mutex
+	buckets    map[string]*rate.Limiter
+	lastPruned time.Time
+}
+
+func NewMemoryTokenBuckets() TokenBuckets {
+	return &memoryTokenBuckets{
+		buckets:    map[string]*rate.Limiter{},
+		lastPruned: time.Now(),
+	}
+}
+
+func (this *memoryTokenBuckets) Take(ctx context.Context, key string, limit BucketLimit) (time.Duration, error) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	now := time.Now()
+	this.prune(now)
+
+	bucket, ok := this.buckets[key]
+	if !ok {
+		bucket = rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)
+		this.buckets[key] = bucket
+	}
+
+	reservation := bucket.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay, nil
+	}
+
+	return 0, nil
+}
+
+// prune drops the buckets that have been refilled completely since they were last used, as they are no different
+// from new buckets
+func (this *memoryTokenBuckets) prune(now time.Time) {
+	if now.Sub(this.lastPruned) < bucketPruneInterval {
+		return
+	}
+
+	for key, bucket := range this.buckets {
+		if bucket.TokensAt(now) >= float64(bucket.Burst()) {
+			delete(this.buckets, key)
+		}
+	}
+
+	this.lastPruned = now
+}
+
+// refills the bucket for the time passed since it was last used and takes a token if there is one.
+// Returns the number of milliseconds until a token becomes available if the bucket is empty, 0 otherwise.
+// The clock of the server is used so that all replicas agree on the time. Buckets expire once refilled completely.
+var takeTokenScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'updated')
+local tokens = tonumber(state[1]) or burst
+local updated = tonumber(state[2]) or now
+
+tokens = math.min(burst, tokens + math.max(0, now - updated) * rate / 1000)
+
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+else
+	wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil((burst - tokens) * 1000 / rate) + 1000)
+
+return wait
+`)
+
+type redisTokenBuckets struct {
+	client redis.Scripter
+}
+
+func NewRedisTokenBuckets(client redis.Scripter) TokenBuckets {
+	return &redisTokenBuckets{client: client}
+}
+
+func (this *redisTokenBuckets) Take(ctx context.Context, key string, limit BucketLimit) (time.Duration, error) {
+	wait, err := takeTokenScript.Run(ctx, this.client, []string{rateLimitKeyPrefix + key}, limit.Rate, limit.Burst).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("error taking token from bucket: %w", err)
+	}
+
+	return time.Duration(wait) * time.Millisecond, nil
+}
@@ -0,0 +1,139 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+)
+
+var _ = Describe("Public rate limit middleware", func() {
+	var cfg *viper.Viper
+
+	BeforeEach(func() {
+		cfg = viper.New()
+		cfg.Set("public.ratelimit.enabled", true)
+		cfg.Set("public.ratelimit.default.rate", 1)
+		cfg.Set("public.ratelimit.default.burst", 2)
+	})
+
+	call := func(handler echo.HandlerFunc, orgId, username string) (*httptest.ResponseRecorder, error) {
+		req := newReqInternal()
+		req = req.WithContext(identity.WithIdentity(req.Context(), identity.XRHID{
+			Identity: identity.Identity{Type: "User", User: &identity.User{Username: username}, OrgID: orgId},
+		}))
+		recorder := httptest.NewRecorder()
+		return recorder, handler(echo.New().NewContext(req, recorder))
+	}
+
+	ok := func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	}
+
+	expectTooManyRequests := func(recorder *httptest.ResponseRecorder, err error) {
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*echo.HTTPError).Code).To(Equal(http.StatusTooManyRequests))
+		Expect(recorder.Header().Get(echo.HeaderRetryAfter)).To(Equal("1"))
+	}
+
+	examples := func(newBuckets func() TokenBuckets) {
+		newHandler := func(next echo.HandlerFunc) echo.HandlerFunc {
+			return LimitPublicRequests(cfg, newBuckets())(next)
+		}
+
+		It("allows bursts up to the limit", func() {
+			handler := newHandler(ok)
+
+			for i := 0; i < 2; i++ {
+				_, err := call(handler, "12345", "jdoe")
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			expectTooManyRequests(call(handler, "12345", "jdoe"))
+		})
+
+		It("limits each principal of an org separately", func() {
+			handler := newHandler(ok)
+
+			for i := 0; i < 2; i++ {
+				_, err := call(handler, "12345", "jdoe")
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			_, err := call(handler, "12345", "jsmith")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = call(handler, "67890", "jdoe")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("applies the limits configured for an org", func() {
+			cfg.Set("public.ratelimit.org.12345.burst", 5)
+			handler := newHandler(ok)
+
+			for i := 0; i < 5; i++ {
+				_, err := call(handler, "12345", "jdoe")
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			expectTooManyRequests(call(handler, "12345", "jdoe"))
+		})
+	}
+
+	Describe("in memory", func() {
+		examples(NewMemoryTokenBuckets)
+	})
+
+	Describe("in redis", func() {
+		var server *miniredis.Miniredis
+
+		BeforeEach(func() {
+			server = miniredis.RunT(GinkgoT())
+		})
+
+		examples(func() TokenBuckets {
+			return NewRedisTokenBuckets(redis.NewClient(&redis.Options{Addr: server.Addr()}))
+		})
+
+		It("lets requests through if redis is unavailable", func() {
+			handler := LimitPublicRequests(cfg, NewRedisTokenBuckets(redis.NewClient(&redis.Options{Addr: server.Addr()})))(ok)
+			server.Close()
+
+			for i := 0; i < 3; i++ {
+				_, err := call(handler, "12345", "jdoe")
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	})
+
+	It("does nothing if disabled", func() {
+		cfg.Set("public.ratelimit.enabled", false)
+		handler := LimitPublicRequests(cfg, NewMemoryTokenBuckets())(ok)
+
+		for i := 0; i < 5; i++ {
+			_, err := call(handler, "12345", "jdoe")
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	It("takes tokens from buckets shared by replicas", func() {
+		server := miniredis.RunT(GinkgoT())
+		first := NewRedisTokenBuckets(redis.NewClient(&redis.Options{Addr: server.Addr()}))
+		second := NewRedisTokenBuckets(redis.NewClient(&redis.Options{Addr: server.Addr()}))
+		limit := BucketLimit{Rate: 1, Burst: 1}
+
+		delay, err := first.Take(context.Background(), "12345:jdoe", limit)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(delay).To(BeZero())
+
+		delay, err = second.Take(context.Background(), "12345:jdoe", limit)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(delay).To(BeNumerically(">", 0))
+	})
+})
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"golang.org/x/time/rate"
+)
+
+type callerLimits struct {
+	limiter *rate.Limiter
+	// a slot is taken for every request in progress, nil if concurrency is not limited
+	slots chan struct{}
+}
+
+// LimitInternalRequests enforces a rate limit (internal.ratelimit.*.rate requests per second with bursts of up to
+// internal.ratelimit.*.burst requests) and a cap on concurrent requests (internal.ratelimit.*.concurrency) per caller.
+// The limits of a caller are read from internal.ratelimit.service.<principal>.* falling back to
+// internal.ratelimit.default.*. A value of 0 disables the respective limit.
+// Requests exceeding a limit are rejected with 429. Needs to run after the caller has been authenticated.
+func LimitInternalRequests(cfg *viper.Viper) echo.MiddlewareFunc {
+	var lock sync.Mutex
+	callers := map[string]*callerLimits{}
+
+	getLimits := func(principal string) *callerLimits {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if limits, ok := callers[principal]; ok {
+			return limits
+		}
+
+		limits := newCallerLimits(cfg, principal)
+		callers[principal] = limits
+		return limits
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if !cfg.GetBool("internal.ratelimit.enabled") {
+			return next
+		}
+
+		return func(c echo.Context) error {
+			principal := GetPSKPrincipal(c.Request().Context())
+			limits := getLimits(principal)
+
+			if limits.limiter != nil {
+				reservation := limits.limiter.Reserve()
+				if delay := reservation.Delay(); delay > 0 {
+					reservation.Cancel()
+					instrumentation.InternalRateLimited(c, principal)
+					c.Response().Header().Set(echo.HeaderRetryAfter, fmt.Sprint(math.Ceil(delay.Seconds())))
+					return echo.NewHTTPError(http.StatusTooManyRequests, "Rate limit exceeded")
+				}
+			}
+
+			if limits.slots != nil {
+				select {
+				case limits.slots <- struct{}{}:
+					defer func() { <-limits.slots }()
+				default:
+					instrumentation.InternalConcurrencyLimited(c, principal)
+					c.Response().Header().Set(echo.HeaderRetryAfter, "1")
+					return echo.NewHTTPError(http.StatusTooManyRequests, "Too many concurrent requests")
+				}
+			}
+
+			instrumentation.InternalRequestStarted(principal)
+			defer instrumentation.InternalRequestFinished(principal)
+
+			return next(c)
+		}
+	}
+}
+
+func newCallerLimits(cfg *viper.Viper, principal string) *callerLimits {
+	get := func(key string) int {
+		if value := cfg.GetInt(fmt.Sprintf("internal.ratelimit.service.%s.%s", principal, key)); value > 0 {
+			return value
+		}
+
+		return cfg.GetInt("internal.ratelimit.default." + key)
+	}
+
+	result := &callerLimits{}
+
+	if limit := get("rate"); limit > 0 {
+		result.limiter = rate.NewLimiter(rate.Limit(limit), max(get("burst"), 1))
+	}
+
+	if concurrency := get("concurrency"); concurrency > 0 {
+		result.slots = make(chan struct{}, concurrency)
+	}
+
+	return result
+}
@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+var _ = Describe("Internal rate limit middleware", func() {
+	var cfg *viper.Viper
+
+	BeforeEach(func() {
+		cfg = viper.New()
+		cfg.Set("internal.ratelimit.enabled", true)
+		cfg.Set("internal.ratelimit.default.rate", 1)
+		cfg.Set("internal.ratelimit.default.burst", 2)
+		cfg.Set("internal.ratelimit.default.concurrency", 0)
+	})
+
+	newHandler := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return LimitInternalRequests(cfg)(next)
+	}
+
+	call := func(handler echo.HandlerFunc, principal string) (*httptest.ResponseRecorder, error) {
+		req := newReqInternal()
+		req = req.WithContext(context.WithValue(req.Context(), pskPrincipal, principal))
+		recorder := httptest.NewRecorder()
+		return recorder, handler(echo.New().NewContext(req, recorder))
+	}
+
+	ok := func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	}
+
+	expectTooManyRequests := func(recorder *httptest.ResponseRecorder, err error) {
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*echo.HTTPError).Code).To(Equal(http.StatusTooManyRequests))
+		Expect(recorder.Header().Get(echo.HeaderRetryAfter)).ToNot(BeEmpty())
+	}
+
+	It("allows bursts up to the limit", func() {
+		handler := newHandler(ok)
+
+		for i := 0; i < 2; i++ {
+			_, err := call(handler, "remediations")
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		expectTooManyRequests(call(handler, "remediations"))
+	})
+
+	It("limits each caller separately", func() {
+		handler := newHandler(ok)
+
+		for i := 0; i < 2; i++ {
+			_, err := call(handler, "remediations")
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		_, err := call(handler, "config_manager")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("applies the limits configured for a caller", func() {
+		cfg.Set("internal.ratelimit.service.remediations.burst", 5)
+		handler := newHandler(ok)
+
+		for i := 0; i < 5; i++ {
+			_, err := call(handler, "remediations")
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		expectTooManyRequests(call(handler, "remediations"))
+	})
+
+	It("limits concurrent requests", func() {
+		cfg.Set("internal.ratelimit.default.rate", 0)
+		cfg.Set("internal.ratelimit.default.concurrency", 1)
+
+		var handler echo.HandlerFunc
+		var nested error
+		first := true
+		handler = newHandler(func(ctx echo.Context) error {
+			if first {
+				first = false
+				// issued while the outer request is still in progress
+				_, nested = call(handler, "remediations")
+			}
+
+			return ctx.NoContent(http.StatusOK)
+		})
+
+		_, err := call(handler, "remediations")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nested).To(HaveOccurred())
+		Expect(nested.(*echo.HTTPError).Code).To(Equal(http.StatusTooManyRequests))
+
+		// the slot is released once the request completes
+		_, err = call(handler, "remediations")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("does nothing unless enabled", func() {
+		cfg.Set("internal.ratelimit.enabled", false)
+		handler := newHandler(ok)
+
+		for i := 0; i < 5; i++ {
+			_, err := call(handler, "remediations")
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+})
@@ -23,9 +23,11 @@ import (
 
 type permissionsKeyType int
 type allowedServicesKeyType int
+type ownedRunsKeyType int
 
 const permissionsKey permissionsKeyType = iota
 const allowedServicesKey allowedServicesKeyType = iota
+const ownedRunsKey ownedRunsKeyType = iota
 
 func EnforcePermissions(cfg *viper.Viper, requiredPermissions ...rbac.RequiredPermission) echo.MiddlewareFunc {
 	var client rbac.RbacClient
@@ -36,6 +38,8 @@ func EnforcePermissions(cfg *viper.Viper, requiredPermissions ...rbac.RequiredPe
 		client = rbac.NewMockRbacClient()
 	}
 
+	failOpen := cfg.GetString("kessel.failure_policy") == config.KesselFailOpen
+
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			req := c.Request()
@@ -91,12 +95,19 @@ func EnforcePermissions(cfg *viper.Viper, requiredPermissions ...rbac.RequiredPe
 			}
 
 			// TIER 2: Service-level authorization
-			allowedServices := computeAllowedServices(c, permissions, mode, log)
+			allowedServices := computeAllowedServices(c, permissions, mode, failOpen, log)
 
 			// In Kessel-enforcing modes, empty allowedServices means no permissions (403)
+			// unless the user owns runs (kessel.run_ownership.enabled), access is limited to those then
 			if len(allowedServices) == 0 {
 				switch mode {
 				case config.KesselModeBothKesselEnforces, config.KesselModeKesselOnly:
+					if ownedRuns, ok := getOwnedRuns(c, log); ok {
+						log.Debugw("User has no Kessel permissions to any services, limiting access to owned runs", "mode", mode, "runs", len(ownedRuns))
+						utils.SetRequestContextValue(c, ownedRunsKey, ownedRuns)
+						break
+					}
+
 					log.Debugw("User has no Kessel permissions to any services", "mode", mode)
 					return echo.NewHTTPError(http.StatusForbidden)
 				}
@@ -136,9 +147,32 @@ func GetAllowedServices(c echo.Context) []string {
 	return services
 }
 
+// GetOwnedRuns returns the IDs of the runs the user owns if access is limited to them, see kessel.run_ownership.enabled
+func GetOwnedRuns(c echo.Context) ([]string, bool) {
+	runs, ok := c.Request().Context().Value(ownedRunsKey).([]string)
+	return runs, ok
+}
+
+// getOwnedRuns looks up the runs the user owns, false if run ownership is disabled, the lookup fails or there are none
+func getOwnedRuns(c echo.Context, log *zap.SugaredLogger) ([]string, bool) {
+	if !kessel.RunOwnershipEnabled() {
+		return nil, false
+	}
+
+	runs, err := kessel.OwnedRuns(c.Request().Context(), log)
+	if err != nil {
+		log.Errorw("Kessel owned runs lookup error", "error", err)
+		instrumentation.KesselAuthorizationError(c)
+		return nil, false
+	}
+
+	return runs, len(runs) > 0
+}
+
 // computeAllowedServices determines which services the user can access
 // based on the authorization mode
-func computeAllowedServices(ctx echo.Context, rbacPermissions []rbac.Access, mode string, log *zap.SugaredLogger) []string {
+// If Kessel cannot be consulted, Kessel-enforcing modes allow all applications with failOpen and none otherwise
+func computeAllowedServices(ctx echo.Context, rbacPermissions []rbac.Access, mode string, failOpen bool, log *zap.SugaredLogger) []string {
 	switch mode {
 	case config.KesselModeRBACOnly:
 		log.Debugw("Using RBAC-only authorization mode")
@@ -147,20 +181,21 @@ func computeAllowedServices(ctx echo.Context, rbacPermissions []rbac.Access, mod
 	case config.KesselModeBothRBACEnforces:
 		log.Debugw("Using both-rbac-enforces authorization mode (validation)")
 		rbacServices := getRbacAllowedServices(rbacPermissions)
-		kesselServices := getKesselAllowedServices(ctx, log)
+		kesselServices, _ := getKesselAllowedServices(ctx, log)
 		logComparison(ctx, rbacServices, kesselServices, log)
 		return rbacServices
 
 	case config.KesselModeBothKesselEnforces:
 		log.Debugw("Using both-kessel-enforces authorization mode (transition)")
 		rbacServices := getRbacAllowedServices(rbacPermissions)
-		kesselServices := getKesselAllowedServices(ctx, log)
+		kesselServices, err := getKesselAllowedServices(ctx, log)
 		logComparison(ctx, rbacServices, kesselServices, log)
-		return kesselServices
+		return applyFailurePolicy(ctx, kesselServices, err, failOpen, log)
 
 	case config.KesselModeKesselOnly:
 		log.Debugw("Using kessel-only authorization mode")
-		return getKesselAllowedServices(ctx, log)
+		kesselServices, err := getKesselAllowedServices(ctx, log)
+		return applyFailurePolicy(ctx, kesselServices, err, failOpen, log)
 
 	default:
 		log.Warnw("Unknown Kessel authorization mode, falling back to RBAC",
@@ -169,13 +204,24 @@ func computeAllowedServices(ctx echo.Context, rbacPermissions []rbac.Access, mod
 	}
 }
 
+func applyFailurePolicy(ctx echo.Context, kesselServices []string, err error, failOpen bool, log *zap.SugaredLogger) []string {
+	if err == nil || !failOpen {
+		return kesselServices
+	}
+
+	log.Warnw("Kessel unavailable, granting access to all applications (fail_open)", "error", err)
+	instrumentation.KesselFailOpen(ctx)
+	return kessel.ApplicationNames()
+}
+
 // getRbacAllowedServices extracts allowed services from RBAC permissions
 func getRbacAllowedServices(permissions []rbac.Access) []string {
 	return rbac.GetPredicateValues(permissions, "service")
 }
 
 // getKesselAllowedServices queries Kessel for allowed services
-func getKesselAllowedServices(ctx echo.Context, log *zap.SugaredLogger) []string {
+// On failure the error is returned along with an empty list
+func getKesselAllowedServices(ctx echo.Context, log *zap.SugaredLogger) ([]string, error) {
 	// Extract identity from context
 	xrhid := identity.GetIdentity(ctx.Request().Context())
 	orgID := xrhid.Identity.OrgID
@@ -219,7 +265,7 @@ func getKesselAllowedServices(ctx echo.Context, log *zap.SugaredLogger) []string
 			"identity_type", identityType,
 			"user_id", userID)
 		instrumentation.KesselAuthorizationError(ctx)
-		return []string{} // Return empty list on error
+		return []string{}, err
 	}
 
 	// Check permissions via Kessel (uses V2ApplicationPermissions map)
@@ -232,7 +278,20 @@ func getKesselAllowedServices(ctx echo.Context, log *zap.SugaredLogger) []string
 			"identity_type", identityType,
 			"user_id", userID)
 		instrumentation.KesselAuthorizationError(ctx)
-		return []string{} // Return empty list on error
+		return []string{}, err
+	}
+
+	// Narrow down to the services the principal has been granted (RBAC service attribute filters)
+	allowedServices, err = kessel.FilterByServicePermission(ctx.Request().Context(), orgID, allowedServices, log)
+	if err != nil {
+		log.Errorw("Kessel authorization error",
+			"error", err,
+			"org_id", orgID,
+			"workspace_id", workspaceID,
+			"identity_type", identityType,
+			"user_id", userID)
+		instrumentation.KesselAuthorizationError(ctx)
+		return []string{}, err
 	}
 
 	if len(allowedServices) == 0 {
@@ -252,11 +311,11 @@ func getKesselAllowedServices(ctx echo.Context, log *zap.SugaredLogger) []string
 		instrumentation.KesselAuthorizationPassed(ctx)
 	}
 
-	return allowedServices
+	return allowedServices, nil
 }
 
-// logComparison compares RBAC and Kessel results and logs any discrepancies
-func logComparison(ctx echo.Context, rbacServices, kesselServices []string, log *zap.SugaredLogger) {
+// logComparison compares RBAC and Kessel results, logs any discrepancies and tells whether the results match
+func logComparison(ctx echo.Context, rbacServices, kesselServices []string, log *zap.SugaredLogger) bool {
 	// Sort for comparison
 	sortedRbac := make([]string, len(rbacServices))
 	copy(sortedRbac, rbacServices)
@@ -309,13 +368,16 @@ func logComparison(ctx echo.Context, rbacServices, kesselServices []string, log
 			"identity_type", identityType,
 			"user_id", userID)
 		instrumentation.KesselRbacMismatch(ctx)
-	} else {
-		log.Debugw("RBAC and Kessel permissions match",
-			"rbac_services", sortedRbac,
-			"kessel_services", sortedKessel,
-			"org_id", orgID,
-			"identity_type", identityType,
-			"user_id", userID)
-		instrumentation.KesselRbacMatch(ctx)
+		return false
 	}
+
+	log.Debugw("RBAC and Kessel permissions match",
+		"rbac_services", sortedRbac,
+		"kessel_services", sortedKessel,
+		"org_id", orgID,
+		"identity_type", identityType,
+		"user_id", userID)
+	instrumentation.KesselRbacMatch(ctx)
+
+	return true
 }
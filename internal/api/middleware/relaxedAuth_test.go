@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"playbook-dispatcher/internal/common/constants"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("Relaxed authentication", func() {
+	var cfg *viper.Viper
+
+	BeforeEach(func() {
+		cfg = viper.New()
+		cfg.Set("dev.auth.relaxed", true)
+		cfg.Set("dev.org.id", "5318290")
+	})
+
+	serve := func(req *http.Request) (*httptest.ResponseRecorder, echo.Context) {
+		var handled echo.Context
+
+		server := echo.New()
+		server.Use(DefaultIdentity(cfg))
+
+		auth := RelaxInternalAuth(cfg, CheckPskAuth(map[string][]PskKey{"test": {{Id: "0", Value: "secret"}}}))
+		server.GET("/internal/v2/usage", func(ctx echo.Context) error {
+			handled = ctx
+			return ctx.NoContent(http.StatusOK)
+		}, auth)
+
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, req.WithContext(utils.SetLog(context.Background(), zap.NewNop().Sugar())))
+		return recorder, handled
+	}
+
+	It("sets a default identity header", func() {
+		res, ctx := serve(httptest.NewRequest(http.MethodGet, "/internal/v2/usage", nil))
+		Expect(res.Code).To(Equal(http.StatusOK))
+
+		identity, err := base64.StdEncoding.DecodeString(ctx.Request().Header.Get(constants.HeaderIdentity))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(identity)).To(ContainSubstring(`"org_id":"5318290"`))
+		Expect(GetPSKPrincipal(ctx.Request().Context())).To(Equal("local-dev"))
+	})
+
+	It("keeps the identity header of the request", func() {
+		req := httptest.NewRequest(http.MethodGet, "/internal/v2/usage", nil)
+		req.Header.Set(constants.HeaderIdentity, "eyJ9")
+		_, ctx := serve(req)
+
+		Expect(ctx.Request().Header.Get(constants.HeaderIdentity)).To(Equal("eyJ9"))
+	})
+
+	It("still checks presented credentials", func() {
+		req := httptest.NewRequest(http.MethodGet, "/internal/v2/usage", nil)
+		req.Header.Set("authorization", "PSK invalid")
+		res, _ := serve(req)
+		Expect(res.Code).To(Equal(http.StatusForbidden))
+
+		req.Header.Set("authorization", "PSK secret")
+		res, ctx := serve(req)
+		Expect(res.Code).To(Equal(http.StatusOK))
+		Expect(GetPSKPrincipal(ctx.Request().Context())).To(Equal("test"))
+	})
+
+	It("is disabled by default", func() {
+		cfg.Set("dev.auth.relaxed", false)
+		res, _ := serve(httptest.NewRequest(http.MethodGet, "/internal/v2/usage", nil))
+
+		Expect(res.Code).To(Equal(http.StatusUnauthorized))
+	})
+})
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/common/constants"
+	"playbook-dispatcher/internal/common/utils"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	echoMiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/spf13/viper"
+)
+
+// only responses of the public API are hardened, internal callers are services rather than browsers
+func skipNonPublic(c echo.Context) bool {
+	return !strings.HasPrefix(c.Request().URL.Path, publicPathPrefix)
+}
+
+// SecurityHeaders sets the standard security headers on responses of the public API.
+// Strict-Transport-Security (max age web.hsts.max.age, 0 turns it off) is only sent on requests received over HTTPS
+// (directly or as indicated by X-Forwarded-Proto).
+func SecurityHeaders(cfg *viper.Viper) echo.MiddlewareFunc {
+	return echoMiddleware.SecureWithConfig(echoMiddleware.SecureConfig{
+		Skipper: skipNonPublic,
+		// the X-XSS-Protection filter is deprecated and can introduce vulnerabilities of its own
+		XSSProtection:         "0",
+		ContentTypeNosniff:    "nosniff",
+		XFrameOptions:         "DENY",
+		HSTSMaxAge:            cfg.GetInt("web.hsts.max.age"),
+		ContentSecurityPolicy: "default-src 'none'; frame-ancestors 'none'",
+		ReferrerPolicy:        "no-referrer",
+	})
+}
+
+// Cors allows the origins listed in web.cors.allowed.origins (comma-separated) to call the public API from a browser.
+// Cross-origin requests are not allowed if no origins are configured.
+func Cors(cfg *viper.Viper) echo.MiddlewareFunc {
+	origins := []string{}
+	for _, origin := range strings.Split(cfg.GetString("web.cors.allowed.origins"), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+
+	if len(origins) == 0 {
+		return func(next echo.HandlerFunc) echo.HandlerFunc {
+			return next
+		}
+	}
+
+	return echoMiddleware.CORSWithConfig(echoMiddleware.CORSConfig{
+		Skipper:          skipNonPublic,
+		AllowOrigins:     origins,
+		AllowMethods:     []string{http.MethodGet, http.MethodHead},
+		AllowHeaders:     []string{echo.HeaderAuthorization, echo.HeaderContentType, constants.HeaderRequestId, "If-None-Match"},
+		ExposeHeaders:    []string{constants.HeaderRequestId, "ETag"},
+		AllowCredentials: true,
+		MaxAge:           cfg.GetInt("web.cors.max.age"),
+	})
+}
+
+// RejectConflictingIdentity rejects requests carrying more than one distinct identity header.
+// Only the first header would be looked at further on while a proxy or backend may look at another one.
+func RejectConflictingIdentity(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		values := c.Request().Header.Values(constants.HeaderIdentity)
+
+		for _, value := range values {
+			if value != values[0] {
+				utils.GetLogFromEcho(c).Warnw("Rejecting request with conflicting identity headers", "count", len(values))
+				return echo.NewHTTPError(http.StatusBadRequest, "Conflicting identity headers")
+			}
+		}
+
+		return next(c)
+	}
+}
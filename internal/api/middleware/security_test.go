@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("Public API hardening", func() {
+	var cfg *viper.Viper
+
+	BeforeEach(func() {
+		cfg = viper.New()
+		cfg.Set("web.cors.allowed.origins", "https://console.redhat.com")
+		cfg.Set("web.cors.max.age", 600)
+		cfg.Set("web.hsts.max.age", 31536000)
+	})
+
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		server := echo.New()
+		server.Use(SecurityHeaders(cfg), Cors(cfg))
+
+		ok := func(ctx echo.Context) error {
+			return ctx.NoContent(http.StatusOK)
+		}
+
+		server.GET("/api/playbook-dispatcher/v1/runs", ok, RejectConflictingIdentity)
+		server.GET("/internal/version", ok)
+
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	Describe("security headers", func() {
+		It("are set on public routes", func() {
+			req := httptest.NewRequest(http.MethodGet, "/api/playbook-dispatcher/v1/runs", nil)
+			req.Header.Set(echo.HeaderXForwardedProto, "https")
+			res := serve(req)
+
+			Expect(res.Code).To(Equal(http.StatusOK))
+			Expect(res.Header().Get(echo.HeaderXContentTypeOptions)).To(Equal("nosniff"))
+			Expect(res.Header().Get(echo.HeaderXFrameOptions)).To(Equal("DENY"))
+			Expect(res.Header().Get(echo.HeaderContentSecurityPolicy)).To(Equal("default-src 'none'; frame-ancestors 'none'"))
+			Expect(res.Header().Get(echo.HeaderStrictTransportSecurity)).To(Equal("max-age=31536000; includeSubdomains"))
+		})
+
+		It("omit HSTS on plain HTTP", func() {
+			res := serve(httptest.NewRequest(http.MethodGet, "/api/playbook-dispatcher/v1/runs", nil))
+			Expect(res.Header().Get(echo.HeaderStrictTransportSecurity)).To(BeEmpty())
+		})
+
+		It("are not set on internal routes", func() {
+			res := serve(httptest.NewRequest(http.MethodGet, "/internal/version", nil))
+			Expect(res.Header().Get(echo.HeaderXFrameOptions)).To(BeEmpty())
+		})
+	})
+
+	Describe("CORS", func() {
+		preflight := func(origin string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodOptions, "/api/playbook-dispatcher/v1/runs", nil)
+			req.Header.Set(echo.HeaderOrigin, origin)
+			req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodGet)
+			return serve(req)
+		}
+
+		It("allows a configured origin", func() {
+			res := preflight("https://console.redhat.com")
+			Expect(res.Code).To(Equal(http.StatusNoContent))
+			Expect(res.Header().Get(echo.HeaderAccessControlAllowOrigin)).To(Equal("https://console.redhat.com"))
+		})
+
+		It("rejects other origins", func() {
+			res := preflight("https://example.com")
+			Expect(res.Header().Get(echo.HeaderAccessControlAllowOrigin)).To(BeEmpty())
+		})
+
+		It("allows no origin if none is configured", func() {
+			cfg.Set("web.cors.allowed.origins", "")
+			res := preflight("https://console.redhat.com")
+			Expect(res.Header().Get(echo.HeaderAccessControlAllowOrigin)).To(BeEmpty())
+		})
+	})
+
+	Describe("identity headers", func() {
+		request := func(identities ...string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/api/playbook-dispatcher/v1/runs", nil)
+			req = req.WithContext(utils.SetLog(context.Background(), zap.NewNop().Sugar()))
+			for _, identity := range identities {
+				req.Header.Add("x-rh-identity", identity)
+			}
+
+			return serve(req)
+		}
+
+		It("accepts a single identity header", func() {
+			Expect(request("eyJpZGVudGl0eSI6e319").Code).To(Equal(http.StatusOK))
+		})
+
+		It("accepts repeated identical headers", func() {
+			Expect(request("eyJpZGVudGl0eSI6e319", "eyJpZGVudGl0eSI6e319").Code).To(Equal(http.StatusOK))
+		})
+
+		It("rejects conflicting identity headers", func() {
+			Expect(request("eyJpZGVudGl0eSI6e319", "eyJpZGVudGl0eSI6eyJvcmdfaWQiOiIxIn19").Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
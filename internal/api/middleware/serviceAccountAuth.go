@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/utils"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+)
+
+// CheckInternalAuth authenticates callers of the internal API using either a pre-shared key (see CheckPskAuth) or,
+// with internal.jwt.enabled, a service account JWT issued by the platform SSO (Authorization: Bearer <token>).
+// Tokens have to be signed by a key published at internal.jwt.jwks.url and carry the configured issuer and audience.
+// The client id claim of the token is mapped to a principal using internal.jwt.principals so that both kinds of
+// callers are identified (and audited) the same way.
+func CheckInternalAuth(cfg *viper.Viper, authKeys map[string][]PskKey) echo.MiddlewareFunc {
+	pskAuth := CheckPskAuth(authKeys)
+
+	if !cfg.GetBool("internal.jwt.enabled") {
+		return pskAuth
+	}
+
+	audience := cfg.GetString("internal.jwt.audience")
+	if audience == "" {
+		panic("internal.jwt.audience is required when internal.jwt.enabled is set")
+	}
+
+	principals, err := parsePrincipalMapping(cfg.GetString("internal.jwt.principals"))
+	if err != nil {
+		panic(fmt.Sprintf("invalid internal.jwt.principals: %s", err))
+	}
+
+	keys := newKeySet(
+		cfg.GetString("internal.jwt.jwks.url"),
+		cfg.GetDuration("internal.jwt.jwks.timeout")*time.Second,
+		cfg.GetDuration("internal.jwt.jwks.max.age")*time.Second,
+	)
+
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(cfg.GetString("internal.jwt.issuer")),
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(cfg.GetDuration("internal.jwt.leeway")*time.Second),
+	)
+
+	clientClaim := cfg.GetString("internal.jwt.client.claim")
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		withPsk := pskAuth(next)
+
+		return func(c echo.Context) error {
+			scheme, token, found := strings.Cut(c.Request().Header.Get("authorization"), " ")
+			if !found || !strings.EqualFold(scheme, "bearer") {
+				return withPsk(c)
+			}
+
+			claims := jwt.MapClaims{}
+			_, err := parser.ParseWithClaims(strings.TrimSpace(token), claims, func(token *jwt.Token) (interface{}, error) {
+				kid, _ := token.Header["kid"].(string)
+				return keys.key(c.Request().Context(), kid)
+			})
+
+			if err != nil {
+				instrumentation.ServiceAccountRejected(c, err)
+				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid token")
+			}
+
+			clientId, _ := claims[clientClaim].(string)
+			principal, ok := principals[clientId]
+			if !ok {
+				instrumentation.ServiceAccountUnknown(c, clientId)
+				return echo.NewHTTPError(http.StatusForbidden, "Unknown service account")
+			}
+
+			instrumentation.ServiceAccountAuthenticated(c, principal, clientId)
+			utils.SetRequestContextValue(c, pskPrincipal, principal)
+			return next(c)
+		}
+	}
+}
+
+// parses a comma-separated list of <client id>=<principal> pairs
+func parsePrincipalMapping(value string) (map[string]string, error) {
+	result := map[string]string{}
+
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+
+		clientId, principal, found := strings.Cut(entry, "=")
+		if !found || clientId == "" || principal == "" {
+			return nil, fmt.Errorf("invalid entry %q", entry)
+		}
+
+		result[strings.TrimSpace(clientId)] = strings.ToLower(strings.TrimSpace(principal))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,230 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+const testIssuer = "https://sso.example.com/auth/realms/test"
+
+var _ = Describe("Service account auth middleware", func() {
+	var signingKey *rsa.PrivateKey
+	var jwksServer *httptest.Server
+	var jwksRequests atomic.Int32
+	var jwksStatus int
+	var jwksDelay time.Duration
+	var cfg *viper.Viper
+	var handler echo.HandlerFunc
+
+	BeforeEach(func() {
+		handler = nil
+
+		var err error
+		signingKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+
+		jwksRequests.Store(0)
+		jwksStatus = http.StatusOK
+		jwksDelay = 0
+		jwksServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			jwksRequests.Add(1)
+			time.Sleep(jwksDelay)
+
+			if jwksStatus != http.StatusOK {
+				w.WriteHeader(jwksStatus)
+				return
+			}
+
+			Expect(json.NewEncoder(w).Encode(map[string]interface{}{
+				"keys": []map[string]string{{
+					"kid": "key1",
+					"kty": "RSA",
+					"use": "sig",
+					"n":   base64.RawURLEncoding.EncodeToString(signingKey.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(signingKey.E)).Bytes()),
+				}},
+			})).To(Succeed())
+		}))
+
+		cfg = viper.New()
+		cfg.Set("internal.jwt.enabled", true)
+		cfg.Set("internal.jwt.jwks.url", jwksServer.URL)
+		cfg.Set("internal.jwt.jwks.timeout", 1)
+		cfg.Set("internal.jwt.jwks.max.age", 3600)
+		cfg.Set("internal.jwt.issuer", testIssuer)
+		cfg.Set("internal.jwt.audience", "playbook-dispatcher")
+		cfg.Set("internal.jwt.client.claim", "client_id")
+		cfg.Set("internal.jwt.principals", "remediations-sa=remediations")
+	})
+
+	AfterEach(func() {
+		jwksServer.Close()
+	})
+
+	sign := func(kid string, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(signingKey)
+		Expect(err).ToNot(HaveOccurred())
+		return signed
+	}
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":       testIssuer,
+			"aud":       "playbook-dispatcher",
+			"exp":       time.Now().Add(time.Hour).Unix(),
+			"client_id": "remediations-sa",
+		}
+	}
+
+	test := func(authorization string) (string, error) {
+		req := newReqInternal()
+		req.Header.Set("authorization", authorization)
+		recorder := httptest.NewRecorder()
+
+		// built on first use so that the configuration can be adjusted by each test
+		if handler == nil {
+			handler = CheckInternalAuth(cfg, map[string][]PskKey{"principal1": {{Id: "env-0", Value: key}}})(func(ctx echo.Context) error {
+				return ctx.String(http.StatusOK, GetPSKPrincipal(ctx.Request().Context()))
+			})
+		}
+
+		if err := handler(echo.New().NewContext(req, recorder)); err != nil {
+			return "", err
+		}
+
+		body, err := io.ReadAll(recorder.Result().Body)
+		Expect(err).ToNot(HaveOccurred())
+		return string(body), nil
+	}
+
+	It("maps the client id of a valid token to the principal", func() {
+		principal, err := test("Bearer " + sign("key1", validClaims()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(principal).To(Equal("remediations"))
+	})
+
+	It("caches the signing keys", func() {
+		for i := 0; i < 3; i++ {
+			_, err := test("Bearer " + sign("key1", validClaims()))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(jwksRequests.Load()).To(BeEquivalentTo(1))
+	})
+
+	It("refetches the keys on an unknown key id at most once per minute", func() {
+		for i := 0; i < 3; i++ {
+			_, err := test("Bearer " + sign("key2", validClaims()))
+			Expect(err).To(HaveOccurred())
+		}
+
+		Expect(jwksRequests.Load()).To(BeEquivalentTo(1))
+	})
+
+	It("does not refetch the keys on every request while the SSO fails", func() {
+		jwksStatus = http.StatusServiceUnavailable
+
+		for i := 0; i < 3; i++ {
+			_, err := test("Bearer " + sign("key1", validClaims()))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("code=401, message=Invalid token"))
+		}
+
+		Expect(jwksRequests.Load()).To(BeEquivalentTo(1))
+	})
+
+	It("fetches the keys once for concurrent requests", func() {
+		jwksDelay = 100 * time.Millisecond
+		// built upfront as the handler is shared by the requests
+		_, err := test(fmt.Sprintf("PSK %s", key))
+		Expect(err).ToNot(HaveOccurred())
+
+		var wg sync.WaitGroup
+		errs := make([]error, 5)
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				_, errs[i] = test("Bearer " + sign("key1", validClaims()))
+			}(i)
+		}
+
+		wg.Wait()
+
+		for _, err := range errs {
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(jwksRequests.Load()).To(BeEquivalentTo(1))
+	})
+
+	It("still accepts PSKs", func() {
+		principal, err := test(fmt.Sprintf("PSK %s", key))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(principal).To(Equal("principal1"))
+	})
+
+	It("401s on a token with a different audience", func() {
+		claims := validClaims()
+		claims["aud"] = "other"
+		_, err := test("Bearer " + sign("key1", claims))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("code=401, message=Invalid token"))
+	})
+
+	It("401s on a token from a different issuer", func() {
+		claims := validClaims()
+		claims["iss"] = "https://evil.example.com"
+		_, err := test("Bearer " + sign("key1", claims))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("code=401, message=Invalid token"))
+	})
+
+	It("401s on an expired token", func() {
+		claims := validClaims()
+		claims["exp"] = time.Now().Add(-time.Hour).Unix()
+		_, err := test("Bearer " + sign("key1", claims))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("code=401, message=Invalid token"))
+	})
+
+	It("401s on a token signed by an unknown key", func() {
+		_, err := test("Bearer " + sign("key2", validClaims()))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("code=401, message=Invalid token"))
+	})
+
+	It("403s on a token of an unknown service account", func() {
+		claims := validClaims()
+		claims["client_id"] = "someone-else"
+		_, err := test("Bearer " + sign("key1", claims))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("code=403, message=Unknown service account"))
+	})
+
+	It("rejects bearer tokens when disabled", func() {
+		cfg.Set("internal.jwt.enabled", false)
+		_, err := test("Bearer " + sign("key1", validClaims()))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("code=401, message=Unsupported authentication key format"))
+	})
+})
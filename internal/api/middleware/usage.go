@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"playbook-dispatcher/internal/api/usage"
+
+	"github.com/labstack/echo/v4"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+)
+
+// RecordUsage counts the request towards the API usage of the org found in the identity header.
+// Needs to be placed after identity.EnforceIdentity.
+func RecordUsage(recorder *usage.Recorder) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if orgId := identity.GetIdentity(c.Request().Context()).Identity.OrgID; orgId != "" {
+				recorder.Record(orgId)
+			}
+
+			return next(c)
+		}
+	}
+}
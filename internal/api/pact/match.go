@@ -0,0 +1,206 @@
+package pact
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// matcher is a matching rule of a response body path, see https://github.com/pact-foundation/pact-specification
+type matcher struct {
+	Match string `json:"match"`
+	Regex string `json:"regex"`
+	Min   *int   `json:"min"`
+}
+
+type bodyRule struct {
+	path    *regexp.Regexp
+	length  int
+	matcher matcher
+}
+
+type bodyRules []bodyRule
+
+// parseBodyRules reads the body matching rules of a response in either the v2 format
+// ({"$.body.data": {"match": "type"}}) or the v3 format ({"body": {"$.data": {"matchers": [{"match": "type"}]}}})
+func parseBodyRules(raw json.RawMessage) (bodyRules, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &sections); err != nil {
+		return nil, err
+	}
+
+	result := bodyRules{}
+
+	if body, ok := sections["body"]; ok {
+		var v3 map[string]struct {
+			Matchers []matcher `json:"matchers"`
+		}
+
+		if err := json.Unmarshal(body, &v3); err != nil {
+			return nil, err
+		}
+
+		for path, rule := range v3 {
+			for _, matcher := range rule.Matchers {
+				result = append(result, newBodyRule(path, matcher))
+			}
+		}
+	}
+
+	for path, value := range sections {
+		if !strings.HasPrefix(path, "$.body") {
+			continue
+		}
+
+		var v2 matcher
+		if err := json.Unmarshal(value, &v2); err != nil {
+			return nil, err
+		}
+
+		result = append(result, newBodyRule("$"+strings.TrimPrefix(path, "$.body"), v2))
+	}
+
+	// the most specific rule wins
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].length > result[j].length
+	})
+
+	return result, nil
+}
+
+func newBodyRule(path string, matcher matcher) bodyRule {
+	pattern := regexp.QuoteMeta(path)
+	pattern = strings.ReplaceAll(pattern, `\[\*\]`, `\[\d+\]`)
+	pattern = strings.ReplaceAll(pattern, `\.\*`, `\.[^.\[]+`)
+
+	return bodyRule{
+		path:    regexp.MustCompile("^" + pattern + "$"),
+		length:  len(path),
+		matcher: matcher,
+	}
+}
+
+func (this bodyRules) find(path string) *matcher {
+	for _, rule := range this {
+		if rule.path.MatchString(path) {
+			return &rule.matcher
+		}
+	}
+
+	return nil
+}
+
+// compareBody returns the mismatches of the actual body against the expected one.
+// Keys not present in the expected body are ignored. Matching by type applies to all descendants of a path.
+func compareBody(expected, actual interface{}, rules bodyRules) []string {
+	return compareValue(expected, actual, "$", rules, false)
+}
+
+func compareValue(expected, actual interface{}, path string, rules bodyRules, byType bool) (mismatches []string) {
+	mismatch := func(format string, args ...interface{}) []string {
+		return append(mismatches, fmt.Sprintf("%s: %s", path, fmt.Sprintf(format, args...)))
+	}
+
+	if rule := rules.find(path); rule != nil {
+		switch rule.Match {
+		case "type":
+			byType = true
+		case "regex":
+			pattern, err := regexp.Compile(rule.Regex)
+			if err != nil {
+				return mismatch("invalid regex %q: %s", rule.Regex, err)
+			}
+
+			value, ok := actual.(string)
+			if !ok || !pattern.MatchString(value) {
+				return mismatch("expected a value matching %q, got %v", rule.Regex, actual)
+			}
+
+			return nil
+		}
+
+		if expectedArray, ok := expected.([]interface{}); ok && rule.Min != nil {
+			actualArray, ok := actual.([]interface{})
+			if !ok {
+				return mismatch("expected an array, got %v", actual)
+			}
+
+			if len(actualArray) < *rule.Min {
+				return mismatch("expected at least %d elements, got %d", *rule.Min, len(actualArray))
+			}
+
+			if len(expectedArray) == 0 {
+				return nil
+			}
+
+			// every element is matched against the example
+			for i, element := range actualArray {
+				mismatches = append(mismatches, compareValue(expectedArray[0], element, fmt.Sprintf("%s[%d]", path, i), rules, byType)...)
+			}
+
+			return mismatches
+		}
+	}
+
+	switch expectedValue := expected.(type) {
+	case map[string]interface{}:
+		actualValue, ok := actual.(map[string]interface{})
+		if !ok {
+			return mismatch("expected an object, got %v", actual)
+		}
+
+		keys := make([]string, 0, len(expectedValue))
+		for key := range expectedValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			value, found := actualValue[key]
+			if !found {
+				mismatches = mismatch("missing key %q", key)
+				continue
+			}
+
+			mismatches = append(mismatches, compareValue(expectedValue[key], value, path+"."+key, rules, byType)...)
+		}
+
+		return mismatches
+	case []interface{}:
+		actualValue, ok := actual.([]interface{})
+		if !ok {
+			return mismatch("expected an array, got %v", actual)
+		}
+
+		if len(actualValue) != len(expectedValue) {
+			return mismatch("expected %d elements, got %d", len(expectedValue), len(actualValue))
+		}
+
+		for i := range expectedValue {
+			mismatches = append(mismatches, compareValue(expectedValue[i], actualValue[i], fmt.Sprintf("%s[%d]", path, i), rules, byType)...)
+		}
+
+		return mismatches
+	default:
+		if byType {
+			if reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+				return mismatch("expected a value of the type of %v, got %v", expected, actual)
+			}
+
+			return nil
+		}
+
+		if !reflect.DeepEqual(expected, actual) {
+			return mismatch("expected %v, got %v", expected, actual)
+		}
+
+		return nil
+	}
+}
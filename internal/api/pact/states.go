@@ -0,0 +1,210 @@
+// Package pact verifies the consumer-driven contracts (https://docs.pact.io) that services calling the dispatcher,
+// such as remediations and config-manager, publish for its API.
+//
+// Each interaction of a contract may require a provider state (e.g. "runs exist"). The verifier (see Verifier) asks
+// the dispatcher to set up the state by calling the provider state endpoint (see StateHandler) before replaying the
+// interaction and to tear it down afterwards.
+package pact
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// StatesPath is where the provider state endpoint is served (outside of the API groups and their validation)
+const StatesPath = "/_pact/provider_states"
+
+const (
+	actionSetup    = "setup"
+	actionTeardown = "teardown"
+
+	defaultOrgId = "5318290"
+)
+
+// StateChange is the request the Pact verifier sends to set up or tear down a provider state
+type StateChange struct {
+	Consumer string                 `json:"consumer"`
+	State    string                 `json:"state"`
+	Params   map[string]interface{} `json:"params,omitempty"`
+	Action   string                 `json:"action"`
+}
+
+type stateFn func(tx *gorm.DB, params stateParams) error
+
+// provider states consumers can refer to, all of them are scoped to the org_id parameter (5318290 by default)
+var states = map[string]stateFn{
+	// runs (each with a run host) of the org, see setupRuns for the parameters
+	"runs exist": setupRuns,
+	"no runs exist": func(tx *gorm.DB, params stateParams) error {
+		return deleteRuns(tx, params.string("org_id", defaultOrgId))
+	},
+}
+
+// StateHandler sets up the provider state described by a StateChange.
+// It modifies the data of arbitrary orgs and must only be registered in development (pact.provider.states.enabled).
+func StateHandler(db *gorm.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var change StateChange
+		if err := c.Bind(&change); err != nil {
+			return err
+		}
+
+		params := stateParams(change.Params)
+
+		err := db.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			if change.Action == actionTeardown {
+				return deleteRuns(tx, params.string("org_id", defaultOrgId))
+			}
+
+			setup, ok := states[change.State]
+			if !ok {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown provider state %q", change.State))
+			}
+
+			return setup(tx, params)
+		})
+
+		if err != nil {
+			return err
+		}
+
+		utils.GetLogFromEcho(c).Infow("Provider state changed", "consumer", change.Consumer, "state", change.State, "action", change.Action)
+		return c.JSON(http.StatusOK, map[string]interface{}{})
+	}
+}
+
+// setupRuns replaces the runs of the org with the given number of runs (count, 1 by default).
+// The id, recipient, status, service and labels of the runs as well as the inventory_id of their hosts can be set.
+func setupRuns(tx *gorm.DB, params stateParams) error {
+	orgId := params.string("org_id", defaultOrgId)
+
+	if err := deleteRuns(tx, orgId); err != nil {
+		return err
+	}
+
+	count, err := params.int("count", 1)
+	if err != nil {
+		return err
+	}
+
+	runStatus, err := status.Parse(params.string("status", status.Running.String()))
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < count; i++ {
+		run := dbModel.Run{
+			ID:            uuid.New(),
+			OrgID:         orgId,
+			Service:       params.string("service", "remediations"),
+			Recipient:     uuid.New(),
+			CorrelationID: uuid.New(),
+			URL:           "http://example.com/playbook.yml",
+			Status:        string(runStatus),
+			Labels:        dbModel.Labels{},
+			Timeout:       3600,
+			ResponseFull:  true,
+		}
+
+		// the id and the recipient only apply to the first run as they need to be unique
+		if i == 0 {
+			if run.ID, err = params.uuid("id", run.ID); err != nil {
+				return err
+			}
+
+			if run.Recipient, err = params.uuid("recipient", run.Recipient); err != nil {
+				return err
+			}
+		}
+
+		if labels, ok := params["labels"].(map[string]interface{}); ok {
+			for key, value := range labels {
+				run.Labels[key] = fmt.Sprint(value)
+			}
+		}
+
+		if err := tx.Create(&run).Error; err != nil {
+			return err
+		}
+
+		host := dbModel.RunHost{
+			ID:     uuid.New(),
+			RunID:  run.ID,
+			Host:   "localhost",
+			Status: run.Status,
+		}
+
+		if inventoryId, ok := params["inventory_id"]; ok {
+			parsed, err := uuid.Parse(fmt.Sprint(inventoryId))
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid inventory_id: %s", err))
+			}
+
+			host.InventoryID = &parsed
+		}
+
+		if err := tx.Create(&host).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteRuns(tx *gorm.DB, orgId string) error {
+	runs := tx.Model(&dbModel.Run{}).Select("id").Where("org_id = ?", orgId)
+
+	if err := tx.Where("run_id IN (?)", runs).Delete(&dbModel.RunHost{}).Error; err != nil {
+		return err
+	}
+
+	return tx.Where("org_id = ?", orgId).Delete(&dbModel.Run{}).Error
+}
+
+type stateParams map[string]interface{}
+
+func (this stateParams) string(key, fallback string) string {
+	if value, ok := this[key]; ok && value != nil {
+		return fmt.Sprint(value)
+	}
+
+	return fallback
+}
+
+func (this stateParams) int(key string, fallback int) (int, error) {
+	switch value := this[key].(type) {
+	case nil:
+		return fallback, nil
+	case float64:
+		return int(value), nil
+	default:
+		parsed, err := strconv.Atoi(fmt.Sprint(value))
+		if err != nil {
+			return 0, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid %s: %s", key, err))
+		}
+
+		return parsed, nil
+	}
+}
+
+func (this stateParams) uuid(key string, fallback uuid.UUID) (uuid.UUID, error) {
+	if _, ok := this[key]; !ok {
+		return fallback, nil
+	}
+
+	parsed, err := uuid.Parse(this.string(key, ""))
+	if err != nil {
+		return uuid.Nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid %s: %s", key, err))
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,294 @@
+package pact
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// Pact is a contract between a consumer and the dispatcher (pact specification v2 or v3)
+type Pact struct {
+	Consumer struct {
+		Name string `json:"name"`
+	} `json:"consumer"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+type providerState struct {
+	Name   string                 `json:"name"`
+	Params map[string]interface{} `json:"params"`
+}
+
+type Interaction struct {
+	Description string `json:"description"`
+	// v2
+	ProviderState string `json:"providerState"`
+	// v3
+	ProviderStates []providerState `json:"providerStates"`
+
+	Request struct {
+		Method  string                 `json:"method"`
+		Path    string                 `json:"path"`
+		Query   interface{}            `json:"query"`
+		Headers map[string]interface{} `json:"headers"`
+		Body    json.RawMessage        `json:"body"`
+	} `json:"request"`
+
+	Response struct {
+		Status        int                    `json:"status"`
+		Headers       map[string]interface{} `json:"headers"`
+		Body          json.RawMessage        `json:"body"`
+		MatchingRules json.RawMessage        `json:"matchingRules"`
+	} `json:"response"`
+}
+
+func (this Interaction) states() []providerState {
+	if this.ProviderState != "" {
+		return []providerState{{Name: this.ProviderState}}
+	}
+
+	return this.ProviderStates
+}
+
+// Failure describes an interaction the dispatcher does not honor
+type Failure struct {
+	Consumer    string
+	Interaction string
+	Mismatches  []string
+}
+
+func (this Failure) Error() string {
+	return fmt.Sprintf("%s: %s:\n\t%s", this.Consumer, this.Interaction, strings.Join(this.Mismatches, "\n\t"))
+}
+
+// Verifier replays the interactions of contracts against a running instance of the dispatcher
+type Verifier struct {
+	ProviderURL string
+	// the provider state endpoint, states are not set up if empty
+	StatesURL string
+	// set on every request, replacing the headers recorded by the consumer (e.g. a local pre-shared key)
+	Headers http.Header
+	Client  *http.Client
+}
+
+func ReadPact(path string) (*Pact, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Pact{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, fmt.Errorf("invalid pact %s: %w", path, err)
+	}
+
+	return result, nil
+}
+
+// Verify replays each interaction of the pact and returns those the responses do not satisfy.
+// An error is only returned if the dispatcher could not be reached.
+func (this *Verifier) Verify(ctx context.Context, pact *Pact) ([]Failure, error) {
+	failures := []Failure{}
+
+	for _, interaction := range pact.Interactions {
+		mismatches, err := this.verifyInteraction(ctx, pact.Consumer.Name, interaction)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", interaction.Description, err)
+		}
+
+		if len(mismatches) > 0 {
+			failures = append(failures, Failure{
+				Consumer:    pact.Consumer.Name,
+				Interaction: interaction.Description,
+				Mismatches:  mismatches,
+			})
+		}
+	}
+
+	return failures, nil
+}
+
+func (this *Verifier) verifyInteraction(ctx context.Context, consumer string, interaction Interaction) (mismatches []string, err error) {
+	for _, state := range interaction.states() {
+		if err := this.changeState(ctx, consumer, state, actionSetup); err != nil {
+			return nil, err
+		}
+
+		defer func(state providerState) {
+			if teardownErr := this.changeState(ctx, consumer, state, actionTeardown); err == nil {
+				err = teardownErr
+			}
+		}(state)
+	}
+
+	req, err := this.newRequest(ctx, interaction)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := this.client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	expected := interaction.Response
+
+	if expected.Status != 0 && res.StatusCode != expected.Status {
+		// the body of an unexpected response is not going to match either
+		return []string{fmt.Sprintf("status: expected %d, got %d (%s)", expected.Status, res.StatusCode, strings.TrimSpace(string(body)))}, nil
+	}
+
+	for name, value := range expected.Headers {
+		if mismatch := compareHeader(name, headerValue(value), res.Header.Get(name)); mismatch != "" {
+			mismatches = append(mismatches, mismatch)
+		}
+	}
+
+	if len(expected.Body) == 0 || string(expected.Body) == "null" {
+		return mismatches, nil
+	}
+
+	rules, err := parseBodyRules(expected.MatchingRules)
+	if err != nil {
+		return nil, fmt.Errorf("invalid matching rules: %w", err)
+	}
+
+	var expectedBody, actualBody interface{}
+	if err := json.Unmarshal(expected.Body, &expectedBody); err != nil {
+		return nil, fmt.Errorf("invalid response body: %w", err)
+	}
+
+	if err := json.Unmarshal(body, &actualBody); err != nil {
+		return append(mismatches, fmt.Sprintf("body: expected JSON, got %q", string(body))), nil
+	}
+
+	return append(mismatches, compareBody(expectedBody, actualBody, rules)...), nil
+}
+
+func (this *Verifier) newRequest(ctx context.Context, interaction Interaction) (*http.Request, error) {
+	target := strings.TrimSuffix(this.ProviderURL, "/") + interaction.Request.Path
+
+	switch query := interaction.Request.Query.(type) {
+	case string:
+		if query != "" {
+			target += "?" + query
+		}
+	case map[string]interface{}:
+		values := url.Values{}
+		for key, value := range query {
+			switch value := value.(type) {
+			case []interface{}:
+				for _, element := range value {
+					values.Add(key, fmt.Sprint(element))
+				}
+			default:
+				values.Add(key, fmt.Sprint(value))
+			}
+		}
+
+		target += "?" + values.Encode()
+	}
+
+	var body io.Reader
+	if len(interaction.Request.Body) > 0 {
+		body = bytes.NewReader(interaction.Request.Body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, interaction.Request.Method, target, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range interaction.Request.Headers {
+		req.Header.Set(name, headerValue(value))
+	}
+
+	for name, values := range this.Headers {
+		req.Header[http.CanonicalHeaderKey(name)] = values
+	}
+
+	return req, nil
+}
+
+func (this *Verifier) changeState(ctx context.Context, consumer string, state providerState, action string) error {
+	if this.StatesURL == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(StateChange{Consumer: consumer, State: state.Name, Params: state.Params, Action: action})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, this.StatesURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("content-type", "application/json")
+
+	res, err := this.client().Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("%s of provider state %q failed with %d: %s", action, state.Name, res.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+func (this *Verifier) client() *http.Client {
+	if this.Client != nil {
+		return this.Client
+	}
+
+	return http.DefaultClient
+}
+
+// v3 pacts may record multiple values of a header
+func headerValue(value interface{}) string {
+	if values, ok := value.([]interface{}); ok {
+		parts := make([]string, len(values))
+		for i, value := range values {
+			parts[i] = fmt.Sprint(value)
+		}
+
+		return strings.Join(parts, ", ")
+	}
+
+	return fmt.Sprint(value)
+}
+
+func compareHeader(name, expected, actual string) string {
+	if strings.EqualFold(name, "content-type") {
+		expectedType, _, _ := mime.ParseMediaType(expected)
+		actualType, _, _ := mime.ParseMediaType(actual)
+
+		if expectedType != "" && expectedType == actualType {
+			return ""
+		}
+	} else if expected == actual {
+		return ""
+	}
+
+	return fmt.Sprintf("header %s: expected %q, got %q", name, expected, actual)
+}
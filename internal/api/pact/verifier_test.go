@@ -0,0 +1,141 @@
+package pact
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const runsPact = `{
+	"consumer": {"name": "remediations"},
+	"provider": {"name": "playbook-dispatcher"},
+	"interactions": [{
+		"description": "a request for runs",
+		"providerStates": [{"name": "runs exist", "params": {"org_id": "12345"}}],
+		"request": {
+			"method": "GET",
+			"path": "/api/playbook-dispatcher/v1/runs",
+			"query": {"fields[data]": ["id", "status"]},
+			"headers": {"x-rh-identity": "recorded"}
+		},
+		"response": {
+			"status": 200,
+			"headers": {"Content-Type": "application/json; charset=utf-8"},
+			"body": {"data": [{"id": "9b7a9e52-63f2-4d2e-9f8f-f3a7c7a1f0a1", "status": "running"}], "meta": {"count": 1}},
+			"matchingRules": {"body": {
+				"$.data": {"matchers": [{"match": "type", "min": 1}]},
+				"$.data[*].id": {"matchers": [{"match": "regex", "regex": "^[0-9a-f-]{36}$"}]},
+				"$.meta.count": {"matchers": [{"match": "type"}]}
+			}}
+		}
+	}]
+}`
+
+var _ = Describe("Contract verification", func() {
+	var (
+		stateChanges []StateChange
+		requests     []*http.Request
+		response     string
+		server       *httptest.Server
+	)
+
+	BeforeEach(func() {
+		stateChanges = nil
+		requests = nil
+		response = `{"data": [{"id": "41b8e4c3-5fcb-4a3f-8d3e-4c3c2a0e5d11", "status": "success", "name": "extra"}, {"id": "6f1f1b0e-8f6e-4c9e-9b1e-2f0d5c8b1a22", "status": "running"}], "meta": {"count": 2}}`
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == StatesPath {
+				var change StateChange
+				body, _ := io.ReadAll(r.Body)
+				Expect(json.Unmarshal(body, &change)).To(Succeed())
+				stateChanges = append(stateChanges, change)
+				w.Write([]byte("{}"))
+				return
+			}
+
+			requests = append(requests, r)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(response))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	verify := func() []Failure {
+		contract := &Pact{}
+		Expect(json.Unmarshal([]byte(runsPact), contract)).To(Succeed())
+
+		verifier := &Verifier{
+			ProviderURL: server.URL,
+			StatesURL:   server.URL + StatesPath,
+			Headers:     http.Header{"X-Rh-Identity": {"local"}},
+		}
+
+		failures, err := verifier.Verify(context.Background(), contract)
+		Expect(err).ToNot(HaveOccurred())
+		return failures
+	}
+
+	It("verifies a response matching the contract", func() {
+		Expect(verify()).To(BeEmpty())
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].URL.Query()["fields[data]"]).To(Equal([]string{"id", "status"}))
+		Expect(requests[0].Header.Get("x-rh-identity")).To(Equal("local"))
+	})
+
+	It("sets up and tears down provider states", func() {
+		verify()
+
+		Expect(stateChanges).To(HaveLen(2))
+		Expect(stateChanges[0].Action).To(Equal("setup"))
+		Expect(stateChanges[0].State).To(Equal("runs exist"))
+		Expect(stateChanges[0].Consumer).To(Equal("remediations"))
+		Expect(stateChanges[0].Params).To(HaveKeyWithValue("org_id", "12345"))
+		Expect(stateChanges[1].Action).To(Equal("teardown"))
+	})
+
+	It("reports mismatches", func() {
+		response = `{"data": [{"id": 1, "status": "running"}], "meta": {}}`
+
+		failures := verify()
+		Expect(failures).To(HaveLen(1))
+		Expect(failures[0].Interaction).To(Equal("a request for runs"))
+		Expect(failures[0].Mismatches).To(ConsistOf(
+			ContainSubstring(`$.data[0].id: expected a value matching`),
+			ContainSubstring(`$.meta: missing key "count"`),
+		))
+	})
+
+	It("enforces the minimum number of elements", func() {
+		response = `{"data": [], "meta": {"count": 0}}`
+
+		failures := verify()
+		Expect(failures).To(HaveLen(1))
+		Expect(failures[0].Mismatches).To(ConsistOf(ContainSubstring("expected at least 1 elements")))
+	})
+
+	Describe("matching rules", func() {
+		It("reads the v2 format", func() {
+			rules, err := parseBodyRules(json.RawMessage(`{"$.body.meta.count": {"match": "type"}, "$.headers.Content-Type": {"match": "regex", "regex": "json"}}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rules).To(HaveLen(1))
+
+			Expect(compareBody(map[string]interface{}{"meta": map[string]interface{}{"count": 1.0}}, map[string]interface{}{"meta": map[string]interface{}{"count": 5.0}}, rules)).To(BeEmpty())
+			Expect(compareBody(map[string]interface{}{"meta": map[string]interface{}{"count": 1.0}}, map[string]interface{}{"meta": map[string]interface{}{"count": "5"}}, rules)).To(HaveLen(1))
+		})
+
+		It("compares values without rules by equality", func() {
+			Expect(compareBody([]interface{}{"a"}, []interface{}{"a", "b"}, nil)).To(ConsistOf("$: expected 1 elements, got 2"))
+			Expect(compareBody("a", "b", nil)).To(ConsistOf("$: expected a, got b"))
+		})
+	})
+})
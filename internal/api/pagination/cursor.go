@@ -0,0 +1,85 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Key is the position of a result in the keyset order of list endpoints (creation time, then ID)
+type Key struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// Cursor identifies a page of a cursor-paginated endpoint relative to a result.
+// The zero value is the first page and encodes to an empty string.
+type Cursor struct {
+	// the result the page follows (or precedes if Backward); nil for the first page
+	Key *Key `json:"k,omitempty"`
+	// the page is made of the results before Key
+	Backward bool `json:"b,omitempty"`
+}
+
+// ParseCursor decodes a cursor returned in the links of a previous page
+func ParseCursor(value string) (Cursor, error) {
+	cursor := Cursor{}
+
+	if value == "" {
+		return cursor, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return cursor, errors.New("invalid cursor")
+	}
+
+	if err := json.Unmarshal(data, &cursor); err != nil || (cursor.Key == nil && cursor.Backward) {
+		return Cursor{}, errors.New("invalid cursor")
+	}
+
+	return cursor, nil
+}
+
+// String encodes the cursor as an opaque value of the cursor parameter
+func (this Cursor) String() string {
+	if this.Key == nil {
+		return ""
+	}
+
+	data, _ := json.Marshal(this)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Neighbours returns the cursors of the pages before and after the page of this cursor (nil if there is none) given the
+// keys of the first and last result of the page in list order (nil if the page is empty) and whether more results
+// exist beyond the page in the direction it was fetched in.
+func (this Cursor) Neighbours(first, last *Key, more bool) (previous, next *Cursor) {
+	if this.Backward {
+		if more && first != nil {
+			previous = &Cursor{Key: first, Backward: true}
+		}
+
+		if last != nil {
+			next = &Cursor{Key: last}
+		} else {
+			// nothing precedes the key, i.e. the results after it start on the first page
+			next = &Cursor{}
+		}
+
+		return
+	}
+
+	if this.Key != nil && first != nil {
+		previous = &Cursor{Key: first, Backward: true}
+	}
+
+	if more && last != nil {
+		next = &Cursor{Key: last}
+	}
+
+	return
+}
@@ -0,0 +1,102 @@
+// Package pagination builds the meta and links of list responses so that all list endpoints page the same way.
+//
+// Links keep the query parameters of the request (filters, sorting, sparse fieldsets) and only replace the
+// pagination parameters. In offset mode (limit/offset) all four links are available. In cursor mode (limit/cursor) the
+// position of the last page is unknown, so the last link is only returned on the last page.
+package pagination
+
+import (
+	"net/url"
+	"strconv"
+)
+
+const (
+	paramLimit  = "limit"
+	paramOffset = "offset"
+	paramCursor = "cursor"
+)
+
+// Links has the shape of the Links schema of the API and converts to the generated type, e.g. public.Links(links)
+type Links struct {
+	First    string
+	Last     string
+	Next     *string
+	Previous *string
+}
+
+// Meta has the shape of the Meta schema of the API
+type Meta struct {
+	Count int
+	Total int
+}
+
+// Page describes the page returned by a list endpoint
+type Page struct {
+	// path of the endpoint, e.g. /api/playbook-dispatcher/v1/runs
+	Base string
+	// raw query string of the request
+	Query string
+	Limit int
+}
+
+// Offset returns the meta and links of the page starting at offset, given the number of results it contains and the
+// total number of results matching the query
+func (this Page) Offset(offset, count, total int) (Meta, Links) {
+	limit := max(this.Limit, 1)
+	lastPage := max(total-1, 0) / limit
+
+	links := Links{
+		First: this.link(paramOffset, "0"),
+		Last:  this.link(paramOffset, strconv.Itoa(lastPage*limit)),
+	}
+
+	if offset > 0 {
+		previous := this.link(paramOffset, strconv.Itoa(max(offset-limit, 0)))
+		links.Previous = &previous
+	}
+
+	if offset+limit < total {
+		next := this.link(paramOffset, strconv.Itoa(offset+limit))
+		links.Next = &next
+	}
+
+	return Meta{Count: count, Total: total}, links
+}
+
+// Cursor returns the meta and links of a page of a cursor-paginated endpoint given the cursors of the pages around it
+// (see Cursor.Neighbours). If there is no next page Last points to the current page, otherwise it is empty.
+// The cursor parameter is kept in all the links, with an empty value for the first page, so that following them stays
+// in cursor mode.
+func (this Page) Cursor(cursor Cursor, previous, next *Cursor, count, total int) (Meta, Links) {
+	links := Links{
+		First: this.link(paramCursor, ""),
+	}
+
+	if previous != nil {
+		link := this.link(paramCursor, previous.String())
+		links.Previous = &link
+	}
+
+	if next != nil {
+		link := this.link(paramCursor, next.String())
+		links.Next = &link
+	} else {
+		links.Last = this.link(paramCursor, cursor.String())
+	}
+
+	return Meta{Count: count, Total: total}, links
+}
+
+func (this Page) link(param, value string) string {
+	query, _ := url.ParseQuery(this.Query)
+
+	// only one pagination mode applies to a link
+	query.Del(paramOffset)
+	query.Del(paramCursor)
+
+	query.Set(paramLimit, strconv.Itoa(this.Limit))
+	query.Set(param, value)
+
+	// Encode sorts the parameters so that links do not depend on the order of the request parameters
+	return this.Base + "?" + query.Encode()
+}
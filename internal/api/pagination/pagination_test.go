@@ -0,0 +1,136 @@
+package pagination
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pagination", func() {
+	const base = "/api/playbook-dispatcher/v1/runs"
+
+	Describe("offset", func() {
+		It("links a single page", func() {
+			meta, links := Page{Base: base, Limit: 50}.Offset(0, 5, 5)
+
+			Expect(meta).To(Equal(Meta{Count: 5, Total: 5}))
+			Expect(links.First).To(Equal(base + "?limit=50&offset=0"))
+			Expect(links.Last).To(Equal(base + "?limit=50&offset=0"))
+			Expect(links.Next).To(BeNil())
+			Expect(links.Previous).To(BeNil())
+		})
+
+		It("links the pages around a page in the middle", func() {
+			_, links := Page{Base: base, Limit: 2}.Offset(1, 2, 5)
+
+			Expect(links.First).To(Equal(base + "?limit=2&offset=0"))
+			Expect(links.Last).To(Equal(base + "?limit=2&offset=4"))
+			Expect(*links.Next).To(Equal(base + "?limit=2&offset=3"))
+			Expect(*links.Previous).To(Equal(base + "?limit=2&offset=0"))
+		})
+
+		It("has no next link on the last page", func() {
+			_, links := Page{Base: base, Limit: 2}.Offset(4, 1, 5)
+
+			Expect(links.Next).To(BeNil())
+			Expect(*links.Previous).To(Equal(base + "?limit=2&offset=2"))
+		})
+
+		It("links the first page of an empty result", func() {
+			meta, links := Page{Base: base, Limit: 10}.Offset(0, 0, 0)
+
+			Expect(meta).To(Equal(Meta{}))
+			Expect(links.Last).To(Equal(links.First))
+		})
+
+		It("keeps the other query parameters and replaces the pagination ones", func() {
+			page := Page{Base: base, Query: "sort_by=created_at%3Adesc&offset=3&limit=1&filter%5Bstatus%5D=running&cursor=abc", Limit: 1}
+			_, links := page.Offset(3, 1, 5)
+
+			Expect(links.First).To(Equal(base + "?filter%5Bstatus%5D=running&limit=1&offset=0&sort_by=created_at%3Adesc"))
+			Expect(*links.Next).To(Equal(base + "?filter%5Bstatus%5D=running&limit=1&offset=4&sort_by=created_at%3Adesc"))
+		})
+	})
+
+	Describe("cursor", func() {
+		key := &Key{CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 6000, time.UTC), ID: uuid.MustParse("0c3d5e6a-1f2b-4c3d-8e9f-0a1b2c3d4e5f")}
+		other := &Key{CreatedAt: key.CreatedAt.Add(-time.Hour), ID: uuid.MustParse("4a7c2b1e-9d8f-4e6a-b5c4-3d2e1f0a9b8c")}
+
+		It("links the next page of the first page", func() {
+			page := Page{Base: base, Query: "filter%5Bstatus%5D=running&cursor=", Limit: 10}
+			meta, links := page.Cursor(Cursor{}, nil, &Cursor{Key: key}, 10, 20)
+
+			Expect(meta).To(Equal(Meta{Count: 10, Total: 20}))
+			Expect(links.First).To(Equal(base + "?cursor=&filter%5Bstatus%5D=running&limit=10"))
+			Expect(*links.Next).To(Equal(base + "?cursor=" + Cursor{Key: key}.String() + "&filter%5Bstatus%5D=running&limit=10"))
+			Expect(links.Last).To(BeEmpty())
+			Expect(links.Previous).To(BeNil())
+		})
+
+		It("links the last page to itself", func() {
+			current := Cursor{Key: key}
+			_, links := Page{Base: base, Query: "offset=10", Limit: 10}.Cursor(current, &Cursor{Key: other, Backward: true}, nil, 3, 23)
+
+			Expect(links.Next).To(BeNil())
+			Expect(links.Last).To(Equal(base + "?cursor=" + current.String() + "&limit=10"))
+			Expect(*links.Previous).To(Equal(base + "?cursor=" + Cursor{Key: other, Backward: true}.String() + "&limit=10"))
+		})
+
+		It("round-trips cursors", func() {
+			for _, cursor := range []Cursor{{}, {Key: key}, {Key: other, Backward: true}} {
+				parsed, err := ParseCursor(cursor.String())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(parsed).To(Equal(cursor))
+			}
+		})
+
+		It("encodes the first page as an empty cursor", func() {
+			Expect(Cursor{}.String()).To(BeEmpty())
+		})
+
+		It("rejects malformed cursors", func() {
+			for _, value := range []string{"not a cursor", "eyJiIjp0cnVlfQ"} {
+				_, err := ParseCursor(value)
+				Expect(err).To(HaveOccurred(), value)
+			}
+		})
+
+		Describe("neighbours", func() {
+			It("has no previous page on the first page", func() {
+				previous, next := Cursor{}.Neighbours(key, other, true)
+
+				Expect(previous).To(BeNil())
+				Expect(next).To(Equal(&Cursor{Key: other}))
+			})
+
+			It("pages backwards from the first result", func() {
+				previous, next := Cursor{Key: key}.Neighbours(key, other, false)
+
+				Expect(previous).To(Equal(&Cursor{Key: key, Backward: true}))
+				Expect(next).To(BeNil())
+			})
+
+			It("pages forwards from the last result of a page fetched backwards", func() {
+				previous, next := Cursor{Key: key, Backward: true}.Neighbours(key, other, false)
+
+				Expect(previous).To(BeNil())
+				Expect(next).To(Equal(&Cursor{Key: other}))
+			})
+
+			It("continues backwards if more results precede the page", func() {
+				previous, _ := Cursor{Key: key, Backward: true}.Neighbours(key, other, true)
+
+				Expect(previous).To(Equal(&Cursor{Key: key, Backward: true}))
+			})
+
+			It("links the first page from an empty page fetched backwards", func() {
+				previous, next := Cursor{Key: key, Backward: true}.Neighbours(nil, nil, false)
+
+				Expect(previous).To(BeNil())
+				Expect(next).To(Equal(&Cursor{}))
+			})
+		})
+	})
+})
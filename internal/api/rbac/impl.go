@@ -7,7 +7,6 @@ import (
 	"playbook-dispatcher/internal/common/constants"
 	"playbook-dispatcher/internal/common/utils"
 	"regexp"
-	"time"
 
 	"github.com/spf13/viper"
 )
@@ -44,11 +43,9 @@ func NewRbacClientWithHttpRequestDoer(cfg *viper.Viper, doer HttpRequestDoer) Rb
 }
 
 func NewRbacClient(cfg *viper.Viper) RbacClient {
-	doer := http.Client{
-		Timeout: time.Duration(cfg.GetInt64("rbac.timeout") * int64(time.Second)),
-	}
+	doer := utils.NewTimeoutHttpRequestDoer(cfg, "rbac.timeout")
 
-	return NewRbacClientWithHttpRequestDoer(cfg, &doer)
+	return NewRbacClientWithHttpRequestDoer(cfg, doer)
 }
 
 type clientImpl struct {
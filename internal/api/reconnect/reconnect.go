@@ -0,0 +1,81 @@
+// Package reconnect dispatches the runs waiting for their recipient once cloud-connector reports that it connected.
+package reconnect
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"playbook-dispatcher/internal/api/dispatch"
+	"playbook-dispatcher/internal/common/kafka"
+	"playbook-dispatcher/internal/common/utils"
+
+	k "github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+)
+
+const stateConnected = "connected"
+
+// connectionEvent is emitted by cloud-connector whenever the connection state of a client changes
+type connectionEvent struct {
+	OrgId    string `json:"org_id"`
+	ClientId string `json:"client_id"`
+	State    string `json:"state"`
+}
+
+type handler struct {
+	dispatchManager dispatch.DispatchManager
+}
+
+// Start consumes the connection events of cloud-connector until the context is canceled
+func Start(ctx context.Context, cfg *viper.Viper, dispatchManager dispatch.DispatchManager, errors chan<- error, ready *utils.ProbeHandler, wg *sync.WaitGroup) {
+	consumer, err := kafka.NewConsumerWithGroup(ctx, cfg, cfg.GetString("wait.for.connection.group.id"), cfg.GetString("topic.connection.events"))
+	utils.DieOnError(err)
+
+	// runs keep waiting while kafka is unavailable
+	err = ready.RegisterDependency("reconnect.kafka", false, func() error {
+		return kafka.Ping(cfg.GetInt("kafka.timeout"), consumer)
+	})
+	utils.DieOnError(err)
+
+	handler := &handler{dispatchManager: dispatchManager}
+	start := kafka.NewConsumerEventLoop(ctx, consumer, nil, nil, handler.onMessage, errors)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer utils.GetLogFromContext(ctx).Debug("Connection event consumer stopped")
+		defer consumer.Close()
+		start()
+	}()
+}
+
+func (this *handler) onMessage(ctx context.Context, msg *k.Message) {
+	log := utils.GetLogFromContext(ctx)
+
+	var event connectionEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		log.Warnw("Connection event cannot be parsed", "error", err, "partition", msg.TopicPartition.Partition, "offset", msg.TopicPartition.Offset.String())
+		return
+	}
+
+	if event.State != stateConnected || event.OrgId == "" {
+		return
+	}
+
+	recipient, err := uuid.Parse(event.ClientId)
+	if err != nil {
+		log.Debugw("Ignoring connection event of a client not addressable as a recipient", "client_id", event.ClientId)
+		return
+	}
+
+	ctx = utils.WithOrgId(ctx, event.OrgId)
+
+	dispatched, err := this.dispatchManager.ProcessReconnect(ctx, event.OrgId, recipient)
+	if err != nil {
+		utils.GetLogFromContext(ctx).Errorw("Error dispatching runs waiting for recipient", "recipient", recipient, "error", err)
+	} else if dispatched > 0 {
+		utils.GetLogFromContext(ctx).Infow("Dispatched runs waiting for recipient", "recipient", recipient, "runs", dispatched)
+	}
+}
@@ -0,0 +1,78 @@
+package reconnect
+
+import (
+	"context"
+	"playbook-dispatcher/internal/api/dispatch"
+	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	k "github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type reconnect struct {
+	orgID     string
+	recipient uuid.UUID
+}
+
+type dispatchManagerMock struct {
+	reconnects []reconnect
+}
+
+func (this *dispatchManagerMock) ProcessRun(ctx context.Context, orgID string, service string, run generic.RunInput) (runID, correlationID uuid.UUID, err error) {
+	panic("not implemented")
+}
+
+func (this *dispatchManagerMock) ProcessCancel(ctx context.Context, orgID string, cancel generic.CancelInput) (runID, correlationID uuid.UUID, err error) {
+	panic("not implemented")
+}
+
+func (this *dispatchManagerMock) PlanRun(ctx context.Context, orgID string, service string, run generic.RunInput) (dispatch.RunPlan, error) {
+	panic("not implemented")
+}
+
+func (this *dispatchManagerMock) ProcessReconnect(ctx context.Context, orgID string, recipient uuid.UUID) (int, error) {
+	this.reconnects = append(this.reconnects, reconnect{orgID, recipient})
+	return 1, nil
+}
+
+func message(value string) *k.Message {
+	topic := "platform.cloud-connector.connection-events"
+	return &k.Message{TopicPartition: k.TopicPartition{Topic: &topic}, Value: []byte(value)}
+}
+
+var _ = Describe("Connection events", func() {
+	var (
+		dispatchManager *dispatchManagerMock
+		instance        *handler
+	)
+
+	BeforeEach(func() {
+		dispatchManager = &dispatchManagerMock{}
+		instance = &handler{dispatchManager: dispatchManager}
+	})
+
+	It("dispatches the runs of a recipient that connected", func() {
+		recipient := uuid.New()
+		instance.onMessage(test.TestContext(), message(`{"org_id": "5318290", "client_id": "`+recipient.String()+`", "state": "connected"}`))
+
+		Expect(dispatchManager.reconnects).To(Equal([]reconnect{{"5318290", recipient}}))
+	})
+
+	It("ignores disconnects", func() {
+		instance.onMessage(test.TestContext(), message(`{"org_id": "5318290", "client_id": "`+uuid.New().String()+`", "state": "disconnected"}`))
+		Expect(dispatchManager.reconnects).To(BeEmpty())
+	})
+
+	It("ignores clients that are not addressable as recipients", func() {
+		instance.onMessage(test.TestContext(), message(`{"org_id": "5318290", "client_id": "satellite-1", "state": "connected"}`))
+		Expect(dispatchManager.reconnects).To(BeEmpty())
+	})
+
+	It("ignores malformed events", func() {
+		instance.onMessage(test.TestContext(), message(`{"org_id": `))
+		Expect(dispatchManager.reconnects).To(BeEmpty())
+	})
+})
@@ -0,0 +1,208 @@
+package api
+
+import (
+	"fmt"
+	"playbook-dispatcher/internal/api/controllers/public"
+	"playbook-dispatcher/internal/api/middleware"
+	"playbook-dispatcher/internal/api/rbac"
+	"playbook-dispatcher/internal/common/kessel"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+const publicPrefix = "/api/playbook-dispatcher"
+
+// route binds an operation of the public API to the permissions it requires
+type route struct {
+	// ID of the operation served, also the name links to the route are generated by (echo.Reverse)
+	name   string
+	method string
+	// relative to publicPrefix, in the echo syntax
+	path    string
+	handler echo.HandlerFunc
+
+	permission rbac.RequiredPermission
+	kessel     middleware.KesselCheck
+}
+
+var runRead = middleware.KesselCheck{
+	ResourceType: kessel.ResourceTypeWorkspace,
+	Relation:     kessel.PermissionRunRead,
+	Extractor:    middleware.OrgWorkspace,
+}
+
+// runReadOne checks the permission on the run of the path, which requires its relationships (kessel.tuples.enabled)
+func runReadOne(db *gorm.DB) middleware.KesselCheck {
+	return middleware.KesselCheck{
+		ResourceType: kessel.ResourceTypeRun,
+		Relation:     kessel.PermissionRunRead,
+		Extractor:    middleware.RunWithConsistency(db, "run_id"),
+	}
+}
+
+func publicRoutes(controller public.ServerInterfaceWrapper, db *gorm.DB) []route {
+	return []route{
+		{
+			name:       public.RouteRunHostsList,
+			method:     echo.GET,
+			path:       "/v1/run_hosts",
+			handler:    controller.ApiRunHostsList,
+			permission: rbac.DispatcherPermission("run", "read"),
+			kessel:     runRead,
+		},
+		{
+			name:       "ApiRunsList",
+			method:     echo.GET,
+			path:       "/v1/runs",
+			handler:    controller.ApiRunsList,
+			permission: rbac.DispatcherPermission("run", "read"),
+			kessel:     runRead,
+		},
+		{
+			name:       public.RouteRunGet,
+			method:     echo.GET,
+			path:       "/v1/runs/:run_id",
+			handler:    controller.ApiRunGet,
+			permission: rbac.DispatcherPermission("run", "read"),
+			kessel:     runReadOne(db),
+		},
+		{
+			name:       public.RouteRunEvents,
+			method:     echo.GET,
+			path:       "/v1/runs/:run_id/events",
+			handler:    controller.ApiRunEvents,
+			permission: rbac.DispatcherPermission("run", "read"),
+			kessel:     runReadOne(db),
+		},
+		{
+			name:       public.RouteRunHistory,
+			method:     echo.GET,
+			path:       "/v1/runs/:run_id/history",
+			handler:    controller.ApiRunHistoryGet,
+			permission: rbac.DispatcherPermission("run", "read"),
+			kessel:     runReadOne(db),
+		},
+		{
+			name:       "ApiRunHostArtifactsGet",
+			method:     echo.GET,
+			path:       "/v1/runs/:run_id/hosts/:host/artifacts",
+			handler:    controller.ApiRunHostArtifactsGet,
+			permission: rbac.DispatcherPermission("run", "read"),
+			kessel:     runRead,
+		},
+		{
+			name:       "ApiRunHostStdoutGet",
+			method:     echo.GET,
+			path:       "/v1/run_hosts/:run_host_id/stdout",
+			handler:    controller.ApiRunHostStdoutGet,
+			permission: rbac.DispatcherPermission("run", "read"),
+			kessel:     runRead,
+		},
+		{
+			name:       "ApiRunsStatus",
+			method:     echo.POST,
+			path:       "/v1/runs/status",
+			handler:    controller.ApiRunsStatus,
+			permission: rbac.DispatcherPermission("run", "read"),
+			kessel:     runRead,
+		},
+		{
+			name:       "ApiRunsGroups",
+			method:     echo.GET,
+			path:       "/v1/runs/groups",
+			handler:    controller.ApiRunsGroups,
+			permission: rbac.DispatcherPermission("run", "read"),
+			kessel:     runRead,
+		},
+		{
+			name:       "ApiRunsListV2",
+			method:     echo.GET,
+			path:       "/v2/runs",
+			handler:    controller.ApiRunsListV2,
+			permission: rbac.DispatcherPermission("run", "read"),
+			kessel:     runRead,
+		},
+		{
+			name:       "ApiRunHostsListV2",
+			method:     echo.GET,
+			path:       "/v2/run_hosts",
+			handler:    controller.ApiRunHostsListV2,
+			permission: rbac.DispatcherPermission("run", "read"),
+			kessel:     runRead,
+		},
+	}
+}
+
+// registerRoutes adds the routes to the group along with the authorization middleware they require
+func registerRoutes(cfg *viper.Viper, group *echo.Group, routes []route) {
+	for _, route := range routes {
+		group.Add(route.method, route.path, route.handler,
+			middleware.AuthorizeApiTokens(route.permission,
+				middleware.EnforcePermissions(cfg, route.permission),
+				middleware.KesselShadow(cfg),
+				middleware.EnforceKesselPermission(cfg, route.kessel),
+			),
+		).Name = route.name
+	}
+}
+
+var echoParamRegex = regexp.MustCompile(`:([^/]+)`)
+
+func (this route) operation() string {
+	return this.method + " " + publicPrefix + echoParamRegex.ReplaceAllString(this.path, "{$1}")
+}
+
+// validateRoutes fails unless every operation of the specification is served by exactly one route named after it with
+// complete authorization requirements, and every route is an operation of the specification
+func validateRoutes(spec *openapi3.T, routes []route) error {
+	// operation IDs by method and path
+	operations := map[string]string{}
+	for path, item := range spec.Paths.Map() {
+		for method, operation := range item.Operations() {
+			operations[method+" "+path] = operation.OperationID
+		}
+	}
+
+	problems := []string{}
+	routed := map[string]bool{}
+
+	for _, route := range routes {
+		operation := route.operation()
+
+		switch {
+		case routed[operation]:
+			problems = append(problems, fmt.Sprintf("%s is routed more than once", operation))
+		case operations[operation] == "":
+			problems = append(problems, fmt.Sprintf("%s is not defined in the specification", operation))
+		case route.name != operations[operation]:
+			problems = append(problems, fmt.Sprintf("%s is named %q instead of %q", operation, route.name, operations[operation]))
+		case route.permission.Application == "":
+			problems = append(problems, fmt.Sprintf("%s has no RBAC permission", operation))
+		}
+
+		if err := route.kessel.Validate(); err != nil {
+			problems = append(problems, fmt.Sprintf("%s has an invalid Kessel check: %s", operation, err))
+		}
+
+		routed[operation] = true
+	}
+
+	for operation := range operations {
+		if !routed[operation] {
+			problems = append(problems, fmt.Sprintf("%s has no authorization mapping", operation))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("invalid routes: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"playbook-dispatcher/internal/api/controllers/public"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRoutes(t *testing.T) {
+	spec, err := public.GetSwagger()
+	assert.NoError(t, err)
+
+	routes := publicRoutes(public.CreateController(nil, nil, nil, nil, nil), nil)
+
+	assert.NoError(t, validateRoutes(spec, routes))
+}
+
+func TestValidateRoutes_Unmapped(t *testing.T) {
+	spec, err := public.GetSwagger()
+	assert.NoError(t, err)
+
+	routes := publicRoutes(public.CreateController(nil, nil, nil, nil, nil), nil)
+
+	err = validateRoutes(spec, routes[1:])
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "GET /api/playbook-dispatcher/v1/run_hosts has no authorization mapping")
+}
+
+func TestValidateRoutes_Invalid(t *testing.T) {
+	spec, err := public.GetSwagger()
+	assert.NoError(t, err)
+
+	routes := publicRoutes(public.CreateController(nil, nil, nil, nil, nil), nil)
+	routes = append(routes, routes[0], route{method: echo.DELETE, path: "/v1/runs/:run_id", kessel: runRead})
+	routes[1].kessel.Extractor = nil
+	routes[2].name = "ApiRunsList"
+
+	err = validateRoutes(spec, routes)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "GET /api/playbook-dispatcher/v1/run_hosts is routed more than once")
+	assert.Contains(t, err.Error(), "DELETE /api/playbook-dispatcher/v1/runs/{run_id} is not defined in the specification")
+	assert.Contains(t, err.Error(), "GET /api/playbook-dispatcher/v1/runs has an invalid Kessel check")
+	assert.Contains(t, err.Error(), `GET /api/playbook-dispatcher/v1/runs/{run_id} is named "ApiRunsList" instead of "ApiRunGet"`)
+}
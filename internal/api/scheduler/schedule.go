@@ -0,0 +1,55 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// number of consecutive triggers checked against the minimum interval
+const intervalSamples = 10
+
+var parser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ParseSchedule parses the cron expression of a run template, evaluated in UTC. Schedules triggering more often than
+// minInterval are rejected.
+func ParseSchedule(expression string, minInterval time.Duration) (cron.Schedule, error) {
+	if strings.HasPrefix(expression, "TZ=") || strings.HasPrefix(expression, "CRON_TZ=") {
+		return nil, errors.New("schedules are evaluated in UTC, time zones cannot be specified")
+	}
+
+	schedule, err := parser.Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule: %w", err)
+	}
+
+	previous := schedule.Next(time.Now().UTC())
+	if previous.IsZero() {
+		return nil, errors.New("invalid schedule: never triggers")
+	}
+
+	for i := 0; i < intervalSamples; i++ {
+		next := schedule.Next(previous)
+		if !next.IsZero() && next.Sub(previous) < minInterval {
+			return nil, fmt.Errorf("invalid schedule: triggers more often than every %s", minInterval)
+		}
+
+		previous = next
+	}
+
+	return schedule, nil
+}
+
+// NextRunAt is the time the next run of a template with the given schedule is dispatched at, nil if the template is
+// disabled
+func NextRunAt(schedule cron.Schedule, enabled bool, now time.Time) *time.Time {
+	if !enabled {
+		return nil
+	}
+
+	next := schedule.Next(now.UTC())
+	return &next
+}
@@ -0,0 +1,49 @@
+package scheduler
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	DescribeTable("accepts",
+		func(expression string) {
+			_, err := ParseSchedule(expression, 15*time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+		},
+
+		Entry("weekly", "0 3 * * 1"),
+		Entry("every 15 minutes", "*/15 * * * *"),
+		Entry("shorthand", "@daily"),
+	)
+
+	DescribeTable("rejects",
+		func(expression string) {
+			_, err := ParseSchedule(expression, 15*time.Minute)
+			Expect(err).To(HaveOccurred())
+		},
+
+		Entry("malformed", "0 3 * *"),
+		Entry("seconds", "0 0 3 * * 1"),
+		Entry("too frequent", "*/5 * * * *"),
+		Entry("too frequent at times", "0,1 * * * *"),
+		Entry("time zone", "TZ=Europe/Prague 0 3 * * 1"),
+	)
+
+	It("computes the next run in UTC", func() {
+		schedule, err := ParseSchedule("30 3 * * *", 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+		Expect(*NextRunAt(schedule, true, now)).To(Equal(time.Date(2024, 5, 2, 3, 30, 0, 0, time.UTC)))
+	})
+
+	It("does not schedule disabled templates", func() {
+		schedule, err := ParseSchedule("@hourly", 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(NextRunAt(schedule, false, time.Now())).To(BeNil())
+	})
+})
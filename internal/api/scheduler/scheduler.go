@@ -0,0 +1,156 @@
+// Package scheduler dispatches the runs of run templates each time their cron schedule triggers.
+//
+// Every API replica polls for templates due. A replica claims a template by moving its next_run_at forward, which
+// only one of them succeeds in, before dispatching the run. Triggers missed while no replica was running are not made
+// up for, the template is dispatched once and scheduled from then on.
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"playbook-dispatcher/internal/api/dispatch"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/encryption"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+type scheduler struct {
+	config          *viper.Viper
+	db              *gorm.DB
+	dispatchManager dispatch.DispatchManager
+	labelCipher     *encryption.LabelCipher
+}
+
+// Start polls for run templates due every run.templates.scheduler.interval seconds until the context is canceled
+func Start(ctx context.Context, cfg *viper.Viper, db *gorm.DB, dispatchManager dispatch.DispatchManager, labelCipher *encryption.LabelCipher, wg *sync.WaitGroup) {
+	this := &scheduler{config: cfg, db: db, dispatchManager: dispatchManager, labelCipher: labelCipher}
+
+	ticker := time.NewTicker(cfg.GetDuration("run.templates.scheduler.interval") * time.Second)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				this.trigger(ctx, time.Now())
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// trigger dispatches the runs of the templates due at the given time
+func (this *scheduler) trigger(ctx context.Context, now time.Time) {
+	var templates []dbModel.RunTemplate
+
+	err := this.db.WithContext(ctx).
+		Where("next_run_at <= ?", now).
+		Order("next_run_at").
+		Limit(this.config.GetInt("run.templates.scheduler.batch.size")).
+		Find(&templates).Error
+	if err != nil {
+		utils.GetLogFromContext(ctx).Errorw("Error reading run templates due", "error", err)
+		return
+	}
+
+	for i := range templates {
+		if ctx.Err() != nil {
+			return
+		}
+
+		this.triggerTemplate(ctx, &templates[i], now)
+	}
+}
+
+func (this *scheduler) triggerTemplate(ctx context.Context, template *dbModel.RunTemplate, now time.Time) {
+	ctx = utils.WithOrgId(ctx, template.OrgID)
+	ctx = utils.WithRequestType(ctx, instrumentation.LabelAnsibleRequest)
+
+	claimed, err := this.claim(ctx, template, now)
+	if err != nil {
+		instrumentation.RunTemplateError(ctx, err, template.ID)
+		return
+	} else if !claimed {
+		return
+	}
+
+	runID, err := this.dispatch(ctx, template)
+	if err != nil {
+		instrumentation.RunTemplateError(ctx, err, template.ID)
+	} else {
+		instrumentation.RunTemplateDispatched(ctx, template.ID, *runID)
+	}
+
+	err = this.db.WithContext(ctx).Model(template).Updates(map[string]interface{}{
+		"last_run_at": now,
+		"last_run_id": runID,
+	}).Error
+	if err != nil {
+		utils.GetLogFromContext(ctx).Errorw("Error recording the run of run template", "template_id", template.ID.String(), "error", err)
+	}
+}
+
+// claim schedules the next run of the template, reporting false if another replica did so already
+func (this *scheduler) claim(ctx context.Context, template *dbModel.RunTemplate, now time.Time) (bool, error) {
+	var next *time.Time
+
+	// a template whose schedule does not parse anymore stops being scheduled
+	schedule, err := ParseSchedule(template.Schedule, 0)
+	if err == nil {
+		next = NextRunAt(schedule, template.Enabled, now)
+	} else {
+		utils.GetLogFromContext(ctx).Warnw("Run template not scheduled anymore", "template_id", template.ID.String(), "error", err)
+	}
+
+	result := this.db.WithContext(ctx).
+		Model(&dbModel.RunTemplate{}).
+		Where("id = ? AND next_run_at = ?", template.ID, template.NextRunAt).
+		Update("next_run_at", next)
+
+	return result.RowsAffected == 1, result.Error
+}
+
+func (this *scheduler) dispatch(ctx context.Context, template *dbModel.RunTemplate) (*uuid.UUID, error) {
+	if utils.IsOrgIdBlocklisted(this.config, template.OrgID) {
+		return nil, &utils.BlocklistedOrgIdError{OrgID: template.OrgID}
+	}
+
+	if !utils.IsPlaybookUrlAllowed(this.config, template.Service, template.URL) {
+		return nil, &utils.PlaybookUrlNotAllowedError{Service: template.Service, Url: template.URL}
+	}
+
+	labels, err := this.labelCipher.Decrypt(template.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	runInput := generic.RunInput{
+		Recipient:     template.Recipient,
+		OrgId:         template.OrgID,
+		Url:           template.URL,
+		Labels:        labels,
+		Timeout:       template.Timeout,
+		Name:          &template.Name,
+		WebConsoleUrl: template.WebConsoleURL,
+		Principal:     &template.Principal,
+	}
+
+	runID, _, err := this.dispatchManager.ProcessRun(ctx, template.OrgID, template.Service, runInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runID, nil
+}
@@ -0,0 +1,149 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"playbook-dispatcher/internal/api/dispatch"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/encryption"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+)
+
+type dispatchManagerMock struct {
+	db   func() *gorm.DB
+	err  error
+	runs []generic.RunInput
+}
+
+func (this *dispatchManagerMock) ProcessRun(ctx context.Context, orgID string, service string, run generic.RunInput) (runID, correlationID uuid.UUID, err error) {
+	this.runs = append(this.runs, run)
+	if this.err != nil {
+		return uuid.UUID{}, uuid.UUID{}, this.err
+	}
+
+	entity := test.NewRun(orgID)
+	Expect(this.db().Create(&entity).Error).ToNot(HaveOccurred())
+	return entity.ID, entity.CorrelationID, nil
+}
+
+func (this *dispatchManagerMock) ProcessCancel(ctx context.Context, orgID string, cancel generic.CancelInput) (runID, correlationID uuid.UUID, err error) {
+	panic("not implemented")
+}
+
+func (this *dispatchManagerMock) PlanRun(ctx context.Context, orgID string, service string, run generic.RunInput) (dispatch.RunPlan, error) {
+	panic("not implemented")
+}
+
+func (this *dispatchManagerMock) ProcessReconnect(ctx context.Context, orgID string, recipient uuid.UUID) (int, error) {
+	panic("not implemented")
+}
+
+var _ = Describe("Scheduler", func() {
+	db := test.WithDatabase()
+
+	var (
+		dispatchManager *dispatchManagerMock
+		instance        *scheduler
+		now             time.Time
+	)
+
+	BeforeEach(func() {
+		labelCipher, err := encryption.NewLabelCipher(context.Background(), config.Get())
+		Expect(err).ToNot(HaveOccurred())
+
+		dispatchManager = &dispatchManagerMock{db: db}
+		instance = &scheduler{config: config.Get(), db: db(), dispatchManager: dispatchManager, labelCipher: labelCipher}
+		now = time.Now().UTC().Truncate(time.Second)
+	})
+
+	at := func(value time.Time) *time.Time {
+		return &value
+	}
+
+	newTemplate := func(nextRunAt *time.Time) dbModel.RunTemplate {
+		template := dbModel.RunTemplate{
+			ID:        uuid.New(),
+			OrgID:     "5318290",
+			Service:   "remediations",
+			Name:      "compliance scan",
+			Principal: "test_user",
+			Schedule:  "0 3 * * *",
+			Recipient: uuid.New(),
+			URL:       "http://example.com",
+			Labels:    dbModel.Labels{"policy": "cis"},
+			Enabled:   nextRunAt != nil,
+			NextRunAt: nextRunAt,
+		}
+
+		Expect(db().Create(&template).Error).ToNot(HaveOccurred())
+		return template
+	}
+
+	reload := func(template dbModel.RunTemplate) dbModel.RunTemplate {
+		var result dbModel.RunTemplate
+		Expect(db().Where("id = ?", template.ID).Take(&result).Error).ToNot(HaveOccurred())
+		return result
+	}
+
+	It("dispatches the run of a template due", func() {
+		template := newTemplate(at(now.Add(-time.Minute)))
+
+		instance.triggerTemplate(test.TestContext(), &template, now)
+
+		Expect(dispatchManager.runs).To(HaveLen(1))
+		Expect(dispatchManager.runs[0].Recipient).To(Equal(template.Recipient))
+		Expect(dispatchManager.runs[0].Url).To(Equal("http://example.com"))
+		Expect(dispatchManager.runs[0].Labels).To(Equal(map[string]string{"policy": "cis"}))
+		Expect(*dispatchManager.runs[0].Name).To(Equal("compliance scan"))
+
+		updated := reload(template)
+		Expect(updated.NextRunAt.After(now)).To(BeTrue())
+		Expect(updated.NextRunAt.UTC().Hour()).To(Equal(3))
+		Expect(updated.LastRunAt.Equal(now)).To(BeTrue())
+		Expect(updated.LastRunID).ToNot(BeNil())
+	})
+
+	It("does not dispatch a template claimed already", func() {
+		template := newTemplate(at(now.Add(-time.Minute)))
+		stale := template
+
+		instance.triggerTemplate(test.TestContext(), &template, now)
+		instance.triggerTemplate(test.TestContext(), &stale, now)
+
+		Expect(dispatchManager.runs).To(HaveLen(1))
+	})
+
+	It("schedules the next run if the dispatch fails", func() {
+		dispatchManager.err = errors.New("recipient not connected")
+		template := newTemplate(at(now.Add(-time.Minute)))
+
+		instance.triggerTemplate(test.TestContext(), &template, now)
+
+		updated := reload(template)
+		Expect(updated.NextRunAt.After(now)).To(BeTrue())
+		Expect(updated.LastRunAt.Equal(now)).To(BeTrue())
+		Expect(updated.LastRunID).To(BeNil())
+	})
+
+	It("only triggers templates due", func() {
+		due := newTemplate(at(now.Add(-time.Minute)))
+		newTemplate(at(now.Add(time.Hour)))
+		newTemplate(nil)
+
+		instance.trigger(test.TestContext(), now)
+
+		Expect(dispatchManager.runs).To(ContainElement(WithTransform(func(run generic.RunInput) uuid.UUID {
+			return run.Recipient
+		}, Equal(due.Recipient))))
+		Expect(reload(due).LastRunID).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"playbook-dispatcher/internal/common/kessel"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+var refreshErrorTotal = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "api_services_refresh_error_total",
+	Help: "The total number of errors refreshing the known services from the database",
+})
+
+// Start registers the services listed in services.known and, if services.refresh.interval is set, periodically
+// registers the services of existing runs so that a new service is known without a code change or redeploy.
+func Start(ctx context.Context, cfg *viper.Viper, db *gorm.DB, wg *sync.WaitGroup) error {
+	log := utils.GetLogFromContext(ctx)
+
+	known := []string{}
+	for _, service := range strings.Split(cfg.GetString("services.known"), ",") {
+		if service = strings.TrimSpace(service); service != "" {
+			known = append(known, service)
+		}
+	}
+
+	added, err := kessel.RegisterApplications(kessel.ApplicationSourceConfig, known...)
+	if err != nil {
+		return err
+	}
+
+	if len(added) > 0 {
+		log.Infow("Registered services", "services", added, "source", kessel.ApplicationSourceConfig)
+	}
+
+	interval := cfg.GetDuration("services.refresh.interval") * time.Second
+	if interval <= 0 {
+		return nil
+	}
+
+	refresh := func() {
+		var services []string
+		if err := db.WithContext(ctx).Model(&dbModel.Run{}).Distinct().Pluck("service", &services).Error; err != nil {
+			log.Errorw("Error refreshing the known services", "error", err)
+			refreshErrorTotal.Inc()
+			return
+		}
+
+		for _, service := range services {
+			added, err := kessel.RegisterApplications(kessel.ApplicationSourceDatabase, service)
+			if err != nil {
+				log.Warnw("Ignoring the service of existing runs", "service", service, "error", err)
+			} else if len(added) > 0 {
+				log.Infow("Registered services", "services", added, "source", kessel.ApplicationSourceDatabase)
+			}
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer ticker.Stop()
+
+		refresh()
+
+		for {
+			select {
+			case <-ticker.C:
+				refresh()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package slo
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"sync"
+	"time"
+
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+// objective: runs reach a terminal state within their timeout (plus a grace period)
+const sloRunCompletion = "run_completion"
+
+// the largest timeout of a run (RunTimeout in the API schema), bounds the runs whose deadline can fall within a window
+const maxRunTimeout = 7 * 24 * time.Hour
+
+// id of the advisory lock held by the jobs replica that evaluates the SLOs ("slo")
+const sloLockId = 0x736c6f
+
+var (
+	sloTarget = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "api_slo_target",
+		Help: "The target ratio of good events of the given SLO",
+	}, []string{"slo"})
+
+	sloErrorRatio = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "api_slo_error_ratio",
+		Help: "The ratio of bad events within the given window",
+	}, []string{"slo", "window"})
+
+	sloBurnRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "api_slo_burn_rate",
+		Help: "The rate at which the error budget is consumed within the given window (1 = budget exhausted exactly at the end of the SLO period)",
+	}, []string{"slo", "window"})
+
+	sloEvaluationErrorTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "api_slo_evaluation_error_total",
+		Help: "The total number of errors evaluating SLOs",
+	})
+)
+
+type counts struct {
+	Total int64
+	Bad   int64
+}
+
+// Start periodically evaluates the run completion SLO over each of the configured windows and exports
+// the error ratio and burn rate so that multi-window burn rate alerts can be defined on top of them directly.
+// With several replicas running the jobs only the one holding the SLO lock evaluates the SLO and exports the ratios.
+func Start(ctx context.Context, cfg *viper.Viper, db *gorm.DB, wg *sync.WaitGroup) {
+	log := utils.GetLogFromContext(ctx)
+
+	sqlDB, err := db.DB()
+	utils.DieOnError(err)
+	lock := &leaderLock{db: sqlDB, id: sloLockId}
+
+	target := cfg.GetFloat64("slo.run.completion.target")
+	grace := cfg.GetDuration("slo.run.completion.grace") * time.Second
+	windows, err := parseWindows(cfg.GetString("slo.windows"))
+	utils.DieOnError(err)
+
+	sloTarget.WithLabelValues(sloRunCompletion).Set(target)
+
+	evaluate := func() {
+		leader, err := lock.acquire(ctx)
+		if err != nil {
+			log.Errorw("Error acquiring SLO lock", "error", err)
+			sloEvaluationErrorTotal.Inc()
+		}
+
+		if !leader {
+			sloErrorRatio.Reset()
+			sloBurnRate.Reset()
+			return
+		}
+
+		for _, window := range windows {
+			result, err := countRunCompletion(ctx, db, window, grace)
+			if err != nil {
+				log.Errorw("Error evaluating SLO", "slo", sloRunCompletion, "window", window.String(), "error", err)
+				sloEvaluationErrorTotal.Inc()
+				continue
+			}
+
+			errorRatio := ratio(result.Bad, result.Total)
+			sloErrorRatio.WithLabelValues(sloRunCompletion, window.String()).Set(errorRatio)
+			sloBurnRate.WithLabelValues(sloRunCompletion, window.String()).Set(burnRate(errorRatio, target))
+		}
+	}
+
+	ticker := time.NewTicker(cfg.GetDuration("slo.evaluation.interval") * time.Second)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer ticker.Stop()
+		defer lock.release()
+
+		evaluate()
+
+		for {
+			select {
+			case <-ticker.C:
+				evaluate()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// A run is evaluated once its deadline (created_at + timeout + grace) falls within the window.
+// It counts as bad if by then it has not reached a terminal state, has timed out or has been finished only after the deadline.
+// The deadline cannot be indexed, the runs are narrowed down by their creation time first.
+func countRunCompletion(ctx context.Context, db *gorm.DB, window, grace time.Duration) (result counts, err error) {
+	deadline := "runs.created_at + (runs.timeout + ?) * interval '1 second'"
+	graceSeconds := int(grace.Seconds())
+	createdAfter := time.Now().Add(-(window + maxRunTimeout + grace))
+
+	err = db.WithContext(ctx).
+		Table("runs").
+		Select("count(*) AS total, count(*) FILTER (WHERE runs.status IN ? OR runs.updated_at > "+deadline+") AS bad",
+			status.Strings(append(status.Active(), status.Timeout)...), graceSeconds).
+		Where("runs.created_at >= ?", createdAfter).
+		Where(deadline+" BETWEEN NOW() - ? * interval '1 second' AND NOW()", graceSeconds, int(window.Seconds())).
+		Scan(&result).Error
+
+	return
+}
+
+// leaderLock is a session-level advisory lock, the replica holding it is the leader for as long as its connection lives
+type leaderLock struct {
+	db   *sql.DB
+	id   int64
+	conn *sql.Conn
+}
+
+// acquire tells whether this replica holds the lock, trying to take it if it does not
+func (this *leaderLock) acquire(ctx context.Context) (bool, error) {
+	if this.conn != nil {
+		if err := this.conn.PingContext(ctx); err == nil {
+			return true, nil
+		}
+
+		this.release()
+	}
+
+	conn, err := this.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	acquired := false
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", this.id).Scan(&acquired); err != nil || !acquired {
+		conn.Close()
+		return false, err
+	}
+
+	this.conn = conn
+	return true, nil
+}
+
+// release gives the lock up by closing the connection holding it rather than returning it to the pool
+func (this *leaderLock) release() {
+	if this.conn == nil {
+		return
+	}
+
+	_ = this.conn.Raw(func(any) error { return driver.ErrBadConn })
+	this.conn.Close()
+	this.conn = nil
+}
+
+func ratio(bad, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return float64(bad) / float64(total)
+}
+
+func burnRate(errorRatio, target float64) float64 {
+	budget := 1 - target
+	if budget <= 0 {
+		return 0
+	}
+
+	return errorRatio / budget
+}
+
+func parseWindows(value string) ([]time.Duration, error) {
+	result := []time.Duration{}
+
+	for _, window := range strings.Split(value, ",") {
+		window = strings.TrimSpace(window)
+		if window == "" {
+			continue
+		}
+
+		duration, err := time.ParseDuration(window)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, duration)
+	}
+
+	return result, nil
+}
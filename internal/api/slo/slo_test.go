@@ -0,0 +1,96 @@
+package slo
+
+import (
+	"context"
+	"playbook-dispatcher/internal/common/utils/test"
+	"playbook-dispatcher/pkg/status"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SLO", func() {
+	DescribeTable("burn rate",
+		func(bad, total int64, target, expected float64) {
+			Expect(burnRate(ratio(bad, total), target)).To(BeNumerically("~", expected, 0.0001))
+		},
+
+		Entry("no events", int64(0), int64(0), 0.95, 0.0),
+		Entry("no bad events", int64(0), int64(100), 0.95, 0.0),
+		Entry("budget consumed at the expected rate", int64(5), int64(100), 0.95, 1.0),
+		Entry("budget consumed fast", int64(50), int64(100), 0.95, 10.0),
+		Entry("no budget", int64(5), int64(100), 1.0, 0.0),
+	)
+
+	Describe("windows", func() {
+		It("parses a list of windows", func() {
+			windows, err := parseWindows("5m, 1h,72h")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(windows).To(Equal([]time.Duration{5 * time.Minute, time.Hour, 72 * time.Hour}))
+		})
+
+		It("rejects an invalid window", func() {
+			_, err := parseWindows("5m,1d")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("run completion", func() {
+		db := test.WithDatabase()
+
+		createRun := func(runStatus status.Status, age time.Duration, timeout int, finishedAfter time.Duration) {
+			run := test.NewRunWithStatus("12345", string(runStatus))
+			run.CreatedAt = time.Now().Add(-age)
+			run.UpdatedAt = run.CreatedAt.Add(finishedAfter)
+			run.Timeout = timeout
+			Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+		}
+
+		It("counts the runs whose deadline falls within the window", func() {
+			window := time.Hour
+			grace := 5 * time.Minute
+
+			before, err := countRunCompletion(context.Background(), db(), window, grace)
+			Expect(err).ToNot(HaveOccurred())
+
+			// deadline 55 minutes ago
+			createRun(status.Success, 2*time.Hour, 3600, 10*time.Minute)
+			createRun(status.Timeout, 2*time.Hour, 3600, 65*time.Minute)
+			createRun(status.Success, 2*time.Hour, 3600, 2*time.Hour)
+			createRun(status.Running, 2*time.Hour, 3600, 0)
+			// the largest timeout, deadline 25 minutes ago
+			createRun(status.Failure, maxRunTimeout+30*time.Minute, int(maxRunTimeout.Seconds()), time.Hour)
+			// deadline outside of the window
+			createRun(status.Running, 5*time.Hour, 3600, 0)
+			// deadline not reached yet
+			createRun(status.Running, 0, 3600, 0)
+
+			after, err := countRunCompletion(context.Background(), db(), window, grace)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(after.Total - before.Total).To(BeEquivalentTo(5))
+			Expect(after.Bad - before.Bad).To(BeEquivalentTo(3))
+		})
+	})
+
+	Describe("leader lock", func() {
+		db := test.WithDatabase()
+
+		It("is held by a single replica at a time", func() {
+			sqlDB, err := db().DB()
+			Expect(err).ToNot(HaveOccurred())
+
+			first := &leaderLock{db: sqlDB, id: sloLockId + 1}
+			second := &leaderLock{db: sqlDB, id: sloLockId + 1}
+			defer second.release()
+
+			Expect(first.acquire(context.Background())).To(BeTrue())
+			Expect(second.acquire(context.Background())).To(BeFalse())
+			Expect(first.acquire(context.Background())).To(BeTrue())
+
+			first.release()
+			Expect(second.acquire(context.Background())).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,112 @@
+package stuck
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	commonInstrumentation "playbook-dispatcher/internal/common/instrumentation"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/jobs/sweeper"
+	"playbook-dispatcher/pkg/status"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+var (
+	stuckRuns = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "api_stuck_runs",
+		Help: "The number of runs still running past their timeout plus grace period",
+	}, []string{"dispatching_service"})
+
+	stuckRunsTimedOutTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "api_stuck_runs_timed_out_total",
+		Help: "The total number of stuck runs transitioned to the timeout state",
+	})
+
+	stuckRunsErrorTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "api_stuck_runs_error_total",
+		Help: "The total number of errors detecting stuck runs",
+	})
+
+	stuckRunsServices = commonInstrumentation.NewLabelGuard("api_stuck_runs", "dispatching_service", commonInstrumentation.DefaultLabelLimit)
+)
+
+type serviceCount struct {
+	Service string
+	Count   int64
+}
+
+// Start periodically looks for runs that are still running past created_at + timeout + grace, exports their number
+// per dispatching service and (if enabled) transitions them to the timeout state.
+func Start(ctx context.Context, cfg *viper.Viper, db *gorm.DB, wg *sync.WaitGroup) {
+	log := utils.GetLogFromContext(ctx)
+
+	grace := cfg.GetDuration("stuck.runs.grace") * time.Second
+	timeoutEnabled := cfg.GetBool("stuck.runs.timeout.enabled")
+
+	detect := func() {
+		counts, err := countStuckRuns(ctx, db, grace)
+		if err != nil {
+			log.Errorw("Error detecting stuck runs", "error", err)
+			stuckRunsErrorTotal.Inc()
+			return
+		}
+
+		stuckRuns.Reset()
+		for _, count := range counts {
+			stuckRuns.WithLabelValues(stuckRunsServices.Value(count.Service)).Add(float64(count.Count))
+		}
+
+		if !timeoutEnabled || len(counts) == 0 {
+			return
+		}
+
+		options := sweeper.OptionsFromConfig(cfg)
+		options.Grace = grace
+
+		result, err := sweeper.Sweep(ctx, db, options)
+		if err != nil {
+			log.Errorw("Error timing out stuck runs", "error", err)
+			stuckRunsErrorTotal.Inc()
+			return
+		}
+
+		stuckRunsTimedOutTotal.Add(float64(result.Runs))
+	}
+
+	ticker := time.NewTicker(cfg.GetDuration("stuck.runs.interval") * time.Second)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer ticker.Stop()
+
+		detect()
+
+		for {
+			select {
+			case <-ticker.C:
+				detect()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func countStuckRuns(ctx context.Context, db *gorm.DB, grace time.Duration) (result []serviceCount, err error) {
+	err = db.WithContext(ctx).
+		Model(&dbModel.Run{}).
+		Select("runs.service, count(*) AS count").
+		Where("runs.status IN ?", status.Strings(status.Active()...)).
+		Where("runs.created_at + (runs.timeout + ?) * interval '1 second' <= NOW()", int(grace.Seconds())).
+		Group("runs.service").
+		Scan(&result).Error
+
+	return
+}
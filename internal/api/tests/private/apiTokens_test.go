@@ -0,0 +1,84 @@
+package private
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/apitokens"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func createApiToken(input ApiTokenInput) *ApiInternalV2ApiTokensCreateResponse {
+	resp, err := client.ApiInternalV2ApiTokensCreate(test.TestContext(), input)
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV2ApiTokensCreateResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+	return res
+}
+
+func listApiTokens(org OrgId) []ApiToken {
+	resp, err := client.ApiInternalV2ApiTokensList(test.TestContext(), &ApiInternalV2ApiTokensListParams{OrgId: org})
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV2ApiTokensListResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(res.StatusCode()).To(Equal(http.StatusOK))
+	return *res.JSON200
+}
+
+func deleteApiToken(id uuid.UUID, org OrgId) int {
+	resp, err := client.ApiInternalV2ApiTokensDelete(test.TestContext(), id, &ApiInternalV2ApiTokensDeleteParams{OrgId: org})
+	Expect(err).ToNot(HaveOccurred())
+	return resp.StatusCode
+}
+
+var _ = Describe("api tokens", func() {
+	db := test.WithDatabase()
+
+	It("mints a token stored as a hash", func() {
+		org := OrgId(orgId())
+		expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+		res := createApiToken(ApiTokenInput{OrgId: org, Name: "ci", ExpiresAt: &expiresAt})
+		Expect(res.StatusCode()).To(Equal(http.StatusCreated))
+		Expect(res.JSON201.OrgId).To(Equal(org))
+		Expect(res.JSON201.Name).To(Equal("ci"))
+		Expect(res.JSON201.ExpiresAt.Equal(expiresAt)).To(BeTrue())
+		Expect(res.JSON201.Token).To(HavePrefix(apitokens.Prefix))
+
+		var stored dbModel.ApiToken
+		Expect(db().Where("id = ?", res.JSON201.Id).Take(&stored).Error).ToNot(HaveOccurred())
+		Expect(stored.Hash).To(Equal(apitokens.Hash(res.JSON201.Token)))
+	})
+
+	It("rejects an expiry in the past", func() {
+		expiresAt := time.Now().Add(-time.Hour)
+		res := createApiToken(ApiTokenInput{OrgId: OrgId(orgId()), Name: "ci", ExpiresAt: &expiresAt})
+		Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+
+	It("lists the tokens of the org without the token", func() {
+		org := OrgId(orgId())
+		first := createApiToken(ApiTokenInput{OrgId: org, Name: "first"})
+		createApiToken(ApiTokenInput{OrgId: OrgId(orgId() + "1"), Name: "other"})
+
+		tokens := listApiTokens(org)
+		Expect(tokens).To(HaveLen(1))
+		Expect(tokens[0].Id).To(Equal(first.JSON201.Id))
+		Expect(tokens[0].Name).To(Equal("first"))
+		Expect(tokens[0].ExpiresAt).To(BeNil())
+	})
+
+	It("revokes a token", func() {
+		org := OrgId(orgId())
+		created := createApiToken(ApiTokenInput{OrgId: org, Name: "ci"})
+
+		Expect(deleteApiToken(created.JSON201.Id, OrgId("1234567"))).To(Equal(http.StatusNotFound))
+		Expect(deleteApiToken(created.JSON201.Id, org)).To(Equal(http.StatusNoContent))
+		Expect(listApiTokens(org)).To(BeEmpty())
+		Expect(deleteApiToken(created.JSON201.Id, org)).To(Equal(http.StatusNotFound))
+	})
+})
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	externalRef0 "playbook-dispatcher/internal/api/controllers/public"
 
@@ -19,6 +20,72 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for FeaturesKesselMode.
+const (
+	BothKesselEnforces FeaturesKesselMode = "both-kessel-enforces"
+	BothRbacEnforces   FeaturesKesselMode = "both-rbac-enforces"
+	KesselOnly         FeaturesKesselMode = "kessel-only"
+	RbacOnly           FeaturesKesselMode = "rbac-only"
+)
+
+// Valid indicates whether the value is a known member of the FeaturesKesselMode enum.
+func (e FeaturesKesselMode) Valid() bool {
+	switch e {
+	case BothKesselEnforces:
+		return true
+	case BothRbacEnforces:
+		return true
+	case KesselOnly:
+		return true
+	case RbacOnly:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for KnownServiceSource.
+const (
+	Builtin  KnownServiceSource = "builtin"
+	Config   KnownServiceSource = "config"
+	Database KnownServiceSource = "database"
+)
+
+// Valid indicates whether the value is a known member of the KnownServiceSource enum.
+func (e KnownServiceSource) Valid() bool {
+	switch e {
+	case Builtin:
+		return true
+	case Config:
+		return true
+	case Database:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PreflightReason.
+const (
+	PlaybookHeaderMissing  PreflightReason = "playbook_header_missing"
+	PlaybookUrlUnreachable PreflightReason = "playbook_url_unreachable"
+	RecipientNotConnected  PreflightReason = "recipient_not_connected"
+)
+
+// Valid indicates whether the value is a known member of the PreflightReason enum.
+func (e PreflightReason) Valid() bool {
+	switch e {
+	case PlaybookHeaderMissing:
+		return true
+	case PlaybookUrlUnreachable:
+		return true
+	case RecipientNotConnected:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for RecipientType.
 const (
 	DirectConnect RecipientType = "directConnect"
@@ -61,23 +128,81 @@ func (e RecipientWithConnectionInfoStatus) Valid() bool {
 	}
 }
 
+// Defines values for RunGroupStatusStatus.
+const (
+	Failure RunGroupStatusStatus = "failure"
+	Running RunGroupStatusStatus = "running"
+	Success RunGroupStatusStatus = "success"
+)
+
+// Valid indicates whether the value is a known member of the RunGroupStatusStatus enum.
+func (e RunGroupStatusStatus) Valid() bool {
+	switch e {
+	case Failure:
+		return true
+	case Running:
+		return true
+	case Success:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RunPriority.
+const (
+	High   RunPriority = "high"
+	Low    RunPriority = "low"
+	Normal RunPriority = "normal"
+)
+
+// Valid indicates whether the value is a known member of the RunPriority enum.
+func (e RunPriority) Valid() bool {
+	switch e {
+	case High:
+		return true
+	case Low:
+		return true
+	case Normal:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ApiInternalV2RunHostsListParamsFieldsData.
 const (
-	Host        ApiInternalV2RunHostsListParamsFieldsData = "host"
-	InventoryId ApiInternalV2RunHostsListParamsFieldsData = "inventory_id"
-	Links       ApiInternalV2RunHostsListParamsFieldsData = "links"
-	Run         ApiInternalV2RunHostsListParamsFieldsData = "run"
-	Status      ApiInternalV2RunHostsListParamsFieldsData = "status"
-	Stdout      ApiInternalV2RunHostsListParamsFieldsData = "stdout"
+	CancelState      ApiInternalV2RunHostsListParamsFieldsData = "cancel_state"
+	Diffs            ApiInternalV2RunHostsListParamsFieldsData = "diffs"
+	DisplayName      ApiInternalV2RunHostsListParamsFieldsData = "display_name"
+	Host             ApiInternalV2RunHostsListParamsFieldsData = "host"
+	Id               ApiInternalV2RunHostsListParamsFieldsData = "id"
+	InventoryId      ApiInternalV2RunHostsListParamsFieldsData = "inventory_id"
+	LastUpdatedDelta ApiInternalV2RunHostsListParamsFieldsData = "last_updated_delta"
+	Links            ApiInternalV2RunHostsListParamsFieldsData = "links"
+	Run              ApiInternalV2RunHostsListParamsFieldsData = "run"
+	Status           ApiInternalV2RunHostsListParamsFieldsData = "status"
+	Stdout           ApiInternalV2RunHostsListParamsFieldsData = "stdout"
+	StdoutSize       ApiInternalV2RunHostsListParamsFieldsData = "stdout_size"
 )
 
 // Valid indicates whether the value is a known member of the ApiInternalV2RunHostsListParamsFieldsData enum.
 func (e ApiInternalV2RunHostsListParamsFieldsData) Valid() bool {
 	switch e {
+	case CancelState:
+		return true
+	case Diffs:
+		return true
+	case DisplayName:
+		return true
 	case Host:
 		return true
+	case Id:
+		return true
 	case InventoryId:
 		return true
+	case LastUpdatedDelta:
+		return true
 	case Links:
 		return true
 	case Run:
@@ -86,11 +211,69 @@ func (e ApiInternalV2RunHostsListParamsFieldsData) Valid() bool {
 		return true
 	case Stdout:
 		return true
+	case StdoutSize:
+		return true
 	default:
 		return false
 	}
 }
 
+// ApiToken defines model for ApiToken.
+type ApiToken struct {
+	CreatedAt time.Time          `json:"created_at"`
+	ExpiresAt *time.Time         `json:"expires_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+}
+
+// ApiTokenCreated defines model for ApiTokenCreated.
+type ApiTokenCreated struct {
+	CreatedAt time.Time          `json:"created_at"`
+	ExpiresAt *time.Time         `json:"expires_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Token The token to present in the Authorization header, only ever returned when the token is created
+	Token string `json:"token"`
+}
+
+// ApiTokenInput defines model for ApiTokenInput.
+type ApiTokenInput struct {
+	// ExpiresAt Time after which the token is rejected, the token does not expire if not set
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Name Describes what the token is used for
+	Name string `json:"name"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+}
+
+// ApiVersion defines model for ApiVersion.
+type ApiVersion struct {
+	// Specification Path of the OpenAPI specification of the version
+	Specification string `json:"specification"`
+	Version       string `json:"version"`
+}
+
+// CancelFilterInputV2 defines model for CancelFilterInputV2.
+type CancelFilterInputV2 struct {
+	// Filter Criteria the runs need to match all of, at least one needs to be given
+	Filter RunsCancelFilter `json:"filter"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Principal Username of the user interacting with the service
+	Principal Principal `json:"principal"`
+}
+
 // CancelInputV2 defines model for CancelInputV2.
 type CancelInputV2 struct {
 	// OrgId Identifies the organization that the given resource belongs to
@@ -103,12 +286,61 @@ type CancelInputV2 struct {
 	RunId externalRef0.RunId `json:"run_id"`
 }
 
+// DebugCapture defines model for DebugCapture.
+type DebugCapture struct {
+	DurationMs   int64     `json:"duration_ms"`
+	Method       string    `json:"method"`
+	OrgId        string    `json:"org_id"`
+	RequestBody  string    `json:"request_body"`
+	RequestId    string    `json:"request_id"`
+	ResponseBody string    `json:"response_body"`
+	Status       int       `json:"status"`
+	Timestamp    time.Time `json:"timestamp"`
+	Url          string    `json:"url"`
+}
+
+// Deployment defines model for Deployment.
+type Deployment struct {
+	ApiVersions []ApiVersion `json:"api_versions"`
+
+	// Commit Git revision the API was built from
+	Commit   string   `json:"commit"`
+	Features Features `json:"features"`
+
+	// SchemaVersion Database schema version the code is written against
+	SchemaVersion int `json:"schema_version"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Message Human readable error message
 	Message string `json:"message"`
 }
 
+// Features defines model for Features.
+type Features struct {
+	// ApiTokens Whether the public API accepts API tokens
+	ApiTokens bool `json:"api_tokens"`
+
+	// Kessel Whether Kessel is enabled
+	Kessel bool `json:"kessel"`
+
+	// KesselMode Authorization mode used unless overridden per request by a feature flag
+	KesselMode FeaturesKesselMode `json:"kessel_mode"`
+
+	// PublicRateLimit Whether the public API is rate limited
+	PublicRateLimit bool `json:"public_rate_limit"`
+
+	// Rbac Whether permissions are checked with RBAC (otherwise every principal is allowed)
+	Rbac bool `json:"rbac"`
+
+	// WaitForConnection Whether runs of disconnected recipients wait for the recipient to connect
+	WaitForConnection bool `json:"wait_for_connection"`
+}
+
+// FeaturesKesselMode Authorization mode used unless overridden per request by a feature flag
+type FeaturesKesselMode string
+
 // HighLevelRecipientStatus defines model for HighLevelRecipientStatus.
 type HighLevelRecipientStatus = []RecipientWithConnectionInfo
 
@@ -123,9 +355,40 @@ type HostsWithOrgId struct {
 	OrgId OrgId `json:"org_id"`
 }
 
+// KnownService defines model for KnownService.
+type KnownService struct {
+	// Name Service that triggered the given Playbook run
+	Name externalRef0.Service `json:"name"`
+
+	// Permission Kessel permission to view the runs of the service
+	Permission string             `json:"permission"`
+	Source     KnownServiceSource `json:"source"`
+}
+
+// KnownServiceSource defines model for KnownService.Source.
+type KnownServiceSource string
+
 // OrgId Identifies the organization that the given resource belongs to
 type OrgId = string
 
+// OrgUsage defines model for OrgUsage.
+type OrgUsage struct {
+	// ApiCalls Number of public API calls made within the period
+	ApiCalls int64 `json:"api_calls"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// RunsCreated Number of runs created within the period
+	RunsCreated int64 `json:"runs_created"`
+
+	// StdoutBytes Size of run host output stored for runs created within the period
+	StdoutBytes int64 `json:"stdout_bytes"`
+}
+
+// PreflightReason Check that failed the pre-flight check of a run dispatched with check_recipient (code 409)
+type PreflightReason string
+
 // Principal Username of the user interacting with the service
 type Principal = string
 
@@ -192,6 +455,9 @@ type RunCanceled struct {
 	// Code status code of the request
 	Code int `json:"code"`
 
+	// CorrelationId Unique identifier used to match work request with responses
+	CorrelationId *externalRef0.RunCorrelationId `json:"correlation_id,omitempty"`
+
 	// RunId Unique identifier of a Playbook run
 	RunId externalRef0.RunId `json:"run_id"`
 }
@@ -201,13 +467,150 @@ type RunCreated struct {
 	// Code status code of the request
 	Code int `json:"code"`
 
+	// CorrelationId Unique identifier used to match work request with responses
+	CorrelationId *externalRef0.RunCorrelationId `json:"correlation_id,omitempty"`
+
+	// DryRun How the run would be dispatched, only returned by /internal/v2/dispatch/validate. The code is 200 if the run would be created, otherwise it is the code the dispatch would fail with.
+	DryRun *RunDryRun `json:"dry_run,omitempty"`
+
 	// Id Unique identifier of a Playbook run
 	Id *externalRef0.RunId `json:"id,omitempty"`
 
 	// Message Error Message
 	Message *string `json:"message,omitempty"`
+
+	// Reason Check that failed the pre-flight check of a run dispatched with check_recipient (code 409)
+	Reason *PreflightReason `json:"reason,omitempty"`
+}
+
+// RunDryRun How the run would be dispatched, only returned by /internal/v2/dispatch/validate. The code is 200 if the run would be created, otherwise it is the code the dispatch would fail with.
+type RunDryRun struct {
+	// DispatchChunks Number of requests the hosts of the run would be sent in
+	DispatchChunks int    `json:"dispatch_chunks"`
+	ExecutionMode  string `json:"execution_mode"`
+
+	// Hosts Number of hosts of the run
+	Hosts int `json:"hosts"`
+
+	// PlaybookUrlReachable The playbook URL responded (with a status code below 500)
+	PlaybookUrlReachable bool `json:"playbook_url_reachable"`
+
+	// Protocol Either rhc (directly connected hosts) or satellite
+	Protocol           string `json:"protocol"`
+	RecipientConnected bool   `json:"recipient_connected"`
+
+	// SatelliteRegistered Satellite runs only, whether the Satellite is registered in Sources for the organization with the recipient as its rhc connection
+	SatelliteRegistered *bool `json:"satellite_registered,omitempty"`
+
+	// Timeout Timeout of the run, defaults applied
+	Timeout int `json:"timeout"`
+
+	// WaitsForConnection The recipient is not connected, the run would be kept until it connects
+	WaitsForConnection bool   `json:"waits_for_connection"`
+	WebConsoleUrl      string `json:"web_console_url"`
+}
+
+// RunGroupCounts Number of runs of the group in each status
+type RunGroupCounts struct {
+	Canceled             int `json:"canceled"`
+	Canceling            int `json:"canceling"`
+	Failure              int `json:"failure"`
+	Running              int `json:"running"`
+	Success              int `json:"success"`
+	Timeout              int `json:"timeout"`
+	Total                int `json:"total"`
+	WaitingForConnection int `json:"waiting_for_connection"`
+}
+
+// RunGroupCreated defines model for RunGroupCreated.
+type RunGroupCreated struct {
+	GroupId openapi_types.UUID `json:"group_id"`
+
+	// Runs Result of each recipient, in the order of the recipients
+	Runs []RunCreated `json:"runs"`
+}
+
+// RunGroupInputV3 defines model for RunGroupInputV3.
+type RunGroupInputV3 struct {
+	// ExecutionMode Whether the playbook is run normally (run) or in check mode (check). In check mode Ansible reports the changes it would make, including their diffs, without making them.
+	ExecutionMode *externalRef0.ExecutionMode `json:"execution_mode,omitempty"`
+
+	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
+	Labels *externalRef0.Labels `json:"labels,omitempty"`
+
+	// Name Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
+	Name externalRef0.PlaybookName `json:"name"`
+
+	// OrgId Identifier of the tenant
+	OrgId externalRef0.OrgId `json:"org_id"`
+
+	// Principal Username of the user interacting with the service
+	Principal Principal `json:"principal"`
+
+	// Priority Priority of the run. While the rate limit of Cloud Connector holds up dispatches, the requests of each priority wait in a queue of their own and the queues are drained by weight (6:3:1 by default) so that urgent runs are not delayed behind bulk ones.
+	Priority   *RunPriority        `json:"priority,omitempty"`
+	Recipients []RunGroupRecipient `json:"recipients"`
+
+	// Timeout Amount of seconds after which the run is considered failed due to timeout
+	Timeout *externalRef0.RunTimeout `json:"timeout,omitempty"`
+
+	// Url URL hosting the Playbook
+	Url externalRef0.Url `json:"url"`
+
+	// WaitForConnection Runs of recipients that are not connected are created in the waiting_for_connection state instead of being rejected, see /internal/v2/dispatch.
+	WaitForConnection *bool `json:"wait_for_connection,omitempty"`
+
+	// WebConsoleUrl URL that points to the section of the web console where the user find more information about the playbook run. The field is optional but highly suggested.
+	WebConsoleUrl *externalRef0.WebConsoleUrl `json:"web_console_url,omitempty"`
+}
+
+// RunGroupRecipient defines model for RunGroupRecipient.
+type RunGroupRecipient struct {
+	// Hosts Optionally, information about hosts involved in the Playbook run can be provided.
+	// This information is used to pre-allocate run_host resources.
+	// Moreover, it can be used to create a connection between a run_host resource and host inventory.
+	Hosts *RunInputHosts `json:"hosts,omitempty"`
+
+	// Recipient Identifier of the host to which a given Playbook is addressed
+	Recipient externalRef0.RunRecipient `json:"recipient"`
+
+	// RecipientConfig recipient-specific configuration options
+	RecipientConfig *RecipientConfig `json:"recipient_config,omitempty"`
+}
+
+// RunGroupRun defines model for RunGroupRun.
+type RunGroupRun struct {
+	// Id Unique identifier of a Playbook run
+	Id externalRef0.RunId `json:"id"`
+
+	// Recipient Identifier of the host to which a given Playbook is addressed
+	Recipient externalRef0.RunRecipient `json:"recipient"`
+
+	// Status Current status of a Playbook run. A run that asked to wait for its recipient to connect is waiting_for_connection until it is dispatched. A canceled run is canceling until the recipient acknowledges the cancel.
+	Status externalRef0.RunStatus `json:"status"`
+}
+
+// RunGroupStatus defines model for RunGroupStatus.
+type RunGroupStatus struct {
+	// Counts Number of runs of the group in each status
+	Counts    RunGroupCounts     `json:"counts"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Name Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
+	Name externalRef0.PlaybookName `json:"name"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId         `json:"org_id"`
+	Runs  []RunGroupRun `json:"runs"`
+
+	// Status running as long as any run is running, canceling or waiting for connection, then success if every run succeeded and failure otherwise
+	Status RunGroupStatusStatus `json:"status"`
 }
 
+// RunGroupStatusStatus running as long as any run is running, canceling or waiting for connection, then success if every run succeeded and failure otherwise
+type RunGroupStatusStatus string
+
 // RunInput defines model for RunInput.
 type RunInput struct {
 	// Account Identifier of the tenant
@@ -249,6 +652,12 @@ type RunInputHosts = []struct {
 
 // RunInputV2 defines model for RunInputV2.
 type RunInputV2 struct {
+	// CheckRecipient Before the run is dispatched, check that the recipient is connected and that the playbook URL responds to a HEAD request (carrying the playbook signature if configured). The run is rejected with 409 and a reason otherwise.
+	CheckRecipient *bool `json:"check_recipient,omitempty"`
+
+	// ExecutionMode Whether the playbook is run normally (run) or in check mode (check). In check mode Ansible reports the changes it would make, including their diffs, without making them.
+	ExecutionMode *externalRef0.ExecutionMode `json:"execution_mode,omitempty"`
+
 	// Hosts Optionally, information about hosts involved in the Playbook run can be provided.
 	// This information is used to pre-allocate run_host resources.
 	// Moreover, it can be used to create a connection between a run_host resource and host inventory.
@@ -266,6 +675,9 @@ type RunInputV2 struct {
 	// Principal Username of the user interacting with the service
 	Principal Principal `json:"principal"`
 
+	// Priority Priority of the run. While the rate limit of Cloud Connector holds up dispatches, the requests of each priority wait in a queue of their own and the queues are drained by weight (6:3:1 by default) so that urgent runs are not delayed behind bulk ones.
+	Priority *RunPriority `json:"priority,omitempty"`
+
 	// Recipient Identifier of the host to which a given Playbook is addressed
 	Recipient externalRef0.RunRecipient `json:"recipient"`
 
@@ -278,76 +690,302 @@ type RunInputV2 struct {
 	// Url URL hosting the Playbook
 	Url externalRef0.Url `json:"url"`
 
+	// WaitForConnection If the recipient is not connected the run is created in the waiting_for_connection state instead of being rejected. It is dispatched once the recipient connects, unless that takes longer than the configured window.
+	WaitForConnection *bool `json:"wait_for_connection,omitempty"`
+
 	// WebConsoleUrl URL that points to the section of the web console where the user find more information about the playbook run. The field is optional but highly suggested.
 	WebConsoleUrl *externalRef0.WebConsoleUrl `json:"web_console_url,omitempty"`
 }
 
-// RunsCanceled defines model for RunsCanceled.
-type RunsCanceled = []RunCanceled
+// RunLimit defines model for RunLimit.
+type RunLimit struct {
+	// MaxRunning Maximum number of runs running at a time, 0 if not limited
+	MaxRunning int `json:"max_running"`
 
-// RunsCreated defines model for RunsCreated.
-type RunsCreated = []RunCreated
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
 
-// SatelliteId Identifier of the Satellite instance in the uuid v4/v5 format
-type SatelliteId = string
+	// Override Whether the limit is set for the organization rather than the default one
+	Override bool `json:"override"`
 
-// SatelliteOrgId Identifier of the organization within Satellite
-type SatelliteOrgId = string
+	// Running Number of runs of the organization counting against the limit, i.e. running, being canceled or waiting for their recipient to connect
+	Running int `json:"running"`
+}
 
-// Version Version of the API
-type Version = string
+// RunLimitInput defines model for RunLimitInput.
+type RunLimitInput struct {
+	// MaxRunning Maximum number of runs running at a time, 0 disables the limit
+	MaxRunning int `json:"max_running"`
+}
 
-// BadRequest defines model for BadRequest.
-type BadRequest = Error
+// RunPriority Priority of the run. While the rate limit of Cloud Connector holds up dispatches, the requests of each priority wait in a queue of their own and the queues are drained by weight (6:3:1 by default) so that urgent runs are not delayed behind bulk ones.
+type RunPriority string
 
-// Forbidden defines model for Forbidden.
-type Forbidden = Error
+// RunRetryInputV2 defines model for RunRetryInputV2.
+type RunRetryInputV2 struct {
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
 
-// ApiInternalRunsCreateJSONBody defines parameters for ApiInternalRunsCreate.
-type ApiInternalRunsCreateJSONBody = []RunInput
+	// Principal Username of the user interacting with the service
+	Principal Principal `json:"principal"`
+}
 
-// ApiInternalV2RunsCancelJSONBody defines parameters for ApiInternalV2RunsCancel.
-type ApiInternalV2RunsCancelJSONBody = []CancelInputV2
+// RunTemplate defines model for RunTemplate.
+type RunTemplate struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Enabled   bool               `json:"enabled"`
+	Id        openapi_types.UUID `json:"id"`
 
-// ApiInternalV2RunsCreateJSONBody defines parameters for ApiInternalV2RunsCreate.
-type ApiInternalV2RunsCreateJSONBody = []RunInputV2
+	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
+	Labels    externalRef0.Labels `json:"labels"`
+	LastRunAt *time.Time          `json:"last_run_at"`
 
-// ApiInternalV2RecipientsStatusJSONBody defines parameters for ApiInternalV2RecipientsStatus.
-type ApiInternalV2RecipientsStatusJSONBody = []RecipientWithOrg
+	// LastRunId Run dispatched last, not set if the last dispatch failed
+	LastRunId *openapi_types.UUID `json:"last_run_id"`
 
-// ApiInternalV2RunHostsListParams defines parameters for ApiInternalV2RunHostsList.
-type ApiInternalV2RunHostsListParams struct {
-	// Filter Allows for filtering based on various criteria
-	Filter *externalRef0.RunHostFilter `json:"filter,omitempty"`
+	// Name Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
+	Name externalRef0.PlaybookName `json:"name"`
 
-	// Fields Defines fields to be returned in the response.
-	Fields *externalRef0.RunHostFields `json:"fields,omitempty"`
+	// NextRunAt Time the next run is dispatched at, not set while the template is disabled
+	NextRunAt *time.Time `json:"next_run_at"`
 
-	// Limit Maximum number of results to return
-	Limit *externalRef0.Limit `form:"limit,omitempty" json:"limit,omitempty"`
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
 
-	// Offset Indicates the starting position of the query relative to the complete set of items that match the query
-	Offset *externalRef0.Offset `form:"offset,omitempty" json:"offset,omitempty"`
-}
+	// Principal Username of the user interacting with the service
+	Principal Principal `json:"principal"`
 
-// ApiInternalV2RunHostsListParamsFieldsData defines parameters for ApiInternalV2RunHostsList.
-type ApiInternalV2RunHostsListParamsFieldsData string
+	// Recipient Identifier of the host to which a given Playbook is addressed
+	Recipient externalRef0.RunRecipient `json:"recipient"`
 
-// ApiInternalRunsCreateJSONRequestBody defines body for ApiInternalRunsCreate for application/json ContentType.
-type ApiInternalRunsCreateJSONRequestBody = ApiInternalRunsCreateJSONBody
+	// Schedule Cron expression (minute, hour, day of month, month, day of week) or one of the @hourly, @daily, @weekly and @monthly shorthands, evaluated in UTC
+	Schedule RunTemplateSchedule `json:"schedule"`
 
-// ApiInternalV2RunsCancelJSONRequestBody defines body for ApiInternalV2RunsCancel for application/json ContentType.
-type ApiInternalV2RunsCancelJSONRequestBody = ApiInternalV2RunsCancelJSONBody
+	// Service Service that created the template, runs are dispatched on its behalf
+	Service   string    `json:"service"`
+	Timeout   *int      `json:"timeout"`
+	UpdatedAt time.Time `json:"updated_at"`
 
-// ApiInternalHighlevelConnectionStatusJSONRequestBody defines body for ApiInternalHighlevelConnectionStatus for application/json ContentType.
-type ApiInternalHighlevelConnectionStatusJSONRequestBody = HostsWithOrgId
+	// Url URL hosting the Playbook
+	Url           externalRef0.Url `json:"url"`
+	WebConsoleUrl *string          `json:"web_console_url"`
+}
 
-// ApiInternalV2RunsCreateJSONRequestBody defines body for ApiInternalV2RunsCreate for application/json ContentType.
+// RunTemplateInput defines model for RunTemplateInput.
+type RunTemplateInput struct {
+	// Enabled Runs are only dispatched while the template is enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
+	Labels *externalRef0.Labels `json:"labels,omitempty"`
+
+	// Name Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
+	Name externalRef0.PlaybookName `json:"name"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Principal Username of the user interacting with the service
+	Principal Principal `json:"principal"`
+
+	// Recipient Identifier of the host to which a given Playbook is addressed
+	Recipient externalRef0.RunRecipient `json:"recipient"`
+
+	// Schedule Cron expression (minute, hour, day of month, month, day of week) or one of the @hourly, @daily, @weekly and @monthly shorthands, evaluated in UTC
+	Schedule RunTemplateSchedule `json:"schedule"`
+
+	// Timeout Amount of seconds after which the run is considered failed due to timeout
+	Timeout *externalRef0.RunTimeout `json:"timeout,omitempty"`
+
+	// Url URL hosting the Playbook
+	Url externalRef0.Url `json:"url"`
+
+	// WebConsoleUrl URL that points to the section of the web console where the user find more information about the playbook run. The field is optional but highly suggested.
+	WebConsoleUrl *externalRef0.WebConsoleUrl `json:"web_console_url,omitempty"`
+}
+
+// RunTemplateSchedule Cron expression (minute, hour, day of month, month, day of week) or one of the @hourly, @daily, @weekly and @monthly shorthands, evaluated in UTC
+type RunTemplateSchedule = string
+
+// RunsCancelFilter Criteria the runs need to match all of, at least one needs to be given
+type RunsCancelFilter struct {
+	CreatedBefore *time.Time `json:"created_before,omitempty"`
+
+	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
+	Labels *externalRef0.Labels `json:"labels,omitempty"`
+
+	// Service Service that dispatched the runs
+	Service *string `json:"service,omitempty"`
+}
+
+// RunsCancelSummary defines model for RunsCancelSummary.
+type RunsCancelSummary struct {
+	// Canceled Number of runs canceled
+	Canceled int `json:"canceled"`
+
+	// Failed Number of runs that could not be canceled
+	Failed int `json:"failed"`
+
+	// More More runs match the filter than canceled in a single request
+	More bool         `json:"more"`
+	Runs RunsCanceled `json:"runs"`
+}
+
+// RunsCanceled defines model for RunsCanceled.
+type RunsCanceled = []RunCanceled
+
+// RunsCreated defines model for RunsCreated.
+type RunsCreated = []RunCreated
+
+// SatelliteId Identifier of the Satellite instance in the uuid v4/v5 format
+type SatelliteId = string
+
+// SatelliteOrgId Identifier of the organization within Satellite
+type SatelliteOrgId = string
+
+// UsageReport defines model for UsageReport.
+type UsageReport struct {
+	Data  []OrgUsage `json:"data"`
+	Since time.Time  `json:"since"`
+	Until time.Time  `json:"until"`
+}
+
+// Version Version of the API
+type Version = string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = Error
+
+// Forbidden defines model for Forbidden.
+type Forbidden = Error
+
+// NotFound defines model for NotFound.
+type NotFound = Error
+
+// ApiInternalRunsCreateJSONBody defines parameters for ApiInternalRunsCreate.
+type ApiInternalRunsCreateJSONBody = []RunInput
+
+// ApiInternalV2ApiTokensListParams defines parameters for ApiInternalV2ApiTokensList.
+type ApiInternalV2ApiTokensListParams struct {
+	OrgId OrgId `form:"org_id" json:"org_id"`
+}
+
+// ApiInternalV2ApiTokensDeleteParams defines parameters for ApiInternalV2ApiTokensDelete.
+type ApiInternalV2ApiTokensDeleteParams struct {
+	OrgId OrgId `form:"org_id" json:"org_id"`
+}
+
+// ApiInternalV2RunsCancelJSONBody defines parameters for ApiInternalV2RunsCancel.
+type ApiInternalV2RunsCancelJSONBody = []CancelInputV2
+
+// ApiInternalV2DebugCapturesParams defines parameters for ApiInternalV2DebugCaptures.
+type ApiInternalV2DebugCapturesParams struct {
+	OrgId *OrgId `form:"org_id,omitempty" json:"org_id,omitempty"`
+}
+
+// ApiInternalV2RunsCreateJSONBody defines parameters for ApiInternalV2RunsCreate.
+type ApiInternalV2RunsCreateJSONBody = []RunInputV2
+
+// ApiInternalV2RunsValidateJSONBody defines parameters for ApiInternalV2RunsValidate.
+type ApiInternalV2RunsValidateJSONBody = []RunInputV2
+
+// ApiInternalV2RecipientsStatusJSONBody defines parameters for ApiInternalV2RecipientsStatus.
+type ApiInternalV2RecipientsStatusJSONBody = []RecipientWithOrg
+
+// ApiInternalV2RunHostsListParams defines parameters for ApiInternalV2RunHostsList.
+type ApiInternalV2RunHostsListParams struct {
+	// Filter Allows for filtering based on various criteria
+	Filter *externalRef0.RunHostFilter `json:"filter,omitempty"`
+
+	// Fields Defines fields to be returned in the response.
+	Fields *externalRef0.RunHostFields `json:"fields,omitempty"`
+
+	// Limit Maximum number of results to return
+	Limit *externalRef0.Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Indicates the starting position of the query relative to the complete set of items that match the query
+	Offset *externalRef0.Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ApiInternalV2RunHostsListParamsFieldsData defines parameters for ApiInternalV2RunHostsList.
+type ApiInternalV2RunHostsListParamsFieldsData string
+
+// ApiInternalV2RunTemplatesListParams defines parameters for ApiInternalV2RunTemplatesList.
+type ApiInternalV2RunTemplatesListParams struct {
+	OrgId OrgId `form:"org_id" json:"org_id"`
+}
+
+// ApiInternalV2RunTemplatesDeleteParams defines parameters for ApiInternalV2RunTemplatesDelete.
+type ApiInternalV2RunTemplatesDeleteParams struct {
+	OrgId OrgId `form:"org_id" json:"org_id"`
+}
+
+// ApiInternalV2RunTemplatesGetParams defines parameters for ApiInternalV2RunTemplatesGet.
+type ApiInternalV2RunTemplatesGetParams struct {
+	OrgId OrgId `form:"org_id" json:"org_id"`
+}
+
+// ApiInternalV2RunTemplatesUpdateParams defines parameters for ApiInternalV2RunTemplatesUpdate.
+type ApiInternalV2RunTemplatesUpdateParams struct {
+	OrgId OrgId `form:"org_id" json:"org_id"`
+}
+
+// ApiInternalV2UsageParams defines parameters for ApiInternalV2Usage.
+type ApiInternalV2UsageParams struct {
+	// OrgId Restricts the report to a single organization
+	OrgId *OrgId `form:"org_id,omitempty" json:"org_id,omitempty"`
+
+	// Since Start of the reporting period (inclusive)
+	Since time.Time `form:"since" json:"since"`
+
+	// Until End of the reporting period (exclusive). Defaults to the current time.
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// ApiInternalV3GroupsGetParams defines parameters for ApiInternalV3GroupsGet.
+type ApiInternalV3GroupsGetParams struct {
+	OrgId OrgId `form:"org_id" json:"org_id"`
+}
+
+// ApiInternalRunsCreateJSONRequestBody defines body for ApiInternalRunsCreate for application/json ContentType.
+type ApiInternalRunsCreateJSONRequestBody = ApiInternalRunsCreateJSONBody
+
+// ApiInternalV2ApiTokensCreateJSONRequestBody defines body for ApiInternalV2ApiTokensCreate for application/json ContentType.
+type ApiInternalV2ApiTokensCreateJSONRequestBody = ApiTokenInput
+
+// ApiInternalV2RunsCancelJSONRequestBody defines body for ApiInternalV2RunsCancel for application/json ContentType.
+type ApiInternalV2RunsCancelJSONRequestBody = ApiInternalV2RunsCancelJSONBody
+
+// ApiInternalV2RunsCancelFilterJSONRequestBody defines body for ApiInternalV2RunsCancelFilter for application/json ContentType.
+type ApiInternalV2RunsCancelFilterJSONRequestBody = CancelFilterInputV2
+
+// ApiInternalHighlevelConnectionStatusJSONRequestBody defines body for ApiInternalHighlevelConnectionStatus for application/json ContentType.
+type ApiInternalHighlevelConnectionStatusJSONRequestBody = HostsWithOrgId
+
+// ApiInternalV2RunsCreateJSONRequestBody defines body for ApiInternalV2RunsCreate for application/json ContentType.
 type ApiInternalV2RunsCreateJSONRequestBody = ApiInternalV2RunsCreateJSONBody
 
+// ApiInternalV2RunsValidateJSONRequestBody defines body for ApiInternalV2RunsValidate for application/json ContentType.
+type ApiInternalV2RunsValidateJSONRequestBody = ApiInternalV2RunsValidateJSONBody
+
 // ApiInternalV2RecipientsStatusJSONRequestBody defines body for ApiInternalV2RecipientsStatus for application/json ContentType.
 type ApiInternalV2RecipientsStatusJSONRequestBody = ApiInternalV2RecipientsStatusJSONBody
 
+// ApiInternalV2RunLimitsUpdateJSONRequestBody defines body for ApiInternalV2RunLimitsUpdate for application/json ContentType.
+type ApiInternalV2RunLimitsUpdateJSONRequestBody = RunLimitInput
+
+// ApiInternalV2RunTemplatesCreateJSONRequestBody defines body for ApiInternalV2RunTemplatesCreate for application/json ContentType.
+type ApiInternalV2RunTemplatesCreateJSONRequestBody = RunTemplateInput
+
+// ApiInternalV2RunTemplatesUpdateJSONRequestBody defines body for ApiInternalV2RunTemplatesUpdate for application/json ContentType.
+type ApiInternalV2RunTemplatesUpdateJSONRequestBody = RunTemplateInput
+
+// ApiInternalV2RunsRetryJSONRequestBody defines body for ApiInternalV2RunsRetry for application/json ContentType.
+type ApiInternalV2RunsRetryJSONRequestBody = RunRetryInputV2
+
+// ApiInternalV3RunsCreateJSONRequestBody defines body for ApiInternalV3RunsCreate for application/json ContentType.
+type ApiInternalV3RunsCreateJSONRequestBody = RunGroupInputV3
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -426,21 +1064,45 @@ type ClientInterface interface {
 
 	ApiInternalRunsCreate(ctx context.Context, body ApiInternalRunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ApiInternalV2ApiTokensList request
+	ApiInternalV2ApiTokensList(ctx context.Context, params *ApiInternalV2ApiTokensListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV2ApiTokensCreateWithBody request with any body
+	ApiInternalV2ApiTokensCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiInternalV2ApiTokensCreate(ctx context.Context, body ApiInternalV2ApiTokensCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV2ApiTokensDelete request
+	ApiInternalV2ApiTokensDelete(ctx context.Context, tokenId openapi_types.UUID, params *ApiInternalV2ApiTokensDeleteParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ApiInternalV2RunsCancelWithBody request with any body
 	ApiInternalV2RunsCancelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	ApiInternalV2RunsCancel(ctx context.Context, body ApiInternalV2RunsCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ApiInternalV2RunsCancelFilterWithBody request with any body
+	ApiInternalV2RunsCancelFilterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiInternalV2RunsCancelFilter(ctx context.Context, body ApiInternalV2RunsCancelFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ApiInternalHighlevelConnectionStatusWithBody request with any body
 	ApiInternalHighlevelConnectionStatusWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	ApiInternalHighlevelConnectionStatus(ctx context.Context, body ApiInternalHighlevelConnectionStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ApiInternalV2DebugCaptures request
+	ApiInternalV2DebugCaptures(ctx context.Context, params *ApiInternalV2DebugCapturesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ApiInternalV2RunsCreateWithBody request with any body
 	ApiInternalV2RunsCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	ApiInternalV2RunsCreate(ctx context.Context, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ApiInternalV2RunsValidateWithBody request with any body
+	ApiInternalV2RunsValidateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiInternalV2RunsValidate(ctx context.Context, body ApiInternalV2RunsValidateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ApiInternalV2RecipientsStatusWithBody request with any body
 	ApiInternalV2RecipientsStatusWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -449,6 +1111,58 @@ type ClientInterface interface {
 	// ApiInternalV2RunHostsList request
 	ApiInternalV2RunHostsList(ctx context.Context, params *ApiInternalV2RunHostsListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ApiInternalV2RunLimitsDelete request
+	ApiInternalV2RunLimitsDelete(ctx context.Context, orgId OrgId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV2RunLimitsGet request
+	ApiInternalV2RunLimitsGet(ctx context.Context, orgId OrgId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV2RunLimitsUpdateWithBody request with any body
+	ApiInternalV2RunLimitsUpdateWithBody(ctx context.Context, orgId OrgId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiInternalV2RunLimitsUpdate(ctx context.Context, orgId OrgId, body ApiInternalV2RunLimitsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV2RunTemplatesList request
+	ApiInternalV2RunTemplatesList(ctx context.Context, params *ApiInternalV2RunTemplatesListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV2RunTemplatesCreateWithBody request with any body
+	ApiInternalV2RunTemplatesCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiInternalV2RunTemplatesCreate(ctx context.Context, body ApiInternalV2RunTemplatesCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV2RunTemplatesDelete request
+	ApiInternalV2RunTemplatesDelete(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesDeleteParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV2RunTemplatesGet request
+	ApiInternalV2RunTemplatesGet(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV2RunTemplatesUpdateWithBody request with any body
+	ApiInternalV2RunTemplatesUpdateWithBody(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesUpdateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiInternalV2RunTemplatesUpdate(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesUpdateParams, body ApiInternalV2RunTemplatesUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV2RunsRetryWithBody request with any body
+	ApiInternalV2RunsRetryWithBody(ctx context.Context, runId externalRef0.RunId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiInternalV2RunsRetry(ctx context.Context, runId externalRef0.RunId, body ApiInternalV2RunsRetryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV2Services request
+	ApiInternalV2Services(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV2Usage request
+	ApiInternalV2Usage(ctx context.Context, params *ApiInternalV2UsageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV2Version request
+	ApiInternalV2Version(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV3RunsCreateWithBody request with any body
+	ApiInternalV3RunsCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiInternalV3RunsCreate(ctx context.Context, body ApiInternalV3RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV3GroupsGet request
+	ApiInternalV3GroupsGet(ctx context.Context, groupId openapi_types.UUID, params *ApiInternalV3GroupsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ApiInternalVersion request
 	ApiInternalVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -477,8 +1191,8 @@ func (c *Client) ApiInternalRunsCreate(ctx context.Context, body ApiInternalRuns
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiInternalV2RunsCancelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiInternalV2RunsCancelRequestWithBody(c.Server, contentType, body)
+func (c *Client) ApiInternalV2ApiTokensList(ctx context.Context, params *ApiInternalV2ApiTokensListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2ApiTokensListRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -489,8 +1203,8 @@ func (c *Client) ApiInternalV2RunsCancelWithBody(ctx context.Context, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiInternalV2RunsCancel(ctx context.Context, body ApiInternalV2RunsCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiInternalV2RunsCancelRequest(c.Server, body)
+func (c *Client) ApiInternalV2ApiTokensCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2ApiTokensCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -501,8 +1215,8 @@ func (c *Client) ApiInternalV2RunsCancel(ctx context.Context, body ApiInternalV2
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiInternalHighlevelConnectionStatusWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiInternalHighlevelConnectionStatusRequestWithBody(c.Server, contentType, body)
+func (c *Client) ApiInternalV2ApiTokensCreate(ctx context.Context, body ApiInternalV2ApiTokensCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2ApiTokensCreateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -513,8 +1227,8 @@ func (c *Client) ApiInternalHighlevelConnectionStatusWithBody(ctx context.Contex
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiInternalHighlevelConnectionStatus(ctx context.Context, body ApiInternalHighlevelConnectionStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiInternalHighlevelConnectionStatusRequest(c.Server, body)
+func (c *Client) ApiInternalV2ApiTokensDelete(ctx context.Context, tokenId openapi_types.UUID, params *ApiInternalV2ApiTokensDeleteParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2ApiTokensDeleteRequest(c.Server, tokenId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -525,8 +1239,8 @@ func (c *Client) ApiInternalHighlevelConnectionStatus(ctx context.Context, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiInternalV2RunsCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiInternalV2RunsCreateRequestWithBody(c.Server, contentType, body)
+func (c *Client) ApiInternalV2RunsCancelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsCancelRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -537,8 +1251,8 @@ func (c *Client) ApiInternalV2RunsCreateWithBody(ctx context.Context, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiInternalV2RunsCreate(ctx context.Context, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiInternalV2RunsCreateRequest(c.Server, body)
+func (c *Client) ApiInternalV2RunsCancel(ctx context.Context, body ApiInternalV2RunsCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsCancelRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -549,8 +1263,8 @@ func (c *Client) ApiInternalV2RunsCreate(ctx context.Context, body ApiInternalV2
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiInternalV2RecipientsStatusWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiInternalV2RecipientsStatusRequestWithBody(c.Server, contentType, body)
+func (c *Client) ApiInternalV2RunsCancelFilterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsCancelFilterRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -561,8 +1275,8 @@ func (c *Client) ApiInternalV2RecipientsStatusWithBody(ctx context.Context, cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiInternalV2RecipientsStatus(ctx context.Context, body ApiInternalV2RecipientsStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiInternalV2RecipientsStatusRequest(c.Server, body)
+func (c *Client) ApiInternalV2RunsCancelFilter(ctx context.Context, body ApiInternalV2RunsCancelFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsCancelFilterRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -573,8 +1287,8 @@ func (c *Client) ApiInternalV2RecipientsStatus(ctx context.Context, body ApiInte
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiInternalV2RunHostsList(ctx context.Context, params *ApiInternalV2RunHostsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiInternalV2RunHostsListRequest(c.Server, params)
+func (c *Client) ApiInternalHighlevelConnectionStatusWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalHighlevelConnectionStatusRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -585,8 +1299,8 @@ func (c *Client) ApiInternalV2RunHostsList(ctx context.Context, params *ApiInter
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiInternalVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiInternalVersionRequest(c.Server)
+func (c *Client) ApiInternalHighlevelConnectionStatus(ctx context.Context, body ApiInternalHighlevelConnectionStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalHighlevelConnectionStatusRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -597,179 +1311,355 @@ func (c *Client) ApiInternalVersion(ctx context.Context, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-// NewApiInternalRunsCreateRequest calls the generic ApiInternalRunsCreate builder with application/json body
-func NewApiInternalRunsCreateRequest(server string, body ApiInternalRunsCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ApiInternalV2DebugCaptures(ctx context.Context, params *ApiInternalV2DebugCapturesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2DebugCapturesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewApiInternalRunsCreateRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewApiInternalRunsCreateRequestWithBody generates requests for ApiInternalRunsCreate with any type of body
-func NewApiInternalRunsCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ApiInternalV2RunsCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/internal/dispatch")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ApiInternalV2RunsCreate(ctx context.Context, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsCreateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewApiInternalV2RunsCancelRequest calls the generic ApiInternalV2RunsCancel builder with application/json body
-func NewApiInternalV2RunsCancelRequest(server string, body ApiInternalV2RunsCancelJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ApiInternalV2RunsValidateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsValidateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewApiInternalV2RunsCancelRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewApiInternalV2RunsCancelRequestWithBody generates requests for ApiInternalV2RunsCancel with any type of body
-func NewApiInternalV2RunsCancelRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ApiInternalV2RunsValidate(ctx context.Context, body ApiInternalV2RunsValidateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsValidateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/internal/v2/cancel")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ApiInternalV2RecipientsStatusWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RecipientsStatusRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiInternalV2RecipientsStatus(ctx context.Context, body ApiInternalV2RecipientsStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RecipientsStatusRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) ApiInternalV2RunHostsList(ctx context.Context, params *ApiInternalV2RunHostsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunHostsListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) ApiInternalV2RunLimitsDelete(ctx context.Context, orgId OrgId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunLimitsDeleteRequest(c.Server, orgId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewApiInternalHighlevelConnectionStatusRequest calls the generic ApiInternalHighlevelConnectionStatus builder with application/json body
-func NewApiInternalHighlevelConnectionStatusRequest(server string, body ApiInternalHighlevelConnectionStatusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ApiInternalV2RunLimitsGet(ctx context.Context, orgId OrgId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunLimitsGetRequest(c.Server, orgId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewApiInternalHighlevelConnectionStatusRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewApiInternalHighlevelConnectionStatusRequestWithBody generates requests for ApiInternalHighlevelConnectionStatus with any type of body
-func NewApiInternalHighlevelConnectionStatusRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) ApiInternalV2RunLimitsUpdateWithBody(ctx context.Context, orgId OrgId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunLimitsUpdateRequestWithBody(c.Server, orgId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ApiInternalV2RunLimitsUpdate(ctx context.Context, orgId OrgId, body ApiInternalV2RunLimitsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunLimitsUpdateRequest(c.Server, orgId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/internal/v2/connection_status")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) ApiInternalV2RunTemplatesList(ctx context.Context, params *ApiInternalV2RunTemplatesListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunTemplatesListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ApiInternalV2RunTemplatesCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunTemplatesCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) ApiInternalV2RunTemplatesCreate(ctx context.Context, body ApiInternalV2RunTemplatesCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunTemplatesCreateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) ApiInternalV2RunTemplatesDelete(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesDeleteParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunTemplatesDeleteRequest(c.Server, templateId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) ApiInternalV2RunTemplatesGet(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunTemplatesGetRequest(c.Server, templateId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewApiInternalV2RunsCreateRequest calls the generic ApiInternalV2RunsCreate builder with application/json body
-func NewApiInternalV2RunsCreateRequest(server string, body ApiInternalV2RunsCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ApiInternalV2RunTemplatesUpdateWithBody(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesUpdateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunTemplatesUpdateRequestWithBody(c.Server, templateId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewApiInternalV2RunsCreateRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewApiInternalV2RunsCreateRequestWithBody generates requests for ApiInternalV2RunsCreate with any type of body
-func NewApiInternalV2RunsCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) ApiInternalV2RunTemplatesUpdate(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesUpdateParams, body ApiInternalV2RunTemplatesUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunTemplatesUpdateRequest(c.Server, templateId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ApiInternalV2RunsRetryWithBody(ctx context.Context, runId externalRef0.RunId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsRetryRequestWithBody(c.Server, runId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/internal/v2/dispatch")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) ApiInternalV2RunsRetry(ctx context.Context, runId externalRef0.RunId, body ApiInternalV2RunsRetryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsRetryRequest(c.Server, runId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ApiInternalV2Services(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2ServicesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) ApiInternalV2Usage(ctx context.Context, params *ApiInternalV2UsageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2UsageRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) ApiInternalV2Version(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2VersionRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) ApiInternalV3RunsCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV3RunsCreateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewApiInternalV2RecipientsStatusRequest calls the generic ApiInternalV2RecipientsStatus builder with application/json body
-func NewApiInternalV2RecipientsStatusRequest(server string, body ApiInternalV2RecipientsStatusJSONRequestBody) (*http.Request, error) {
+func (c *Client) ApiInternalV3RunsCreate(ctx context.Context, body ApiInternalV3RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV3RunsCreateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiInternalV3GroupsGet(ctx context.Context, groupId openapi_types.UUID, params *ApiInternalV3GroupsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV3GroupsGetRequest(c.Server, groupId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiInternalVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalVersionRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewApiInternalRunsCreateRequest calls the generic ApiInternalRunsCreate builder with application/json body
+func NewApiInternalRunsCreateRequest(server string, body ApiInternalRunsCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewApiInternalV2RecipientsStatusRequestWithBody(server, "application/json", bodyReader)
+	return NewApiInternalRunsCreateRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewApiInternalV2RecipientsStatusRequestWithBody generates requests for ApiInternalV2RecipientsStatus with any type of body
-func NewApiInternalV2RecipientsStatusRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewApiInternalRunsCreateRequestWithBody generates requests for ApiInternalRunsCreate with any type of body
+func NewApiInternalRunsCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -777,7 +1667,7 @@ func NewApiInternalV2RecipientsStatusRequestWithBody(server string, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/internal/v2/recipients/status")
+	operationPath := fmt.Sprintf("/internal/dispatch")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -797,8 +1687,8 @@ func NewApiInternalV2RecipientsStatusRequestWithBody(server string, contentType
 	return req, nil
 }
 
-// NewApiInternalV2RunHostsListRequest generates requests for ApiInternalV2RunHostsList
-func NewApiInternalV2RunHostsListRequest(server string, params *ApiInternalV2RunHostsListParams) (*http.Request, error) {
+// NewApiInternalV2ApiTokensListRequest generates requests for ApiInternalV2ApiTokensList
+func NewApiInternalV2ApiTokensListRequest(server string, params *ApiInternalV2ApiTokensListParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -806,7 +1696,7 @@ func NewApiInternalV2RunHostsListRequest(server string, params *ApiInternalV2Run
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/internal/v2/run_hosts")
+	operationPath := fmt.Sprintf("/internal/v2/api_tokens")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -819,91 +1709,86 @@ func NewApiInternalV2RunHostsListRequest(server string, params *ApiInternalV2Run
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Filter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "org_id", params.OrgId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
-
 		}
 
-		if params.Fields != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "fields", *params.Fields, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewApiInternalV2ApiTokensCreateRequest calls the generic ApiInternalV2ApiTokensCreate builder with application/json body
+func NewApiInternalV2ApiTokensCreateRequest(server string, body ApiInternalV2ApiTokensCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV2ApiTokensCreateRequestWithBody(server, "application/json", bodyReader)
+}
 
-		if params.Offset != nil {
+// NewApiInternalV2ApiTokensCreateRequestWithBody generates requests for ApiInternalV2ApiTokensCreate with any type of body
+func NewApiInternalV2ApiTokensCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/internal/v2/api_tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewApiInternalVersionRequest generates requests for ApiInternalVersion
-func NewApiInternalVersionRequest(server string) (*http.Request, error) {
+// NewApiInternalV2ApiTokensDeleteRequest generates requests for ApiInternalV2ApiTokensDelete
+func NewApiInternalV2ApiTokensDeleteRequest(server string, tokenId openapi_types.UUID, params *ApiInternalV2ApiTokensDeleteParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "token_id", tokenId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/internal/version")
+	operationPath := fmt.Sprintf("/internal/v2/api_tokens/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -913,7 +1798,25 @@ func NewApiInternalVersionRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "org_id", params.OrgId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -921,364 +1824,2824 @@ func NewApiInternalVersionRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewApiInternalV2RunsCancelRequest calls the generic ApiInternalV2RunsCancel builder with application/json body
+func NewApiInternalV2RunsCancelRequest(server string, body ApiInternalV2RunsCancelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV2RunsCancelRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewApiInternalV2RunsCancelRequestWithBody generates requests for ApiInternalV2RunsCancel with any type of body
+func NewApiInternalV2RunsCancelRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/internal/v2/cancel")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ApiInternalRunsCreateWithBodyWithResponse request with any body
-	ApiInternalRunsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalRunsCreateResponse, error)
 
-	ApiInternalRunsCreateWithResponse(ctx context.Context, body ApiInternalRunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalRunsCreateResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ApiInternalV2RunsCancelWithBodyWithResponse request with any body
-	ApiInternalV2RunsCancelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	ApiInternalV2RunsCancelWithResponse(ctx context.Context, body ApiInternalV2RunsCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// ApiInternalHighlevelConnectionStatusWithBodyWithResponse request with any body
-	ApiInternalHighlevelConnectionStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalHighlevelConnectionStatusResponse, error)
+	return req, nil
+}
 
-	ApiInternalHighlevelConnectionStatusWithResponse(ctx context.Context, body ApiInternalHighlevelConnectionStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalHighlevelConnectionStatusResponse, error)
+// NewApiInternalV2RunsCancelFilterRequest calls the generic ApiInternalV2RunsCancelFilter builder with application/json body
+func NewApiInternalV2RunsCancelFilterRequest(server string, body ApiInternalV2RunsCancelFilterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV2RunsCancelFilterRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// ApiInternalV2RunsCreateWithBodyWithResponse request with any body
-	ApiInternalV2RunsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error)
+// NewApiInternalV2RunsCancelFilterRequestWithBody generates requests for ApiInternalV2RunsCancelFilter with any type of body
+func NewApiInternalV2RunsCancelFilterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	ApiInternalV2RunsCreateWithResponse(ctx context.Context, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ApiInternalV2RecipientsStatusWithBodyWithResponse request with any body
-	ApiInternalV2RecipientsStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RecipientsStatusResponse, error)
+	operationPath := fmt.Sprintf("/internal/v2/cancel/filter")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	ApiInternalV2RecipientsStatusWithResponse(ctx context.Context, body ApiInternalV2RecipientsStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RecipientsStatusResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ApiInternalV2RunHostsListWithResponse request
-	ApiInternalV2RunHostsListWithResponse(ctx context.Context, params *ApiInternalV2RunHostsListParams, reqEditors ...RequestEditorFn) (*ApiInternalV2RunHostsListResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// ApiInternalVersionWithResponse request
-	ApiInternalVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ApiInternalVersionResponse, error)
-}
+	req.Header.Add("Content-Type", contentType)
 
-type ApiInternalRunsCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON207      *RunsCreated
-	JSON400      *BadRequest
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ApiInternalRunsCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewApiInternalHighlevelConnectionStatusRequest calls the generic ApiInternalHighlevelConnectionStatus builder with application/json body
+func NewApiInternalHighlevelConnectionStatusRequest(server string, body ApiInternalHighlevelConnectionStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalHighlevelConnectionStatusRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApiInternalRunsCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewApiInternalHighlevelConnectionStatusRequestWithBody generates requests for ApiInternalHighlevelConnectionStatus with any type of body
+func NewApiInternalHighlevelConnectionStatusRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ApiInternalV2RunsCancelResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON207      *RunsCanceled
-	JSON400      *BadRequest
-}
+	operationPath := fmt.Sprintf("/internal/v2/connection_status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ApiInternalV2RunsCancelResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApiInternalV2RunsCancelResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ApiInternalHighlevelConnectionStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *HighLevelRecipientStatus
-	JSON400      *BadRequest
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
+// NewApiInternalV2DebugCapturesRequest generates requests for ApiInternalV2DebugCaptures
+func NewApiInternalV2DebugCapturesRequest(server string, params *ApiInternalV2DebugCapturesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/debug/captures")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OrgId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "org_id", *params.OrgId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiInternalV2RunsCreateRequest calls the generic ApiInternalV2RunsCreate builder with application/json body
+func NewApiInternalV2RunsCreateRequest(server string, body ApiInternalV2RunsCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV2RunsCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewApiInternalV2RunsCreateRequestWithBody generates requests for ApiInternalV2RunsCreate with any type of body
+func NewApiInternalV2RunsCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/dispatch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApiInternalV2RunsValidateRequest calls the generic ApiInternalV2RunsValidate builder with application/json body
+func NewApiInternalV2RunsValidateRequest(server string, body ApiInternalV2RunsValidateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV2RunsValidateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewApiInternalV2RunsValidateRequestWithBody generates requests for ApiInternalV2RunsValidate with any type of body
+func NewApiInternalV2RunsValidateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/dispatch/validate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApiInternalV2RecipientsStatusRequest calls the generic ApiInternalV2RecipientsStatus builder with application/json body
+func NewApiInternalV2RecipientsStatusRequest(server string, body ApiInternalV2RecipientsStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV2RecipientsStatusRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewApiInternalV2RecipientsStatusRequestWithBody generates requests for ApiInternalV2RecipientsStatus with any type of body
+func NewApiInternalV2RecipientsStatusRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/recipients/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApiInternalV2RunHostsListRequest generates requests for ApiInternalV2RunHostsList
+func NewApiInternalV2RunHostsListRequest(server string, params *ApiInternalV2RunHostsListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/run_hosts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "fields", *params.Fields, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiInternalV2RunLimitsDeleteRequest generates requests for ApiInternalV2RunLimitsDelete
+func NewApiInternalV2RunLimitsDeleteRequest(server string, orgId OrgId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "org_id", orgId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/run_limits/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiInternalV2RunLimitsGetRequest generates requests for ApiInternalV2RunLimitsGet
+func NewApiInternalV2RunLimitsGetRequest(server string, orgId OrgId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "org_id", orgId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/run_limits/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiInternalV2RunLimitsUpdateRequest calls the generic ApiInternalV2RunLimitsUpdate builder with application/json body
+func NewApiInternalV2RunLimitsUpdateRequest(server string, orgId OrgId, body ApiInternalV2RunLimitsUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV2RunLimitsUpdateRequestWithBody(server, orgId, "application/json", bodyReader)
+}
+
+// NewApiInternalV2RunLimitsUpdateRequestWithBody generates requests for ApiInternalV2RunLimitsUpdate with any type of body
+func NewApiInternalV2RunLimitsUpdateRequestWithBody(server string, orgId OrgId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "org_id", orgId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/run_limits/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApiInternalV2RunTemplatesListRequest generates requests for ApiInternalV2RunTemplatesList
+func NewApiInternalV2RunTemplatesListRequest(server string, params *ApiInternalV2RunTemplatesListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/run_templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "org_id", params.OrgId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiInternalV2RunTemplatesCreateRequest calls the generic ApiInternalV2RunTemplatesCreate builder with application/json body
+func NewApiInternalV2RunTemplatesCreateRequest(server string, body ApiInternalV2RunTemplatesCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV2RunTemplatesCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewApiInternalV2RunTemplatesCreateRequestWithBody generates requests for ApiInternalV2RunTemplatesCreate with any type of body
+func NewApiInternalV2RunTemplatesCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/run_templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApiInternalV2RunTemplatesDeleteRequest generates requests for ApiInternalV2RunTemplatesDelete
+func NewApiInternalV2RunTemplatesDeleteRequest(server string, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesDeleteParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/run_templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "org_id", params.OrgId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiInternalV2RunTemplatesGetRequest generates requests for ApiInternalV2RunTemplatesGet
+func NewApiInternalV2RunTemplatesGetRequest(server string, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesGetParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/run_templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "org_id", params.OrgId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiInternalV2RunTemplatesUpdateRequest calls the generic ApiInternalV2RunTemplatesUpdate builder with application/json body
+func NewApiInternalV2RunTemplatesUpdateRequest(server string, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesUpdateParams, body ApiInternalV2RunTemplatesUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV2RunTemplatesUpdateRequestWithBody(server, templateId, params, "application/json", bodyReader)
+}
+
+// NewApiInternalV2RunTemplatesUpdateRequestWithBody generates requests for ApiInternalV2RunTemplatesUpdate with any type of body
+func NewApiInternalV2RunTemplatesUpdateRequestWithBody(server string, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesUpdateParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/run_templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "org_id", params.OrgId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApiInternalV2RunsRetryRequest calls the generic ApiInternalV2RunsRetry builder with application/json body
+func NewApiInternalV2RunsRetryRequest(server string, runId externalRef0.RunId, body ApiInternalV2RunsRetryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV2RunsRetryRequestWithBody(server, runId, "application/json", bodyReader)
+}
+
+// NewApiInternalV2RunsRetryRequestWithBody generates requests for ApiInternalV2RunsRetry with any type of body
+func NewApiInternalV2RunsRetryRequestWithBody(server string, runId externalRef0.RunId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "run_id", runId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/runs/%s/retry", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApiInternalV2ServicesRequest generates requests for ApiInternalV2Services
+func NewApiInternalV2ServicesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/services")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiInternalV2UsageRequest generates requests for ApiInternalV2Usage
+func NewApiInternalV2UsageRequest(server string, params *ApiInternalV2UsageParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/usage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OrgId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "org_id", *params.OrgId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "since", params.Since, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date-time"}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "until", *params.Until, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date-time"}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiInternalV2VersionRequest generates requests for ApiInternalV2Version
+func NewApiInternalV2VersionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiInternalV3RunsCreateRequest calls the generic ApiInternalV3RunsCreate builder with application/json body
+func NewApiInternalV3RunsCreateRequest(server string, body ApiInternalV3RunsCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV3RunsCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewApiInternalV3RunsCreateRequestWithBody generates requests for ApiInternalV3RunsCreate with any type of body
+func NewApiInternalV3RunsCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v3/dispatch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApiInternalV3GroupsGetRequest generates requests for ApiInternalV3GroupsGet
+func NewApiInternalV3GroupsGetRequest(server string, groupId openapi_types.UUID, params *ApiInternalV3GroupsGetParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "group_id", groupId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v3/groups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "org_id", params.OrgId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiInternalVersionRequest generates requests for ApiInternalVersion
+func NewApiInternalVersionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ApiInternalRunsCreateWithBodyWithResponse request with any body
+	ApiInternalRunsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalRunsCreateResponse, error)
+
+	ApiInternalRunsCreateWithResponse(ctx context.Context, body ApiInternalRunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalRunsCreateResponse, error)
+
+	// ApiInternalV2ApiTokensListWithResponse request
+	ApiInternalV2ApiTokensListWithResponse(ctx context.Context, params *ApiInternalV2ApiTokensListParams, reqEditors ...RequestEditorFn) (*ApiInternalV2ApiTokensListResponse, error)
+
+	// ApiInternalV2ApiTokensCreateWithBodyWithResponse request with any body
+	ApiInternalV2ApiTokensCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2ApiTokensCreateResponse, error)
+
+	ApiInternalV2ApiTokensCreateWithResponse(ctx context.Context, body ApiInternalV2ApiTokensCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2ApiTokensCreateResponse, error)
+
+	// ApiInternalV2ApiTokensDeleteWithResponse request
+	ApiInternalV2ApiTokensDeleteWithResponse(ctx context.Context, tokenId openapi_types.UUID, params *ApiInternalV2ApiTokensDeleteParams, reqEditors ...RequestEditorFn) (*ApiInternalV2ApiTokensDeleteResponse, error)
+
+	// ApiInternalV2RunsCancelWithBodyWithResponse request with any body
+	ApiInternalV2RunsCancelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelResponse, error)
+
+	ApiInternalV2RunsCancelWithResponse(ctx context.Context, body ApiInternalV2RunsCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelResponse, error)
+
+	// ApiInternalV2RunsCancelFilterWithBodyWithResponse request with any body
+	ApiInternalV2RunsCancelFilterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelFilterResponse, error)
+
+	ApiInternalV2RunsCancelFilterWithResponse(ctx context.Context, body ApiInternalV2RunsCancelFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelFilterResponse, error)
+
+	// ApiInternalHighlevelConnectionStatusWithBodyWithResponse request with any body
+	ApiInternalHighlevelConnectionStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalHighlevelConnectionStatusResponse, error)
+
+	ApiInternalHighlevelConnectionStatusWithResponse(ctx context.Context, body ApiInternalHighlevelConnectionStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalHighlevelConnectionStatusResponse, error)
+
+	// ApiInternalV2DebugCapturesWithResponse request
+	ApiInternalV2DebugCapturesWithResponse(ctx context.Context, params *ApiInternalV2DebugCapturesParams, reqEditors ...RequestEditorFn) (*ApiInternalV2DebugCapturesResponse, error)
+
+	// ApiInternalV2RunsCreateWithBodyWithResponse request with any body
+	ApiInternalV2RunsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error)
+
+	ApiInternalV2RunsCreateWithResponse(ctx context.Context, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error)
+
+	// ApiInternalV2RunsValidateWithBodyWithResponse request with any body
+	ApiInternalV2RunsValidateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsValidateResponse, error)
+
+	ApiInternalV2RunsValidateWithResponse(ctx context.Context, body ApiInternalV2RunsValidateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsValidateResponse, error)
+
+	// ApiInternalV2RecipientsStatusWithBodyWithResponse request with any body
+	ApiInternalV2RecipientsStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RecipientsStatusResponse, error)
+
+	ApiInternalV2RecipientsStatusWithResponse(ctx context.Context, body ApiInternalV2RecipientsStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RecipientsStatusResponse, error)
+
+	// ApiInternalV2RunHostsListWithResponse request
+	ApiInternalV2RunHostsListWithResponse(ctx context.Context, params *ApiInternalV2RunHostsListParams, reqEditors ...RequestEditorFn) (*ApiInternalV2RunHostsListResponse, error)
+
+	// ApiInternalV2RunLimitsDeleteWithResponse request
+	ApiInternalV2RunLimitsDeleteWithResponse(ctx context.Context, orgId OrgId, reqEditors ...RequestEditorFn) (*ApiInternalV2RunLimitsDeleteResponse, error)
+
+	// ApiInternalV2RunLimitsGetWithResponse request
+	ApiInternalV2RunLimitsGetWithResponse(ctx context.Context, orgId OrgId, reqEditors ...RequestEditorFn) (*ApiInternalV2RunLimitsGetResponse, error)
+
+	// ApiInternalV2RunLimitsUpdateWithBodyWithResponse request with any body
+	ApiInternalV2RunLimitsUpdateWithBodyWithResponse(ctx context.Context, orgId OrgId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunLimitsUpdateResponse, error)
+
+	ApiInternalV2RunLimitsUpdateWithResponse(ctx context.Context, orgId OrgId, body ApiInternalV2RunLimitsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunLimitsUpdateResponse, error)
+
+	// ApiInternalV2RunTemplatesListWithResponse request
+	ApiInternalV2RunTemplatesListWithResponse(ctx context.Context, params *ApiInternalV2RunTemplatesListParams, reqEditors ...RequestEditorFn) (*ApiInternalV2RunTemplatesListResponse, error)
+
+	// ApiInternalV2RunTemplatesCreateWithBodyWithResponse request with any body
+	ApiInternalV2RunTemplatesCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunTemplatesCreateResponse, error)
+
+	ApiInternalV2RunTemplatesCreateWithResponse(ctx context.Context, body ApiInternalV2RunTemplatesCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunTemplatesCreateResponse, error)
+
+	// ApiInternalV2RunTemplatesDeleteWithResponse request
+	ApiInternalV2RunTemplatesDeleteWithResponse(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesDeleteParams, reqEditors ...RequestEditorFn) (*ApiInternalV2RunTemplatesDeleteResponse, error)
+
+	// ApiInternalV2RunTemplatesGetWithResponse request
+	ApiInternalV2RunTemplatesGetWithResponse(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesGetParams, reqEditors ...RequestEditorFn) (*ApiInternalV2RunTemplatesGetResponse, error)
+
+	// ApiInternalV2RunTemplatesUpdateWithBodyWithResponse request with any body
+	ApiInternalV2RunTemplatesUpdateWithBodyWithResponse(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesUpdateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunTemplatesUpdateResponse, error)
+
+	ApiInternalV2RunTemplatesUpdateWithResponse(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesUpdateParams, body ApiInternalV2RunTemplatesUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunTemplatesUpdateResponse, error)
+
+	// ApiInternalV2RunsRetryWithBodyWithResponse request with any body
+	ApiInternalV2RunsRetryWithBodyWithResponse(ctx context.Context, runId externalRef0.RunId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsRetryResponse, error)
+
+	ApiInternalV2RunsRetryWithResponse(ctx context.Context, runId externalRef0.RunId, body ApiInternalV2RunsRetryJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsRetryResponse, error)
+
+	// ApiInternalV2ServicesWithResponse request
+	ApiInternalV2ServicesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ApiInternalV2ServicesResponse, error)
+
+	// ApiInternalV2UsageWithResponse request
+	ApiInternalV2UsageWithResponse(ctx context.Context, params *ApiInternalV2UsageParams, reqEditors ...RequestEditorFn) (*ApiInternalV2UsageResponse, error)
+
+	// ApiInternalV2VersionWithResponse request
+	ApiInternalV2VersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ApiInternalV2VersionResponse, error)
+
+	// ApiInternalV3RunsCreateWithBodyWithResponse request with any body
+	ApiInternalV3RunsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV3RunsCreateResponse, error)
+
+	ApiInternalV3RunsCreateWithResponse(ctx context.Context, body ApiInternalV3RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV3RunsCreateResponse, error)
+
+	// ApiInternalV3GroupsGetWithResponse request
+	ApiInternalV3GroupsGetWithResponse(ctx context.Context, groupId openapi_types.UUID, params *ApiInternalV3GroupsGetParams, reqEditors ...RequestEditorFn) (*ApiInternalV3GroupsGetResponse, error)
+
+	// ApiInternalVersionWithResponse request
+	ApiInternalVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ApiInternalVersionResponse, error)
+}
+
+type ApiInternalRunsCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON207      *RunsCreated
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalRunsCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalRunsCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2ApiTokensListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ApiToken
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2ApiTokensListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2ApiTokensListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2ApiTokensCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ApiTokenCreated
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2ApiTokensCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2ApiTokensCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2ApiTokensDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2ApiTokensDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2ApiTokensDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RunsCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON207      *RunsCanceled
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunsCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunsCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RunsCancelFilterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunsCancelSummary
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunsCancelFilterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunsCancelFilterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalHighlevelConnectionStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HighLevelRecipientStatus
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
 func (r ApiInternalHighlevelConnectionStatusResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalHighlevelConnectionStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2DebugCapturesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DebugCapture
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2DebugCapturesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2DebugCapturesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RunsCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON207      *RunsCreated
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunsCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunsCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RunsValidateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON207      *RunsCreated
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunsValidateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunsValidateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RecipientsStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RecipientStatus
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RecipientsStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RecipientsStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RunHostsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *externalRef0.RunHosts
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunHostsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunHostsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RunLimitsDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunLimitsDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunLimitsDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RunLimitsGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunLimit
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunLimitsGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunLimitsGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RunLimitsUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunLimit
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunLimitsUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunLimitsUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RunTemplatesListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RunTemplate
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunTemplatesListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunTemplatesListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RunTemplatesCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RunTemplate
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunTemplatesCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunTemplatesCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RunTemplatesDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunTemplatesDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunTemplatesDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RunTemplatesGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunTemplate
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunTemplatesGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunTemplatesGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RunTemplatesUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunTemplate
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunTemplatesUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunTemplatesUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2RunsRetryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RunCreated
+	JSON400      *BadRequest
+	JSONDefault  *RunCreated
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunsRetryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunsRetryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2ServicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]KnownService
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2ServicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2ServicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2UsageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UsageReport
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2UsageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2UsageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2VersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Deployment
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2VersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2VersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV3RunsCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON207      *RunGroupCreated
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV3RunsCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV3RunsCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV3GroupsGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunGroupStatus
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV3GroupsGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV3GroupsGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Version
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ApiInternalRunsCreateWithBodyWithResponse request with arbitrary body returning *ApiInternalRunsCreateResponse
+func (c *ClientWithResponses) ApiInternalRunsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalRunsCreateResponse, error) {
+	rsp, err := c.ApiInternalRunsCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalRunsCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalRunsCreateWithResponse(ctx context.Context, body ApiInternalRunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalRunsCreateResponse, error) {
+	rsp, err := c.ApiInternalRunsCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalRunsCreateResponse(rsp)
+}
+
+// ApiInternalV2ApiTokensListWithResponse request returning *ApiInternalV2ApiTokensListResponse
+func (c *ClientWithResponses) ApiInternalV2ApiTokensListWithResponse(ctx context.Context, params *ApiInternalV2ApiTokensListParams, reqEditors ...RequestEditorFn) (*ApiInternalV2ApiTokensListResponse, error) {
+	rsp, err := c.ApiInternalV2ApiTokensList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2ApiTokensListResponse(rsp)
+}
+
+// ApiInternalV2ApiTokensCreateWithBodyWithResponse request with arbitrary body returning *ApiInternalV2ApiTokensCreateResponse
+func (c *ClientWithResponses) ApiInternalV2ApiTokensCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2ApiTokensCreateResponse, error) {
+	rsp, err := c.ApiInternalV2ApiTokensCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2ApiTokensCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV2ApiTokensCreateWithResponse(ctx context.Context, body ApiInternalV2ApiTokensCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2ApiTokensCreateResponse, error) {
+	rsp, err := c.ApiInternalV2ApiTokensCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2ApiTokensCreateResponse(rsp)
+}
+
+// ApiInternalV2ApiTokensDeleteWithResponse request returning *ApiInternalV2ApiTokensDeleteResponse
+func (c *ClientWithResponses) ApiInternalV2ApiTokensDeleteWithResponse(ctx context.Context, tokenId openapi_types.UUID, params *ApiInternalV2ApiTokensDeleteParams, reqEditors ...RequestEditorFn) (*ApiInternalV2ApiTokensDeleteResponse, error) {
+	rsp, err := c.ApiInternalV2ApiTokensDelete(ctx, tokenId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2ApiTokensDeleteResponse(rsp)
+}
+
+// ApiInternalV2RunsCancelWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RunsCancelResponse
+func (c *ClientWithResponses) ApiInternalV2RunsCancelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelResponse, error) {
+	rsp, err := c.ApiInternalV2RunsCancelWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunsCancelResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV2RunsCancelWithResponse(ctx context.Context, body ApiInternalV2RunsCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelResponse, error) {
+	rsp, err := c.ApiInternalV2RunsCancel(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunsCancelResponse(rsp)
+}
+
+// ApiInternalV2RunsCancelFilterWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RunsCancelFilterResponse
+func (c *ClientWithResponses) ApiInternalV2RunsCancelFilterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelFilterResponse, error) {
+	rsp, err := c.ApiInternalV2RunsCancelFilterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunsCancelFilterResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV2RunsCancelFilterWithResponse(ctx context.Context, body ApiInternalV2RunsCancelFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelFilterResponse, error) {
+	rsp, err := c.ApiInternalV2RunsCancelFilter(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunsCancelFilterResponse(rsp)
+}
+
+// ApiInternalHighlevelConnectionStatusWithBodyWithResponse request with arbitrary body returning *ApiInternalHighlevelConnectionStatusResponse
+func (c *ClientWithResponses) ApiInternalHighlevelConnectionStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalHighlevelConnectionStatusResponse, error) {
+	rsp, err := c.ApiInternalHighlevelConnectionStatusWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalHighlevelConnectionStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalHighlevelConnectionStatusWithResponse(ctx context.Context, body ApiInternalHighlevelConnectionStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalHighlevelConnectionStatusResponse, error) {
+	rsp, err := c.ApiInternalHighlevelConnectionStatus(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalHighlevelConnectionStatusResponse(rsp)
+}
+
+// ApiInternalV2DebugCapturesWithResponse request returning *ApiInternalV2DebugCapturesResponse
+func (c *ClientWithResponses) ApiInternalV2DebugCapturesWithResponse(ctx context.Context, params *ApiInternalV2DebugCapturesParams, reqEditors ...RequestEditorFn) (*ApiInternalV2DebugCapturesResponse, error) {
+	rsp, err := c.ApiInternalV2DebugCaptures(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2DebugCapturesResponse(rsp)
+}
+
+// ApiInternalV2RunsCreateWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RunsCreateResponse
+func (c *ClientWithResponses) ApiInternalV2RunsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error) {
+	rsp, err := c.ApiInternalV2RunsCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunsCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV2RunsCreateWithResponse(ctx context.Context, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error) {
+	rsp, err := c.ApiInternalV2RunsCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunsCreateResponse(rsp)
+}
+
+// ApiInternalV2RunsValidateWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RunsValidateResponse
+func (c *ClientWithResponses) ApiInternalV2RunsValidateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsValidateResponse, error) {
+	rsp, err := c.ApiInternalV2RunsValidateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunsValidateResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV2RunsValidateWithResponse(ctx context.Context, body ApiInternalV2RunsValidateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsValidateResponse, error) {
+	rsp, err := c.ApiInternalV2RunsValidate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunsValidateResponse(rsp)
+}
+
+// ApiInternalV2RecipientsStatusWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RecipientsStatusResponse
+func (c *ClientWithResponses) ApiInternalV2RecipientsStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RecipientsStatusResponse, error) {
+	rsp, err := c.ApiInternalV2RecipientsStatusWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RecipientsStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV2RecipientsStatusWithResponse(ctx context.Context, body ApiInternalV2RecipientsStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RecipientsStatusResponse, error) {
+	rsp, err := c.ApiInternalV2RecipientsStatus(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RecipientsStatusResponse(rsp)
+}
+
+// ApiInternalV2RunHostsListWithResponse request returning *ApiInternalV2RunHostsListResponse
+func (c *ClientWithResponses) ApiInternalV2RunHostsListWithResponse(ctx context.Context, params *ApiInternalV2RunHostsListParams, reqEditors ...RequestEditorFn) (*ApiInternalV2RunHostsListResponse, error) {
+	rsp, err := c.ApiInternalV2RunHostsList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunHostsListResponse(rsp)
+}
+
+// ApiInternalV2RunLimitsDeleteWithResponse request returning *ApiInternalV2RunLimitsDeleteResponse
+func (c *ClientWithResponses) ApiInternalV2RunLimitsDeleteWithResponse(ctx context.Context, orgId OrgId, reqEditors ...RequestEditorFn) (*ApiInternalV2RunLimitsDeleteResponse, error) {
+	rsp, err := c.ApiInternalV2RunLimitsDelete(ctx, orgId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunLimitsDeleteResponse(rsp)
+}
+
+// ApiInternalV2RunLimitsGetWithResponse request returning *ApiInternalV2RunLimitsGetResponse
+func (c *ClientWithResponses) ApiInternalV2RunLimitsGetWithResponse(ctx context.Context, orgId OrgId, reqEditors ...RequestEditorFn) (*ApiInternalV2RunLimitsGetResponse, error) {
+	rsp, err := c.ApiInternalV2RunLimitsGet(ctx, orgId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunLimitsGetResponse(rsp)
+}
+
+// ApiInternalV2RunLimitsUpdateWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RunLimitsUpdateResponse
+func (c *ClientWithResponses) ApiInternalV2RunLimitsUpdateWithBodyWithResponse(ctx context.Context, orgId OrgId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunLimitsUpdateResponse, error) {
+	rsp, err := c.ApiInternalV2RunLimitsUpdateWithBody(ctx, orgId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunLimitsUpdateResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV2RunLimitsUpdateWithResponse(ctx context.Context, orgId OrgId, body ApiInternalV2RunLimitsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunLimitsUpdateResponse, error) {
+	rsp, err := c.ApiInternalV2RunLimitsUpdate(ctx, orgId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunLimitsUpdateResponse(rsp)
+}
+
+// ApiInternalV2RunTemplatesListWithResponse request returning *ApiInternalV2RunTemplatesListResponse
+func (c *ClientWithResponses) ApiInternalV2RunTemplatesListWithResponse(ctx context.Context, params *ApiInternalV2RunTemplatesListParams, reqEditors ...RequestEditorFn) (*ApiInternalV2RunTemplatesListResponse, error) {
+	rsp, err := c.ApiInternalV2RunTemplatesList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunTemplatesListResponse(rsp)
+}
+
+// ApiInternalV2RunTemplatesCreateWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RunTemplatesCreateResponse
+func (c *ClientWithResponses) ApiInternalV2RunTemplatesCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunTemplatesCreateResponse, error) {
+	rsp, err := c.ApiInternalV2RunTemplatesCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunTemplatesCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV2RunTemplatesCreateWithResponse(ctx context.Context, body ApiInternalV2RunTemplatesCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunTemplatesCreateResponse, error) {
+	rsp, err := c.ApiInternalV2RunTemplatesCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunTemplatesCreateResponse(rsp)
+}
+
+// ApiInternalV2RunTemplatesDeleteWithResponse request returning *ApiInternalV2RunTemplatesDeleteResponse
+func (c *ClientWithResponses) ApiInternalV2RunTemplatesDeleteWithResponse(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesDeleteParams, reqEditors ...RequestEditorFn) (*ApiInternalV2RunTemplatesDeleteResponse, error) {
+	rsp, err := c.ApiInternalV2RunTemplatesDelete(ctx, templateId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunTemplatesDeleteResponse(rsp)
+}
+
+// ApiInternalV2RunTemplatesGetWithResponse request returning *ApiInternalV2RunTemplatesGetResponse
+func (c *ClientWithResponses) ApiInternalV2RunTemplatesGetWithResponse(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesGetParams, reqEditors ...RequestEditorFn) (*ApiInternalV2RunTemplatesGetResponse, error) {
+	rsp, err := c.ApiInternalV2RunTemplatesGet(ctx, templateId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunTemplatesGetResponse(rsp)
+}
+
+// ApiInternalV2RunTemplatesUpdateWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RunTemplatesUpdateResponse
+func (c *ClientWithResponses) ApiInternalV2RunTemplatesUpdateWithBodyWithResponse(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesUpdateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunTemplatesUpdateResponse, error) {
+	rsp, err := c.ApiInternalV2RunTemplatesUpdateWithBody(ctx, templateId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunTemplatesUpdateResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV2RunTemplatesUpdateWithResponse(ctx context.Context, templateId openapi_types.UUID, params *ApiInternalV2RunTemplatesUpdateParams, body ApiInternalV2RunTemplatesUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunTemplatesUpdateResponse, error) {
+	rsp, err := c.ApiInternalV2RunTemplatesUpdate(ctx, templateId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunTemplatesUpdateResponse(rsp)
+}
+
+// ApiInternalV2RunsRetryWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RunsRetryResponse
+func (c *ClientWithResponses) ApiInternalV2RunsRetryWithBodyWithResponse(ctx context.Context, runId externalRef0.RunId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsRetryResponse, error) {
+	rsp, err := c.ApiInternalV2RunsRetryWithBody(ctx, runId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunsRetryResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV2RunsRetryWithResponse(ctx context.Context, runId externalRef0.RunId, body ApiInternalV2RunsRetryJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsRetryResponse, error) {
+	rsp, err := c.ApiInternalV2RunsRetry(ctx, runId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunsRetryResponse(rsp)
+}
+
+// ApiInternalV2ServicesWithResponse request returning *ApiInternalV2ServicesResponse
+func (c *ClientWithResponses) ApiInternalV2ServicesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ApiInternalV2ServicesResponse, error) {
+	rsp, err := c.ApiInternalV2Services(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2ServicesResponse(rsp)
+}
+
+// ApiInternalV2UsageWithResponse request returning *ApiInternalV2UsageResponse
+func (c *ClientWithResponses) ApiInternalV2UsageWithResponse(ctx context.Context, params *ApiInternalV2UsageParams, reqEditors ...RequestEditorFn) (*ApiInternalV2UsageResponse, error) {
+	rsp, err := c.ApiInternalV2Usage(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2UsageResponse(rsp)
+}
+
+// ApiInternalV2VersionWithResponse request returning *ApiInternalV2VersionResponse
+func (c *ClientWithResponses) ApiInternalV2VersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ApiInternalV2VersionResponse, error) {
+	rsp, err := c.ApiInternalV2Version(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2VersionResponse(rsp)
+}
+
+// ApiInternalV3RunsCreateWithBodyWithResponse request with arbitrary body returning *ApiInternalV3RunsCreateResponse
+func (c *ClientWithResponses) ApiInternalV3RunsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV3RunsCreateResponse, error) {
+	rsp, err := c.ApiInternalV3RunsCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV3RunsCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV3RunsCreateWithResponse(ctx context.Context, body ApiInternalV3RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV3RunsCreateResponse, error) {
+	rsp, err := c.ApiInternalV3RunsCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV3RunsCreateResponse(rsp)
+}
+
+// ApiInternalV3GroupsGetWithResponse request returning *ApiInternalV3GroupsGetResponse
+func (c *ClientWithResponses) ApiInternalV3GroupsGetWithResponse(ctx context.Context, groupId openapi_types.UUID, params *ApiInternalV3GroupsGetParams, reqEditors ...RequestEditorFn) (*ApiInternalV3GroupsGetResponse, error) {
+	rsp, err := c.ApiInternalV3GroupsGet(ctx, groupId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV3GroupsGetResponse(rsp)
+}
+
+// ApiInternalVersionWithResponse request returning *ApiInternalVersionResponse
+func (c *ClientWithResponses) ApiInternalVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ApiInternalVersionResponse, error) {
+	rsp, err := c.ApiInternalVersion(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalVersionResponse(rsp)
+}
+
+// ParseApiInternalRunsCreateResponse parses an HTTP response from a ApiInternalRunsCreateWithResponse call
+func ParseApiInternalRunsCreateResponse(rsp *http.Response) (*ApiInternalRunsCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalRunsCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest RunsCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiInternalV2ApiTokensListResponse parses an HTTP response from a ApiInternalV2ApiTokensListWithResponse call
+func ParseApiInternalV2ApiTokensListResponse(rsp *http.Response) (*ApiInternalV2ApiTokensListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalV2ApiTokensListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ApiToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiInternalV2ApiTokensCreateResponse parses an HTTP response from a ApiInternalV2ApiTokensCreateWithResponse call
+func ParseApiInternalV2ApiTokensCreateResponse(rsp *http.Response) (*ApiInternalV2ApiTokensCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalV2ApiTokensCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ApiTokenCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiInternalV2ApiTokensDeleteResponse parses an HTTP response from a ApiInternalV2ApiTokensDeleteWithResponse call
+func ParseApiInternalV2ApiTokensDeleteResponse(rsp *http.Response) (*ApiInternalV2ApiTokensDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalV2ApiTokensDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiInternalV2RunsCancelResponse parses an HTTP response from a ApiInternalV2RunsCancelWithResponse call
+func ParseApiInternalV2RunsCancelResponse(rsp *http.Response) (*ApiInternalV2RunsCancelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalV2RunsCancelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest RunsCanceled
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiInternalV2RunsCancelFilterResponse parses an HTTP response from a ApiInternalV2RunsCancelFilterWithResponse call
+func ParseApiInternalV2RunsCancelFilterResponse(rsp *http.Response) (*ApiInternalV2RunsCancelFilterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalV2RunsCancelFilterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunsCancelSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiInternalHighlevelConnectionStatusResponse parses an HTTP response from a ApiInternalHighlevelConnectionStatusWithResponse call
+func ParseApiInternalHighlevelConnectionStatusResponse(rsp *http.Response) (*ApiInternalHighlevelConnectionStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalHighlevelConnectionStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HighLevelRecipientStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApiInternalHighlevelConnectionStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type ApiInternalV2RunsCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON207      *RunsCreated
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ApiInternalV2RunsCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseApiInternalV2DebugCapturesResponse parses an HTTP response from a ApiInternalV2DebugCapturesWithResponse call
+func ParseApiInternalV2DebugCapturesResponse(rsp *http.Response) (*ApiInternalV2DebugCapturesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApiInternalV2RunsCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ApiInternalV2DebugCapturesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type ApiInternalV2RecipientsStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]RecipientStatus
-	JSON400      *BadRequest
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DebugCapture
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r ApiInternalV2RecipientsStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApiInternalV2RecipientsStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseApiInternalV2RunsCreateResponse parses an HTTP response from a ApiInternalV2RunsCreateWithResponse call
+func ParseApiInternalV2RunsCreateResponse(rsp *http.Response) (*ApiInternalV2RunsCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &ApiInternalV2RunsCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest RunsCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	}
+
+	return response, nil
 }
 
-type ApiInternalV2RunHostsListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *externalRef0.RunHosts
-	JSON400      *BadRequest
-	JSON403      *Forbidden
+// ParseApiInternalV2RunsValidateResponse parses an HTTP response from a ApiInternalV2RunsValidateWithResponse call
+func ParseApiInternalV2RunsValidateResponse(rsp *http.Response) (*ApiInternalV2RunsValidateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalV2RunsValidateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest RunsCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ApiInternalV2RunHostsListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseApiInternalV2RecipientsStatusResponse parses an HTTP response from a ApiInternalV2RecipientsStatusWithResponse call
+func ParseApiInternalV2RecipientsStatusResponse(rsp *http.Response) (*ApiInternalV2RecipientsStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &ApiInternalV2RecipientsStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RecipientStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApiInternalV2RunHostsListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseApiInternalV2RunHostsListResponse parses an HTTP response from a ApiInternalV2RunHostsListWithResponse call
+func ParseApiInternalV2RunHostsListResponse(rsp *http.Response) (*ApiInternalV2RunHostsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &ApiInternalV2RunHostsListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest externalRef0.RunHosts
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
 }
 
-type ApiInternalVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Version
+// ParseApiInternalV2RunLimitsDeleteResponse parses an HTTP response from a ApiInternalV2RunLimitsDeleteWithResponse call
+func ParseApiInternalV2RunLimitsDeleteResponse(rsp *http.Response) (*ApiInternalV2RunLimitsDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalV2RunLimitsDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ApiInternalVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseApiInternalV2RunLimitsGetResponse parses an HTTP response from a ApiInternalV2RunLimitsGetWithResponse call
+func ParseApiInternalV2RunLimitsGetResponse(rsp *http.Response) (*ApiInternalV2RunLimitsGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalV2RunLimitsGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunLimit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApiInternalVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// ApiInternalRunsCreateWithBodyWithResponse request with arbitrary body returning *ApiInternalRunsCreateResponse
-func (c *ClientWithResponses) ApiInternalRunsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalRunsCreateResponse, error) {
-	rsp, err := c.ApiInternalRunsCreateWithBody(ctx, contentType, body, reqEditors...)
+// ParseApiInternalV2RunLimitsUpdateResponse parses an HTTP response from a ApiInternalV2RunLimitsUpdateWithResponse call
+func ParseApiInternalV2RunLimitsUpdateResponse(rsp *http.Response) (*ApiInternalV2RunLimitsUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiInternalRunsCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) ApiInternalRunsCreateWithResponse(ctx context.Context, body ApiInternalRunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalRunsCreateResponse, error) {
-	rsp, err := c.ApiInternalRunsCreate(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ApiInternalV2RunLimitsUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseApiInternalRunsCreateResponse(rsp)
-}
 
-// ApiInternalV2RunsCancelWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RunsCancelResponse
-func (c *ClientWithResponses) ApiInternalV2RunsCancelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelResponse, error) {
-	rsp, err := c.ApiInternalV2RunsCancelWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunLimit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseApiInternalV2RunsCancelResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) ApiInternalV2RunsCancelWithResponse(ctx context.Context, body ApiInternalV2RunsCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelResponse, error) {
-	rsp, err := c.ApiInternalV2RunsCancel(ctx, body, reqEditors...)
+// ParseApiInternalV2RunTemplatesListResponse parses an HTTP response from a ApiInternalV2RunTemplatesListWithResponse call
+func ParseApiInternalV2RunTemplatesListResponse(rsp *http.Response) (*ApiInternalV2RunTemplatesListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiInternalV2RunsCancelResponse(rsp)
-}
 
-// ApiInternalHighlevelConnectionStatusWithBodyWithResponse request with arbitrary body returning *ApiInternalHighlevelConnectionStatusResponse
-func (c *ClientWithResponses) ApiInternalHighlevelConnectionStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalHighlevelConnectionStatusResponse, error) {
-	rsp, err := c.ApiInternalHighlevelConnectionStatusWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ApiInternalV2RunTemplatesListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseApiInternalHighlevelConnectionStatusResponse(rsp)
-}
 
-func (c *ClientWithResponses) ApiInternalHighlevelConnectionStatusWithResponse(ctx context.Context, body ApiInternalHighlevelConnectionStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalHighlevelConnectionStatusResponse, error) {
-	rsp, err := c.ApiInternalHighlevelConnectionStatus(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RunTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseApiInternalHighlevelConnectionStatusResponse(rsp)
+
+	return response, nil
 }
 
-// ApiInternalV2RunsCreateWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RunsCreateResponse
-func (c *ClientWithResponses) ApiInternalV2RunsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error) {
-	rsp, err := c.ApiInternalV2RunsCreateWithBody(ctx, contentType, body, reqEditors...)
+// ParseApiInternalV2RunTemplatesCreateResponse parses an HTTP response from a ApiInternalV2RunTemplatesCreateWithResponse call
+func ParseApiInternalV2RunTemplatesCreateResponse(rsp *http.Response) (*ApiInternalV2RunTemplatesCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiInternalV2RunsCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) ApiInternalV2RunsCreateWithResponse(ctx context.Context, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error) {
-	rsp, err := c.ApiInternalV2RunsCreate(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ApiInternalV2RunTemplatesCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseApiInternalV2RunsCreateResponse(rsp)
-}
 
-// ApiInternalV2RecipientsStatusWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RecipientsStatusResponse
-func (c *ClientWithResponses) ApiInternalV2RecipientsStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RecipientsStatusResponse, error) {
-	rsp, err := c.ApiInternalV2RecipientsStatusWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RunTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseApiInternalV2RecipientsStatusResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) ApiInternalV2RecipientsStatusWithResponse(ctx context.Context, body ApiInternalV2RecipientsStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RecipientsStatusResponse, error) {
-	rsp, err := c.ApiInternalV2RecipientsStatus(ctx, body, reqEditors...)
+// ParseApiInternalV2RunTemplatesDeleteResponse parses an HTTP response from a ApiInternalV2RunTemplatesDeleteWithResponse call
+func ParseApiInternalV2RunTemplatesDeleteResponse(rsp *http.Response) (*ApiInternalV2RunTemplatesDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiInternalV2RecipientsStatusResponse(rsp)
-}
 
-// ApiInternalV2RunHostsListWithResponse request returning *ApiInternalV2RunHostsListResponse
-func (c *ClientWithResponses) ApiInternalV2RunHostsListWithResponse(ctx context.Context, params *ApiInternalV2RunHostsListParams, reqEditors ...RequestEditorFn) (*ApiInternalV2RunHostsListResponse, error) {
-	rsp, err := c.ApiInternalV2RunHostsList(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ApiInternalV2RunTemplatesDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseApiInternalV2RunHostsListResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ApiInternalVersionWithResponse request returning *ApiInternalVersionResponse
-func (c *ClientWithResponses) ApiInternalVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ApiInternalVersionResponse, error) {
-	rsp, err := c.ApiInternalVersion(ctx, reqEditors...)
+// ParseApiInternalV2RunTemplatesGetResponse parses an HTTP response from a ApiInternalV2RunTemplatesGetWithResponse call
+func ParseApiInternalV2RunTemplatesGetResponse(rsp *http.Response) (*ApiInternalV2RunTemplatesGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiInternalVersionResponse(rsp)
+
+	response := &ApiInternalV2RunTemplatesGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseApiInternalRunsCreateResponse parses an HTTP response from a ApiInternalRunsCreateWithResponse call
-func ParseApiInternalRunsCreateResponse(rsp *http.Response) (*ApiInternalRunsCreateResponse, error) {
+// ParseApiInternalV2RunTemplatesUpdateResponse parses an HTTP response from a ApiInternalV2RunTemplatesUpdateWithResponse call
+func ParseApiInternalV2RunTemplatesUpdateResponse(rsp *http.Response) (*ApiInternalV2RunTemplatesUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiInternalRunsCreateResponse{
+	response := &ApiInternalV2RunTemplatesUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
-		var dest RunsCreated
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunTemplate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON207 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -1287,31 +4650,38 @@ func ParseApiInternalRunsCreateResponse(rsp *http.Response) (*ApiInternalRunsCre
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseApiInternalV2RunsCancelResponse parses an HTTP response from a ApiInternalV2RunsCancelWithResponse call
-func ParseApiInternalV2RunsCancelResponse(rsp *http.Response) (*ApiInternalV2RunsCancelResponse, error) {
+// ParseApiInternalV2RunsRetryResponse parses an HTTP response from a ApiInternalV2RunsRetryWithResponse call
+func ParseApiInternalV2RunsRetryResponse(rsp *http.Response) (*ApiInternalV2RunsRetryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiInternalV2RunsCancelResponse{
+	response := &ApiInternalV2RunsRetryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
-		var dest RunsCanceled
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RunCreated
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON207 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -1320,27 +4690,60 @@ func ParseApiInternalV2RunsCancelResponse(rsp *http.Response) (*ApiInternalV2Run
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest RunCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseApiInternalHighlevelConnectionStatusResponse parses an HTTP response from a ApiInternalHighlevelConnectionStatusWithResponse call
-func ParseApiInternalHighlevelConnectionStatusResponse(rsp *http.Response) (*ApiInternalHighlevelConnectionStatusResponse, error) {
+// ParseApiInternalV2ServicesResponse parses an HTTP response from a ApiInternalV2ServicesWithResponse call
+func ParseApiInternalV2ServicesResponse(rsp *http.Response) (*ApiInternalV2ServicesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiInternalHighlevelConnectionStatusResponse{
+	response := &ApiInternalV2ServicesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HighLevelRecipientStatus
+		var dest []KnownService
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiInternalV2UsageResponse parses an HTTP response from a ApiInternalV2UsageWithResponse call
+func ParseApiInternalV2UsageResponse(rsp *http.Response) (*ApiInternalV2UsageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalV2UsageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsageReport
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1358,52 +4761,52 @@ func ParseApiInternalHighlevelConnectionStatusResponse(rsp *http.Response) (*Api
 	return response, nil
 }
 
-// ParseApiInternalV2RunsCreateResponse parses an HTTP response from a ApiInternalV2RunsCreateWithResponse call
-func ParseApiInternalV2RunsCreateResponse(rsp *http.Response) (*ApiInternalV2RunsCreateResponse, error) {
+// ParseApiInternalV2VersionResponse parses an HTTP response from a ApiInternalV2VersionWithResponse call
+func ParseApiInternalV2VersionResponse(rsp *http.Response) (*ApiInternalV2VersionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiInternalV2RunsCreateResponse{
+	response := &ApiInternalV2VersionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
-		var dest RunsCreated
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Deployment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON207 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseApiInternalV2RecipientsStatusResponse parses an HTTP response from a ApiInternalV2RecipientsStatusWithResponse call
-func ParseApiInternalV2RecipientsStatusResponse(rsp *http.Response) (*ApiInternalV2RecipientsStatusResponse, error) {
+// ParseApiInternalV3RunsCreateResponse parses an HTTP response from a ApiInternalV3RunsCreateWithResponse call
+func ParseApiInternalV3RunsCreateResponse(rsp *http.Response) (*ApiInternalV3RunsCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiInternalV2RecipientsStatusResponse{
+	response := &ApiInternalV3RunsCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []RecipientStatus
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest RunGroupCreated
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON207 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -1417,22 +4820,22 @@ func ParseApiInternalV2RecipientsStatusResponse(rsp *http.Response) (*ApiInterna
 	return response, nil
 }
 
-// ParseApiInternalV2RunHostsListResponse parses an HTTP response from a ApiInternalV2RunHostsListWithResponse call
-func ParseApiInternalV2RunHostsListResponse(rsp *http.Response) (*ApiInternalV2RunHostsListResponse, error) {
+// ParseApiInternalV3GroupsGetResponse parses an HTTP response from a ApiInternalV3GroupsGetWithResponse call
+func ParseApiInternalV3GroupsGetResponse(rsp *http.Response) (*ApiInternalV3GroupsGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiInternalV2RunHostsListResponse{
+	response := &ApiInternalV3GroupsGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest externalRef0.RunHosts
+		var dest RunGroupStatus
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1445,12 +4848,12 @@ func ParseApiInternalV2RunHostsListResponse(rsp *http.Response) (*ApiInternalV2R
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON404 = &dest
 
 	}
 
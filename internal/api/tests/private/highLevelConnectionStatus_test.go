@@ -3,6 +3,8 @@ package private
 import (
 	"playbook-dispatcher/internal/api/controllers/public"
 	"playbook-dispatcher/internal/api/tests/common"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
 	"strconv"
 
 	"github.com/google/uuid"
@@ -30,6 +32,8 @@ func getConnectionStatus(payload ApiInternalHighlevelConnectionStatusJSONRequest
 }
 
 var _ = Describe("high level connection status", func() {
+	db := test.WithDatabase()
+
 	It("get status for multiple different recipients", func() {
 		satID := SatelliteId("bd54e0e9-5310-45be-b107-fd7c96672ce5")
 		satOrgID := SatelliteOrgId("5")
@@ -64,6 +68,20 @@ var _ = Describe("high level connection status", func() {
 		Expect((*result)[1].Status).To(Equal(Connected))
 		Expect((*result)[1].Systems).To(Equal(directConnectHost))
 	})
+	It("caches the display names of the hosts", func() {
+		payload := ApiInternalHighlevelConnectionStatusJSONRequestBody{
+			Hosts: []string{"c484f980-ab8d-401b-90e7-aa1d4ccf8c0e"},
+			OrgId: "12345",
+		}
+
+		response, err := getConnectionStatus(payload)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode()).To(Equal(200))
+
+		var host dbModel.InventoryHost
+		Expect(db().Where("org_id = ? AND id = ?", "12345", "c484f980-ab8d-401b-90e7-aa1d4ccf8c0e").First(&host).Error).ToNot(HaveOccurred())
+		Expect(host.DisplayName).To(Equal("satellite.example.com"))
+	})
 	It("disallow more than 50 hosts", func() {
 
 		hosts := make([]string, 51)
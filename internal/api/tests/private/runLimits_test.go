@@ -0,0 +1,135 @@
+package private
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/utils/test"
+	"sync"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func setRunLimit(org OrgId, maxRunning int) *ApiInternalV2RunLimitsUpdateResponse {
+	resp, err := client.ApiInternalV2RunLimitsUpdate(test.TestContext(), org, RunLimitInput{MaxRunning: maxRunning})
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV2RunLimitsUpdateResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+	return res
+}
+
+func getRunLimit(org OrgId) RunLimit {
+	resp, err := client.ApiInternalV2RunLimitsGet(test.TestContext(), org)
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV2RunLimitsGetResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(res.StatusCode()).To(Equal(http.StatusOK))
+	return *res.JSON200
+}
+
+func dispatchToOrg(org OrgId) int {
+	payload := minimalV2Payload(uuid.New())
+	payload.OrgId = org
+
+	runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+	Expect(*runs).To(HaveLen(1))
+	return (*runs)[0].Code
+}
+
+var _ = Describe("run limits", func() {
+	db := test.WithDatabase()
+
+	It("rejects runs above the limit of the org", func() {
+		org := OrgId(orgId())
+
+		res := setRunLimit(org, 1)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.MaxRunning).To(Equal(1))
+		Expect(res.JSON200.Override).To(BeTrue())
+
+		Expect(dispatchToOrg(org)).To(Equal(http.StatusCreated))
+		Expect(dispatchToOrg(org)).To(Equal(http.StatusTooManyRequests))
+
+		limit := getRunLimit(org)
+		Expect(limit.MaxRunning).To(Equal(1))
+		Expect(limit.Running).To(Equal(1))
+	})
+
+	It("counts runs being canceled or waiting for their recipient", func() {
+		org := OrgId(orgId())
+
+		for _, status := range []string{"canceling", "waiting_for_connection", "success"} {
+			run := test.NewRunWithStatus(string(org), status)
+			Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+		}
+
+		setRunLimit(org, 3)
+		Expect(getRunLimit(org).Running).To(Equal(2))
+
+		Expect(dispatchToOrg(org)).To(Equal(http.StatusCreated))
+		Expect(dispatchToOrg(org)).To(Equal(http.StatusTooManyRequests))
+	})
+
+	It("does not exceed the limit with runs dispatched at the same time", func() {
+		org := OrgId(orgId())
+		setRunLimit(org, 2)
+
+		var wg sync.WaitGroup
+		codes := make([]int, 5)
+		for i := range codes {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				codes[i] = dispatchToOrg(org)
+			}(i)
+		}
+
+		wg.Wait()
+
+		created := 0
+		for _, code := range codes {
+			Expect(code).To(BeElementOf(http.StatusCreated, http.StatusTooManyRequests))
+			if code == http.StatusCreated {
+				created++
+			}
+		}
+
+		Expect(created).To(Equal(2))
+		Expect(getRunLimit(org).Running).To(Equal(2))
+	})
+
+	It("applies the default limit unless overridden", func() {
+		config.Get().Set("run.concurrency.limit", 1)
+		defer config.Get().Set("run.concurrency.limit", 0)
+
+		org := OrgId(orgId())
+
+		limit := getRunLimit(org)
+		Expect(limit.MaxRunning).To(Equal(1))
+		Expect(limit.Override).To(BeFalse())
+
+		Expect(dispatchToOrg(org)).To(Equal(http.StatusCreated))
+		Expect(dispatchToOrg(org)).To(Equal(http.StatusTooManyRequests))
+
+		// 0 disables the limit for the org
+		setRunLimit(org, 0)
+		Expect(dispatchToOrg(org)).To(Equal(http.StatusCreated))
+	})
+
+	It("removes the override", func() {
+		org := OrgId(orgId())
+		setRunLimit(org, 5)
+
+		resp, err := client.ApiInternalV2RunLimitsDelete(test.TestContext(), org)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+
+		Expect(getRunLimit(org).Override).To(BeFalse())
+
+		resp, err = client.ApiInternalV2RunLimitsDelete(test.TestContext(), org)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+	})
+})
@@ -0,0 +1,135 @@
+package private
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/controllers/public"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func minimalRunTemplate(org OrgId) RunTemplateInput {
+	return RunTemplateInput{
+		OrgId:     org,
+		Principal: Principal("test_user"),
+		Name:      public.PlaybookName("compliance scan"),
+		Schedule:  "0 3 * * 1",
+		Recipient: uuid.New(),
+		Url:       public.Url("http://example.com"),
+	}
+}
+
+func createRunTemplate(input RunTemplateInput) *ApiInternalV2RunTemplatesCreateResponse {
+	resp, err := client.ApiInternalV2RunTemplatesCreate(test.TestContext(), input)
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV2RunTemplatesCreateResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+	return res
+}
+
+func getRunTemplate(id uuid.UUID, org OrgId) *ApiInternalV2RunTemplatesGetResponse {
+	resp, err := client.ApiInternalV2RunTemplatesGet(test.TestContext(), id, &ApiInternalV2RunTemplatesGetParams{OrgId: org})
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV2RunTemplatesGetResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+	return res
+}
+
+var _ = Describe("run templates", func() {
+	db := test.WithDatabase()
+
+	It("creates a template scheduled for the next trigger", func() {
+		org := OrgId(orgId())
+		labels := public.Labels{"policy": "cis"}
+		input := minimalRunTemplate(org)
+		input.Labels = &labels
+
+		res := createRunTemplate(input)
+		Expect(res.StatusCode()).To(Equal(http.StatusCreated))
+
+		template := res.JSON201
+		Expect(template.OrgId).To(Equal(org))
+		Expect(template.Service).To(Equal("test"))
+		Expect(template.Schedule).To(Equal("0 3 * * 1"))
+		Expect(template.Recipient).To(Equal(input.Recipient))
+		Expect(template.Labels).To(Equal(labels))
+		Expect(template.Enabled).To(BeTrue())
+		Expect(template.NextRunAt.After(time.Now())).To(BeTrue())
+		Expect(template.NextRunAt.UTC().Weekday()).To(Equal(time.Monday))
+		Expect(template.LastRunAt).To(BeNil())
+
+		var stored dbModel.RunTemplate
+		Expect(db().Where("id = ?", template.Id).Take(&stored).Error).ToNot(HaveOccurred())
+		Expect(stored.URL).To(Equal("http://example.com"))
+	})
+
+	It("does not schedule a disabled template", func() {
+		input := minimalRunTemplate(OrgId(orgId()))
+		input.Enabled = new(bool)
+
+		res := createRunTemplate(input)
+		Expect(res.StatusCode()).To(Equal(http.StatusCreated))
+		Expect(res.JSON201.NextRunAt).To(BeNil())
+	})
+
+	It("rejects an invalid schedule", func() {
+		input := minimalRunTemplate(OrgId(orgId()))
+		input.Schedule = "every monday"
+
+		res := createRunTemplate(input)
+		Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+		Expect(res.JSON400.Message).To(ContainSubstring("invalid schedule"))
+	})
+
+	It("rejects a schedule triggering too often", func() {
+		input := minimalRunTemplate(OrgId(orgId()))
+		input.Schedule = "* * * * *"
+
+		res := createRunTemplate(input)
+		Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+
+	It("lists, updates and deletes templates of the org", func() {
+		org := OrgId(orgId())
+		created := createRunTemplate(minimalRunTemplate(org)).JSON201
+		createRunTemplate(minimalRunTemplate(OrgId(orgId())))
+
+		listResp, err := client.ApiInternalV2RunTemplatesList(test.TestContext(), &ApiInternalV2RunTemplatesListParams{OrgId: org})
+		Expect(err).ToNot(HaveOccurred())
+		list, err := ParseApiInternalV2RunTemplatesListResponse(listResp)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*list.JSON200).To(HaveLen(1))
+		Expect((*list.JSON200)[0].Id).To(Equal(created.Id))
+
+		input := minimalRunTemplate(org)
+		input.Schedule = "@daily"
+		input.Enabled = new(bool)
+
+		updateResp, err := client.ApiInternalV2RunTemplatesUpdate(test.TestContext(), created.Id, &ApiInternalV2RunTemplatesUpdateParams{OrgId: org}, input)
+		Expect(err).ToNot(HaveOccurred())
+		updated, err := ParseApiInternalV2RunTemplatesUpdateResponse(updateResp)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated.StatusCode()).To(Equal(http.StatusOK))
+		Expect(updated.JSON200.Schedule).To(Equal("@daily"))
+		Expect(updated.JSON200.Enabled).To(BeFalse())
+		Expect(updated.JSON200.NextRunAt).To(BeNil())
+
+		Expect(getRunTemplate(created.Id, org).JSON200.Schedule).To(Equal("@daily"))
+
+		deleteResp, err := client.ApiInternalV2RunTemplatesDelete(test.TestContext(), created.Id, &ApiInternalV2RunTemplatesDeleteParams{OrgId: org})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleteResp.StatusCode).To(Equal(http.StatusNoContent))
+
+		Expect(getRunTemplate(created.Id, org).StatusCode()).To(Equal(http.StatusNotFound))
+	})
+
+	It("404s for a template of another org", func() {
+		created := createRunTemplate(minimalRunTemplate(OrgId(orgId()))).JSON201
+
+		Expect(getRunTemplate(created.Id, OrgId(orgId())).StatusCode()).To(Equal(http.StatusNotFound))
+	})
+})
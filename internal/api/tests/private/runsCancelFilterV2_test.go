@@ -0,0 +1,89 @@
+package private
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/controllers/public"
+	"playbook-dispatcher/internal/common/config"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func cancelFilterV2(filter RunsCancelFilter) *ApiInternalV2RunsCancelFilterResponse {
+	resp, err := client.ApiInternalV2RunsCancelFilter(test.TestContext(), CancelFilterInputV2{
+		OrgId:     OrgId(orgId()),
+		Principal: Principal("test_user"),
+		Filter:    filter,
+	})
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV2RunsCancelFilterResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+	return res
+}
+
+var _ = Describe("runsCancel filter V2", func() {
+	db := test.WithDatabase()
+
+	createRun := func(labels dbModel.Labels, satellite bool) dbModel.Run {
+		data := test.NewRun(orgId())
+		data.Labels = labels
+
+		if satellite {
+			satId := uuid.MustParse("95cbea43-bb85-4153-96c2-eb2474b3e2b3")
+			data.SatId = &satId
+			data.SatOrgId = utils.StringRef("2")
+		}
+
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+		return data
+	}
+
+	It("cancels the running runs matching the filter", func() {
+		first := createRun(dbModel.Labels{"batch": "erroneous"}, true)
+		second := createRun(dbModel.Labels{"batch": "erroneous"}, true)
+		direct := createRun(dbModel.Labels{"batch": "erroneous"}, false)
+		other := createRun(dbModel.Labels{"batch": "fine"}, true)
+
+		res := cancelFilterV2(RunsCancelFilter{Labels: &public.Labels{"batch": "erroneous"}})
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+
+		summary := res.JSON200
+		Expect(summary.Canceled).To(Equal(2))
+		Expect(summary.Failed).To(Equal(1))
+		Expect(summary.More).To(BeFalse())
+
+		codes := map[uuid.UUID]int{}
+		for _, run := range summary.Runs {
+			codes[run.RunId] = run.Code
+		}
+
+		Expect(codes).To(HaveKeyWithValue(first.ID, http.StatusAccepted))
+		Expect(codes).To(HaveKeyWithValue(second.ID, http.StatusAccepted))
+		// only Satellite runs can be canceled
+		Expect(codes).To(HaveKeyWithValue(direct.ID, http.StatusBadRequest))
+		Expect(codes).ToNot(HaveKey(other.ID))
+	})
+
+	It("cancels a limited number of runs per request", func() {
+		config.Get().Set("cancel.filter.max.runs", 1)
+		defer config.Get().Set("cancel.filter.max.runs", 500)
+
+		first := createRun(dbModel.Labels{}, true)
+		createRun(dbModel.Labels{}, true)
+
+		res := cancelFilterV2(RunsCancelFilter{Service: utils.StringRef(first.Service)})
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.Runs).To(HaveLen(1))
+		Expect(res.JSON200.Runs[0].RunId).To(Equal(first.ID))
+		Expect(res.JSON200.More).To(BeTrue())
+	})
+
+	It("rejects an empty filter", func() {
+		res := cancelFilterV2(RunsCancelFilter{})
+		Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+})
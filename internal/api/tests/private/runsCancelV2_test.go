@@ -3,6 +3,7 @@ package private
 import (
 	"net/http"
 	"playbook-dispatcher/internal/api/controllers/public"
+	"playbook-dispatcher/internal/common/config"
 	dbModel "playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/utils/test"
 
@@ -71,6 +72,37 @@ var _ = Describe("runsCancel V2", func() {
 		Expect(*runs).To(HaveLen(1))
 		Expect((*runs)[0].Code).To(Equal(202))
 		Expect((*runs)[0].RunId).To(BeEquivalentTo(data.ID))
+
+		var run dbModel.Run
+		Expect(db().First(&run, data.ID).Error).ToNot(HaveOccurred())
+		Expect(run.Status).To(Equal("canceling"))
+	})
+
+	It("marks the hosts still running as cancel requested", func() {
+		satId := uuid.MustParse("95cbea43-bb85-4153-96c2-eb2474b3e2b3")
+		satOrgId := "2"
+
+		var data = test.NewRun(orgId())
+		data.SatId = &satId
+		data.SatOrgId = &satOrgId
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		running := test.NewRunHostWithHostname(data.ID, "running", "running.example.com")
+		finished := test.NewRunHostWithHostname(data.ID, "success", "finished.example.com")
+		Expect(db().Create(&[]dbModel.RunHost{running, finished}).Error).ToNot(HaveOccurred())
+
+		payload := minimalV2Cancel()
+		payload.RunId = public.RunId(data.ID)
+		payload.OrgId = OrgId(data.OrgID)
+
+		runs, _ := cancelV2(&ApiInternalV2RunsCancelJSONRequestBody{payload})
+		Expect((*runs)[0].Code).To(Equal(202))
+
+		var hosts []dbModel.RunHost
+		Expect(db().Where("run_id = ?", data.ID).Order("host").Find(&hosts).Error).ToNot(HaveOccurred())
+		Expect(hosts).To(HaveLen(2))
+		Expect(hosts[0].CancelState).To(BeNil())
+		Expect(*hosts[1].CancelState).To(Equal("cancel_requested"))
 	})
 
 	It("404s if playbook run is not known", func() {
@@ -121,6 +153,47 @@ var _ = Describe("runsCancel V2", func() {
 		Expect((*runs)[0].Code).To(Equal(400))
 	})
 
+	It("cancels a run of a directly connected host if enabled", func() {
+		config.Get().Set("cancel.rhc.enabled", true)
+		defer config.Get().Set("cancel.rhc.enabled", false)
+
+		var data = test.NewRun(orgId())
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		payload := minimalV2Cancel()
+		payload.RunId = public.RunId(data.ID)
+		payload.OrgId = OrgId(data.OrgID)
+
+		runs, _ := cancelV2(&ApiInternalV2RunsCancelJSONRequestBody{payload})
+
+		Expect(*runs).To(HaveLen(1))
+		Expect((*runs)[0].Code).To(Equal(202))
+
+		var run dbModel.Run
+		Expect(db().First(&run, data.ID).Error).ToNot(HaveOccurred())
+		Expect(run.Status).To(Equal("canceling"))
+	})
+
+	It("409s on a run already being canceled", func() {
+		satId := uuid.MustParse("95cbea43-bb85-4153-96c2-eb2474b3e2b3")
+		satOrgId := "2"
+
+		var data = test.NewRun(orgId())
+		data.SatId = &satId
+		data.SatOrgId = &satOrgId
+		data.Status = "canceling"
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		payload := minimalV2Cancel()
+		payload.RunId = public.RunId(data.ID)
+		payload.OrgId = OrgId(data.OrgID)
+
+		runs, _ := cancelV2(&ApiInternalV2RunsCancelJSONRequestBody{payload})
+
+		Expect(*runs).To(HaveLen(1))
+		Expect((*runs)[0].Code).To(Equal(409))
+	})
+
 	It("409s on the run being completed", func() {
 		satId, _ := uuid.Parse("95cbea43-bb85-4153-96c2-eb2474b3e2b3")
 		satOrgId := "2"
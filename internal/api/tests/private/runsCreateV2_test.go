@@ -4,8 +4,11 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"playbook-dispatcher/internal/api/controllers/public"
+	"playbook-dispatcher/internal/common/config"
 	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
 	"playbook-dispatcher/internal/common/utils/test"
 	"strings"
 	"time"
@@ -58,6 +61,7 @@ var _ = Describe("runsCreate V2", func() {
 		Expect(*run.PlaybookName).To(Equal(string(payload.Name)))
 		Expect(run.Status).To(Equal("running"))
 		Expect(run.Labels).To(BeEmpty())
+		Expect(string(*(*runs)[0].CorrelationId)).To(Equal(run.CorrelationID.String()))
 		Expect(run.Timeout).To(Equal(3600))
 	})
 
@@ -355,6 +359,169 @@ var _ = Describe("runsCreate V2", func() {
 		Expect((*runs)[0].Code).To(Equal(404))
 	})
 
+	It("keeps the run until the recipient connects if asked to", func() {
+		config.Get().Set("wait.for.connection.enabled", true)
+		defer config.Get().Set("wait.for.connection.enabled", false)
+
+		payload := minimalV2Payload(uuid.MustParse("b5fbb740-5590-45a4-8240-89192dc49199"))
+		payload.WaitForConnection = utils.BoolRef(true)
+		payload.Hosts = &RunInputHosts{{AnsibleHost: utils.StringRef("localhost")}}
+
+		runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+		Expect(*runs).To(HaveLen(1))
+		Expect((*runs)[0].Code).To(Equal(201))
+
+		var run dbModel.Run
+		Expect(db().Where("id = ?", (*runs)[0].Id).First(&run).Error).ToNot(HaveOccurred())
+		Expect(run.Status).To(Equal("waiting_for_connection"))
+
+		var host dbModel.RunHost
+		Expect(db().Where("run_id = ?", run.ID).First(&host).Error).ToNot(HaveOccurred())
+		Expect(host.Status).To(Equal("waiting_for_connection"))
+	})
+
+	Describe("pre-flight check", func() {
+		var playbook *httptest.Server
+
+		BeforeEach(func() {
+			playbook = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal(http.MethodHead))
+				w.Header().Set("X-Playbook-Signature", "c2lnbmVk")
+			}))
+		})
+
+		AfterEach(func() {
+			playbook.Close()
+		})
+
+		checkedPayload := func(recipient uuid.UUID, url string) RunInputV2 {
+			payload := minimalV2Payload(recipient)
+			payload.Url = public.Url(url)
+			payload.CheckRecipient = utils.BoolRef(true)
+			return payload
+		}
+
+		It("dispatches the run if the checks pass", func() {
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{checkedPayload(uuid.New(), playbook.URL)})
+
+			Expect(*runs).To(HaveLen(1))
+			Expect((*runs)[0].Code).To(Equal(201))
+		})
+
+		It("409s if the recipient is not connected", func() {
+			payload := checkedPayload(uuid.MustParse("411cb203-f8c9-480e-ba20-1efbc74e3a33"), playbook.URL)
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+			Expect((*runs)[0].Code).To(Equal(409))
+			Expect(*(*runs)[0].Reason).To(Equal(RecipientNotConnected))
+			Expect((*runs)[0].Id).To(BeNil())
+		})
+
+		It("409s if the playbook URL is not reachable", func() {
+			url := playbook.URL
+			playbook.Close()
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{checkedPayload(uuid.New(), url)})
+
+			Expect((*runs)[0].Code).To(Equal(409))
+			Expect(*(*runs)[0].Reason).To(Equal(PlaybookUrlUnreachable))
+		})
+
+		It("409s if the playbook URL does not respond with the required header", func() {
+			config.Get().Set("dispatch.preflight.required.header", "X-Insights-Signature")
+			defer config.Get().Set("dispatch.preflight.required.header", "")
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{checkedPayload(uuid.New(), playbook.URL)})
+
+			Expect((*runs)[0].Code).To(Equal(409))
+			Expect(*(*runs)[0].Reason).To(Equal(PlaybookHeaderMissing))
+		})
+
+		It("accepts a playbook URL responding with the required header", func() {
+			config.Get().Set("dispatch.preflight.required.header", "X-Playbook-Signature")
+			defer config.Get().Set("dispatch.preflight.required.header", "")
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{checkedPayload(uuid.New(), playbook.URL)})
+
+			Expect((*runs)[0].Code).To(Equal(201))
+		})
+	})
+
+	Describe("playbook signature", func() {
+		var (
+			playbook *httptest.Server
+			fetched  int
+		)
+
+		BeforeEach(func() {
+			fetched = 0
+			playbook = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fetched++
+				_, _ = w.Write([]byte("- hosts: all\n  vars:\n    foo: bar\n  tasks: []\n"))
+			}))
+		})
+
+		AfterEach(func() {
+			playbook.Close()
+		})
+
+		signedPayload := func(url string) RunInputV2 {
+			payload := minimalV2Payload(uuid.New())
+			payload.Url = public.Url(url)
+			return payload
+		}
+
+		It("400s if the playbook of a service requiring signed playbooks is not signed", func() {
+			config.Get().Set("playbook.signature.verify.service.test", true)
+			defer config.Get().Set("playbook.signature.verify.service.test", nil)
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{signedPayload(playbook.URL)})
+
+			Expect((*runs)[0].Code).To(Equal(400))
+			Expect(*(*runs)[0].Message).To(ContainSubstring("insights_signature"))
+			Expect((*runs)[0].Id).To(BeNil())
+			Expect(fetched).To(Equal(1))
+		})
+
+		It("400s if the playbook cannot be fetched", func() {
+			config.Get().Set("playbook.signature.verify.service.test", true)
+			defer config.Get().Set("playbook.signature.verify.service.test", nil)
+
+			url := playbook.URL
+			playbook.Close()
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{signedPayload(url)})
+
+			Expect((*runs)[0].Code).To(Equal(400))
+			Expect(*(*runs)[0].Message).To(ContainSubstring("could not be fetched"))
+		})
+
+		It("does not verify the playbook if the service does not require it", func() {
+			config.Get().Set("playbook.signature.verify.default", true)
+			config.Get().Set("playbook.signature.verify.service.test", false)
+			defer config.Get().Set("playbook.signature.verify.default", false)
+			defer config.Get().Set("playbook.signature.verify.service.test", nil)
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{signedPayload(playbook.URL)})
+
+			Expect((*runs)[0].Code).To(Equal(201))
+			Expect(fetched).To(Equal(0))
+		})
+	})
+
+	It("dispatches a run with a priority", func() {
+		priority := High
+		payload := minimalV2Payload(uuid.New())
+		payload.Priority = &priority
+
+		runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+		Expect(*runs).To(HaveLen(1))
+		Expect((*runs)[0].Code).To(Equal(201))
+	})
+
 	It("Successfully handles an anemic tenant", func() {
 		payload := minimalV2Payload(uuid.New())
 		payload.OrgId = "654322"
@@ -487,6 +654,11 @@ var _ = Describe("runsCreate V2", func() {
 			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "blahblah", "name": "Red Hat Playbook"}]`,
 			`string doesn't match the format \"url\"`,
 		),
+		Entry(
+			"invalid priority",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "priority": "urgent"}]`,
+			"value is not one of the allowed values",
+		),
 		Entry(
 			"invalid URL (web console url)",
 			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "web_console_url": "blahblah"}]`,
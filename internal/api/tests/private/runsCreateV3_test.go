@@ -0,0 +1,132 @@
+package private
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/controllers/public"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func dispatchV3(payload *ApiInternalV3RunsCreateJSONRequestBody) *RunGroupCreated {
+	resp, err := client.ApiInternalV3RunsCreate(test.TestContext(), *payload)
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV3RunsCreateResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(res.StatusCode()).To(Equal(http.StatusMultiStatus))
+
+	return res.JSON207
+}
+
+func getGroup(groupId uuid.UUID, orgId string) *ApiInternalV3GroupsGetResponse {
+	resp, err := client.ApiInternalV3GroupsGet(test.TestContext(), groupId, &ApiInternalV3GroupsGetParams{OrgId: OrgId(orgId)})
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV3GroupsGetResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+
+	return res
+}
+
+func minimalV3Payload(recipients ...uuid.UUID) RunGroupInputV3 {
+	payload := RunGroupInputV3{
+		Url:       public.Url("http://example.com"),
+		OrgId:     public.OrgId("5318290"),
+		Name:      public.PlaybookName("ansible playbook"),
+		Principal: Principal("test_user"),
+	}
+
+	for _, recipient := range recipients {
+		payload.Recipients = append(payload.Recipients, RunGroupRecipient{Recipient: public.RunRecipient(recipient)})
+	}
+
+	return payload
+}
+
+var _ = Describe("runsCreate V3", func() {
+	db := test.WithDatabase()
+
+	It("creates a run for each recipient within a group", func() {
+		payload := minimalV3Payload(uuid.New(), uuid.New())
+
+		created := dispatchV3(&payload)
+
+		Expect(created.Runs).To(HaveLen(2))
+
+		for i, result := range created.Runs {
+			Expect(result.Code).To(Equal(201))
+
+			var run dbModel.Run
+			Expect(db().Where("id = ?", result.Id).First(&run).Error).ToNot(HaveOccurred())
+			Expect(run.Recipient).To(Equal(payload.Recipients[i].Recipient))
+			Expect(*run.GroupID).To(Equal(created.GroupId))
+			Expect(*run.PlaybookName).To(Equal(string(payload.Name)))
+		}
+
+		var group dbModel.RunGroup
+		Expect(db().Where("id = ?", created.GroupId).First(&group).Error).ToNot(HaveOccurred())
+		Expect(group.OrgID).To(Equal("5318290"))
+		Expect(group.Name).To(Equal("ansible playbook"))
+		Expect(group.Principal).To(Equal("test_user"))
+	})
+
+	It("reports recipients that are not connected individually", func() {
+		payload := minimalV3Payload(uuid.New(), uuid.MustParse("b5fbb740-5590-45a4-8240-89192dc49199"))
+
+		created := dispatchV3(&payload)
+
+		Expect(created.Runs).To(HaveLen(2))
+		Expect(created.Runs[0].Code).To(Equal(201))
+		Expect(created.Runs[1].Code).To(Equal(404))
+	})
+
+	It("400s if there are no recipients", func() {
+		payload := minimalV3Payload()
+
+		resp, err := client.ApiInternalV3RunsCreate(test.TestContext(), payload)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+
+	Describe("group status", func() {
+		It("aggregates the status of the runs", func() {
+			payload := minimalV3Payload(uuid.New(), uuid.New())
+			created := dispatchV3(&payload)
+
+			res := getGroup(created.GroupId, "5318290")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(res.JSON200.Status).To(Equal(Running))
+			Expect(res.JSON200.Counts.Total).To(Equal(2))
+			Expect(res.JSON200.Counts.Running).To(Equal(2))
+			Expect(res.JSON200.Runs).To(HaveLen(2))
+
+			Expect(db().Model(&dbModel.Run{}).Where("group_id = ?", created.GroupId).Update("status", "success").Error).ToNot(HaveOccurred())
+
+			res = getGroup(created.GroupId, "5318290")
+			Expect(res.JSON200.Status).To(Equal(Success))
+			Expect(res.JSON200.Counts.Success).To(Equal(2))
+
+			Expect(db().Model(&dbModel.Run{}).Where("id = ?", created.Runs[0].Id).Update("status", "failure").Error).ToNot(HaveOccurred())
+
+			res = getGroup(created.GroupId, "5318290")
+			Expect(res.JSON200.Status).To(Equal(Failure))
+			Expect(res.JSON200.Counts.Failure).To(Equal(1))
+			Expect(res.JSON200.Counts.Success).To(Equal(1))
+		})
+
+		It("404s if the group belongs to a different org", func() {
+			payload := minimalV3Payload(uuid.New())
+			created := dispatchV3(&payload)
+
+			res := getGroup(created.GroupId, "12900172")
+			Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+		})
+
+		It("404s if the group does not exist", func() {
+			res := getGroup(uuid.New(), "5318290")
+			Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -0,0 +1,105 @@
+package private
+
+import (
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func retryV2(runId uuid.UUID, orgId string) *ApiInternalV2RunsRetryResponse {
+	resp, err := client.ApiInternalV2RunsRetry(test.TestContext(), runId, ApiInternalV2RunsRetryJSONRequestBody{
+		OrgId:     OrgId(orgId),
+		Principal: Principal("test_user"),
+	})
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV2RunsRetryResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+
+	return res
+}
+
+var _ = Describe("runsRetry V2", func() {
+	db := test.WithDatabase()
+
+	createRun := func(status string) dbModel.Run {
+		run := test.NewRunWithStatus(orgId(), status)
+		run.Labels = dbModel.Labels{"foo": "bar"}
+		run.PlaybookRunUrl = "https://console.redhat.com/insights/remediations"
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		host := test.NewRunHost(run.ID, status, nil)
+		Expect(db().Create(&host).Error).ToNot(HaveOccurred())
+
+		return run
+	}
+
+	It("dispatches a copy of a failed run", func() {
+		parent := createRun("failure")
+
+		res := retryV2(parent.ID, parent.OrgID)
+		Expect(res.StatusCode()).To(Equal(http.StatusCreated))
+		Expect(res.JSON201.Id).ToNot(BeNil())
+
+		var run dbModel.Run
+		Expect(db().First(&run, *res.JSON201.Id).Error).ToNot(HaveOccurred())
+		Expect(*run.ParentRunID).To(Equal(parent.ID))
+		Expect(run.Status).To(Equal("running"))
+		Expect(run.Recipient).To(Equal(parent.Recipient))
+		Expect(run.URL).To(Equal(parent.URL))
+		Expect(run.Labels).To(Equal(parent.Labels))
+		Expect(run.Timeout).To(Equal(parent.Timeout))
+		Expect(run.CorrelationID).ToNot(Equal(parent.CorrelationID))
+		Expect(*run.Principal).To(Equal("test_user"))
+
+		var hosts []dbModel.RunHost
+		Expect(db().Where("run_id = ?", run.ID).Find(&hosts).Error).ToNot(HaveOccurred())
+		Expect(hosts).To(HaveLen(1))
+		Expect(hosts[0].Host).To(Equal("localhost"))
+		Expect(hosts[0].Status).To(Equal("running"))
+	})
+
+	It("retries a retry", func() {
+		parent := createRun("timeout")
+
+		res := retryV2(parent.ID, parent.OrgID)
+		Expect(res.StatusCode()).To(Equal(http.StatusCreated))
+		Expect(db().Model(&dbModel.Run{}).Where("id = ?", *res.JSON201.Id).Update("status", "canceled").Error).ToNot(HaveOccurred())
+
+		res = retryV2(*res.JSON201.Id, parent.OrgID)
+		Expect(res.StatusCode()).To(Equal(http.StatusCreated))
+
+		var count int64
+		Expect(db().Model(&dbModel.Run{}).Where("parent_run_id IS NOT NULL AND org_id = ?", parent.OrgID).Count(&count).Error).ToNot(HaveOccurred())
+		Expect(count).To(BeEquivalentTo(2))
+	})
+
+	It("409s on a run that has not failed", func() {
+		for _, status := range []string{"running", "success"} {
+			run := createRun(status)
+
+			res := retryV2(run.ID, run.OrgID)
+			Expect(res.StatusCode()).To(Equal(http.StatusConflict))
+			Expect(res.JSONDefault.Code).To(Equal(http.StatusConflict))
+		}
+	})
+
+	It("404s on a run of another org", func() {
+		run := createRun("failure")
+
+		res := retryV2(run.ID, orgId())
+		Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+	})
+
+	It("responds with the code of the failed dispatch", func() {
+		run := createRun("failure")
+		Expect(db().Model(&run).Update("recipient", uuid.MustParse("b5fbb740-5590-45a4-8240-89192dc49199")).Error).ToNot(HaveOccurred())
+
+		res := retryV2(run.ID, run.OrgID)
+		Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+		Expect(res.JSONDefault.Message).ToNot(BeNil())
+	})
+})
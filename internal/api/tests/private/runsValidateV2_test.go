@@ -0,0 +1,115 @@
+package private
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/common/config"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func validateV2(payload ApiInternalV2RunsValidateJSONRequestBody) RunsCreated {
+	resp, err := client.ApiInternalV2RunsValidate(test.TestContext(), payload)
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV2RunsValidateResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(res.StatusCode()).To(Equal(http.StatusMultiStatus))
+
+	return *res.JSON207
+}
+
+var _ = Describe("runsValidate V2", func() {
+	db := test.WithDatabase()
+
+	It("describes the run without creating it", func() {
+		payload := minimalV2Payload(uuid.New())
+		payload.OrgId = "12900172"
+
+		result := validateV2(ApiInternalV2RunsValidateJSONRequestBody{payload})
+
+		Expect(result).To(HaveLen(1))
+		Expect(result[0].Code).To(Equal(http.StatusOK))
+		Expect(result[0].Id).To(BeNil())
+
+		dryRun := result[0].DryRun
+		Expect(dryRun.Protocol).To(Equal("rhc"))
+		Expect(dryRun.Timeout).To(Equal(3600))
+		Expect(dryRun.WebConsoleUrl).To(Equal(webConsoleUrlDefault))
+		Expect(dryRun.ExecutionMode).To(Equal("run"))
+		Expect(dryRun.DispatchChunks).To(Equal(1))
+		Expect(dryRun.RecipientConnected).To(BeTrue())
+		Expect(dryRun.SatelliteRegistered).To(BeNil())
+
+		var count int64
+		Expect(db().Model(&dbModel.Run{}).Where("recipient = ?", payload.Recipient).Count(&count).Error).ToNot(HaveOccurred())
+		Expect(count).To(BeEquivalentTo(0))
+	})
+
+	It("reports a recipient that is not connected", func() {
+		payload := minimalV2Payload(uuid.MustParse("411cb203-f8c9-480e-ba20-1efbc74e3a33"))
+
+		result := validateV2(ApiInternalV2RunsValidateJSONRequestBody{payload})
+
+		Expect(result[0].Code).To(Equal(http.StatusNotFound))
+		Expect(result[0].DryRun.RecipientConnected).To(BeFalse())
+		Expect(result[0].DryRun.WaitsForConnection).To(BeFalse())
+	})
+
+	It("reports the failed pre-flight check of the run", func() {
+		payload := minimalV2Payload(uuid.MustParse("411cb203-f8c9-480e-ba20-1efbc74e3a33"))
+		payload.CheckRecipient = utils.BoolRef(true)
+
+		result := validateV2(ApiInternalV2RunsValidateJSONRequestBody{payload})
+
+		Expect(result[0].Code).To(Equal(http.StatusConflict))
+		Expect(*result[0].Reason).To(Equal(RecipientNotConnected))
+		Expect(result[0].DryRun.RecipientConnected).To(BeFalse())
+	})
+
+	It("reports a run that would wait for its recipient", func() {
+		config.Get().Set("wait.for.connection.enabled", true)
+		defer config.Get().Set("wait.for.connection.enabled", false)
+
+		payload := minimalV2Payload(uuid.MustParse("411cb203-f8c9-480e-ba20-1efbc74e3a33"))
+		payload.WaitForConnection = utils.BoolRef(true)
+
+		result := validateV2(ApiInternalV2RunsValidateJSONRequestBody{payload})
+
+		Expect(result[0].Code).To(Equal(http.StatusOK))
+		Expect(result[0].DryRun.RecipientConnected).To(BeFalse())
+		Expect(result[0].DryRun.WaitsForConnection).To(BeTrue())
+	})
+
+	It("checks the Satellite is registered for the recipient", func() {
+		satId := uuid.New().String()
+		satOrgId := "123"
+
+		payload := minimalV2Payload(uuid.MustParse("d415fc2d-9700-4e30-9621-6a410ccc92d8"))
+		payload.RecipientConfig = &RecipientConfig{SatId: &satId, SatOrgId: &satOrgId}
+
+		mismatched := minimalV2Payload(uuid.New())
+		mismatched.RecipientConfig = &RecipientConfig{SatId: &satId, SatOrgId: &satOrgId}
+
+		result := validateV2(ApiInternalV2RunsValidateJSONRequestBody{payload, mismatched})
+
+		Expect(result[0].Code).To(Equal(http.StatusOK))
+		Expect(result[0].DryRun.Protocol).To(Equal("satellite"))
+		Expect(*result[0].DryRun.SatelliteRegistered).To(BeTrue())
+		Expect(*result[1].DryRun.SatelliteRegistered).To(BeFalse())
+	})
+
+	It("rejects a playbook URL not allowed for the service", func() {
+		config.Get().Set("playbook.url.allowlist.enabled", true)
+		config.Get().Set("playbook.url.allowlist.default", "https://console.redhat.com/api/*")
+		defer config.Get().Set("playbook.url.allowlist.enabled", false)
+
+		result := validateV2(ApiInternalV2RunsValidateJSONRequestBody{minimalV2Payload(uuid.New())})
+
+		Expect(result[0].Code).To(Equal(http.StatusBadRequest))
+		Expect(result[0].DryRun).To(BeNil())
+	})
+})
@@ -0,0 +1,25 @@
+package private
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("services", func() {
+	It("lists the built-in services", func() {
+		resp, err := client.ApiInternalV2Services(test.TestContext())
+		Expect(err).ToNot(HaveOccurred())
+		res, err := ParseApiInternalV2ServicesResponse(resp)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+
+		Expect(*res.JSON200).To(ContainElement(KnownService{
+			Name:       "remediations",
+			Permission: "playbook_dispatcher_remediations_run_view",
+			Source:     Builtin,
+		}))
+	})
+})
@@ -0,0 +1,73 @@
+package private
+
+import (
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func getUsage(params *ApiInternalV2UsageParams) (*UsageReport, *ApiInternalV2UsageResponse) {
+	resp, err := client.ApiInternalV2Usage(test.TestContext(), params)
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV2UsageResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+
+	return res.JSON200, res
+}
+
+var _ = Describe("usage", func() {
+	db := test.WithDatabase()
+
+	It("reports runs, stdout volume and api calls of an org", func() {
+		org := OrgId(orgId())
+
+		run := test.NewRun(string(org))
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		host := test.NewRunHost(run.ID, "success", nil)
+		host.Log = "12345"
+		Expect(db().Create(&host).Error).ToNot(HaveOccurred())
+
+		usage := dbModel.ApiUsage{OrgID: string(org), Period: time.Now().UTC().Truncate(time.Hour), Calls: 7}
+		Expect(db().Create(&usage).Error).ToNot(HaveOccurred())
+
+		result, res := getUsage(&ApiInternalV2UsageParams{
+			OrgId: &org,
+			Since: time.Now().Add(-2 * time.Hour),
+		})
+
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(result.Data).To(HaveLen(1))
+		Expect(result.Data[0].OrgId).To(Equal(org))
+		Expect(result.Data[0].RunsCreated).To(BeEquivalentTo(1))
+		Expect(result.Data[0].StdoutBytes).To(BeEquivalentTo(5))
+		Expect(result.Data[0].ApiCalls).To(BeEquivalentTo(7))
+	})
+
+	It("returns an empty report for an org with no activity", func() {
+		org := OrgId(orgId())
+
+		result, res := getUsage(&ApiInternalV2UsageParams{
+			OrgId: &org,
+			Since: time.Now().Add(-2 * time.Hour),
+		})
+
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(result.Data).To(BeEmpty())
+	})
+
+	It("400s if since is not before until", func() {
+		until := time.Now().Add(-time.Hour)
+
+		_, res := getUsage(&ApiInternalV2UsageParams{
+			Since: time.Now(),
+			Until: &until,
+		})
+
+		Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+})
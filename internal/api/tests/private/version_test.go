@@ -33,4 +33,22 @@ var _ = Describe("Version", func() {
 
 		})
 	})
+
+	Describe("get deployment details", func() {
+		It("should return the git revision, api versions and features", func() {
+			resp, err := client.ApiInternalV2Version(test.TestContext())
+			Expect(err).ToNot(HaveOccurred())
+			res, err := ParseApiInternalV2VersionResponse(resp)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+
+			Expect(res.JSON200.Commit).To(Equal(testBuildCommit))
+			Expect(res.JSON200.SchemaVersion).To(BeNumerically(">", 0))
+			Expect(res.JSON200.ApiVersions).To(ContainElement(ApiVersion{
+				Version:       "v2",
+				Specification: "/api/playbook-dispatcher/v2/openapi.json",
+			}))
+			Expect(res.JSON200.Features.KesselMode).To(Equal(RbacOnly))
+		})
+	})
 })
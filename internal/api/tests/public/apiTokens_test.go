@@ -0,0 +1,77 @@
+package public
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/apitokens"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func getWithApiToken(url, token string) *http.Response {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	Expect(err).ToNot(HaveOccurred())
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	res, err := test.Client.Do(req)
+	Expect(err).ToNot(HaveOccurred())
+	return res
+}
+
+var _ = Describe("API tokens", func() {
+	db := test.WithDatabase()
+
+	const runsUrl = "http://localhost:9002/api/playbook-dispatcher/v1/runs"
+
+	mint := func(expiresAt *time.Time) string {
+		token, hash, err := apitokens.New()
+		Expect(err).ToNot(HaveOccurred())
+
+		record := dbModel.ApiToken{ID: uuid.New(), OrgID: orgId(), Name: "ci", Hash: hash, ExpiresAt: expiresAt}
+		Expect(db().Create(&record).Error).ToNot(HaveOccurred())
+
+		return token
+	}
+
+	It("grants access to the runs of the organization", func() {
+		run, other := test.NewRun(orgId()), test.NewRun("1234567")
+		Expect(db().Create(&[]dbModel.Run{run, other}).Error).ToNot(HaveOccurred())
+
+		res, err := ParseApiRunsListResponse(getWithApiToken(runsUrl, mint(nil)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.Data).To(HaveLen(1))
+		Expect(*res.JSON200.Data[0].Id).To(Equal(run.ID))
+	})
+
+	It("grants access to run hosts", func() {
+		res := getWithApiToken("http://localhost:9002/api/playbook-dispatcher/v1/run_hosts", mint(nil))
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("rejects an unknown token", func() {
+		res := getWithApiToken(runsUrl, apitokens.Prefix+"unknown")
+		Expect(res.StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("rejects an expired token", func() {
+		expired := time.Now().Add(-time.Minute)
+		res := getWithApiToken(runsUrl, mint(&expired))
+		Expect(res.StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("rejects a token combined with an identity header", func() {
+		req, err := http.NewRequest(http.MethodGet, runsUrl, nil)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Authorization", "Bearer "+mint(nil))
+		req.Header.Set("x-rh-identity", test.IdentityHeaderMinimal(orgId()))
+
+		res, err := test.Client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -0,0 +1,111 @@
+package public
+
+import (
+	"io"
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func listRunsV2(keysAndValues ...interface{}) (*RunsV2, *ApiRunsListV2Response) {
+	raw := doGet("http://localhost:9002/api/playbook-dispatcher/v2/runs", keysAndValues...)
+	res, err := ParseApiRunsListV2Response(raw)
+	Expect(err).ToNot(HaveOccurred())
+	return res.JSON200, res
+}
+
+func listRunHostsV2(keysAndValues ...interface{}) (*RunHostsV2, *ApiRunHostsListV2Response) {
+	raw := doGet("http://localhost:9002/api/playbook-dispatcher/v2/run_hosts", keysAndValues...)
+	res, err := ParseApiRunHostsListV2Response(raw)
+	Expect(err).ToNot(HaveOccurred())
+	return res.JSON200, res
+}
+
+var _ = Describe("v2", func() {
+	db := test.WithDatabase()
+
+	var run dbModel.Run
+	var host dbModel.RunHost
+
+	BeforeEach(func() {
+		run = test.NewRun(orgId())
+		run.Principal = utils.StringRef("jharting")
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		host = test.NewRunHostWithHostname(run.ID, "running", "01.example.com")
+		Expect(db().Create(&host).Error).ToNot(HaveOccurred())
+	})
+
+	Describe("runs", func() {
+		It("returns the default fields", func() {
+			runs, res := listRunsV2()
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(runs.Data).To(HaveLen(1))
+			Expect(*runs.Data[0].Id).To(Equal(run.ID))
+			Expect(*runs.Data[0].OrgId).To(Equal(orgId()))
+			Expect(*runs.Data[0].Status).To(BeEquivalentTo("running"))
+			Expect(runs.Data[0].Principal).To(BeNil())
+			Expect(runs.Links.First).To(HavePrefix("/api/playbook-dispatcher/v2/runs?"))
+		})
+
+		It("returns the principal if requested", func() {
+			runs, res := listRunsV2("fields[data]", "id,principal")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(*runs.Data[0].Principal).To(BeEquivalentTo("jharting"))
+			Expect(runs.Data[0].OrgId).To(BeNil())
+		})
+
+		It("returns the links of runs if requested", func() {
+			runs, res := listRunsV2("fields[data]", "links")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(runs.Data[0].Id).To(BeNil())
+			Expect(*runs.Data[0].Links.Self).To(Equal("/api/playbook-dispatcher/v1/runs/" + run.ID.String()))
+			Expect(*runs.Data[0].Links.Cancel).To(Equal("/internal/v2/cancel"))
+		})
+
+		It("rejects the fields of v1 that were dropped", func() {
+			_, res := listRunsV2("fields[data]", "account")
+			Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+		})
+
+		It("exports runs", func() {
+			raw := doGet("http://localhost:9002/api/playbook-dispatcher/v2/runs", "format", "csv", "fields[data]", "id,principal")
+			Expect(raw.StatusCode).To(Equal(http.StatusOK))
+
+			defer raw.Body.Close()
+			body, err := io.ReadAll(raw.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(body)).To(Equal("id,principal\n" + run.ID.String() + ",jharting\n"))
+		})
+	})
+
+	Describe("run hosts", func() {
+		It("returns the default fields named as in the internal v2 API", func() {
+			hosts, res := listRunHostsV2()
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(hosts.Data).To(HaveLen(1))
+			Expect(*hosts.Data[0].Id).To(Equal(host.ID))
+			Expect(*hosts.Data[0].RunId).To(Equal(run.ID))
+			Expect(*hosts.Data[0].AnsibleHost).To(Equal("01.example.com"))
+			Expect(*hosts.Data[0].Status).To(BeEquivalentTo("running"))
+			Expect(hosts.Data[0].Stdout).To(BeNil())
+		})
+
+		It("supports the filters and fields of v1", func() {
+			hosts, res := listRunHostsV2("filter[run][id]", run.ID.String(), "fields[data]", "ansible_host,stdout")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(*hosts.Data[0].AnsibleHost).To(Equal("01.example.com"))
+			Expect(*hosts.Data[0].Stdout).To(Equal(host.Log))
+			Expect(hosts.Data[0].Id).To(BeNil())
+		})
+
+		It("rejects the field names of v1", func() {
+			_, res := listRunHostsV2("fields[data]", "host")
+			Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
@@ -37,6 +37,26 @@ var _ = Describe("Middleware", func() {
 
 			Expect(res.StatusCode).To(Equal(http.StatusOK))
 		})
+
+		It("the v2 openapi.json describes the v2 operations", func() {
+			req, err := http.NewRequest(http.MethodGet, "http://localhost:9002/api/playbook-dispatcher/v2/openapi.json", nil)
+			Expect(err).ToNot(HaveOccurred())
+			res, err := test.Client.Do(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+			var spec struct {
+				Info struct {
+					BuildCommit *string `json:"x-build-commit"`
+				} `json:"info"`
+				Paths map[string]interface{} `json:"paths"`
+			}
+
+			Expect(json.NewDecoder(res.Body).Decode(&spec)).To(Succeed())
+			Expect(spec.Info.BuildCommit).ToNot(BeNil())
+			Expect(spec.Paths).To(HaveKey("/api/playbook-dispatcher/v2/runs"))
+			Expect(spec.Paths).ToNot(HaveKey("/api/playbook-dispatcher/v1/runs"))
+		})
 	})
 
 	Describe("identity", func() {
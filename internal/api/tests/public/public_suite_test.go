@@ -19,6 +19,7 @@ func TestConfig(t *testing.T) {
 
 	cfg := config.Get()
 	cfg.Set("web.port", 9002)
+	cfg.Set("public.api.tokens.enabled", true)
 
 	api.WithApi(cfg)
 
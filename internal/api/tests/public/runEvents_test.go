@@ -0,0 +1,72 @@
+package public
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/runevents"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func streamRunEvents(runId uuid.UUID) *http.Response {
+	return doGet(fmt.Sprintf("http://localhost:9002/api/playbook-dispatcher/v1/runs/%s/events", runId))
+}
+
+var _ = Describe("runEvents", func() {
+	db := test.WithDatabase()
+
+	readAll := func(res *http.Response) string {
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return string(body)
+	}
+
+	It("sends the current status and ends the stream of a finished run", func() {
+		run := test.NewRun(orgId())
+		run.Status = "success"
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+		Expect(db().Create([]dbModel.RunHost{test.NewRunHostWithHostname(run.ID, "success", "01.example.com")}).Error).ToNot(HaveOccurred())
+
+		res := streamRunEvents(run.ID)
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		Expect(res.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+
+		body := readAll(res)
+		Expect(body).To(ContainSubstring(fmt.Sprintf(`event: run
+data: {"type":"run","run_id":"%s","status":"success"}`, run.ID)))
+		Expect(body).To(ContainSubstring(fmt.Sprintf(`event: host
+data: {"type":"host","run_id":"%s","host":"01.example.com","status":"success"}`, run.ID)))
+	})
+
+	It("streams the changes of a running run until it finishes", func() {
+		run := test.NewRun(orgId())
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		res := streamRunEvents(run.ID)
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+		Expect(runevents.Publish(db(),
+			runevents.Change{Type: runevents.TypeHost, RunID: run.ID, Host: "localhost", Status: "success"},
+			runevents.Change{Type: runevents.TypeRun, RunID: run.ID, Status: "success"},
+		)).To(Succeed())
+
+		body := readAll(res)
+		Expect(body).To(ContainSubstring(`"status":"running"`))
+		Expect(body).To(ContainSubstring(fmt.Sprintf(`data: {"type":"host","run_id":"%s","host":"localhost","status":"success"}`, run.ID)))
+		Expect(body).To(HaveSuffix(fmt.Sprintf("data: {\"type\":\"run\",\"run_id\":\"%s\",\"status\":\"success\"}\n\n", run.ID)))
+	})
+
+	It("returns 404 for a run of another tenant", func() {
+		run := test.NewRun("1234567")
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		res := streamRunEvents(run.ID)
+		Expect(res.StatusCode).To(Equal(http.StatusNotFound))
+	})
+})
@@ -0,0 +1,128 @@
+package public
+
+import (
+	"fmt"
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func getRun(runId uuid.UUID) *ApiRunGetResponse {
+	raw := doGet(fmt.Sprintf("http://localhost:9002/api/playbook-dispatcher/v1/runs/%s", runId))
+	res, err := ParseApiRunGetResponse(raw)
+	Expect(err).ToNot(HaveOccurred())
+
+	return res
+}
+
+var _ = Describe("runGet", func() {
+	db := test.WithDatabase()
+
+	dbInsert := func(run dbModel.Run, hosts ...dbModel.RunHost) {
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+		if len(hosts) > 0 {
+			Expect(db().Create(hosts).Error).ToNot(HaveOccurred())
+		}
+	}
+
+	It("returns the run with its host counts", func() {
+		run := test.NewRun(orgId())
+		run.Labels = dbModel.Labels{"foo": "bar"}
+		run.Timeout = 600
+		dbInsert(run,
+			test.NewRunHostWithHostname(run.ID, "running", "01.example.com"),
+			test.NewRunHostWithHostname(run.ID, "success", "02.example.com"),
+			test.NewRunHostWithHostname(run.ID, "success", "03.example.com"),
+			test.NewRunHostWithHostname(run.ID, "failure", "04.example.com"),
+		)
+
+		res := getRun(run.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(*res.JSON200.Id).To(BeEquivalentTo(run.ID))
+		Expect(*res.JSON200.CorrelationId).To(BeEquivalentTo(run.CorrelationID.String()))
+		Expect(*res.JSON200.Labels).To(HaveKeyWithValue("foo", "bar"))
+		Expect(*res.JSON200.Timeout).To(BeEquivalentTo(600))
+		Expect(*res.JSON200.Service).To(BeEquivalentTo(run.Service))
+		Expect(*res.JSON200.Status).To(BeEquivalentTo("running"))
+		Expect(*res.JSON200.Hosts).To(Equal(RunHostCounts{Total: 4, Running: 1, Success: 2, Failure: 1}))
+	})
+
+	It("links the resources related to the run", func() {
+		run := test.NewRun(orgId())
+		run.PlaybookRunUrl = "https://console.redhat.com/insights/remediations/1"
+		dbInsert(run)
+
+		res := getRun(run.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+
+		links := res.JSON200.Links
+		Expect(*links.Self).To(Equal(fmt.Sprintf("/api/playbook-dispatcher/v1/runs/%s", run.ID)))
+		Expect(*links.Hosts).To(Equal(fmt.Sprintf("/api/playbook-dispatcher/v1/run_hosts?filter[run][id]=%s", run.ID)))
+		Expect(*links.History).To(Equal(fmt.Sprintf("/api/playbook-dispatcher/v1/runs/%s/history", run.ID)))
+		Expect(*links.Events).To(Equal(fmt.Sprintf("/api/playbook-dispatcher/v1/runs/%s/events", run.ID)))
+		Expect(*links.Cancel).To(Equal("/internal/v2/cancel"))
+		Expect(*links.WebConsole).To(BeEquivalentTo(run.PlaybookRunUrl))
+	})
+
+	It("does not link the cancel operation once the run finished", func() {
+		run := test.NewRunWithStatus(orgId(), "success")
+		dbInsert(run)
+
+		res := getRun(run.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.Links.Cancel).To(BeNil())
+		Expect(res.JSON200.Links.WebConsole).To(BeNil())
+	})
+
+	It("reports an expired run as timeout", func() {
+		run := test.NewRun(orgId())
+		run.CreatedAt = time.Now().Add(-6 * time.Hour)
+		dbInsert(run)
+
+		res := getRun(run.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(*res.JSON200.Status).To(BeEquivalentTo("timeout"))
+		Expect(*res.JSON200.Hosts).To(Equal(RunHostCounts{}))
+	})
+
+	It("returns 304 until the run is updated", func() {
+		run := test.NewRun(orgId())
+		dbInsert(run, test.NewRunHostWithHostname(run.ID, "running", "01.example.com"))
+		url := fmt.Sprintf("http://localhost:9002/api/playbook-dispatcher/v1/runs/%s", run.ID)
+
+		etag := getRun(run.ID).HTTPResponse.Header.Get("ETag")
+		Expect(etag).ToNot(BeEmpty())
+
+		res := doConditionalGet(etag, url)
+		Expect(res.StatusCode).To(Equal(http.StatusNotModified))
+
+		Expect(db().Model(&run).Update("status", "success").Error).ToNot(HaveOccurred())
+
+		res = doConditionalGet(etag, url)
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		Expect(res.Header.Get("ETag")).ToNot(Equal(etag))
+	})
+
+	It("returns 404 for an unknown run", func() {
+		res := getRun(uuid.New())
+		Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+	})
+
+	It("returns 404 for a run of another tenant", func() {
+		run := test.NewRun("1234567")
+		dbInsert(run)
+
+		res := getRun(run.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+	})
+
+	It("returns 400 for an invalid run ID", func() {
+		raw := doGet("http://localhost:9002/api/playbook-dispatcher/v1/runs/not-a-uuid")
+		Expect(raw.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+})
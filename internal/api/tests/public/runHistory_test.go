@@ -0,0 +1,71 @@
+package public
+
+import (
+	"fmt"
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/common/utils/test"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func getRunHistory(runId uuid.UUID) (*RunHistory, *ApiRunHistoryGetResponse) {
+	raw := doGet(fmt.Sprintf("http://localhost:9002/api/playbook-dispatcher/v1/runs/%s/history", runId))
+	res, err := ParseApiRunHistoryGetResponse(raw)
+	Expect(err).ToNot(HaveOccurred())
+
+	return res.JSON200, res
+}
+
+var _ = Describe("runHistory", func() {
+	db := test.WithDatabase()
+
+	It("returns the transitions of the run oldest first", func() {
+		run := test.NewRun(orgId())
+		run.Status = "timeout"
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		created := time.Now().Add(-time.Hour)
+		events := []dbModel.RunEvent{
+			{RunID: run.ID, PreviousStatus: utils.StringRef("running"), Status: "timeout", Source: "sweeper", CreatedAt: created.Add(10 * time.Minute)},
+			{RunID: run.ID, Status: "running", Source: "dispatch", SourceEventID: utils.StringRef("request-1"), CreatedAt: created},
+		}
+		Expect(db().Create(&events).Error).ToNot(HaveOccurred())
+
+		history, res := getRunHistory(run.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(history.Data).To(HaveLen(2))
+
+		Expect(history.Data[0].PreviousStatus).To(BeNil())
+		Expect(history.Data[0].Status).To(Equal(RunStatusRunning))
+		Expect(history.Data[0].Source).To(BeEquivalentTo("dispatch"))
+		Expect(*history.Data[0].SourceEventId).To(Equal("request-1"))
+
+		Expect(*history.Data[1].PreviousStatus).To(Equal(RunStatusRunning))
+		Expect(history.Data[1].Status).To(Equal(RunStatusTimeout))
+		Expect(history.Data[1].Source).To(BeEquivalentTo("sweeper"))
+		Expect(history.Data[1].SourceEventId).To(BeNil())
+		Expect(history.Data[1].CreatedAt).To(BeTemporally("~", created.Add(10*time.Minute), time.Second))
+	})
+
+	It("returns an empty history for runs without transitions", func() {
+		run := test.NewRun(orgId())
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		history, res := getRunHistory(run.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(history.Data).To(BeEmpty())
+	})
+
+	It("returns 404 for a run of another tenant", func() {
+		run := test.NewRun("1234567")
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		_, res := getRunHistory(run.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+	})
+})
@@ -0,0 +1,77 @@
+package public
+
+import (
+	"fmt"
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func getRunHostArtifacts(runId uuid.UUID, host string) *ApiRunHostArtifactsGetResponse {
+	raw := doGet(fmt.Sprintf("http://localhost:9002/api/playbook-dispatcher/v1/runs/%s/hosts/%s/artifacts", runId, host))
+	res, err := ParseApiRunHostArtifactsGetResponse(raw)
+	Expect(err).ToNot(HaveOccurred())
+
+	return res
+}
+
+var _ = Describe("runHostArtifacts", func() {
+	db := test.WithDatabase()
+
+	dbInsert := func(run dbModel.Run, hosts ...dbModel.RunHost) {
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+		Expect(db().Create(hosts).Error).ToNot(HaveOccurred())
+	}
+
+	It("returns the artifacts of a run host", func() {
+		run := test.NewRun(orgId())
+		host := test.NewRunHost(run.ID, "success", nil)
+		host.Artifacts = []byte(`{
+			"facts": {"ansible_distribution": "RedHat"},
+			"stats": {"report_version": 2},
+			"results": [{"task": "check packages", "event": "runner_on_ok", "result": {"updates": 3}}]
+		}`)
+		dbInsert(run, host)
+
+		res := getRunHostArtifacts(run.ID, host.Host)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.Facts).To(HaveKeyWithValue("ansible_distribution", "RedHat"))
+		Expect(res.JSON200.Stats).To(HaveKeyWithValue("report_version", BeEquivalentTo(2)))
+		Expect(res.JSON200.Results).To(HaveLen(1))
+		Expect(res.JSON200.Results[0].Task).To(Equal("check packages"))
+		Expect(res.JSON200.Results[0].Event).To(Equal("runner_on_ok"))
+	})
+
+	It("returns empty artifacts of a host that reported none", func() {
+		run := test.NewRun(orgId())
+		host := test.NewRunHost(run.ID, "running", nil)
+		dbInsert(run, host)
+
+		res := getRunHostArtifacts(run.ID, host.Host)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.Facts).To(BeEmpty())
+		Expect(res.JSON200.Stats).To(BeEmpty())
+		Expect(res.JSON200.Results).To(BeEmpty())
+	})
+
+	It("returns 404 for an unknown host", func() {
+		run := test.NewRun(orgId())
+		dbInsert(run, test.NewRunHost(run.ID, "success", nil))
+
+		res := getRunHostArtifacts(run.ID, "unknown.example.com")
+		Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+	})
+
+	It("returns 404 for a run host of another tenant", func() {
+		run := test.NewRun("1234567")
+		host := test.NewRunHost(run.ID, "success", nil)
+		dbInsert(run, host)
+
+		res := getRunHostArtifacts(run.ID, host.Host)
+		Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+	})
+})
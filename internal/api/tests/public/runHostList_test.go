@@ -1,9 +1,13 @@
 package public
 
 import (
+	"encoding/csv"
+	"fmt"
 	"net/http"
 	dbModel "playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/utils/test"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
@@ -175,6 +179,47 @@ var _ = Describe("runHostList", func() {
 		})
 	})
 
+	Describe("computed fields", func() {
+		var host dbModel.RunHost
+
+		BeforeEach(func() {
+			run := test.NewRun(orgId())
+			dbInsertRuns(run)
+			inventoryID := uuid.New()
+			host = test.NewRunHost(run.ID, "running", &inventoryID)
+			host.Log = "PLAY [all] ***\n"
+			dbInsertHosts(host)
+		})
+
+		It("returns the size of the output and the time since the last update", func() {
+			Expect(db().Model(&host).UpdateColumn("updated_at", time.Now().Add(-time.Hour)).Error).ToNot(HaveOccurred())
+
+			hosts, res := listRunHosts("fields[data]", "stdout_size,last_updated_delta")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(*hosts.Data[0].StdoutSize).To(BeEquivalentTo(len(host.Log)))
+			Expect(*hosts.Data[0].LastUpdatedDelta).To(BeNumerically("~", 3600, 60))
+			Expect(hosts.Data[0].Stdout).To(BeNil())
+		})
+
+		It("returns the display name cached from the inventory", func() {
+			Expect(db().Create(&dbModel.InventoryHost{OrgID: orgId(), ID: *host.InventoryID, DisplayName: "web01"}).Error).ToNot(HaveOccurred())
+
+			hosts, res := listRunHosts("fields[data]", "host,display_name")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(hosts.Data).To(HaveLen(1))
+			Expect(*hosts.Data[0].DisplayName).To(Equal("web01"))
+		})
+
+		It("does not return the display name of a host of another organization", func() {
+			Expect(db().Create(&dbModel.InventoryHost{OrgID: "1234567", ID: *host.InventoryID, DisplayName: "web01"}).Error).ToNot(HaveOccurred())
+
+			hosts, res := listRunHosts("fields[data]", "host,display_name")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(hosts.Data).To(HaveLen(1))
+			Expect(hosts.Data[0].DisplayName).To(BeNil())
+		})
+	})
+
 	DescribeTable("pagination",
 		func(expected, limit, offset int) {
 			newRuns := test.NewRunsWithLocalhost(orgId(), 3)
@@ -279,4 +324,64 @@ var _ = Describe("runHostList", func() {
 			Expect(*(*runs).Links.Previous).To(Equal("/api/playbook-dispatcher/v1/run_hosts?fields%5Bdata%5D=host&filter%5Bstatus%5D=running&limit=1&offset=0"))
 		})
 	})
+
+	Describe("cursor", func() {
+		It("pages through the hosts newest first", func() {
+			run := test.NewRun(orgId())
+			dbInsertRuns(run)
+
+			hosts := []dbModel.RunHost{}
+			for i := 0; i < 3; i++ {
+				host := test.NewRunHostWithHostname(run.ID, "running", fmt.Sprintf("%02d.example.com", i))
+				host.CreatedAt = time.Now().Add(-time.Duration(i) * time.Minute)
+				hosts = append(hosts, host)
+			}
+			dbInsertHosts(hosts...)
+
+			first, res := listRunHosts("limit", 2, "cursor", "", "fields[data]", "host")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(first.Meta.Total).To(Equal(3))
+			Expect([]string{*first.Data[0].Host, *first.Data[1].Host}).To(Equal([]string{"00.example.com", "01.example.com"}))
+			Expect(first.Links.Previous).To(BeNil())
+
+			_, cursor, _ := strings.Cut(*first.Links.Next, "cursor=")
+			cursor, _, _ = strings.Cut(cursor, "&")
+
+			second, res := listRunHosts("limit", 2, "cursor", cursor, "fields[data]", "host")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(second.Data).To(HaveLen(1))
+			Expect(*second.Data[0].Host).To(Equal("02.example.com"))
+			Expect(second.Links.Next).To(BeNil())
+			Expect(second.Links.Previous).ToNot(BeNil())
+		})
+	})
+
+	Describe("export", func() {
+		It("exports all hosts as CSV", func() {
+			run := test.NewRun(orgId())
+			dbInsertRuns(run)
+
+			hosts := []dbModel.RunHost{}
+			for i := 0; i < 3; i++ {
+				host := test.NewRunHostWithHostname(run.ID, "success", fmt.Sprintf("%02d.example.com", i))
+				host.CreatedAt = time.Now().Add(-time.Duration(i) * time.Minute)
+				hosts = append(hosts, host)
+			}
+			dbInsertHosts(hosts...)
+
+			res := listRunHostsRaw("format", "csv", "limit", 1, "fields[data]", "host,run,status")
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+			Expect(res.Header.Get("Content-Disposition")).To(Equal(`attachment; filename="run_hosts.csv"`))
+
+			defer res.Body.Close()
+			records, err := csv.NewReader(res.Body).ReadAll()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(Equal([][]string{
+				{"host", "run", "status"},
+				{"00.example.com", fmt.Sprintf(`{"id":"%s"}`, run.ID), "success"},
+				{"01.example.com", fmt.Sprintf(`{"id":"%s"}`, run.ID), "success"},
+				{"02.example.com", fmt.Sprintf(`{"id":"%s"}`, run.ID), "success"},
+			}))
+		})
+	})
 })
@@ -0,0 +1,118 @@
+package public
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func getRunHostStdout(runHostId uuid.UUID, headers map[string]string) *http.Response {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://localhost:9002/api/playbook-dispatcher/v1/run_hosts/%s/stdout", runHostId), nil)
+	Expect(err).ToNot(HaveOccurred())
+	req.Header.Set("x-rh-identity", test.IdentityHeaderMinimal(orgId()))
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := test.Client.Do(req)
+	Expect(err).ToNot(HaveOccurred())
+	return res
+}
+
+var _ = Describe("runHostStdout", func() {
+	db := test.WithDatabase()
+
+	var host dbModel.RunHost
+
+	readAll := func(reader io.ReadCloser) string {
+		defer reader.Close()
+		body, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		return string(body)
+	}
+
+	BeforeEach(func() {
+		run := test.NewRun(orgId())
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		host = test.NewRunHostWithHostname(run.ID, "success", "localhost")
+		host.Log = "PLAY [all] ***\nok: [localhost]\n"
+		Expect(db().Create(&host).Error).ToNot(HaveOccurred())
+	})
+
+	It("returns the output", func() {
+		res := getRunHostStdout(host.ID, map[string]string{"Accept-Encoding": "identity"})
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		Expect(res.Header.Get("Content-Type")).To(Equal("text/plain; charset=utf-8"))
+		Expect(res.Header.Get("Accept-Ranges")).To(Equal("bytes"))
+		Expect(readAll(res.Body)).To(Equal(host.Log))
+	})
+
+	It("returns a range of the output", func() {
+		res := getRunHostStdout(host.ID, map[string]string{"Range": "bytes=15-"})
+		Expect(res.StatusCode).To(Equal(http.StatusPartialContent))
+		Expect(res.Header.Get("Content-Range")).To(Equal(fmt.Sprintf("bytes 15-%d/%d", len(host.Log)-1, len(host.Log))))
+		Expect(readAll(res.Body)).To(Equal("ok: [localhost]\n"))
+	})
+
+	It("rejects a range beyond the output", func() {
+		res := getRunHostStdout(host.ID, map[string]string{"Range": "bytes=1000-"})
+		Expect(res.StatusCode).To(Equal(http.StatusRequestedRangeNotSatisfiable))
+	})
+
+	It("compresses the output", func() {
+		res := getRunHostStdout(host.ID, map[string]string{"Accept-Encoding": "gzip"})
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		Expect(res.Header.Get("Content-Encoding")).To(Equal("gzip"))
+
+		reader, err := gzip.NewReader(res.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readAll(reader)).To(Equal(host.Log))
+	})
+
+	DescribeTable("negotiates the compression of the output",
+		func(acceptEncoding string, compressed bool) {
+			res := getRunHostStdout(host.ID, map[string]string{"Accept-Encoding": acceptEncoding})
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+			Expect(res.Header.Values("Vary")).To(ContainElement("Accept-Encoding"))
+
+			if compressed {
+				Expect(res.Header.Get("Content-Encoding")).To(Equal("gzip"))
+			} else {
+				Expect(res.Header.Get("Content-Encoding")).To(BeEmpty())
+				Expect(readAll(res.Body)).To(Equal(host.Log))
+			}
+		},
+
+		Entry("gzip among others", "br, gzip;q=0.5", true),
+		Entry("any encoding", "*", true),
+		Entry("gzip refused", "gzip;q=0", false),
+		Entry("gzip refused by the wildcard", "br, *;q=0", false),
+		Entry("similar name", "x-gzip-like", false),
+	)
+
+	It("links the output in the run hosts list", func() {
+		hosts, res := listRunHosts("filter[run][id]", host.RunID.String(), "fields[data]", "id,links")
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(*hosts.Data[0].Id).To(Equal(host.ID))
+		Expect(*hosts.Data[0].Links.Stdout).To(Equal(fmt.Sprintf("/api/playbook-dispatcher/v1/run_hosts/%s/stdout", host.ID)))
+	})
+
+	It("returns 404 for a host of a run of another tenant", func() {
+		run := test.NewRun("1234567")
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+		other := test.NewRunHostWithHostname(run.ID, "success", "localhost")
+		Expect(db().Create(&other).Error).ToNot(HaveOccurred())
+
+		res := getRunHostStdout(other.ID, nil)
+		Expect(res.StatusCode).To(Equal(http.StatusNotFound))
+	})
+})
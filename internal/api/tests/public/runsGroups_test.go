@@ -0,0 +1,102 @@
+package public
+
+import (
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func groupRuns(keysAndValues ...interface{}) (*RunGroups, *ApiRunsGroupsResponse) {
+	raw := doGet("http://localhost:9002/api/playbook-dispatcher/v1/runs/groups", keysAndValues...)
+	res, err := ParseApiRunsGroupsResponse(raw)
+	Expect(err).ToNot(HaveOccurred())
+	return res.JSON200, res
+}
+
+var _ = Describe("runsGroups", func() {
+	db := test.WithDatabase()
+
+	withLabel := func(run dbModel.Run, value string) dbModel.Run {
+		run.Labels = dbModel.Labels{"remediation_id": value}
+		return run
+	}
+
+	It("counts runs and hosts for each label value", func() {
+		runs := []dbModel.Run{
+			withLabel(test.NewRun(orgId()), "a"),
+			withLabel(test.NewRunWithStatus(orgId(), "success"), "a"),
+			withLabel(test.NewRunWithStatus(orgId(), "failure"), "b"),
+			test.NewRun(orgId()),
+		}
+		Expect(db().Create(&runs).Error).ToNot(HaveOccurred())
+
+		hosts := []dbModel.RunHost{
+			test.NewRunHostWithHostname(runs[0].ID, "running", "01.example.com"),
+			test.NewRunHostWithHostname(runs[1].ID, "success", "01.example.com"),
+			test.NewRunHostWithHostname(runs[1].ID, "success", "02.example.com"),
+			test.NewRunHostWithHostname(runs[2].ID, "failure", "01.example.com"),
+			test.NewRunHostWithHostname(runs[3].ID, "running", "01.example.com"),
+		}
+		Expect(db().Create(&hosts).Error).ToNot(HaveOccurred())
+
+		groups, res := groupRuns("label", "remediation_id")
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(groups.Data).To(HaveLen(2))
+		Expect(groups.Meta.Count).To(Equal(2))
+		Expect(groups.Meta.Total).To(Equal(2))
+
+		Expect(groups.Data[0].Value).To(Equal("a"))
+		Expect(groups.Data[0].Runs).To(Equal(RunCounts{Total: 2, Running: 1, Success: 1}))
+		Expect(groups.Data[0].Hosts).To(Equal(RunHostCounts{Total: 3, Running: 1, Success: 2}))
+
+		Expect(groups.Data[1].Value).To(Equal("b"))
+		Expect(groups.Data[1].Runs).To(Equal(RunCounts{Total: 1, Failure: 1}))
+		Expect(groups.Data[1].Hosts).To(Equal(RunHostCounts{Total: 1, Failure: 1}))
+	})
+
+	It("counts runs without hosts", func() {
+		run := withLabel(test.NewRun(orgId()), "a")
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		groups, res := groupRuns("label", "remediation_id")
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(groups.Data).To(HaveLen(1))
+		Expect(groups.Data[0].Runs).To(Equal(RunCounts{Total: 1, Running: 1}))
+		Expect(groups.Data[0].Hosts).To(Equal(RunHostCounts{}))
+	})
+
+	It("leaves out runs of other tenants", func() {
+		run := withLabel(test.NewRun("1234567"), "a")
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		groups, res := groupRuns("label", "remediation_id")
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(groups.Data).To(BeEmpty())
+		Expect(groups.Meta.Total).To(Equal(0))
+	})
+
+	It("paginates the groups", func() {
+		runs := []dbModel.Run{
+			withLabel(test.NewRun(orgId()), "a"),
+			withLabel(test.NewRun(orgId()), "b"),
+			withLabel(test.NewRun(orgId()), "c"),
+		}
+		Expect(db().Create(&runs).Error).ToNot(HaveOccurred())
+
+		groups, res := groupRuns("label", "remediation_id", "limit", 1, "offset", 1)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(groups.Data).To(HaveLen(1))
+		Expect(groups.Data[0].Value).To(Equal("b"))
+		Expect(groups.Meta.Count).To(Equal(1))
+		Expect(groups.Meta.Total).To(Equal(3))
+		Expect(groups.Links.Next).ToNot(BeNil())
+	})
+
+	It("requires the label", func() {
+		_, res := groupRuns()
+		Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+})
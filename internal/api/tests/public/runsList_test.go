@@ -1,9 +1,15 @@
 package public
 
 import (
+	"encoding/csv"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
 	"playbook-dispatcher/internal/common/utils/test"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -93,17 +99,58 @@ var _ = Describe("runsList", func() {
 			},
 
 			Entry("by default orders by created_at desc", nil, RunStatusFailure, RunStatusSuccess),
-			Entry("sorts by created_at", RunsSortByCreatedAt, RunStatusFailure, RunStatusSuccess),
-			Entry("sorts by created_at:desc", RunsSortByCreatedAtDesc, RunStatusFailure, RunStatusSuccess),
-			Entry("sorts by created_at:asc", RunsSortByCreatedAtAsc, RunStatusSuccess, RunStatusFailure),
+			Entry("sorts by created_at", "created_at", RunStatusFailure, RunStatusSuccess),
+			Entry("sorts by created_at:desc", "created_at:desc", RunStatusFailure, RunStatusSuccess),
+			Entry("sorts by created_at:asc", "created_at:asc", RunStatusSuccess, RunStatusFailure),
+			Entry("sorts by status", "status:asc,created_at:desc", RunStatusFailure, RunStatusSuccess),
+			Entry("sorts by status descending", "status:desc,created_at:asc", RunStatusSuccess, RunStatusFailure),
 		)
 
-		It("400s on invalid value", func() {
-			_, res := listRuns("sort_by", "salad:asc")
+		DescribeTable("400s on invalid value",
+			func(sortBy RunsSortBy) {
+				_, res := listRuns("sort_by", sortBy)
+				Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+			},
+
+			Entry("unknown field", "salad:asc"),
+			Entry("unknown direction", "created_at:up"),
+			Entry("duplicate field", "status:asc,status:desc"),
+			Entry("invalid label key", "labels.it's"),
+			Entry("too many fields", "status,name,service,updated_at,created_at,labels.foo"),
+		)
+
+		It("400s on sorting by other fields than created_at using a cursor", func() {
+			_, res := listRuns("sort_by", "status:asc,created_at:desc", "cursor", "")
 			Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
 		})
 	})
 
+	Describe("sorting by multiple columns", func() {
+		It("sorts by the value of a label and then by another column", func() {
+			runs := []dbModel.Run{
+				test.NewRunWithStatus(orgId(), "success"),
+				test.NewRunWithStatus(orgId(), "failure"),
+				test.NewRunWithStatus(orgId(), "running"),
+				test.NewRunWithStatus(orgId(), "success"),
+			}
+
+			runs[0].Labels = dbModel.Labels{"group": "b"}
+			runs[1].Labels = dbModel.Labels{"group": "a"}
+			runs[2].Labels = dbModel.Labels{}
+			runs[3].Labels = dbModel.Labels{"group": "a"}
+
+			Expect(db().Create(&runs).Error).ToNot(HaveOccurred())
+
+			result, res := listRuns("sort_by", "labels.group:asc,status:desc", "fields[data]", "id")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(result.Data).To(HaveLen(4))
+
+			// runs without the label come last
+			ids := []uuid.UUID{*result.Data[0].Id, *result.Data[1].Id, *result.Data[2].Id, *result.Data[3].Id}
+			Expect(ids).To(Equal([]uuid.UUID{runs[3].ID, runs[1].ID, runs[0].ID, runs[2].ID}))
+		})
+	})
+
 	Describe("pagination", func() {
 		BeforeEach(func() {
 			var runs = []dbModel.Run{
@@ -187,6 +234,65 @@ var _ = Describe("runsList", func() {
 				Entry("running", "running", 2),
 				Entry("timeout", "timeout", 3),
 			)
+
+			DescribeTable("filtering by multiple statuses",
+				func(statuses string, indexes ...int) {
+					runs, res := listRuns("filter[status]", statuses)
+					Expect(res.StatusCode()).To(Equal(http.StatusOK))
+					Expect(runs.Meta.Count).To(Equal(len(indexes)))
+
+					for _, index := range indexes {
+						Expect(runs.Data).To(ContainElement(HaveField("Id", Equal(&data[index].ID))))
+					}
+				},
+
+				Entry("success,failure", "success,failure", 0, 1),
+				Entry("running,timeout", "running,timeout", 2, 3),
+				Entry("failure,timeout", "failure,timeout", 1, 3),
+				Entry("repeated status", "success,success", 0),
+			)
+
+			It("rejects an unknown status", func() {
+				_, res := listRuns("filter[status]", "success,unknown")
+				Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Describe("created", func() {
+			var data []dbModel.Run
+
+			BeforeEach(func() {
+				data = []dbModel.Run{
+					test.NewRun(orgId()),
+					test.NewRun(orgId()),
+					test.NewRun(orgId()),
+				}
+
+				data[0].CreatedAt = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+				data[1].CreatedAt = time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+				data[2].CreatedAt = time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)
+
+				Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+			})
+
+			It("finds runs in a time range", func() {
+				runs, res := listRuns("filter[created_after]", "2026-03-02T00:00:00Z", "filter[created_before]", "2026-03-03T00:00:00Z")
+				Expect(res.StatusCode()).To(Equal(http.StatusOK))
+				Expect(runs.Meta.Count).To(Equal(1))
+				Expect(*runs.Data[0].Id).To(BeEquivalentTo(data[1].ID))
+			})
+
+			It("finds runs created after a time", func() {
+				runs, res := listRuns("filter[created_after]", "2026-03-01T12:00:00+02:00")
+				Expect(res.StatusCode()).To(Equal(http.StatusOK))
+				Expect(runs.Meta.Count).To(Equal(2))
+			})
+
+			It("handles an invalid time", func() {
+				_, res := listRuns("filter[created_before]", "yesterday")
+				Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+				Expect(res.JSON400.Message).To(Equal("Unable to parse created_before!"))
+			})
 		})
 
 		Describe("recipient", func() {
@@ -274,6 +380,28 @@ var _ = Describe("runsList", func() {
 				Expect(res.StatusCode()).To(Equal(http.StatusOK))
 				Expect(runs.Meta.Count).To(Equal(0))
 			})
+
+			It("finds all runs matching any of two labels", func() {
+				runs, res := listRuns("filter[labels][foo]", "bar", "filter[labels][abc]", "def", "filter[labels_operator]", "or")
+				Expect(res.StatusCode()).To(Equal(http.StatusOK))
+				Expect(runs.Meta.Count).To(Equal(1))
+				Expect(*runs.Data[0].Id).To(BeEquivalentTo(data[1].ID))
+			})
+
+			It("finds all runs matching any value of a label", func() {
+				data[0].Labels = map[string]string{"service": "config_manager"}
+				Expect(db().Save(&data[0]).Error).ToNot(HaveOccurred())
+
+				runs, res := listRuns("filter[labels][service]", "remediations", "filter[labels][service]", "config_manager", "filter[labels_operator]", "or")
+				Expect(res.StatusCode()).To(Equal(http.StatusOK))
+				Expect(runs.Meta.Count).To(Equal(3))
+			})
+
+			It("requires all labels to match by default", func() {
+				runs, res := listRuns("filter[labels][service]", "remediations", "filter[labels][foo]", "bar", "filter[labels_operator]", "and")
+				Expect(res.StatusCode()).To(Equal(http.StatusOK))
+				Expect(runs.Meta.Count).To(Equal(1))
+			})
 		})
 
 		Describe("service", func() {
@@ -297,6 +425,76 @@ var _ = Describe("runsList", func() {
 				Expect(runs.Meta.Count).To(Equal(0))
 			})
 		})
+
+		Describe("correlation id", func() {
+			var data dbModel.Run
+
+			BeforeEach(func() {
+				data = test.NewRun(orgId())
+				Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+			})
+
+			It("finds a run based on correlation id", func() {
+				runs, res := listRuns("filter[correlation_id]", data.CorrelationID.String())
+				Expect(res.StatusCode()).To(Equal(http.StatusOK))
+				Expect(runs.Meta.Count).To(Equal(1))
+				Expect(*runs.Data[0].Id).To(BeEquivalentTo(data.ID))
+			})
+
+			It("returns nothing if the correlation id does not match", func() {
+				runs, res := listRuns("filter[correlation_id]", uuid.New().String())
+				Expect(res.StatusCode()).To(Equal(http.StatusOK))
+				Expect(runs.Meta.Count).To(Equal(0))
+			})
+
+			It("400s on invalid correlation id", func() {
+				_, res := listRuns("filter[correlation_id]", "not-a-uuid")
+				Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("search", func() {
+		var data []dbModel.Run
+
+		BeforeEach(func() {
+			data = []dbModel.Run{
+				test.NewRun(orgId()),
+				test.NewRun(orgId()),
+				test.NewRun(orgId()),
+				test.NewRun(orgId()),
+			}
+
+			data[0].PlaybookName = utils.StringRef("Patch Tuesday")
+			data[1].PlaybookRunUrl = "https://console.example.com/insights/patch/runs/42"
+			data[2].Labels = dbModel.Labels{"ticket": "SEC-100_patch"}
+			data[3].PlaybookName = utils.StringRef("Remediation")
+
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+		})
+
+		It("finds runs by playbook name, web console URL and label values", func() {
+			runs, res := listRuns("search", "PATCH")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(runs.Meta.Count).To(Equal(3))
+		})
+
+		It("matches wildcards literally", func() {
+			runs, res := listRuns("search", "100_p")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(runs.Meta.Count).To(Equal(1))
+			Expect(*runs.Data[0].Id).To(BeEquivalentTo(data[2].ID))
+
+			runs, res = listRuns("search", "sec%patch")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(runs.Meta.Count).To(Equal(0))
+		})
+
+		It("does not match label keys", func() {
+			runs, res := listRuns("search", "ticket")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(runs.Meta.Count).To(Equal(0))
+		})
 	})
 
 	Describe("sparse fieldsets", func() {
@@ -427,4 +625,212 @@ var _ = Describe("runsList", func() {
 			Expect(*(*runs).Links.Previous).To(Equal("/api/playbook-dispatcher/v1/runs?fields%5Bdata%5D=id&filter%5Bstatus%5D=running&limit=1&offset=0&sort_by=created_at%3Adesc"))
 		})
 	})
+
+	Describe("cursor", func() {
+		var ids []string
+
+		BeforeEach(func() {
+			ids = nil
+
+			var runs []dbModel.Run
+			for i := 0; i < 5; i++ {
+				run := test.NewRun(orgId())
+				run.CreatedAt = time.Now().Add(-time.Duration(i) * time.Minute)
+				runs = append(runs, run)
+				ids = append(ids, run.ID.String())
+			}
+
+			Expect(db().Create(&runs).Error).ToNot(HaveOccurred())
+		})
+
+		follow := func(link string) *Runs {
+			path, rawQuery, _ := strings.Cut(link, "?")
+			query, err := url.ParseQuery(rawQuery)
+			Expect(err).ToNot(HaveOccurred())
+
+			keysAndValues := []interface{}{}
+			for key := range query {
+				keysAndValues = append(keysAndValues, key, query.Get(key))
+			}
+
+			res, err := ParseApiRunsListResponse(doGet("http://localhost:9002"+path, keysAndValues...))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			return res.JSON200
+		}
+
+		runIds := func(runs *Runs) (result []string) {
+			for _, run := range runs.Data {
+				result = append(result, run.Id.String())
+			}
+			return
+		}
+
+		It("pages forwards and backwards", func() {
+			runs, res := listRuns("limit", 2, "cursor", "", "fields[data]", "status")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(runs.Meta.Total).To(Equal(5))
+			Expect(runs.Data).To(HaveLen(2))
+			Expect(runs.Data[0].Id).To(BeNil())
+			Expect(runs.Links.First).To(Equal("/api/playbook-dispatcher/v1/runs?cursor=&fields%5Bdata%5D=status&limit=2"))
+			Expect(runs.Links.Last).To(BeEmpty())
+			Expect(runs.Links.Previous).To(BeNil())
+
+			runs, _ = listRuns("limit", 2, "cursor", "")
+			Expect(runIds(runs)).To(Equal(ids[0:2]))
+
+			second := follow(*runs.Links.Next)
+			Expect(runIds(second)).To(Equal(ids[2:4]))
+
+			third := follow(*second.Links.Next)
+			Expect(runIds(third)).To(Equal(ids[4:]))
+			Expect(third.Links.Next).To(BeNil())
+			Expect(third.Links.Last).ToNot(BeEmpty())
+
+			Expect(runIds(follow(*third.Links.Previous))).To(Equal(ids[2:4]))
+			Expect(runIds(follow(*follow(*third.Links.Previous).Links.Previous))).To(Equal(ids[0:2]))
+		})
+
+		It("pages in ascending order", func() {
+			runs, _ := listRuns("limit", 3, "cursor", "", "sort_by", "created_at:asc")
+			Expect(runIds(runs)).To(Equal([]string{ids[4], ids[3], ids[2]}))
+
+			runs = follow(*runs.Links.Next)
+			Expect(runIds(runs)).To(Equal([]string{ids[1], ids[0]}))
+		})
+
+		It("rejects a cursor combined with an offset", func() {
+			_, res := listRuns("cursor", "", "offset", 1)
+			Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+		})
+
+		It("rejects a malformed cursor", func() {
+			_, res := listRuns("cursor", "not a cursor")
+			Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("export", func() {
+		var data []dbModel.Run
+
+		readAll := func(res *http.Response) string {
+			defer res.Body.Close()
+			body, err := io.ReadAll(res.Body)
+			Expect(err).ToNot(HaveOccurred())
+			return string(body)
+		}
+
+		BeforeEach(func() {
+			data = test.NewRunsWithLocalhost(orgId(), 3)
+			data[0].Labels = dbModel.Labels{"ticket": "SEC-1, SEC-2"}
+			data[1].Labels = dbModel.Labels{}
+			data[2].Labels = dbModel.Labels{}
+			data[0].CreatedAt = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+			data[1].CreatedAt = time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+			data[2].CreatedAt = time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)
+
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+		})
+
+		It("exports all runs as CSV", func() {
+			res := listRunsRaw("format", "csv", "fields[data]", "id,labels,status", "limit", 1, "sort_by", "created_at:asc")
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+			Expect(res.Header.Get("Content-Type")).To(Equal("text/csv"))
+			Expect(res.Header.Get("Content-Disposition")).To(Equal(`attachment; filename="runs.csv"`))
+
+			records, err := csv.NewReader(strings.NewReader(readAll(res))).ReadAll()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(Equal([][]string{
+				{"id", "labels", "status"},
+				{data[0].ID.String(), `{"ticket":"SEC-1, SEC-2"}`, "running"},
+				{data[1].ID.String(), "{}", "running"},
+				{data[2].ID.String(), "{}", "running"},
+			}))
+		})
+
+		It("exports CSV if requested using the Accept header", func() {
+			req, err := http.NewRequest(http.MethodGet, "http://localhost:9002/api/playbook-dispatcher/v1/runs?fields[data]=id", nil)
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Set("x-rh-identity", test.IdentityHeaderMinimal(orgId()))
+			req.Header.Set("Accept", "text/csv")
+
+			res, err := test.Client.Do(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+			Expect(res.Header.Get("Content-Type")).To(Equal("text/csv"))
+			Expect(strings.Split(strings.TrimSpace(readAll(res)), "\n")).To(HaveLen(4))
+		})
+
+		It("exports the filtered runs as NDJSON", func() {
+			res := listRunsRaw("format", "ndjson", "filter[created_after]", "2026-03-02T00:00:00Z", "fields[data]", "id")
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+			Expect(res.Header.Get("Content-Type")).To(Equal("application/x-ndjson"))
+
+			lines := strings.Split(strings.TrimSpace(readAll(res)), "\n")
+			Expect(lines).To(Equal([]string{
+				fmt.Sprintf(`{"id":"%s"}`, data[2].ID),
+				fmt.Sprintf(`{"id":"%s"}`, data[1].ID),
+			}))
+		})
+
+		It("returns a page of JSON by default", func() {
+			runs, res := listRuns("format", "json", "limit", 1)
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(runs.Data).To(HaveLen(1))
+		})
+
+		It("rejects an unknown format", func() {
+			res := listRunsRaw("format", "xlsx")
+			Expect(res.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("etag", func() {
+		const url = "http://localhost:9002/api/playbook-dispatcher/v1/runs"
+
+		var data []dbModel.Run
+
+		BeforeEach(func() {
+			data = test.NewRunsWithLocalhost(orgId(), 2)
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+		})
+
+		It("returns 304 if the runs have not changed", func() {
+			res := doGet(url, "fields[data]", "id")
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+			etag := res.Header.Get("ETag")
+			Expect(etag).To(HavePrefix(`W/"`))
+
+			res = doConditionalGet(etag, url, "fields[data]", "id")
+			Expect(res.StatusCode).To(Equal(http.StatusNotModified))
+			Expect(res.Header.Get("ETag")).To(Equal(etag))
+		})
+
+		It("returns the runs once one of them has been updated", func() {
+			etag := doGet(url).Header.Get("ETag")
+
+			Expect(db().Model(&data[0]).Update("status", "success").Error).ToNot(HaveOccurred())
+
+			res := doConditionalGet(etag, url)
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+			Expect(res.Header.Get("ETag")).ToNot(Equal(etag))
+		})
+
+		It("returns the runs once another run has been created", func() {
+			etag := doGet(url).Header.Get("ETag")
+
+			run := test.NewRun(orgId())
+			Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+			res := doConditionalGet(etag, url)
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("returns the runs if different fields are requested", func() {
+			etag := doGet(url, "fields[data]", "id").Header.Get("ETag")
+
+			res := doConditionalGet(etag, url, "fields[data]", "id,status")
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+		})
+	})
 })
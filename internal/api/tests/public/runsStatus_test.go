@@ -0,0 +1,103 @@
+package public
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func lookupRunStatus(input interface{}) *ApiRunsStatusResponse {
+	body, err := json.Marshal(input)
+	Expect(err).ToNot(HaveOccurred())
+
+	req, err := http.NewRequest(http.MethodPost, "http://localhost:9002/api/playbook-dispatcher/v1/runs/status", bytes.NewBuffer(body))
+	Expect(err).ToNot(HaveOccurred())
+	req.Header.Set("x-rh-identity", test.IdentityHeaderMinimal(orgId()))
+	req.Header.Set("content-type", "application/json")
+
+	raw, err := test.Client.Do(req)
+	Expect(err).ToNot(HaveOccurred())
+
+	res, err := ParseApiRunsStatusResponse(raw)
+	Expect(err).ToNot(HaveOccurred())
+	return res
+}
+
+var _ = Describe("runsStatus", func() {
+	db := test.WithDatabase()
+
+	dbInsert := func(runs ...dbModel.Run) {
+		Expect(db().Create(&runs).Error).ToNot(HaveOccurred())
+	}
+
+	It("returns the status of runs by run ID", func() {
+		running, succeeded := test.NewRun(orgId()), test.NewRunWithStatus(orgId(), "success")
+		dbInsert(running, succeeded)
+
+		res := lookupRunStatus(RunStatusLookup{Ids: &[]uuid.UUID{running.ID, succeeded.ID}})
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.Ids).To(Equal(map[string]RunStatus{
+			running.ID.String():   "running",
+			succeeded.ID.String(): "success",
+		}))
+		Expect(res.JSON200.CorrelationIds).To(BeEmpty())
+	})
+
+	It("returns the status of the latest run of a correlation ID", func() {
+		older, latest := test.NewRunWithStatus(orgId(), "failure"), test.NewRun(orgId())
+		latest.CorrelationID = older.CorrelationID
+		older.CreatedAt = time.Now().Add(-time.Hour)
+		dbInsert(older, latest)
+
+		res := lookupRunStatus(RunStatusLookup{CorrelationIds: &[]uuid.UUID{older.CorrelationID}})
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.CorrelationIds).To(Equal(map[string]RunStatus{older.CorrelationID.String(): "running"}))
+		Expect(res.JSON200.Ids).To(BeEmpty())
+	})
+
+	It("reports an expired run as timeout", func() {
+		run := test.NewRun(orgId())
+		run.CreatedAt = time.Now().Add(-6 * time.Hour)
+		dbInsert(run)
+
+		res := lookupRunStatus(RunStatusLookup{Ids: &[]uuid.UUID{run.ID}})
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.Ids).To(HaveKeyWithValue(run.ID.String(), RunStatus("timeout")))
+	})
+
+	It("leaves out unknown runs and runs of other tenants", func() {
+		other := test.NewRun("1234567")
+		dbInsert(other)
+
+		res := lookupRunStatus(RunStatusLookup{Ids: &[]uuid.UUID{uuid.New(), other.ID}, CorrelationIds: &[]uuid.UUID{other.CorrelationID}})
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(res.JSON200.Ids).To(BeEmpty())
+		Expect(res.JSON200.CorrelationIds).To(BeEmpty())
+	})
+
+	It("rejects more than 200 identifiers", func() {
+		ids, correlationIds := make([]uuid.UUID, 150), make([]uuid.UUID, 51)
+		for i := range ids {
+			ids[i] = uuid.New()
+		}
+
+		for i := range correlationIds {
+			correlationIds[i] = uuid.New()
+		}
+
+		res := lookupRunStatus(RunStatusLookup{Ids: &ids, CorrelationIds: &correlationIds})
+		Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+
+	It("rejects an invalid run ID", func() {
+		res := lookupRunStatus(map[string]interface{}{"ids": []string{"not-a-uuid"}})
+		Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+})
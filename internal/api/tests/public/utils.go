@@ -48,11 +48,20 @@ func fieldTester(fn func(params ...interface{}) *http.Response) func(...string)
 }
 
 func doGet(baseUrl string, keysAndValues ...interface{}) *http.Response {
+	return doConditionalGet("", baseUrl, keysAndValues...)
+}
+
+// doConditionalGet sends the entity tag in If-None-Match unless empty
+func doConditionalGet(etag string, baseUrl string, keysAndValues ...interface{}) *http.Response {
 	url := utils.BuildUrl(baseUrl, keysAndValues...)
 
 	req, err := http.NewRequest("GET", url, nil)
 	Expect(err).ToNot(HaveOccurred())
 	req.Header.Set("x-rh-identity", test.IdentityHeaderMinimal(orgId()))
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
 	resp, err := test.Client.Do(req)
 	Expect(err).ToNot(HaveOccurred())
 	return resp
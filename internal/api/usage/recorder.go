@@ -0,0 +1,103 @@
+package usage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const period = time.Hour
+
+type key struct {
+	orgId  string
+	period time.Time
+}
+
+// Recorder counts API calls per org in memory and periodically flushes the counts into the api_usage table.
+// This keeps the request path free of additional database writes.
+type Recorder struct {
+	db *gorm.DB
+
+	lock   sync.Mutex
+	counts map[key]int64
+}
+
+func NewRecorder(db *gorm.DB) *Recorder {
+	return &Recorder{
+		db:     db,
+		counts: map[key]int64{},
+	}
+}
+
+func (this *Recorder) Record(orgId string) {
+	k := key{orgId: orgId, period: time.Now().UTC().Truncate(period)}
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.counts[k]++
+}
+
+// Flush writes the counts collected so far into the database.
+// Counts that fail to be written are kept and retried on the next flush.
+func (this *Recorder) Flush(ctx context.Context) error {
+	this.lock.Lock()
+	pending := this.counts
+	this.counts = map[key]int64{}
+	this.lock.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	rows := make([]dbModel.ApiUsage, 0, len(pending))
+	for k, calls := range pending {
+		rows = append(rows, dbModel.ApiUsage{OrgID: k.orgId, Period: k.period, Calls: calls})
+	}
+
+	err := this.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "org_id"}, {Name: "period"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"calls": gorm.Expr("api_usage.calls + excluded.calls")}),
+	}).Create(&rows).Error
+
+	if err != nil {
+		this.lock.Lock()
+		for k, calls := range pending {
+			this.counts[k] += calls
+		}
+		this.lock.Unlock()
+	}
+
+	return err
+}
+
+// Start flushes the recorder in the given interval until the context is cancelled.
+// The final flush is left to the caller so that requests served while shutting down are not lost.
+func (this *Recorder) Start(ctx context.Context, interval time.Duration, wg *sync.WaitGroup) {
+	log := utils.GetLogFromContext(ctx)
+	ticker := time.NewTicker(interval)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := this.Flush(ctx); err != nil {
+					log.Errorw("Error flushing API usage", "error", err)
+				}
+			case <-ctx.Done():
+				// the final flush is up to the caller once no more requests are served
+				return
+			}
+		}
+	}()
+}
@@ -56,3 +56,118 @@ func GetStdout(events []messageModel.PlaybookRunResponseMessageYamlEventsElem, h
 
 	return
 }
+
+// Diff is the diff a task reported for a host, e.g. when the playbook is run in check mode
+type Diff struct {
+	Task string      `json:"task"`
+	Diff interface{} `json:"diff"`
+}
+
+func GetDiffs(events []messageModel.PlaybookRunResponseMessageYamlEventsElem, host string) []Diff {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Counter < events[j].Counter
+	})
+
+	result := []Diff{}
+
+	for _, event := range events {
+		if event.EventData == nil || event.EventData.Host == nil || *event.EventData.Host != host {
+			continue
+		}
+
+		if isEmptyDiff(event.EventData.Res["diff"]) {
+			continue
+		}
+
+		diff := Diff{Diff: event.EventData.Res["diff"]}
+		if event.EventData.Task != nil {
+			diff.Task = *event.EventData.Task
+		}
+
+		result = append(result, diff)
+	}
+
+	return result
+}
+
+// modules that do not report changes set diff to an empty list or object
+func isEmptyDiff(diff interface{}) bool {
+	switch value := diff.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(value) == 0
+	case map[string]interface{}:
+		return len(value) == 0
+	default:
+		return false
+	}
+}
+
+// Artifacts is the structured output of a playbook run on a host
+type Artifacts struct {
+	// Facts are the facts gathered or set for the host
+	Facts map[string]interface{} `json:"facts"`
+	// Stats is the data the playbook set using set_stats
+	Stats map[string]interface{} `json:"stats"`
+	// Results are the results the tasks returned on the host
+	Results   []Result `json:"results"`
+	Truncated bool     `json:"truncated,omitempty"`
+}
+
+// Result is the result a task returned on a host
+type Result struct {
+	Task   string                 `json:"task"`
+	Event  string                 `json:"event"`
+	Result map[string]interface{} `json:"result"`
+}
+
+func GetArtifacts(events []messageModel.PlaybookRunResponseMessageYamlEventsElem, host string) Artifacts {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Counter < events[j].Counter
+	})
+
+	result := Artifacts{
+		Facts:   map[string]interface{}{},
+		Stats:   map[string]interface{}{},
+		Results: []Result{},
+	}
+
+	for _, event := range events {
+		if event.EventData == nil {
+			continue
+		}
+
+		// set_stats data is not bound to a host
+		if event.Event == "playbook_on_stats" {
+			for key, value := range event.EventData.ArtifactData {
+				result.Stats[key] = value
+			}
+
+			continue
+		}
+
+		if event.EventData.Host == nil || *event.EventData.Host != host || event.EventData.Res == nil {
+			continue
+		}
+
+		if event.Event != "runner_on_ok" && event.Event != "runner_on_failed" {
+			continue
+		}
+
+		if facts, ok := event.EventData.Res["ansible_facts"].(map[string]interface{}); ok {
+			for key, value := range facts {
+				result.Facts[key] = value
+			}
+		}
+
+		taskResult := Result{Event: event.Event, Result: event.EventData.Res}
+		if event.EventData.Task != nil {
+			taskResult.Task = *event.EventData.Task
+		}
+
+		result.Results = append(result.Results, taskResult)
+	}
+
+	return result
+}
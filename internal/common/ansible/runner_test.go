@@ -106,4 +106,63 @@ var _ = Describe("Ansible", func() {
 			Expect(stdout).To(Equal("\r\nPLAY [ping] ********************************************************************\n\r\nTASK [ping] ********************************************************************\n\x1b[0;32mok: [localhost]\x1b[0m\n\r\nPLAY RECAP *********************************************************************\r\n\x1b[0;32mlocalhost\x1b[0m                  : \x1b[0;32mok=1   \x1b[0m changed=0    unreachable=0    failed=0    skipped=0    rescued=0    ignored=0   \r\n\n"))
 		})
 	})
+
+	Describe("diffs", func() {
+		It("determines the diffs of a run in check mode", func() {
+			events := loadFile("./test-events8.jsonl")
+			diffs := GetDiffs(events, "localhost")
+			Expect(diffs).To(HaveLen(1))
+			Expect(diffs[0].Task).To(Equal("configure motd"))
+			Expect(diffs[0].Diff).To(Equal([]interface{}{map[string]interface{}{
+				"before":        "hello\n",
+				"after":         "welcome\n",
+				"before_header": "/etc/motd",
+				"after_header":  "/etc/motd",
+			}}))
+		})
+
+		It("ignores the events of other hosts", func() {
+			events := loadFile("./test-events8.jsonl")
+			Expect(GetDiffs(events, "jharting1")).To(BeEmpty())
+		})
+
+		It("determines no diffs from a run without diffs", func() {
+			events := loadFile("./test-events1.jsonl")
+			Expect(GetDiffs(events, "localhost")).To(BeEmpty())
+		})
+	})
+
+	Describe("artifacts", func() {
+		It("determines the facts, stats and results of a host", func() {
+			events := loadFile("./test-events9.jsonl")
+			artifacts := GetArtifacts(events, "localhost")
+			Expect(artifacts.Facts).To(Equal(map[string]interface{}{
+				"ansible_distribution":         "RedHat",
+				"ansible_distribution_version": "9.4",
+				"release":                      "9.4",
+			}))
+			Expect(artifacts.Stats).To(Equal(map[string]interface{}{"report_version": float64(2)}))
+			Expect(artifacts.Results).To(HaveLen(3))
+			Expect(artifacts.Results[1].Task).To(Equal("check packages"))
+			Expect(artifacts.Results[1].Event).To(Equal("runner_on_ok"))
+			Expect(artifacts.Results[1].Result).To(HaveKeyWithValue("updates", float64(3)))
+			Expect(artifacts.Truncated).To(BeFalse())
+		})
+
+		It("determines the results of a failed task", func() {
+			events := loadFile("./test-events9.jsonl")
+			artifacts := GetArtifacts(events, "jharting1")
+			Expect(artifacts.Facts).To(HaveKeyWithValue("ansible_distribution", "Fedora"))
+			Expect(artifacts.Results).To(HaveLen(2))
+			Expect(artifacts.Results[1].Event).To(Equal("runner_on_failed"))
+			Expect(artifacts.Results[1].Result).To(HaveKeyWithValue("msg", "dnf not available"))
+		})
+
+		It("determines empty artifacts from a run without structured output", func() {
+			events := loadFile("./test-events1.jsonl")
+			artifacts := GetArtifacts(events, "localhost")
+			Expect(artifacts.Facts).To(BeEmpty())
+			Expect(artifacts.Stats).To(BeEmpty())
+		})
+	})
 })
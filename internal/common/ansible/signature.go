@@ -0,0 +1,367 @@
+package ansible
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/crypto/openpgp"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	signatureVar        = "insights_signature"
+	signatureExcludeVar = "insights_signature_exclude"
+)
+
+// top-level fields of a play that may be excluded from its signature, either whole or a single variable of theirs
+var excludableFields = []string{"hosts", "vars"}
+
+// PlaybookVerifier verifies the insights signatures of playbooks the way insights-core (ansible.playbook_verifier) does
+// before running them on the host: every play carries the base64-encoded GPG signature of the sha256 digest of the play,
+// without the fields listed in insights_signature_exclude, serialized as the Python representation of an ordered dict.
+type PlaybookVerifier struct {
+	keyring openpgp.EntityList
+}
+
+// NewPlaybookVerifier returns a verifier trusting the given armored GPG public keys
+func NewPlaybookVerifier(armoredKeys string) (*PlaybookVerifier, error) {
+	if strings.TrimSpace(armoredKeys) == "" {
+		return &PlaybookVerifier{}, nil
+	}
+
+	keyring, err := openpgp.ReadArmoredKeyRing(strings.NewReader(armoredKeys))
+	if err != nil {
+		return nil, fmt.Errorf("invalid playbook signature public keys: %w", err)
+	}
+
+	return &PlaybookVerifier{keyring: keyring}, nil
+}
+
+// Verify checks the insights signature of every play of the playbook
+func (this *PlaybookVerifier) Verify(playbook []byte) error {
+	var document yaml.Node
+	if err := yaml.Unmarshal(playbook, &document); err != nil {
+		return fmt.Errorf("invalid playbook: %w", err)
+	}
+
+	if len(document.Content) == 0 {
+		return errors.New("empty playbook")
+	}
+
+	plays := resolveAlias(document.Content[0])
+	if plays.Kind != yaml.SequenceNode || len(plays.Content) == 0 {
+		return errors.New("playbook is not a list of plays")
+	}
+
+	for i, play := range plays.Content {
+		if err := this.verifyPlay(resolveAlias(play)); err != nil {
+			return fmt.Errorf("play %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+func (this *PlaybookVerifier) verifyPlay(play *yaml.Node) error {
+	if play.Kind != yaml.MappingNode {
+		return errors.New("play is not a mapping")
+	}
+
+	vars := mappingValue(play, "vars")
+	if vars == nil || vars.Kind != yaml.MappingNode {
+		return errors.New("play has no vars")
+	}
+
+	signature := mappingValue(vars, signatureVar)
+	if signature == nil || signature.Kind != yaml.ScalarNode || signature.Value == "" {
+		return fmt.Errorf("play has no %s", signatureVar)
+	}
+
+	exclude := mappingValue(vars, signatureExcludeVar)
+	if exclude == nil || exclude.Kind != yaml.ScalarNode {
+		return fmt.Errorf("play has no %s", signatureExcludeVar)
+	}
+
+	signed, err := excludeFields(play, exclude.Value)
+	if err != nil {
+		return err
+	}
+
+	var serialized strings.Builder
+	if err := serialize(signed, &serialized); err != nil {
+		return err
+	}
+
+	if len(this.keyring) == 0 {
+		return errors.New("no public keys to verify the signature with")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature.Value))
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", signatureVar, err)
+	}
+
+	digest := sha256.Sum256([]byte(serialized.String()))
+
+	if bytes.HasPrefix(bytes.TrimSpace(decoded), []byte("-----BEGIN")) {
+		_, err = openpgp.CheckArmoredDetachedSignature(this.keyring, bytes.NewReader(digest[:]), bytes.NewReader(decoded))
+	} else {
+		_, err = openpgp.CheckDetachedSignature(this.keyring, bytes.NewReader(digest[:]), bytes.NewReader(decoded))
+	}
+
+	if err != nil {
+		return fmt.Errorf("signature not valid: %w", err)
+	}
+
+	return nil
+}
+
+// excludeFields returns a copy of the play without the comma-separated fields (/hosts, /vars/<name>) excluded from its signature
+func excludeFields(play *yaml.Node, exclusions string) (*yaml.Node, error) {
+	for _, exclusion := range strings.Split(exclusions, ",") {
+		var path []string
+		for _, part := range strings.Split(exclusion, "/") {
+			if part != "" {
+				path = append(path, part)
+			}
+		}
+
+		if len(path) == 0 || len(path) > 2 || !isExcludable(path[0]) {
+			return nil, fmt.Errorf("invalid exclusion %q", exclusion)
+		}
+
+		if len(path) == 1 {
+			result, ok := withoutKey(play, path[0])
+			if !ok {
+				return nil, fmt.Errorf("invalid exclusion %q", exclusion)
+			}
+
+			play = result
+			continue
+		}
+
+		field := mappingValue(play, path[0])
+		if field == nil || field.Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("excluded field %q does not exist", exclusion)
+		}
+
+		result, ok := withoutKey(field, path[1])
+		if !ok {
+			return nil, fmt.Errorf("excluded field %q does not exist", exclusion)
+		}
+
+		play = withValue(play, path[0], result)
+	}
+
+	return play, nil
+}
+
+func isExcludable(field string) bool {
+	for _, excludable := range excludableFields {
+		if field == excludable {
+			return true
+		}
+	}
+
+	return false
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	return node
+}
+
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return resolveAlias(mapping.Content[i+1])
+		}
+	}
+
+	return nil
+}
+
+// withoutKey returns a shallow copy of the mapping without the given key
+func withoutKey(mapping *yaml.Node, key string) (*yaml.Node, bool) {
+	result := *mapping
+	result.Content = nil
+	found := false
+
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			found = true
+			continue
+		}
+
+		result.Content = append(result.Content, mapping.Content[i], mapping.Content[i+1])
+	}
+
+	return &result, found
+}
+
+// withValue returns a shallow copy of the mapping with the value of the given key replaced
+func withValue(mapping *yaml.Node, key string, value *yaml.Node) *yaml.Node {
+	result := *mapping
+	result.Content = make([]*yaml.Node, len(mapping.Content))
+	copy(result.Content, mapping.Content)
+
+	for i := 0; i+1 < len(result.Content); i += 2 {
+		if result.Content[i].Value == key {
+			result.Content[i+1] = value
+		}
+	}
+
+	return &result
+}
+
+// serialize writes the Python representation of the node the signature is computed over, i.e. what str() gives
+// for the play loaded by ruamel.yaml: mappings are ordered dicts, scalars are represented the way Python does.
+func serialize(node *yaml.Node, out *strings.Builder) error {
+	node = resolveAlias(node)
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		if len(node.Content) == 0 {
+			out.WriteString("ordereddict()")
+			return nil
+		}
+
+		out.WriteString("ordereddict([")
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+
+			out.WriteString("(")
+			if err := serialize(node.Content[i], out); err != nil {
+				return err
+			}
+			out.WriteString(", ")
+			if err := serialize(node.Content[i+1], out); err != nil {
+				return err
+			}
+			out.WriteString(")")
+		}
+		out.WriteString("])")
+	case yaml.SequenceNode:
+		out.WriteString("[")
+		for i, item := range node.Content {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+
+			if err := serialize(item, out); err != nil {
+				return err
+			}
+		}
+		out.WriteString("]")
+	case yaml.ScalarNode:
+		out.WriteString(serializeScalar(node))
+	default:
+		return fmt.Errorf("unexpected YAML node at line %d", node.Line)
+	}
+
+	return nil
+}
+
+func serializeScalar(node *yaml.Node) string {
+	switch node.ShortTag() {
+	case "!!null":
+		return "None"
+	case "!!bool":
+		var value bool
+		if node.Decode(&value) == nil {
+			if value {
+				return "True"
+			}
+
+			return "False"
+		}
+	case "!!int":
+		var value int64
+		if node.Decode(&value) == nil {
+			return strconv.FormatInt(value, 10)
+		}
+	case "!!float":
+		var value float64
+		if node.Decode(&value) == nil {
+			return pythonFloat(value)
+		}
+	}
+
+	return pythonString(node.Value)
+}
+
+// pythonFloat formats the float the way repr() does
+func pythonFloat(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "nan"
+	case math.IsInf(value, 1):
+		return "inf"
+	case math.IsInf(value, -1):
+		return "-inf"
+	}
+
+	scientific := strconv.FormatFloat(value, 'e', -1, 64)
+	exponent, _ := strconv.Atoi(scientific[strings.IndexByte(scientific, 'e')+1:])
+
+	if exponent < -4 || exponent >= 16 {
+		return scientific
+	}
+
+	result := strconv.FormatFloat(value, 'f', -1, 64)
+	if !strings.Contains(result, ".") {
+		result += ".0"
+	}
+
+	return result
+}
+
+// pythonString quotes the string the way repr() does
+func pythonString(value string) string {
+	quote := '\''
+	if strings.ContainsRune(value, '\'') && !strings.ContainsRune(value, '"') {
+		quote = '"'
+	}
+
+	var out strings.Builder
+	out.WriteRune(quote)
+
+	for _, r := range value {
+		switch {
+		case r == quote || r == '\\':
+			out.WriteRune('\\')
+			out.WriteRune(r)
+		case r == '\t':
+			out.WriteString(`\t`)
+		case r == '\n':
+			out.WriteString(`\n`)
+		case r == '\r':
+			out.WriteString(`\r`)
+		case r < ' ' || r == 0x7f:
+			fmt.Fprintf(&out, `\x%02x`, r)
+		case r < 0x7f || unicode.IsPrint(r):
+			out.WriteRune(r)
+		case r <= 0xff:
+			fmt.Fprintf(&out, `\x%02x`, r)
+		case r <= 0xffff:
+			fmt.Fprintf(&out, `\u%04x`, r)
+		default:
+			fmt.Fprintf(&out, `\U%08x`, r)
+		}
+	}
+
+	out.WriteRune(quote)
+	return out.String()
+}
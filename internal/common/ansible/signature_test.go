@@ -0,0 +1,199 @@
+package ansible
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	"gopkg.in/yaml.v3"
+)
+
+const unsignedPlay = `- name: update packages
+  hosts: "@@HOSTS@@"
+  become: true
+  vars:
+    insights_signature_exclude: /hosts,/vars/insights_signature
+    insights_signature: "@@SIGNATURE@@"
+  tasks:
+  - name: update
+    yum:
+      name: "*"
+      state: latest
+    retries: 3
+`
+
+func newEntity() *openpgp.Entity {
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+	Expect(err).ToNot(HaveOccurred())
+	return entity
+}
+
+func armoredPublicKey(entity *openpgp.Entity) string {
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(entity.Serialize(w)).To(Succeed())
+	Expect(w.Close()).To(Succeed())
+	return buf.String()
+}
+
+// signPlay fills in the signature of the single play of the given playbook
+func signPlay(entity *openpgp.Entity, playbook string) string {
+	var document yaml.Node
+	Expect(yaml.Unmarshal([]byte(playbook), &document)).To(Succeed())
+
+	play := document.Content[0].Content[0]
+	signed, err := excludeFields(play, mappingValue(mappingValue(play, "vars"), signatureExcludeVar).Value)
+	Expect(err).ToNot(HaveOccurred())
+
+	var serialized strings.Builder
+	Expect(serialize(signed, &serialized)).To(Succeed())
+	digest := sha256.Sum256([]byte(serialized.String()))
+
+	var signature bytes.Buffer
+	Expect(openpgp.ArmoredDetachSign(&signature, entity, bytes.NewReader(digest[:]), nil)).To(Succeed())
+
+	return strings.Replace(playbook, "@@SIGNATURE@@", base64.StdEncoding.EncodeToString(signature.Bytes()), 1)
+}
+
+func playbookFor(hosts string) string {
+	return strings.Replace(unsignedPlay, "@@HOSTS@@", hosts, 1)
+}
+
+var _ = Describe("Playbook signature", func() {
+	Describe("serialization", func() {
+		It("serializes the play as a python ordered dict", func() {
+			play := `name: test
+hosts: all
+vars:
+  port: 8080
+  ratio: 0.5
+  enabled: true
+  empty: null
+  nested: {}
+tasks:
+- shell: echo 'hi' && echo "there"
+  become: false
+`
+			var document yaml.Node
+			Expect(yaml.Unmarshal([]byte(play), &document)).To(Succeed())
+
+			var serialized strings.Builder
+			Expect(serialize(document.Content[0], &serialized)).To(Succeed())
+			Expect(serialized.String()).To(Equal(
+				"ordereddict([('name', 'test'), ('hosts', 'all'), " +
+					"('vars', ordereddict([('port', 8080), ('ratio', 0.5), ('enabled', True), ('empty', None), ('nested', ordereddict())])), " +
+					`('tasks', [ordereddict([('shell', 'echo \'hi\' && echo "there"'), ('become', False)])])])`,
+			))
+		})
+
+		DescribeTable("strings",
+			func(value, expected string) {
+				Expect(pythonString(value)).To(Equal(expected))
+			},
+
+			Entry("plain", "hello", "'hello'"),
+			Entry("single quote", "it's", `"it's"`),
+			Entry("both quotes", `it's "x"`, `'it\'s "x"'`),
+			Entry("backslash", `a\b`, `'a\\b'`),
+			Entry("control characters", "a\tb\nc\r\x01", `'a\tb\nc\r\x01'`),
+			Entry("unicode", "žluťoučký", "'žluťoučký'"),
+			Entry("non-printable unicode", "a\u00a0b\u200b", `'a\xa0b\u200b'`),
+		)
+
+		DescribeTable("floats",
+			func(value float64, expected string) {
+				Expect(pythonFloat(value)).To(Equal(expected))
+			},
+
+			Entry("integral", 1.0, "1.0"),
+			Entry("fraction", 0.1, "0.1"),
+			Entry("large", 1e16, "1e+16"),
+			Entry("below the exponent threshold", 1234567.0, "1234567.0"),
+			Entry("small", 0.00001, "1e-05"),
+		)
+	})
+
+	Describe("verification", func() {
+		var (
+			entity   *openpgp.Entity
+			verifier *PlaybookVerifier
+		)
+
+		BeforeEach(func() {
+			entity = newEntity()
+
+			var err error
+			verifier, err = NewPlaybookVerifier(armoredPublicKey(entity))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("accepts a signed playbook", func() {
+			playbook := signPlay(entity, playbookFor("all"))
+			Expect(verifier.Verify([]byte(playbook))).To(Succeed())
+		})
+
+		It("ignores the excluded fields", func() {
+			playbook := signPlay(entity, playbookFor("all"))
+			playbook = strings.Replace(playbook, `hosts: "all"`, `hosts: "localhost,host1"`, 1)
+			Expect(verifier.Verify([]byte(playbook))).To(Succeed())
+		})
+
+		It("rejects a modified playbook", func() {
+			playbook := signPlay(entity, playbookFor("all"))
+			playbook = strings.Replace(playbook, "state: latest", "state: absent", 1)
+
+			err := verifier.Verify([]byte(playbook))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("signature not valid"))
+		})
+
+		It("rejects a playbook signed by an unknown key", func() {
+			playbook := signPlay(newEntity(), playbookFor("all"))
+			Expect(verifier.Verify([]byte(playbook))).ToNot(Succeed())
+		})
+
+		It("verifies every play", func() {
+			signed := signPlay(entity, playbookFor("all"))
+			playbook := signed + strings.Replace(playbookFor("all"), "@@SIGNATURE@@", "Zm9v", 1)
+
+			err := verifier.Verify([]byte(playbook))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("play 2:"))
+		})
+
+		It("rejects an unsigned playbook", func() {
+			playbook := "- hosts: all\n  vars:\n    foo: bar\n  tasks: []\n"
+
+			err := verifier.Verify([]byte(playbook))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("insights_signature"))
+		})
+
+		It("rejects an invalid exclusion", func() {
+			playbook := strings.Replace(playbookFor("all"), "/hosts,", "/tasks,", 1)
+
+			err := verifier.Verify([]byte(playbook))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid exclusion"))
+		})
+
+		It("rejects a playbook that is not a list of plays", func() {
+			Expect(verifier.Verify([]byte("foo: bar"))).ToNot(Succeed())
+		})
+
+		It("fails without public keys", func() {
+			verifier, err := NewPlaybookVerifier("")
+			Expect(err).ToNot(HaveOccurred())
+
+			playbook := signPlay(entity, playbookFor("all"))
+			Expect(verifier.Verify([]byte(playbook))).ToNot(Succeed())
+		})
+	})
+})
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	clowder "github.com/redhatinsights/app-common-go/pkg/api/v1"
+)
+
+var _ = Describe("Clowder", func() {
+	var (
+		loadedConfig  *clowder.AppConfig
+		objectBuckets map[string]clowder.ObjectStoreBucket
+		acgConfig     string
+		acgConfigSet  bool
+	)
+
+	BeforeEach(func() {
+		loadedConfig, objectBuckets = clowder.LoadedConfig, clowder.ObjectBuckets
+		acgConfig, acgConfigSet = os.LookupEnv("ACG_CONFIG")
+
+		cfg, err := clowder.LoadConfig("testdata/cdappconfig.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		clowder.LoadedConfig = cfg
+		clowder.ObjectBuckets = map[string]clowder.ObjectStoreBucket{}
+		for _, bucket := range cfg.ObjectStore.Buckets {
+			clowder.ObjectBuckets[bucket.RequestedName] = bucket
+		}
+
+		os.Setenv("ACG_CONFIG", "testdata/cdappconfig.json")
+	})
+
+	AfterEach(func() {
+		clowder.LoadedConfig, clowder.ObjectBuckets = loadedConfig, objectBuckets
+
+		if acgConfigSet {
+			os.Setenv("ACG_CONFIG", acgConfig)
+		} else {
+			os.Unsetenv("ACG_CONFIG")
+		}
+
+		os.Unsetenv("OBJECT_STORAGE_ENDPOINT")
+	})
+
+	It("maps the object storage bucket", func() {
+		cfg := Get()
+
+		Expect(cfg.GetBool("object.storage.enabled")).To(BeTrue())
+		Expect(cfg.GetString("object.storage.bucket")).To(Equal("playbook-dispatcher-artifacts-1a2b"))
+		Expect(cfg.GetString("object.storage.endpoint")).To(Equal("minio:9000"))
+		Expect(cfg.GetString("object.storage.region")).To(Equal("eu-west-1"))
+		Expect(cfg.GetString("object.storage.access.key")).To(Equal("bucket-access"))
+		Expect(cfg.GetString("object.storage.secret.key")).To(Equal("bucket-secret"))
+		Expect(cfg.GetBool("object.storage.tls")).To(BeFalse())
+	})
+
+	It("maps the in-memory database", func() {
+		cfg := Get()
+
+		Expect(cfg.GetBool("inmemorydb.enabled")).To(BeTrue())
+		Expect(cfg.GetString("inmemorydb.host")).To(Equal("redis"))
+		Expect(cfg.GetInt("inmemorydb.port")).To(Equal(6380))
+		Expect(cfg.GetString("inmemorydb.username")).To(BeEmpty())
+		Expect(cfg.GetString("inmemorydb.password")).To(Equal("redis-password"))
+		Expect(cfg.GetBool("inmemorydb.tls")).To(BeTrue())
+	})
+
+	It("gives environment variables precedence", func() {
+		os.Setenv("OBJECT_STORAGE_ENDPOINT", "localhost:9000")
+		Expect(Get().GetString("object.storage.endpoint")).To(Equal("localhost:9000"))
+	})
+})
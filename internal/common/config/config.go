@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/spf13/viper"
 
@@ -15,10 +16,22 @@ const (
 	KesselModeBothRBACEnforces   = "both-rbac-enforces"
 	KesselModeBothKesselEnforces = "both-kessel-enforces"
 	KesselModeKesselOnly         = "kessel-only"
+
+	// what Kessel-enforcing modes do when Kessel cannot be consulted
+	KesselFailClosed = "fail_closed"
+	KesselFailOpen   = "fail_open"
 )
 
+// the bucket requested in the ClowdApp (objectStore)
+const objectStorageBucket = "playbook-dispatcher-artifacts"
+
 var rdsCaPath *string
 
+var (
+	secretsLock sync.RWMutex
+	secrets     map[string]string
+)
+
 func init() {
 	if !clowder.IsClowderEnabled() || clowder.LoadedConfig.Database.RdsCa == nil {
 		return
@@ -35,17 +48,202 @@ func Get() *viper.Viper {
 	options := viper.New()
 
 	options.SetDefault("build.commit", "unknown")
+	// optional YAML/JSON file, watched for changes of the reloadable values (see config.OnReload)
+	options.SetDefault("config.file", "")
+	// named set of environment-specific defaults (ephemeral, stage, prod, on-prem), see profiles.go
+	options.SetDefault("config.profile", "")
 
 	options.SetDefault("log.level", "debug")
+	// log the first N entries with the same level and message each second, then every Mth one (0 disables sampling)
+	options.SetDefault("log.sampling.initial", 100)
+	options.SetDefault("log.sampling.thereafter", 100)
+	options.SetDefault("log.sampling.response.consumer.initial", 0)
+	options.SetDefault("log.sampling.response.consumer.thereafter", 0)
+	options.SetDefault("log.sampling.validator.initial", 0)
+	options.SetDefault("log.sampling.validator.thereafter", 0)
 	options.SetDefault("demo.mode", false)
-
+	// local development only (see pd local-dev): requests without credentials are accepted, public API requests
+	// without an identity header act as a user of dev.org.id
+	options.SetDefault("dev.auth.relaxed", false)
+	options.SetDefault("dev.org.id", "5318290")
+	// expose the provider state endpoint used to verify consumer contracts (see pd pact-verify), development only
+	options.SetDefault("pact.provider.states.enabled", false)
+	// pprof and runtime stats on the metrics port (localhost or PSK only)
+	options.SetDefault("debug.endpoints.enabled", true)
+	// capture full request/response bodies of the given orgs (comma-separated), retrievable via /internal/v2/debug/captures
+	options.SetDefault("debug.capture.org.ids", "")
+	options.SetDefault("debug.capture.buffer.size", 200)
+	options.SetDefault("debug.capture.max.body.size", 64*1024)
+
+	// read-only mode: dispatch and cancel operations respond with 503 (see also the playbook-dispatcher-maintenance Unleash flag)
+	// deprecated endpoints and fields signaled to callers, comma-separated <method> <path>[*] or field:<name> entries
+	// optionally followed by ;deprecated=<date>;sunset=<date>;link=<url> (see middleware.Deprecation)
+	options.SetDefault("api.deprecations", "POST /internal/dispatch,field:account")
+
+	options.SetDefault("maintenance.mode", false)
+	options.SetDefault("maintenance.retry.after", 300)
+
+	// services known in addition to the built-in ones (comma-separated), their Kessel permission is derived from the name
+	options.SetDefault("services.known", "")
+	// seconds between registering the distinct services of existing runs, 0 disables
+	options.SetDefault("services.refresh.interval", 0)
+
+	options.SetDefault("stuck.runs.interval", 300)
+	options.SetDefault("stuck.runs.grace", 3600)
+	options.SetDefault("stuck.runs.timeout.enabled", false)
+
+	// timeout sweeper of the jobs module (and the clean command), runs are partitioned across workers by the hash of their id
+	options.SetDefault("timeout.sweeper.enabled", false)
+	options.SetDefault("timeout.sweeper.interval", 60)
+	options.SetDefault("timeout.sweeper.grace", 0)
+	options.SetDefault("timeout.sweeper.batch.size", 500)
+	options.SetDefault("timeout.sweeper.worker.count", 1)
+	options.SetDefault("timeout.sweeper.worker.index", 0)
+
+	// load credentials from a secret manager ("aws" or "vault"), see the secrets package for the expected format
+	options.SetDefault("secrets.provider", "")
+	options.SetDefault("secrets.refresh.interval", 300)
+	options.SetDefault("secrets.aws.region", "us-east-1")
+	options.SetDefault("secrets.aws.secret.id", "playbook-dispatcher")
+	options.SetDefault("secrets.vault.addr", "http://localhost:8200")
+	options.SetDefault("secrets.vault.token", "")
+	options.SetDefault("secrets.vault.mount", "secret")
+	options.SetDefault("secrets.vault.path", "playbook-dispatcher")
+	options.SetDefault("secrets.vault.timeout", 10)
+
+	// used by the admin command to talk to the internal API
+	options.SetDefault("admin.api.url", "http://localhost:8000")
+	options.SetDefault("admin.psk", "")
+
+	options.SetDefault("audit.enabled", false)
+	options.SetDefault("audit.relay.interval", 5)
+	options.SetDefault("audit.relay.batch.size", 100)
+	options.SetDefault("audit.anchor.interval", 300)
+
+	// request body limits, http.max.body.size applies to internal endpoints not listed below
 	options.SetDefault("http.max.body.size", "512KB")
+	options.SetDefault("http.body.limit.public", "64KB")
+	options.SetDefault("http.body.limit.bulk", "512KB")
+	options.SetDefault("http.body.limit.connection.status", "512KB")
+
+	// TLS listener serving the same routes as web.port, required for client certificate authentication
+	options.SetDefault("web.tls.port", 0)
+	options.SetDefault("web.tls.cert", "")
+	options.SetDefault("web.tls.key", "")
+
+	// comma-separated origins allowed to call the public API from a browser, empty disallows cross-origin requests
+	options.SetDefault("web.cors.allowed.origins", "")
+	options.SetDefault("web.cors.max.age", 600)
+	// Strict-Transport-Security max age (seconds) on the public API, 0 turns it off
+	options.SetDefault("web.hsts.max.age", 31536000)
+
+	// comma-separated CIDR ranges the internal API may be called from, empty allows any address
+	options.SetDefault("internal.allowed.cidrs", "")
+
+	// per-caller limits on the internal API, overridden per caller using internal.ratelimit.service.<principal>.*
+	options.SetDefault("internal.ratelimit.enabled", false)
+	options.SetDefault("internal.ratelimit.default.rate", 20)
+	options.SetDefault("internal.ratelimit.default.burst", 40)
+	options.SetDefault("internal.ratelimit.default.concurrency", 10)
+
+	// per-principal limits on the public API, overridden per org using public.ratelimit.org.<org_id>.*
+	// the buckets are kept in memory of each replica or shared using the in-memory database (memory|redis)
+	options.SetDefault("public.ratelimit.enabled", false)
+	options.SetDefault("public.ratelimit.backend", "memory")
+	options.SetDefault("public.ratelimit.default.rate", 10)
+	options.SetDefault("public.ratelimit.default.burst", 50)
+
+	// accept API tokens (minted using /internal/v2/api_tokens) granting read-only access to the public API
+	options.SetDefault("public.api.tokens.enabled", false)
+
+	// compression of public API responses (br|gzip, negotiated), levels range from 0 (brotli) or -2 (gzip, Huffman only)
+	// to 11 (brotli) or 9 (gzip), responses shorter than the minimum length (in bytes) are not compressed
+	options.SetDefault("public.compression.enabled", true)
+	options.SetDefault("public.compression.gzip.level", 5)
+	options.SetDefault("public.compression.brotli.level", 4)
+	options.SetDefault("public.compression.min.length", 1024)
+
+	// require a client certificate on PSK-authenticated internal endpoints (in addition to the PSK)
+	options.SetDefault("internal.mtls.enabled", false)
+	options.SetDefault("internal.mtls.ca", "")
+	options.SetDefault("internal.mtls.allowed.sans", "")
+
+	// platform SSO service account tokens accepted by the internal API as an alternative to PSKs
+	options.SetDefault("internal.jwt.enabled", false)
+	options.SetDefault("internal.jwt.jwks.url", "https://sso.redhat.com/auth/realms/redhat-external/protocol/openid-connect/certs")
+	options.SetDefault("internal.jwt.jwks.timeout", 10)
+	options.SetDefault("internal.jwt.jwks.max.age", 3600)
+	options.SetDefault("internal.jwt.issuer", "https://sso.redhat.com/auth/realms/redhat-external")
+	options.SetDefault("internal.jwt.audience", "")
+	options.SetDefault("internal.jwt.leeway", 30)
+	options.SetDefault("internal.jwt.client.claim", "client_id")
+	// comma-separated list of <client id>=<principal>
+	options.SetDefault("internal.jwt.principals", "")
+
+	options.SetDefault("webhook.timeout", 10)
+	options.SetDefault("webhook.retry.attempts", 8)
+	options.SetDefault("webhook.retry.initial.interval", 1)
+	options.SetDefault("webhook.retry.max.interval", 300)
+	// endpoints of subscriptions that failed this many attempts in a row are dead-lettered (0 disables)
+	options.SetDefault("webhook.dead_letter.failures", 20)
+	// dead-lettered endpoints are probed with a single attempt per interval (seconds)
+	options.SetDefault("webhook.dead_letter.retry.interval", 3600)
+
+	// post-processing hooks of completed runs, executed by the jobs module (see internal/jobs/hooks)
+	// run.hooks.service.<service> lists the hooks of a service, run.hooks.hook.<hook>.* defines a hook
+	options.SetDefault("run.hooks.enabled", false)
+	options.SetDefault("run.hooks.services", "")
+	options.SetDefault("run.hooks.interval", 10)
+	options.SetDefault("run.hooks.batch.size", 100)
+	// runs that completed longer ago (seconds) are not post-processed
+	options.SetDefault("run.hooks.lookback", 24*60*60)
+	options.SetDefault("run.hooks.retry.attempts", 10)
+	options.SetDefault("run.hooks.retry.initial.interval", 10)
+	options.SetDefault("run.hooks.retry.max.interval", 3600)
+
+	// values of these (comma-separated) label keys are encrypted at rest
+	options.SetDefault("label.encryption.keys", "")
+	// comma-separated, base64-encoded data keys encrypted by the KMS key (see pd label-data-key), the first one encrypts new values
+	options.SetDefault("label.encryption.data.keys", "")
+	options.SetDefault("label.encryption.kms.impl", "mock")
+	options.SetDefault("label.encryption.kms.region", "us-east-1")
+	options.SetDefault("label.encryption.kms.key.id", "")
+	// base64-encoded 256-bit key standing in for KMS when label.encryption.kms.impl is not "impl"
+	options.SetDefault("label.encryption.local.key", "")
 
 	options.SetDefault("default.run.timeout", 3600)
 
+	options.SetDefault("usage.flush.interval", 60)
+
+	// streams of run events (GET /v1/runs/{run_id}/events) send a heartbeat, and re-check the status of the run, every
+	// interval seconds and end after max.duration seconds at the latest
+	options.SetDefault("run.events.heartbeat.interval", 15)
+	options.SetDefault("run.events.max.duration", 3600)
+
+	// on SIGTERM the readiness probe fails for shutdown.delay seconds before the service stops accepting requests,
+	// then in-flight work is given shutdown.timeout seconds to finish
+	options.SetDefault("shutdown.delay", 0)
+	options.SetDefault("shutdown.timeout", 20)
+	options.SetDefault("shutdown.api.timeout", 10)
+	options.SetDefault("health.cache.ttl", 10)
+	options.SetDefault("health.check.timeout", 2)
+
+	options.SetDefault("slo.evaluation.interval", 60)
+	options.SetDefault("slo.windows", "5m,30m,1h,2h,6h,24h,72h")
+	options.SetDefault("slo.run.completion.target", 0.95)
+	options.SetDefault("slo.run.completion.grace", 300)
+
+	// wait for postgres and kafka at startup instead of failing on the first connection error
+	options.SetDefault("startup.wait.timeout", 120)
+	options.SetDefault("startup.wait.backoff.initial.ms", 500)
+	options.SetDefault("startup.wait.backoff.max.ms", 10000)
+
 	options.SetDefault("db.max.idle.connections", 10)
 	options.SetDefault("db.max.open.connections", 20)
 	options.SetDefault("migrations.dir", "./migrations")
+	options.SetDefault("schema.check.enabled", true)
+	options.SetDefault("schema.check.timeout", 5)
+	options.SetDefault("schema.ahead.tolerance", 1)
 
 	options.SetDefault("kafka.timeout", 10000)
 	options.SetDefault("kafka.group.id", "playbook-dispatcher")
@@ -55,6 +253,20 @@ func Get() *viper.Viper {
 	options.SetDefault("kafka.message.send.max.retries", 15)
 	options.SetDefault("kafka.retry.backoff.ms", 100)
 
+	// topic migration: consume a secondary topic (optionally on another cluster) next to topic.updates
+	// and skip messages applied within the deduplication window (seconds, 0 disables deduplication)
+	options.SetDefault("topic.secondary.updates", "")
+	options.SetDefault("kafka.secondary.bootstrap.servers", "")
+	options.SetDefault("response.consumer.dedup.window", 0)
+
+	// redact secrets from playbook output before it is stored; patterns are separated by newlines,
+	// an entropy threshold (bits per character) of 0 turns off the detection of random-looking words
+	options.SetDefault("stdout.scrub.enabled", false)
+	options.SetDefault("stdout.scrub.patterns", "")
+	options.SetDefault("stdout.scrub.entropy.threshold", 4.0)
+	options.SetDefault("stdout.scrub.entropy.min.length", 24)
+	options.SetDefault("stdout.scrub.disabled.services", "")
+
 	options.SetDefault("schema.message.response", "./schema/playbookRunResponse.message.yaml")
 	options.SetDefault("schema.satmessage.response", "./schema/playbookSatRunResponse.message.yaml")
 	options.SetDefault("schema.runner.event", "./schema/ansibleRunnerJobEvent.yaml")
@@ -68,8 +280,56 @@ func Get() *viper.Viper {
 	options.SetDefault("artifact.truncate.stdout.field.after.lines", 500)
 	options.SetDefault("artifact.max.stdout.field.size", 1024)
 	options.SetDefault("artifact.max.kafka.message.size", 1024*1024)
+	// structured artifacts (facts, set_stats data, task results) stored per run host, task results are dropped above the limit
+	options.SetDefault("run.host.artifacts.max.size", 512*1024)
 
 	options.SetDefault("satellite.response.full", true)
+	// Satellite runs targeting more hosts are split into several job invocations sharing the correlation id of the run
+	options.SetDefault("satellite.hosts.per.request", 1000)
+	// seconds to wait for the playbook URL to respond when validating runs (/internal/v2/dispatch/validate)
+	options.SetDefault("dispatch.validate.url.timeout", 5)
+	// seconds to wait for the playbook URL to respond to the pre-flight check of runs dispatched with check_recipient
+	options.SetDefault("dispatch.preflight.url.timeout", 5)
+	// response header the playbook URL has to respond with (e.g. the signature of the playbook), not checked if empty.
+	// Only the presence of the header is checked, see playbook.signature.verify for the verification of signatures
+	options.SetDefault("dispatch.preflight.required.header", "")
+
+	// runs of directly connected hosts can only be canceled by versions of rhc-worker-playbook that support the cancel signal
+	options.SetDefault("cancel.rhc.enabled", false)
+	// maximum number of runs canceled by a single request of /internal/v2/cancel/filter
+	options.SetDefault("cancel.filter.max.runs", 500)
+
+	// maximum number of runs an org can have running at a time (0 = no limit), can be overridden per org (/internal/v2/run_limits)
+	options.SetDefault("run.concurrency.limit", 0)
+
+	// runs asking to wait for their recipient are dispatched when it connects within the window (seconds) and time out otherwise
+	// the API consumes the connection events of cloud-connector using a consumer group of its own
+	options.SetDefault("wait.for.connection.enabled", false)
+	options.SetDefault("wait.for.connection.window", 24*60*60)
+	options.SetDefault("wait.for.connection.group.id", "playbook-dispatcher-connection-events")
+
+	// each API replica polls for run templates due every interval (seconds), templates may not trigger more often than the min interval (seconds)
+	options.SetDefault("run.templates.scheduler.enabled", true)
+	options.SetDefault("run.templates.scheduler.interval", 30)
+	options.SetDefault("run.templates.scheduler.batch.size", 100)
+	options.SetDefault("run.templates.min.interval", 15*60)
+
+	// object storage bucket for offloading/archiving large run artifacts (stdout)
+	options.SetDefault("object.storage.enabled", false)
+	options.SetDefault("object.storage.bucket", objectStorageBucket)
+	options.SetDefault("object.storage.endpoint", "localhost:9000")
+	options.SetDefault("object.storage.region", "us-east-1")
+	options.SetDefault("object.storage.access.key", "")
+	options.SetDefault("object.storage.secret.key", "")
+	options.SetDefault("object.storage.tls", false)
+
+	// in-memory database (Redis-compatible) for caching and coordination between replicas
+	options.SetDefault("inmemorydb.enabled", false)
+	options.SetDefault("inmemorydb.host", "localhost")
+	options.SetDefault("inmemorydb.port", 6379)
+	options.SetDefault("inmemorydb.username", "")
+	options.SetDefault("inmemorydb.password", "")
+	options.SetDefault("inmemorydb.tls", false)
 
 	options.SetDefault("cloud.connector.impl", "mock")
 	options.SetDefault("cloud.connector.host", "cloud-connector")
@@ -80,6 +340,10 @@ func Get() *viper.Viper {
 	options.SetDefault("cloud.connector.psk", "")
 	options.SetDefault("cloud.connector.rps", 100)
 	options.SetDefault("cloud.connector.req.bucket", 60)
+	// share of the cloud connector requests each priority gets while requests are queued for the rate limit
+	options.SetDefault("cloud.connector.priority.weight.high", 6)
+	options.SetDefault("cloud.connector.priority.weight.normal", 3)
+	options.SetDefault("cloud.connector.priority.weight.low", 1)
 
 	options.SetDefault("return.url", "https://cloud.redhat.com/api/ingress/v1/upload")
 	options.SetDefault("web.console.url.default", "https://console.redhat.com")
@@ -117,12 +381,31 @@ func Get() *viper.Viper {
 
 	options.SetDefault("blocklist.org.ids", "")
 
+	// comma-separated URL patterns runs may point to, playbook.url.allowlist.service.<service> overrides the default per service
+	options.SetDefault("playbook.url.allowlist.enabled", false)
+	options.SetDefault("playbook.url.allowlist.default", "")
+
+	// playbooks of runs are fetched and their insights signature verified on dispatch if required for the dispatching service,
+	// playbook.signature.verify.service.<service> overrides the default per service
+	options.SetDefault("playbook.signature.verify.default", false)
+	// armored GPG public keys the insights signatures of playbooks are verified with
+	options.SetDefault("playbook.signature.public.keys", "")
+	// seconds to wait for the playbook to be fetched for the verification of its signature
+	options.SetDefault("playbook.signature.fetch.timeout", 10)
+	options.SetDefault("playbook.signature.max.size", 1024*1024)
+
 	// Kessel authorization configuration
 	// Feature flag: master switch for Kessel authorization
 	options.SetDefault("kessel.enabled", false)
 	// Feature flag: authorization mode matching Unleash variants
 	// Valid values: rbac-only, both-rbac-enforces, both-kessel-enforces, kessel-only
 	options.SetDefault("kessel.auth.mode", "rbac-only")
+	// comma-separated public routes (e.g. /api/playbook-dispatcher/v1/runs, "*" for all) on which Kessel is consulted
+	// alongside RBAC in rbac-only mode, even with kessel.enabled=false; RBAC still decides
+	options.SetDefault("kessel.shadow_mode", "")
+	// comma-separated org IDs Kessel authorization is limited to during a staged rollout, all orgs if empty; other orgs
+	// use rbac-only
+	options.SetDefault("kessel.enabled_orgs", "")
 
 	// Kessel client configuration
 	options.SetDefault("kessel.url", "localhost:9091")
@@ -131,6 +414,54 @@ func Get() *viper.Viper {
 	options.SetDefault("kessel.auth.client.secret", "")
 	options.SetDefault("kessel.auth.oidc.issuer", "https://sso.redhat.com/auth/realms/redhat-external/protocol/openid-connect/token")
 	options.SetDefault("kessel.insecure", true)
+	// oidc (client credentials flow) or psk (kessel.auth.psk sent as the bearer token)
+	options.SetDefault("kessel.auth.type", "oidc")
+	options.SetDefault("kessel.auth.psk", "")
+	// with kessel.insecure=false: CA to verify the server with (system certificates if not set) and client certificate
+	// for mTLS, re-read on every handshake so that rotated files are picked up
+	options.SetDefault("kessel.tls.ca.file", "")
+	options.SetDefault("kessel.tls.cert.file", "")
+	options.SetDefault("kessel.tls.key.file", "")
+	// calls are spread over this many connections, each reconnecting (with backoff up to kessel.reconnect.max_delay
+	// seconds) and re-resolving kessel.url when Kessel goes away
+	options.SetDefault("kessel.connection.pool.size", 1)
+	options.SetDefault("kessel.reconnect.max_delay", 30)
+	// seconds of inactivity after which the connections are pinged (0 disables) and seconds to wait for the ping ack;
+	// the server must permit pings this frequent
+	options.SetDefault("kessel.keepalive.time", 60)
+	options.SetDefault("kessel.keepalive.timeout", 20)
+	options.SetDefault("kessel.keepalive.permit_without_stream", false)
+	// resources are listed (see kessel.ListResources) in pages of this size, following the continuation token of the
+	// previous page, until kessel.list.max.results resources were listed
+	options.SetDefault("kessel.list.page.size", 1000)
+	options.SetDefault("kessel.list.max.results", 10000)
+	// permission checks of a batch (e.g. one per application) sent concurrently, each limited to kessel.check.timeout seconds
+	options.SetDefault("kessel.batch.concurrency", 4)
+	// auto (bulk check if Kessel supports it, parallel checks otherwise), bulk or parallel
+	options.SetDefault("kessel.batch.mode", "auto")
+	// seconds a single call to Kessel (e.g. writing the relationships of a run) may take, 0 disables
+	options.SetDefault("kessel.check.timeout", 5)
+	// minimize_latency, at_least_as_fresh (as the relationships written for the run being checked, see
+	// kessel.tuples.enabled) or at_least_as_acknowledged
+	options.SetDefault("kessel.check.consistency", "minimize_latency")
+	// calls are rejected for kessel.breaker.open.interval seconds after this many consecutive failures, 0 disables
+	options.SetDefault("kessel.breaker.failure.threshold", 5)
+	options.SetDefault("kessel.breaker.open.interval", 30)
+	// fail_closed denies access while Kessel fails, fail_open grants access to all applications instead
+	options.SetDefault("kessel.failure_policy", KesselFailClosed)
+	// write the relationships of runs (run -> org, run -> service) for resource-level checks
+	options.SetDefault("kessel.tuples.enabled", false)
+	// only grant access to the services a principal is a viewer of (the Kessel counterpart of RBAC service attribute
+	// filters), see admin kessel-bootstrap-service-permissions
+	options.SetDefault("kessel.service_permissions.enabled", false)
+	// in the Kessel-enforcing modes also require the per-route permission (see the routes of the public API)
+	options.SetDefault("kessel.route.checks.enabled", false)
+	// relate runs to the user they were dispatched on behalf of (requires kessel.tuples.enabled) and, in the
+	// Kessel-enforcing modes, let users without access to any service access the runs they own
+	options.SetDefault("kessel.run_ownership.enabled", false)
+	// log every authorization decision of Kessel (subject, relation, resource, decision, latency, request id) to the
+	// kessel_audit logger
+	options.SetDefault("kessel.audit.enabled", false)
 
 	// Unleash feature flag configuration (defaults for non-Clowder environments)
 	options.SetDefault("unleash.enabled", false)
@@ -152,6 +483,8 @@ func Get() *viper.Viper {
 		options.SetDefault("topic.updates", clowder.KafkaTopics["platform.playbook-dispatcher.runner-updates"].Name)
 		options.SetDefault("topic.validation.request", clowder.KafkaTopics["platform.upload.announce"].Name)
 		options.SetDefault("topic.validation.response", clowder.KafkaTopics["platform.upload.validation"].Name)
+		options.SetDefault("topic.audit", clowder.KafkaTopics["platform.playbook-dispatcher.audit"].Name)
+		options.SetDefault("topic.connection.events", clowder.KafkaTopics["platform.cloud-connector.connection-events"].Name)
 
 		if broker.Authtype != nil {
 			options.Set("kafka.sasl.username", *broker.Sasl.Username)
@@ -203,6 +536,41 @@ func Get() *viper.Viper {
 			}
 		}
 
+		// Object storage (minio in ephemeral, S3 in stage/production)
+		// bucket-level values take precedence over the ones of the object store
+		if bucket, ok := clowder.ObjectBuckets[objectStorageBucket]; ok && cfg.ObjectStore != nil {
+			options.SetDefault("object.storage.enabled", true)
+			options.SetDefault("object.storage.bucket", bucket.Name)
+
+			endpoint := fmt.Sprintf("%s:%d", cfg.ObjectStore.Hostname, cfg.ObjectStore.Port)
+			if bucket.Endpoint != nil {
+				endpoint = *bucket.Endpoint
+			}
+			options.SetDefault("object.storage.endpoint", endpoint)
+
+			tls := cfg.ObjectStore.Tls
+			if bucket.Tls != nil {
+				tls = *bucket.Tls
+			}
+			options.SetDefault("object.storage.tls", tls)
+
+			setDefaultIfPresent(options, "object.storage.region", bucket.Region)
+			setDefaultIfPresent(options, "object.storage.access.key", firstPresent(bucket.AccessKey, cfg.ObjectStore.AccessKey))
+			setDefaultIfPresent(options, "object.storage.secret.key", firstPresent(bucket.SecretKey, cfg.ObjectStore.SecretKey))
+		}
+
+		if cfg.InMemoryDb != nil {
+			options.SetDefault("inmemorydb.enabled", true)
+			options.SetDefault("inmemorydb.host", cfg.InMemoryDb.Hostname)
+			options.SetDefault("inmemorydb.port", cfg.InMemoryDb.Port)
+			setDefaultIfPresent(options, "inmemorydb.username", cfg.InMemoryDb.Username)
+			setDefaultIfPresent(options, "inmemorydb.password", cfg.InMemoryDb.Password)
+
+			if cfg.InMemoryDb.SslMode != nil {
+				options.SetDefault("inmemorydb.tls", *cfg.InMemoryDb.SslMode)
+			}
+		}
+
 		// Kessel endpoint discovery from Clowder
 		// Currently commented out due to RHCLOUD-40314
 		// Uncomment when Kessel inventory is properly registered in Clowder
@@ -222,6 +590,8 @@ func Get() *viper.Viper {
 		options.SetDefault("topic.updates", "platform.playbook-dispatcher.runner-updates")
 		options.SetDefault("topic.validation.request", "platform.upload.announce")
 		options.SetDefault("topic.validation.response", "platform.upload.validation")
+		options.SetDefault("topic.audit", "platform.playbook-dispatcher.audit")
+		options.SetDefault("topic.connection.events", "platform.cloud-connector.connection-events")
 
 		options.SetDefault("db.host", "localhost")
 		options.SetDefault("db.port", 5432)
@@ -233,5 +603,49 @@ func Get() *viper.Viper {
 	options.AutomaticEnv()
 	options.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 
+	if err := applyProfile(options, options.GetString("config.profile")); err != nil {
+		panic(err.Error())
+	}
+
+	// values from the config file take precedence over defaults but not over environment variables
+	if file := options.GetString("config.file"); file != "" {
+		options.SetConfigFile(file)
+		if err := options.ReadInConfig(); err != nil {
+			panic(fmt.Sprintf("Error reading config file %s: %s", file, err))
+		}
+	}
+
+	secretsLock.RLock()
+	defer secretsLock.RUnlock()
+
+	for key, value := range secrets {
+		options.Set(key, value)
+	}
+
 	return options
 }
+
+// SetSecrets sets configuration values loaded from a secret manager.
+// These take precedence over any other source in configuration returned by subsequent calls to Get.
+func SetSecrets(values map[string]string) {
+	secretsLock.Lock()
+	defer secretsLock.Unlock()
+
+	secrets = values
+}
+
+func firstPresent(values ...*string) *string {
+	for _, value := range values {
+		if value != nil {
+			return value
+		}
+	}
+
+	return nil
+}
+
+func setDefaultIfPresent(options *viper.Viper, key string, value *string) {
+	if value != nil {
+		options.SetDefault(key, *value)
+	}
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// keys containing any of these are left out of the fingerprint
+var secretKeyFragments = []string{"password", "secret", "token", "psk", "key"}
+
+// keys expected to differ between otherwise identical deployments
+var ignoredKeys = map[string]bool{
+	"build.commit": true,
+}
+
+var fingerprintInfo = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "config_fingerprint_info",
+	Help: "Hash of the effective (non-secret) configuration, always 1. Compare the fingerprint label across replicas to detect configuration drift",
+}, []string{"fingerprint"})
+
+// Fingerprint computes a hash of the effective configuration, excluding secrets
+func Fingerprint(cfg *viper.Viper) string {
+	keys := cfg.AllKeys()
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, key := range keys {
+		if ignoredKeys[key] || isSecret(key) {
+			continue
+		}
+
+		fmt.Fprintf(hash, "%s=%v\n", key, cfg.Get(key))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// RecordFingerprint exports the configuration fingerprint as a metric and logs it
+func RecordFingerprint(cfg *viper.Viper, log *zap.SugaredLogger) string {
+	fingerprint := Fingerprint(cfg)
+
+	fingerprintInfo.Reset()
+	fingerprintInfo.WithLabelValues(fingerprint).Set(1)
+	log.Infow("Configuration fingerprint", "fingerprint", fingerprint)
+
+	return fingerprint
+}
+
+func isSecret(key string) bool {
+	lower := strings.ToLower(key)
+
+	for _, fragment := range secretKeyFragments {
+		if strings.Contains(lower, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Fingerprint", func() {
+	newConfig := func(values map[string]interface{}) *viper.Viper {
+		cfg := viper.New()
+		for key, value := range values {
+			cfg.Set(key, value)
+		}
+		return cfg
+	}
+
+	It("is stable", func() {
+		values := map[string]interface{}{"web.port": 8000, "db.host": "localhost"}
+		Expect(Fingerprint(newConfig(values))).To(Equal(Fingerprint(newConfig(values))))
+	})
+
+	It("changes with configuration", func() {
+		first := newConfig(map[string]interface{}{"web.port": 8000})
+		second := newConfig(map[string]interface{}{"web.port": 8001})
+		Expect(Fingerprint(first)).ToNot(Equal(Fingerprint(second)))
+	})
+
+	It("ignores secrets", func() {
+		first := newConfig(map[string]interface{}{"web.port": 8000, "db.password": "a", "unleash.api.token": "a"})
+		second := newConfig(map[string]interface{}{"web.port": 8000, "db.password": "b", "unleash.api.token": "b"})
+		Expect(Fingerprint(first)).To(Equal(Fingerprint(second)))
+	})
+
+	It("ignores the build commit", func() {
+		first := newConfig(map[string]interface{}{"build.commit": "abc"})
+		second := newConfig(map[string]interface{}{"build.commit": "def"})
+		Expect(Fingerprint(first)).To(Equal(Fingerprint(second)))
+	})
+})
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// profiles layer environment-specific defaults on top of the base defaults.
+// Environment variables, the config file and secrets still take precedence over them.
+var profiles = map[string]map[string]interface{}{
+	"ephemeral": {
+		"log.level":                         "debug",
+		"cloud.connector.impl":              "mock",
+		"rbac.impl":                         "mock",
+		"inventory.connector.impl":          "mock",
+		"sources.impl":                      "mock",
+		"tenant.translator.impl":            "dynamic-mock",
+		"kessel.insecure":                   true,
+		"kessel.auth.enabled":               false,
+		"unleash.environment":               "development",
+		"http.max.body.size":                "10MB",
+		"http.body.limit.bulk":              "10MB",
+		"http.body.limit.connection.status": "10MB",
+		"cloud.connector.rps":               1000,
+		"cloud.connector.req.bucket":        1000,
+		"shutdown.delay":                    0,
+	},
+	"stage": {
+		"log.level":                "info",
+		"cloud.connector.impl":     "impl",
+		"rbac.impl":                "impl",
+		"inventory.connector.impl": "impl",
+		"sources.impl":             "impl",
+		"tenant.translator.impl":   "impl",
+		"kessel.insecure":          false,
+		"kessel.auth.enabled":      true,
+		"unleash.environment":      "stage",
+		"web.cors.allowed.origins": "https://console.stage.redhat.com",
+		"shutdown.delay":           5,
+	},
+	"prod": {
+		"log.level":                "info",
+		"cloud.connector.impl":     "impl",
+		"rbac.impl":                "impl",
+		"inventory.connector.impl": "impl",
+		"sources.impl":             "impl",
+		"tenant.translator.impl":   "impl",
+		"kessel.insecure":          false,
+		"kessel.auth.enabled":      true,
+		"unleash.environment":      "production",
+		"web.cors.allowed.origins": "https://console.redhat.com",
+		"shutdown.delay":           5,
+	},
+	// standalone installation without the console.redhat.com services (tenant translation, Kessel, Unleash)
+	"on-prem": {
+		"log.level":                "info",
+		"cloud.connector.impl":     "impl",
+		"rbac.impl":                "impl",
+		"inventory.connector.impl": "impl",
+		"sources.impl":             "mock",
+		"tenant.translator.impl":   "mock",
+		"kessel.enabled":           false,
+		"unleash.enabled":          false,
+	},
+}
+
+// Profiles returns the names of the available configuration profiles
+func Profiles() []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+func applyProfile(options *viper.Viper, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	profile, ok := profiles[strings.ToLower(name)]
+	if !ok {
+		return fmt.Errorf("unknown configuration profile %s (available: %s)", name, strings.Join(Profiles(), ", "))
+	}
+
+	for key, value := range profile {
+		options.SetDefault(key, value)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Profiles", func() {
+	AfterEach(func() {
+		os.Unsetenv("CONFIG_PROFILE")
+		os.Unsetenv("RBAC_IMPL")
+	})
+
+	It("only overrides known configuration keys", func() {
+		cfg := Get()
+
+		for name, profile := range profiles {
+			for key := range profile {
+				Expect(cfg.IsSet(key)).To(BeTrue(), "unknown key %s in profile %s", key, name)
+			}
+		}
+	})
+
+	It("layers the profile over the defaults", func() {
+		Expect(Get().GetString("rbac.impl")).To(Equal("mock"))
+
+		os.Setenv("CONFIG_PROFILE", "prod")
+		Expect(Get().GetString("rbac.impl")).To(Equal("impl"))
+		Expect(Get().GetBool("kessel.insecure")).To(BeFalse())
+	})
+
+	It("gives environment variables precedence over the profile", func() {
+		os.Setenv("CONFIG_PROFILE", "prod")
+		os.Setenv("RBAC_IMPL", "mock")
+		Expect(Get().GetString("rbac.impl")).To(Equal("mock"))
+	})
+
+	It("rejects unknown profiles", func() {
+		os.Setenv("CONFIG_PROFILE", "unknown")
+		Expect(func() { Get() }).To(Panic())
+	})
+})
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+var (
+	reloadLock  sync.Mutex
+	reloadHooks []func(cfg *viper.Viper)
+)
+
+// OnReload registers a function that is called with the freshly loaded configuration whenever the config file changes.
+// Only values read by a hook are reloaded, everything else requires a restart.
+func OnReload(hook func(cfg *viper.Viper)) {
+	reloadLock.Lock()
+	defer reloadLock.Unlock()
+
+	reloadHooks = append(reloadHooks, hook)
+}
+
+// Watch reloads the configuration whenever the file set by config.file changes (e.g. a ConfigMap is updated).
+// It is a no-op if no config file is used.
+func Watch(cfg *viper.Viper, log *zap.SugaredLogger) {
+	file := cfg.GetString("config.file")
+	if file == "" {
+		return
+	}
+
+	watcher := viper.New()
+	watcher.SetConfigFile(file)
+	watcher.OnConfigChange(func(event fsnotify.Event) {
+		reload(file, log)
+	})
+	watcher.WatchConfig()
+
+	log.Infow("Watching config file for changes", "file", file)
+}
+
+func reload(file string, log *zap.SugaredLogger) {
+	// validate the file first as Get panics on a config file it cannot read
+	probe := viper.New()
+	probe.SetConfigFile(file)
+	if err := probe.ReadInConfig(); err != nil {
+		log.Errorw("Ignoring invalid config file", "file", file, "error", err)
+		return
+	}
+
+	log.Infow("Config file changed, reloading", "file", file)
+	Reload(log)
+}
+
+// Reload loads the configuration again and passes it to the registered reload hooks
+func Reload(log *zap.SugaredLogger) {
+	cfg := Get()
+	RecordFingerprint(cfg, log)
+
+	reloadLock.Lock()
+	defer reloadLock.Unlock()
+
+	for _, hook := range reloadHooks {
+		hook(cfg)
+	}
+}
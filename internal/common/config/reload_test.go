@@ -0,0 +1,64 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reload", func() {
+	var file string
+
+	BeforeEach(func() {
+		dir, err := os.MkdirTemp("", "config")
+		Expect(err).ToNot(HaveOccurred())
+
+		file = filepath.Join(dir, "config.yaml")
+		Expect(os.WriteFile(file, []byte("log:\n  level: info\n"), 0600)).To(Succeed())
+		os.Setenv("CONFIG_FILE", file)
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("CONFIG_FILE")
+		os.Unsetenv("LOG_LEVEL")
+		os.RemoveAll(filepath.Dir(file))
+	})
+
+	It("reads values from the config file", func() {
+		Expect(Get().GetString("log.level")).To(Equal("info"))
+	})
+
+	It("prefers environment variables over the config file", func() {
+		os.Setenv("LOG_LEVEL", "warn")
+		Expect(Get().GetString("log.level")).To(Equal("warn"))
+	})
+
+	It("passes the new configuration to reload hooks", func() {
+		var reloaded string
+		OnReload(func(cfg *viper.Viper) {
+			reloaded = cfg.GetString("log.level")
+		})
+
+		Expect(os.WriteFile(file, []byte("log:\n  level: error\n"), 0600)).To(Succeed())
+		reload(file, zap.NewNop().Sugar())
+
+		Expect(reloaded).To(Equal("error"))
+	})
+
+	It("ignores an invalid config file", func() {
+		reloaded := false
+		OnReload(func(cfg *viper.Viper) {
+			reloaded = true
+		})
+
+		Expect(os.WriteFile(file, []byte("log: [\n"), 0600)).To(Succeed())
+		reload(file, zap.NewNop().Sugar())
+
+		Expect(reloaded).To(BeFalse())
+	})
+})
@@ -14,6 +14,7 @@ const (
 	HeaderCorrelationId     = "x-rh-insights-playbook-dispatcher-correlation-id"
 	HeaderIdentity          = "x-rh-identity"
 	HeaderRequestType       = "service"
+	HeaderDebugCapture      = "x-rh-playbook-dispatcher-debug-capture"
 
 	HeaderCloudConnectorClientID = "x-rh-cloud-connector-client-id"
 	HeaderCloudConnectorAccount  = "x-rh-cloud-connector-account"
@@ -4,11 +4,15 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"playbook-dispatcher/internal/common/config"
 	"playbook-dispatcher/internal/common/utils"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/spf13/viper"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -16,6 +20,53 @@ import (
 )
 
 func Connect(ctx context.Context, cfg *viper.Viper) (*gorm.DB, *sql.DB) {
+	log := utils.GetLogFromContext(ctx)
+	log.Infow("Connecting to database", "host", cfg.GetString("db.host"), "sslmode", cfg.GetString("db.sslmode"))
+
+	connConfig := parseConfig(cfg)
+	credentials := watchCredentials(cfg)
+
+	pool := stdlib.OpenDB(*connConfig, stdlib.OptionBeforeConnect(func(ctx context.Context, connConfig *pgx.ConnConfig) error {
+		connConfig.User, connConfig.Password = credentials()
+		return nil
+	}))
+
+	utils.DieOnError(utils.WaitForDependency(ctx, cfg, "postgres", func() error {
+		ctx, cancel := context.WithTimeout(ctx, cfg.GetDuration("health.check.timeout")*time.Second)
+		defer cancel()
+		return pool.PingContext(ctx)
+	}))
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: pool}), &gorm.Config{
+		Logger: &zapAdapter{
+			log: log.Named("gorm"),
+		},
+	})
+
+	utils.DieOnError(err)
+
+	sql, err := db.DB()
+	utils.DieOnError(err)
+
+	sql.SetMaxIdleConns(cfg.GetInt("db.max.idle.connections"))
+	sql.SetMaxOpenConns(cfg.GetInt("db.max.open.connections"))
+
+	return db, sql
+}
+
+// Connector returns a function that opens a dedicated connection outside of the pool, e.g. to LISTEN for notifications
+func Connector(cfg *viper.Viper) func(ctx context.Context) (*pgx.Conn, error) {
+	connConfig := parseConfig(cfg)
+	credentials := watchCredentials(cfg)
+
+	return func(ctx context.Context) (*pgx.Conn, error) {
+		current := connConfig.Copy()
+		current.User, current.Password = credentials()
+		return pgx.ConnectConfig(ctx, current)
+	}
+}
+
+func parseConfig(cfg *viper.Viper) *pgx.ConnConfig {
 	dsn := fmt.Sprintf(
 		"host=%s port=%d dbname=%s user=%s password=%s sslmode=%s",
 		cfg.GetString("db.host"),
@@ -34,24 +85,24 @@ func Connect(ctx context.Context, cfg *viper.Viper) (*gorm.DB, *sql.DB) {
 		dsn += fmt.Sprintf(" sslrootcert=%s", cfg.GetString("db.ca"))
 	}
 
-	log := utils.GetLogFromContext(ctx)
-	log.Infow("Connecting to database", "host", cfg.GetString("db.host"), "sslmode", cfg.GetString("db.sslmode"))
-
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: &zapAdapter{
-			log: log.Named("gorm"),
-		},
-	})
-
+	connConfig, err := pgx.ParseConfig(dsn)
 	utils.DieOnError(err)
 
-	sql, err := db.DB()
-	utils.DieOnError(err)
+	return connConfig
+}
 
-	sql.SetMaxIdleConns(cfg.GetInt("db.max.idle.connections"))
-	sql.SetMaxOpenConns(cfg.GetInt("db.max.open.connections"))
+// credentials rotated by the secret manager are used for new connections
+func watchCredentials(cfg *viper.Viper) func() (user, password string) {
+	var credentials atomic.Value
+	credentials.Store([2]string{cfg.GetString("db.username"), cfg.GetString("db.password")})
+	config.OnReload(func(cfg *viper.Viper) {
+		credentials.Store([2]string{cfg.GetString("db.username"), cfg.GetString("db.password")})
+	})
 
-	return db, sql
+	return func() (string, string) {
+		current := credentials.Load().([2]string)
+		return current[0], current[1]
+	}
 }
 
 type zapAdapter struct {
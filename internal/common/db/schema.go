@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// SchemaVersion is the database schema version (the number of the latest migration) the code is written against.
+// It needs to be bumped together with every new migration.
+const SchemaVersion = 49
+
+// MinSchemaVersion is the oldest schema version the code still works with.
+// Raise it once the code starts relying on the changes made by a migration.
+const MinSchemaVersion = 49
+
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_.*\.up\.sql$`)
+
+// SchemaStatus describes the schema version of the database compared to the one the code expects
+type SchemaStatus struct {
+	Version  uint
+	Dirty    bool
+	Expected uint
+	Min      uint
+	Max      uint
+}
+
+// Check returns an error if the code cannot safely serve traffic against the database schema
+func (this SchemaStatus) Check() error {
+	switch {
+	case this.Dirty:
+		return fmt.Errorf("database schema version %d is dirty (a migration failed)", this.Version)
+	case this.Version < this.Min:
+		return fmt.Errorf("database schema version %d is older than the minimum version %d required by the code", this.Version, this.Min)
+	case this.Version > this.Max:
+		return fmt.Errorf("database schema version %d is newer than the maximum version %d supported by the code", this.Version, this.Max)
+	}
+
+	return nil
+}
+
+// GetSchemaStatus reads the current schema version from the table maintained by the migrate command.
+// Migrations applied by a newer release are tolerated up to schema.ahead.tolerance versions, which allows
+// additive migrations to be rolled out before the code that uses them.
+func GetSchemaStatus(ctx context.Context, cfg *viper.Viper, sql *sql.DB) (status SchemaStatus, err error) {
+	status.Expected = SchemaVersion
+	status.Min = MinSchemaVersion
+	status.Max = SchemaVersion + cfg.GetUint("schema.ahead.tolerance")
+
+	err = sql.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&status.Version, &status.Dirty)
+	return
+}
+
+// CheckSchema returns a function that fails if the database schema is not compatible with the code
+func CheckSchema(cfg *viper.Viper, sql *sql.DB) func() error {
+	return func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.GetDuration("schema.check.timeout")*time.Second)
+		defer cancel()
+
+		status, err := GetSchemaStatus(ctx, cfg, sql)
+		if err != nil {
+			return fmt.Errorf("error reading the database schema version: %w", err)
+		}
+
+		return status.Check()
+	}
+}
+
+// LatestMigration returns the number of the latest migration found in the given directory
+func LatestMigration(dir string) (uint, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+
+	versions := []int{}
+	for _, entry := range entries {
+		if match := migrationFilePattern.FindStringSubmatch(entry.Name()); match != nil {
+			version, err := strconv.Atoi(match[1])
+			if err != nil {
+				return 0, err
+			}
+
+			versions = append(versions, version)
+		}
+	}
+
+	if len(versions) == 0 {
+		return 0, fmt.Errorf("no migrations found in %s", dir)
+	}
+
+	sort.Ints(versions)
+	return uint(versions[len(versions)-1]), nil
+}
@@ -0,0 +1,35 @@
+package db
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schema", func() {
+	It("matches the latest migration", func() {
+		latest, err := LatestMigration("../../../migrations")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(latest).To(BeEquivalentTo(SchemaVersion))
+		Expect(MinSchemaVersion).To(BeNumerically("<=", SchemaVersion))
+	})
+
+	DescribeTable("compatibility",
+		func(version uint, dirty, compatible bool) {
+			err := SchemaStatus{Version: version, Dirty: dirty, Expected: 10, Min: 9, Max: 11}.Check()
+
+			if compatible {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+
+		Entry("expected version", uint(10), false, true),
+		Entry("minimum version", uint(9), false, true),
+		Entry("tolerated newer version", uint(11), false, true),
+		Entry("older version", uint(8), false, false),
+		Entry("newer version", uint(12), false, false),
+		Entry("dirty", uint(10), true, false),
+	)
+})
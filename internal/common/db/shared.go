@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"playbook-dispatcher/internal/common/utils"
+	"sync"
+
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+var shared struct {
+	lock sync.Mutex
+	refs int
+	db   *gorm.DB
+	sql  *sql.DB
+}
+
+// Shared returns a connection pool shared by all modules running within the process.
+// The pool is closed once every module that acquired it has called the returned release function.
+// The database connection and schema version are registered as dependencies of the given probes.
+func Shared(ctx context.Context, cfg *viper.Viper, ready, live *utils.ProbeHandler) (*gorm.DB, func()) {
+	shared.lock.Lock()
+	defer shared.lock.Unlock()
+
+	if shared.refs == 0 {
+		shared.db, shared.sql = Connect(ctx, cfg)
+
+		utils.DieOnError(ready.RegisterDependency("postgres", true, shared.sql.Ping))
+		utils.DieOnError(ready.RegisterDependency("schema", cfg.GetBool("schema.check.enabled"), CheckSchema(cfg, shared.sql)))
+		live.Register(shared.sql.Ping)
+	}
+
+	shared.refs++
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			shared.lock.Lock()
+			defer shared.lock.Unlock()
+
+			shared.refs--
+			if shared.refs == 0 {
+				shared.sql.Close()
+				shared.db, shared.sql = nil, nil
+			}
+		})
+	}
+
+	return shared.db, release
+}
@@ -0,0 +1,130 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/spf13/viper"
+)
+
+// keyManager wraps and unwraps data keys
+type keyManager interface {
+	decrypt(ctx context.Context, encrypted []byte) ([]byte, error)
+	generate(ctx context.Context) (encrypted []byte, err error)
+}
+
+func newKeyManager(cfg *viper.Viper) (keyManager, error) {
+	if cfg.GetString("label.encryption.kms.impl") == "impl" {
+		awsSession, err := session.NewSession(aws.NewConfig().WithRegion(cfg.GetString("label.encryption.kms.region")))
+		if err != nil {
+			return nil, err
+		}
+
+		return &awsKeyManager{
+			client: kms.New(awsSession),
+			keyId:  cfg.GetString("label.encryption.kms.key.id"),
+		}, nil
+	}
+
+	return newLocalKeyManager(cfg.GetString("label.encryption.local.key"))
+}
+
+// GenerateDataKey creates a new data key and returns it in the (encrypted) form expected in label.encryption.data.keys
+func GenerateDataKey(ctx context.Context, cfg *viper.Viper) (string, error) {
+	kms, err := newKeyManager(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	encrypted, err := kms.generate(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// uses a key managed by AWS KMS, data keys never leave KMS unencrypted except for being returned to this process
+type awsKeyManager struct {
+	client *kms.KMS
+	keyId  string
+}
+
+func (this *awsKeyManager) decrypt(ctx context.Context, encrypted []byte) ([]byte, error) {
+	output, err := this.client.DecryptWithContext(ctx, &kms.DecryptInput{
+		CiphertextBlob: encrypted,
+		KeyId:          aws.String(this.keyId),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Plaintext, nil
+}
+
+func (this *awsKeyManager) generate(ctx context.Context) ([]byte, error) {
+	output, err := this.client.GenerateDataKeyWithContext(ctx, &kms.GenerateDataKeyInput{
+		KeyId:   aws.String(this.keyId),
+		KeySpec: aws.String(kms.DataKeySpecAes256),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.CiphertextBlob, nil
+}
+
+// wraps data keys with a locally configured key, meant for development and ephemeral environments
+type localKeyManager struct {
+	aead cipher.AEAD
+}
+
+func newLocalKeyManager(encodedKey string) (keyManager, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil || len(key) != 32 {
+		return nil, fmt.Errorf("label.encryption.local.key needs to be a base64-encoded 256-bit key")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &localKeyManager{aead: aead}, nil
+}
+
+func (this *localKeyManager) decrypt(ctx context.Context, encrypted []byte) ([]byte, error) {
+	if len(encrypted) < this.aead.NonceSize() {
+		return nil, fmt.Errorf("encrypted data key too short")
+	}
+
+	nonce, ciphertext := encrypted[:this.aead.NonceSize()], encrypted[this.aead.NonceSize():]
+	return this.aead.Open(nil, nonce, ciphertext, nil)
+}
+
+func (this *localKeyManager) generate(ctx context.Context) ([]byte, error) {
+	key := make([]byte, 32)
+	nonce := make([]byte, this.aead.NonceSize())
+
+	for _, buffer := range [][]byte{key, nonce} {
+		if _, err := rand.Read(buffer); err != nil {
+			return nil, err
+		}
+	}
+
+	return this.aead.Seal(nonce, nonce, key, nil), nil
+}
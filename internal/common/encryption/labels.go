@@ -0,0 +1,228 @@
+// Package encryption protects the values of sensitive run labels at rest.
+//
+// Label values are encrypted with AES-256-GCM using a data key. Data keys are themselves only stored encrypted by a
+// KMS-managed key (envelope encryption) and are decrypted by KMS once on startup.
+// Encryption is deterministic (the nonce is derived from the label key and value) so that runs can still be filtered
+// by the value of an encrypted label. The flip side is that equal values result in equal ciphertexts.
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const valuePrefix = "enc:v1:"
+
+type dataKey struct {
+	id    string
+	aead  cipher.AEAD
+	nonce []byte
+}
+
+// LabelCipher encrypts and decrypts the values of the label keys listed in label.encryption.keys.
+// New values are encrypted using the first of label.encryption.data.keys. The other data keys are only used to
+// decrypt values (and to filter by them), which allows the data key to be rotated.
+type LabelCipher struct {
+	keys     map[string]bool
+	dataKeys []dataKey
+}
+
+// NewLabelCipher decrypts the configured data keys. If no label keys are configured the cipher passes labels through.
+func NewLabelCipher(ctx context.Context, cfg *viper.Viper) (*LabelCipher, error) {
+	result := &LabelCipher{keys: map[string]bool{}}
+
+	for _, key := range strings.Split(cfg.GetString("label.encryption.keys"), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			result.keys[key] = true
+		}
+	}
+
+	if len(result.keys) == 0 {
+		return result, nil
+	}
+
+	kms, err := newKeyManager(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, encoded := range strings.Split(cfg.GetString("label.encryption.data.keys"), ",") {
+		if encoded = strings.TrimSpace(encoded); encoded == "" {
+			continue
+		}
+
+		encrypted, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data key encoding: %w", err)
+		}
+
+		plaintext, err := kms.decrypt(ctx, encrypted)
+		if err != nil {
+			return nil, fmt.Errorf("error decrypting data key: %w", err)
+		}
+
+		key, err := newDataKey(encrypted, plaintext)
+		if err != nil {
+			return nil, err
+		}
+
+		result.dataKeys = append(result.dataKeys, key)
+	}
+
+	if len(result.dataKeys) == 0 {
+		return nil, fmt.Errorf("label.encryption.data.keys is required when label.encryption.keys is set")
+	}
+
+	return result, nil
+}
+
+func newDataKey(encrypted, plaintext []byte) (dataKey, error) {
+	if len(plaintext) != 32 {
+		return dataKey{}, fmt.Errorf("expected a 256-bit data key, got %d bits", len(plaintext)*8)
+	}
+
+	block, err := aes.NewCipher(derive(plaintext, "label-encryption"))
+	if err != nil {
+		return dataKey{}, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return dataKey{}, err
+	}
+
+	// identifies the data key a value was encrypted with without revealing anything about the key
+	id := sha256.Sum256(encrypted)
+
+	return dataKey{
+		id:    hex.EncodeToString(id[:4]),
+		aead:  aead,
+		nonce: derive(plaintext, "label-nonce"),
+	}, nil
+}
+
+func derive(key []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+func (this *LabelCipher) Enabled() bool {
+	return len(this.keys) > 0
+}
+
+func (this *LabelCipher) IsEncrypted(key string) bool {
+	return this.keys[key]
+}
+
+// Encrypt returns a copy of the labels with the values of sensitive keys encrypted
+func (this *LabelCipher) Encrypt(labels map[string]string) map[string]string {
+	if !this.Enabled() || labels == nil {
+		return labels
+	}
+
+	result := make(map[string]string, len(labels))
+	for key, value := range labels {
+		if this.IsEncrypted(key) {
+			value = this.dataKeys[0].encrypt(key, value)
+		}
+
+		result[key] = value
+	}
+
+	return result
+}
+
+// Decrypt returns a copy of the labels with encrypted values decrypted.
+// Values that cannot be decrypted (e.g. because the data key has been removed) are returned as stored, along with an error.
+func (this *LabelCipher) Decrypt(labels map[string]string) (map[string]string, error) {
+	if !this.Enabled() || labels == nil {
+		return labels, nil
+	}
+
+	var err error
+	result := make(map[string]string, len(labels))
+
+	for key, value := range labels {
+		if strings.HasPrefix(value, valuePrefix) {
+			if decrypted, decryptErr := this.decrypt(key, value); decryptErr == nil {
+				value = decrypted
+			} else {
+				err = fmt.Errorf("error decrypting label %s: %w", key, decryptErr)
+			}
+		}
+
+		result[key] = value
+	}
+
+	return result, err
+}
+
+// FilterValues returns the stored representations of a label value, one per data key, to be matched when filtering
+func (this *LabelCipher) FilterValues(key, value string) []string {
+	if !this.IsEncrypted(key) {
+		return []string{value}
+	}
+
+	result := make([]string, len(this.dataKeys))
+	for i, dataKey := range this.dataKeys {
+		result[i] = dataKey.encrypt(key, value)
+	}
+
+	return result
+}
+
+func (this *LabelCipher) decrypt(key, value string) (string, error) {
+	id, encoded, found := strings.Cut(strings.TrimPrefix(value, valuePrefix), ":")
+	if !found {
+		return "", fmt.Errorf("invalid format")
+	}
+
+	for _, dataKey := range this.dataKeys {
+		if dataKey.id == id {
+			return dataKey.decrypt(key, encoded)
+		}
+	}
+
+	return "", fmt.Errorf("unknown data key %s", id)
+}
+
+func (this dataKey) encrypt(key, value string) string {
+	mac := hmac.New(sha256.New, this.nonce)
+	mac.Write([]byte(key))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	nonce := mac.Sum(nil)[:this.aead.NonceSize():this.aead.NonceSize()]
+
+	// the label key is authenticated so that a value cannot be moved to another key
+	sealed := this.aead.Seal(nonce, nonce, []byte(value), []byte(key))
+	return valuePrefix + this.id + ":" + base64.RawURLEncoding.EncodeToString(sealed)
+}
+
+func (this dataKey) decrypt(key, encoded string) (string, error) {
+	sealed, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+
+	if len(sealed) < this.aead.NonceSize() {
+		return "", fmt.Errorf("ciphertext too short")
+	}
+
+	nonce, ciphertext := sealed[:this.aead.NonceSize()], sealed[this.aead.NonceSize():]
+	plaintext, err := this.aead.Open(nil, nonce, ciphertext, []byte(key))
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
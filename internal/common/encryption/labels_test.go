@@ -0,0 +1,121 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+var _ = Describe("Label encryption", func() {
+	var cfg *viper.Viper
+
+	generateDataKey := func() string {
+		key, err := GenerateDataKey(context.Background(), cfg)
+		Expect(err).ToNot(HaveOccurred())
+		return key
+	}
+
+	newCipher := func() *LabelCipher {
+		labelCipher, err := NewLabelCipher(context.Background(), cfg)
+		Expect(err).ToNot(HaveOccurred())
+		return labelCipher
+	}
+
+	BeforeEach(func() {
+		localKey := make([]byte, 32)
+		_, err := rand.Read(localKey)
+		Expect(err).ToNot(HaveOccurred())
+
+		cfg = viper.New()
+		cfg.Set("label.encryption.kms.impl", "mock")
+		cfg.Set("label.encryption.local.key", base64.StdEncoding.EncodeToString(localKey))
+		cfg.Set("label.encryption.keys", "customer, account")
+		cfg.Set("label.encryption.data.keys", generateDataKey())
+	})
+
+	It("passes labels through when no keys are configured", func() {
+		cfg.Set("label.encryption.keys", "")
+		labelCipher := newCipher()
+
+		labels := map[string]string{"customer": "acme"}
+		Expect(labelCipher.Encrypt(labels)).To(Equal(labels))
+		Expect(labelCipher.FilterValues("customer", "acme")).To(Equal([]string{"acme"}))
+	})
+
+	It("encrypts the values of configured keys only", func() {
+		encrypted := newCipher().Encrypt(map[string]string{"customer": "acme", "service": "remediations"})
+
+		Expect(encrypted["service"]).To(Equal("remediations"))
+		Expect(encrypted["customer"]).To(HavePrefix("enc:v1:"))
+		Expect(encrypted["customer"]).ToNot(ContainSubstring("acme"))
+	})
+
+	It("decrypts encrypted values", func() {
+		labelCipher := newCipher()
+		labels := map[string]string{"customer": "acme", "account": "", "service": "remediations"}
+
+		decrypted, err := labelCipher.Decrypt(labelCipher.Encrypt(labels))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted).To(Equal(labels))
+	})
+
+	It("encrypts deterministically so that values can be filtered", func() {
+		labelCipher := newCipher()
+		encrypted := labelCipher.Encrypt(map[string]string{"customer": "acme"})
+
+		Expect(labelCipher.FilterValues("customer", "acme")).To(Equal([]string{encrypted["customer"]}))
+		Expect(labelCipher.FilterValues("service", "remediations")).To(Equal([]string{"remediations"}))
+	})
+
+	It("binds a value to its key", func() {
+		labelCipher := newCipher()
+		encrypted := labelCipher.Encrypt(map[string]string{"customer": "acme"})
+
+		_, err := labelCipher.Decrypt(map[string]string{"account": encrypted["customer"]})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("keeps decrypting values after the data key is rotated", func() {
+		encrypted := newCipher().Encrypt(map[string]string{"customer": "acme"})
+
+		cfg.Set("label.encryption.data.keys", generateDataKey()+","+cfg.GetString("label.encryption.data.keys"))
+		rotated := newCipher()
+
+		decrypted, err := rotated.Decrypt(encrypted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted["customer"]).To(Equal("acme"))
+
+		Expect(rotated.Encrypt(map[string]string{"customer": "acme"})["customer"]).ToNot(Equal(encrypted["customer"]))
+		Expect(rotated.FilterValues("customer", "acme")).To(HaveLen(2))
+		Expect(rotated.FilterValues("customer", "acme")).To(ContainElement(encrypted["customer"]))
+	})
+
+	It("returns values encrypted by an unknown data key as stored", func() {
+		encrypted := newCipher().Encrypt(map[string]string{"customer": "acme"})
+
+		cfg.Set("label.encryption.data.keys", generateDataKey())
+		decrypted, err := newCipher().Decrypt(encrypted)
+		Expect(err).To(HaveOccurred())
+		Expect(decrypted).To(Equal(encrypted))
+	})
+
+	It("requires a data key", func() {
+		cfg.Set("label.encryption.data.keys", "")
+		_, err := NewLabelCipher(context.Background(), cfg)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects a data key wrapped by another key", func() {
+		dataKey := generateDataKey()
+		cfg.Set("label.encryption.local.key", base64.StdEncoding.EncodeToString([]byte(strings.Repeat("x", 32))))
+		cfg.Set("label.encryption.data.keys", dataKey)
+
+		_, err := NewLabelCipher(context.Background(), cfg)
+		Expect(err).To(HaveOccurred())
+	})
+})
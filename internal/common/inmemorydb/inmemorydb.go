@@ -0,0 +1,28 @@
+package inmemorydb
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+)
+
+// Connect creates a client of the in-memory database configured by inmemorydb.*
+func Connect(cfg *viper.Viper) (*redis.Client, error) {
+	if !cfg.GetBool("inmemorydb.enabled") {
+		return nil, fmt.Errorf("in-memory database not enabled (inmemorydb.enabled)")
+	}
+
+	options := &redis.Options{
+		Addr:     fmt.Sprintf("%s:%d", cfg.GetString("inmemorydb.host"), cfg.GetInt("inmemorydb.port")),
+		Username: cfg.GetString("inmemorydb.username"),
+		Password: cfg.GetString("inmemorydb.password"),
+	}
+
+	if cfg.GetBool("inmemorydb.tls") {
+		options.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	return redis.NewClient(options), nil
+}
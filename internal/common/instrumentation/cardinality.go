@@ -0,0 +1,56 @@
+package instrumentation
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// LabelOverflow replaces label values seen after the limit of distinct values is reached
+const LabelOverflow = "other"
+
+// DefaultLabelLimit is the number of distinct values a guarded label may take
+const DefaultLabelLimit = 50
+
+var labelOverflowTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "metric_label_overflow_total",
+	Help: "The total number of observations whose label value was replaced with \"" + LabelOverflow + "\" due to the label cardinality limit",
+}, []string{"metric", "label"})
+
+// LabelGuard limits the number of distinct values of a label derived from request data.
+// The first values seen are passed through unchanged, any further ones are bucketed as LabelOverflow.
+type LabelGuard struct {
+	metric string
+	label  string
+	limit  int
+
+	lock sync.Mutex
+	seen map[string]struct{}
+}
+
+func NewLabelGuard(metric, label string, limit int) *LabelGuard {
+	return &LabelGuard{
+		metric: metric,
+		label:  label,
+		limit:  limit,
+		seen:   map[string]struct{}{},
+	}
+}
+
+func (this *LabelGuard) Value(value string) string {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	if _, ok := this.seen[value]; ok {
+		return value
+	}
+
+	if len(this.seen) < this.limit {
+		this.seen[value] = struct{}{}
+		return value
+	}
+
+	labelOverflowTotal.WithLabelValues(this.metric, this.label).Inc()
+	return LabelOverflow
+}
@@ -0,0 +1,30 @@
+package instrumentation
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Label guard", func() {
+	It("passes values through up to the limit", func() {
+		guard := NewLabelGuard("test_metric", "service", 2)
+		Expect(guard.Value("a")).To(Equal("a"))
+		Expect(guard.Value("b")).To(Equal("b"))
+	})
+
+	It("buckets values beyond the limit", func() {
+		guard := NewLabelGuard("test_metric", "service", 2)
+		guard.Value("a")
+		guard.Value("b")
+		Expect(guard.Value("c")).To(Equal(LabelOverflow))
+		Expect(guard.Value("d")).To(Equal(LabelOverflow))
+	})
+
+	It("keeps passing through values seen before the limit was reached", func() {
+		guard := NewLabelGuard("test_metric", "service", 2)
+		guard.Value("a")
+		guard.Value("b")
+		guard.Value("c")
+		Expect(guard.Value("a")).To(Equal("a"))
+	})
+})
@@ -29,6 +29,24 @@ var outboundHTTPDurationHistogram = promauto.NewHistogramVec(prometheus.Histogra
 	},
 }, []string{"service"})
 
+// buckets for durations within the lifecycle of a playbook run (from seconds up to the maximum run timeout)
+var RunLifecycleBuckets = []float64{
+	1,
+	5,
+	15,
+	30,
+	60, // 1m
+	120,
+	300,
+	600,
+	1800,
+	3600, // 1h
+	7200,
+	21600,
+	86400, // 1d
+	604800,
+}
+
 func OutboundHTTPDurationTimerFactory(service string) func() *prometheus.Timer {
 	return func() *prometheus.Timer {
 		return prometheus.NewTimer(outboundHTTPDurationHistogram.WithLabelValues(service))
@@ -41,14 +41,29 @@ func NewProducer(config *viper.Viper) (*kafka.Producer, error) {
 }
 
 func NewConsumer(ctx context.Context, config *viper.Viper, topic string) (*kafka.Consumer, error) {
+	return NewConsumerFromServers(ctx, config, config.GetString("kafka.bootstrap.servers"), topic)
+}
+
+// NewConsumerFromServers creates a consumer of a cluster other than kafka.bootstrap.servers (e.g. while migrating to a new cluster)
+func NewConsumerFromServers(ctx context.Context, config *viper.Viper, servers string, topic string) (*kafka.Consumer, error) {
+	return newConsumer(ctx, config, servers, config.GetString("kafka.group.id"), topic)
+}
 
+// NewConsumerWithGroup creates a consumer that is a member of a consumer group other than kafka.group.id
+func NewConsumerWithGroup(ctx context.Context, config *viper.Viper, group string, topic string) (*kafka.Consumer, error) {
+	return newConsumer(ctx, config, config.GetString("kafka.bootstrap.servers"), group, topic)
+}
+
+func newConsumer(ctx context.Context, config *viper.Viper, servers string, group string, topic string) (*kafka.Consumer, error) {
 	kafkaConfigMap := &kafka.ConfigMap{
-		"bootstrap.servers":        config.GetString("kafka.bootstrap.servers"),
-		"group.id":                 config.GetString("kafka.group.id"),
+		"bootstrap.servers":        servers,
+		"group.id":                 group,
 		"auto.offset.reset":        config.GetString("kafka.auto.offset.reset"),
 		"auto.commit.interval.ms":  config.GetInt("kafka.auto.commit.interval.ms"),
 		"go.logs.channel.enable":   true,
 		"allow.auto.create.topics": true,
+		// offsets are stored by the event loop once a message has been handled so that in-flight messages are not committed
+		"enable.auto.offset.store": false,
 	}
 
 	if config.Get("kafka.sasl.username") != nil {
@@ -64,6 +79,15 @@ func NewConsumer(ctx context.Context, config *viper.Viper, topic string) (*kafka
 		return nil, err
 	}
 
+	err = utils.WaitForDependency(ctx, config, "kafka", func() error {
+		return Ping(config.GetInt("kafka.timeout"), consumer)
+	})
+
+	if err != nil {
+		consumer.Close()
+		return nil, err
+	}
+
 	err = consumer.SubscribeTopics([]string{topic}, nil)
 
 	if err != nil {
@@ -116,18 +140,28 @@ func NewConsumerEventLoop(
 			}
 
 			if messagePredicate != nil && !messagePredicate(msg) {
+				storeOffset(ctx, consumer, msg)
 				continue
 			}
 
 			if validationPredicate != nil && !validationPredicate(msg) {
+				storeOffset(ctx, consumer, msg)
 				continue
 			}
 
 			handler(ctx, msg)
+			storeOffset(ctx, consumer, msg)
 		}
 	}
 }
 
+// stored offsets are committed periodically and when the consumer is closed
+func storeOffset(ctx context.Context, consumer *kafka.Consumer, msg *kafka.Message) {
+	if _, err := consumer.StoreMessage(msg); err != nil {
+		utils.GetLogFromContext(ctx).Errorw("Error storing offset", "error", err, "partition", msg.TopicPartition.Partition, "offset", msg.TopicPartition.Offset.String())
+	}
+}
+
 func Produce(producer *kafka.Producer, topic string, value interface{}, key string, headers ...kafka.Header) error {
 	marshalledValue, err := json.Marshal(value)
 	if err != nil {
@@ -0,0 +1,163 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/spf13/viper"
+)
+
+// Endpoint identifies the position of a consumer group on a topic
+type Endpoint struct {
+	Servers string
+	Topic   string
+	Group   string
+}
+
+// MirrorResult describes the offsets committed by MirrorOffsets
+type MirrorResult struct {
+	// the time of the oldest message consumed last by the source group (minus the margin)
+	Position time.Time
+	Offsets  []kafka.TopicPartition
+}
+
+func newOffsetClient(config *viper.Viper, endpoint Endpoint) (*kafka.Consumer, error) {
+	kafkaConfigMap := &kafka.ConfigMap{
+		"bootstrap.servers":        endpoint.Servers,
+		"group.id":                 endpoint.Group,
+		"enable.auto.commit":       false,
+		"enable.auto.offset.store": false,
+	}
+
+	if config.Get("kafka.sasl.username") != nil {
+		_ = kafkaConfigMap.SetKey("sasl.username", config.GetString("kafka.sasl.username"))
+		_ = kafkaConfigMap.SetKey("sasl.password", config.GetString("kafka.sasl.password"))
+		_ = kafkaConfigMap.SetKey("sasl.mechanism", config.GetString("kafka.sasl.mechanism"))
+		_ = kafkaConfigMap.SetKey("security.protocol", config.GetString("kafka.sasl.protocol"))
+		_ = kafkaConfigMap.SetKey("ssl.ca.location", config.GetString("kafka.capath"))
+	}
+
+	return kafka.NewConsumer(kafkaConfigMap)
+}
+
+func partitions(client *kafka.Consumer, topic string, timeout int) ([]kafka.TopicPartition, error) {
+	metadata, err := client.GetMetadata(&topic, false, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	topicMetadata, ok := metadata.Topics[topic]
+	if !ok || topicMetadata.Error.Code() != kafka.ErrNoError || len(topicMetadata.Partitions) == 0 {
+		return nil, fmt.Errorf("topic %s not found", topic)
+	}
+
+	result := make([]kafka.TopicPartition, len(topicMetadata.Partitions))
+	for i, partition := range topicMetadata.Partitions {
+		result[i] = kafka.TopicPartition{Topic: &topic, Partition: partition.ID}
+	}
+
+	return result, nil
+}
+
+// MirrorOffsets commits offsets for the destination group on the destination topic that correspond to the position of the source group.
+// Offsets are translated using message timestamps: the destination starts at the oldest message the source group consumed last
+// on any of its partitions (minus the margin). Messages around the cutover are therefore consumed twice, which the response
+// consumer tolerates while deduplication is enabled.
+func MirrorOffsets(ctx context.Context, config *viper.Viper, source, destination Endpoint, margin time.Duration, dryRun bool) (result MirrorResult, err error) {
+	log := utils.GetLogFromContext(ctx)
+	timeout := config.GetInt("kafka.timeout")
+
+	sourceClient, err := newOffsetClient(config, source)
+	if err != nil {
+		return
+	}
+	defer sourceClient.Close()
+
+	sourcePartitions, err := partitions(sourceClient, source.Topic, timeout)
+	if err != nil {
+		return
+	}
+
+	committed, err := sourceClient.Committed(sourcePartitions, timeout)
+	if err != nil {
+		return
+	}
+
+	for _, partition := range committed {
+		if partition.Offset < 1 {
+			continue
+		}
+
+		// the committed offset points to the next message to consume, the one before is the last one consumed
+		last := kafka.TopicPartition{Topic: partition.Topic, Partition: partition.Partition, Offset: partition.Offset - 1}
+		if err = sourceClient.Assign([]kafka.TopicPartition{last}); err != nil {
+			return
+		}
+
+		msg, readErr := sourceClient.ReadMessage(time.Duration(timeout) * time.Millisecond)
+		if readErr != nil {
+			err = fmt.Errorf("error reading the last consumed message of partition %d: %w", partition.Partition, readErr)
+			return
+		}
+
+		log.Infow("Source position", "partition", partition.Partition, "offset", partition.Offset.String(), "timestamp", msg.Timestamp)
+
+		if result.Position.IsZero() || msg.Timestamp.Before(result.Position) {
+			result.Position = msg.Timestamp
+		}
+	}
+
+	if result.Position.IsZero() {
+		err = fmt.Errorf("group %s has no committed offsets on topic %s", source.Group, source.Topic)
+		return
+	}
+
+	result.Position = result.Position.Add(-margin)
+
+	destinationClient, err := newOffsetClient(config, destination)
+	if err != nil {
+		return
+	}
+	defer destinationClient.Close()
+
+	destinationPartitions, err := partitions(destinationClient, destination.Topic, timeout)
+	if err != nil {
+		return
+	}
+
+	for i := range destinationPartitions {
+		destinationPartitions[i].Offset = kafka.Offset(result.Position.UnixMilli())
+	}
+
+	offsets, err := destinationClient.OffsetsForTimes(destinationPartitions, timeout)
+	if err != nil {
+		return
+	}
+
+	for i, partition := range offsets {
+		// no message newer than the position
+		if partition.Offset == kafka.OffsetEnd {
+			_, high, watermarkErr := destinationClient.QueryWatermarkOffsets(*partition.Topic, partition.Partition, timeout)
+			if watermarkErr != nil {
+				err = watermarkErr
+				return
+			}
+
+			offsets[i].Offset = kafka.Offset(high)
+		}
+
+		log.Infow("Destination position", "partition", partition.Partition, "offset", offsets[i].Offset.String())
+	}
+
+	result.Offsets = offsets
+
+	if dryRun {
+		return
+	}
+
+	_, err = destinationClient.CommitOffsets(offsets)
+	return
+}
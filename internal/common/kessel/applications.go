@@ -0,0 +1,105 @@
+package kessel
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ApplicationPermissionFormat is the format of the Kessel permission to view the runs of an application (service)
+const ApplicationPermissionFormat = "playbook_dispatcher_%s_run_view"
+
+const (
+	// ApplicationSourceBuiltIn marks the applications of V2ApplicationPermissions
+	ApplicationSourceBuiltIn = "builtin"
+	// ApplicationSourceConfig marks the applications listed in services.known
+	ApplicationSourceConfig = "config"
+	// ApplicationSourceDatabase marks the applications discovered from the service of existing runs
+	ApplicationSourceDatabase = "database"
+)
+
+// application names end up in Kessel permission names, with dashes replaced by underscores
+var applicationNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// Application is an entry of the application registry
+type Application struct {
+	Name       string
+	Permission string
+	Source     string
+}
+
+// the effective applications, the built-in ones are always included
+var applications = struct {
+	lock    sync.RWMutex
+	entries map[string]Application
+}{
+	entries: builtInApplications(),
+}
+
+func builtInApplications() map[string]Application {
+	entries := make(map[string]Application, len(V2ApplicationPermissions))
+	for name, permission := range V2ApplicationPermissions {
+		entries[name] = Application{Name: name, Permission: permission, Source: ApplicationSourceBuiltIn}
+	}
+
+	return entries
+}
+
+// RegisterApplications adds applications to the registry so that onboarding a service does not need a code change.
+// Their permission is derived from the name (ApplicationPermissionFormat). Known applications are kept as they are.
+// Returns the names of the applications added; names that cannot be used in a permission name are rejected.
+func RegisterApplications(source string, names ...string) (added []string, err error) {
+	applications.lock.Lock()
+	defer applications.lock.Unlock()
+
+	for _, name := range names {
+		if !applicationNameRegex.MatchString(name) {
+			return added, fmt.Errorf("invalid application name: %q", name)
+		}
+
+		if _, ok := applications.entries[name]; ok {
+			continue
+		}
+
+		applications.entries[name] = Application{Name: name, Permission: fmt.Sprintf(ApplicationPermissionFormat, strings.ReplaceAll(name, "-", "_")), Source: source}
+		added = append(added, name)
+	}
+
+	return
+}
+
+// Applications returns the effective application registry sorted by name
+func Applications() []Application {
+	applications.lock.RLock()
+	defer applications.lock.RUnlock()
+
+	result := make([]Application, 0, len(applications.entries))
+	for _, entry := range applications.entries {
+		result = append(result, entry)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// ApplicationNames returns the sorted names of the registered applications
+func ApplicationNames() []string {
+	entries := Applications()
+
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Name
+	}
+
+	return names
+}
+
+// ApplicationPermission returns the Kessel permission of a registered application, "" if unknown
+func ApplicationPermission(name string) string {
+	applications.lock.RLock()
+	defer applications.lock.RUnlock()
+
+	return applications.entries[name].Permission
+}
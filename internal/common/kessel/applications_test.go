@@ -0,0 +1,77 @@
+package kessel
+
+import (
+	"context"
+	"testing"
+
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func resetApplications() {
+	applications.lock.Lock()
+	defer applications.lock.Unlock()
+
+	applications.entries = builtInApplications()
+}
+
+func TestApplications_BuiltIn(t *testing.T) {
+	assert.Equal(t, []string{"config_manager", "remediations", "tasks"}, ApplicationNames())
+	assert.Equal(t, PermissionTasksRunView, ApplicationPermission("tasks"))
+	assert.Equal(t, "", ApplicationPermission("unknown"))
+}
+
+func TestRegisterApplications(t *testing.T) {
+	defer resetApplications()
+
+	added, err := RegisterApplications(ApplicationSourceConfig, "malware-detection", "remediations", "vulnerability")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"malware-detection", "vulnerability"}, added)
+	assert.Equal(t, []string{"config_manager", "malware-detection", "remediations", "tasks", "vulnerability"}, ApplicationNames())
+	assert.Equal(t, "playbook_dispatcher_malware_detection_run_view", ApplicationPermission("malware-detection"))
+
+	// the built-in entry is kept
+	assert.Contains(t, Applications(), Application{Name: "remediations", Permission: PermissionRemediationsRunView, Source: ApplicationSourceBuiltIn})
+	assert.Contains(t, Applications(), Application{Name: "vulnerability", Permission: "playbook_dispatcher_vulnerability_run_view", Source: ApplicationSourceConfig})
+
+	// registering again adds nothing
+	added, err = RegisterApplications(ApplicationSourceDatabase, "vulnerability")
+	assert.NoError(t, err)
+	assert.Empty(t, added)
+}
+
+func TestRegisterApplications_InvalidName(t *testing.T) {
+	defer resetApplications()
+
+	_, err := RegisterApplications(ApplicationSourceConfig, "Bad Name")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid application name")
+	assert.Len(t, ApplicationNames(), len(V2ApplicationPermissions))
+}
+
+func TestCheckApplicationPermissions_RegisteredApplication(t *testing.T) {
+	defer resetApplications()
+
+	_, err := RegisterApplications(ApplicationSourceConfig, "vulnerability")
+	assert.NoError(t, err)
+
+	mockService := &mockKesselInventoryService{
+		checkFunc: func(ctx context.Context, in *kesselv2.CheckRequest, opts ...grpc.CallOption) (*kesselv2.CheckResponse, error) {
+			if in.Relation == "playbook_dispatcher_vulnerability_run_view" {
+				return &kesselv2.CheckResponse{Allowed: kesselv2.Allowed_ALLOWED_TRUE}, nil
+			}
+			return &kesselv2.CheckResponse{Allowed: kesselv2.Allowed_ALLOWED_FALSE}, nil
+		},
+	}
+	cleanup := setupMockClient(mockService)
+	defer cleanup()
+
+	allowed, err := CheckApplicationPermissions(servicePermissionContext(), "workspace-123", zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vulnerability"}, allowed)
+}
@@ -0,0 +1,64 @@
+package kessel
+
+import (
+	"context"
+	"time"
+
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"github.com/redhatinsights/platform-go-middlewares/v2/request_id"
+	"go.uber.org/zap"
+)
+
+// AuditLoggerName is the name of the logger audit records of authorization decisions are written to
+const AuditLoggerName = "kessel_audit"
+
+const (
+	decisionAllowed = "allowed"
+	decisionDenied  = "denied"
+	decisionError   = "error"
+)
+
+// auditDecision writes an audit record of a single authorization decision if kessel.audit.enabled is set
+func auditDecision(ctx context.Context, object *kesselv2.ResourceReference, relation string, subject *kesselv2.SubjectReference, allowed bool, err error, latency time.Duration, forUpdate bool) {
+	if globalManager == nil || globalManager.auditLog == nil {
+		return
+	}
+
+	decision := decisionDenied
+	if err != nil {
+		decision = decisionError
+	} else if allowed {
+		decision = decisionAllowed
+	}
+
+	fields := []any{
+		"decision", decision,
+		"subject_type", subject.GetResource().GetResourceType(),
+		"subject_id", subject.GetResource().GetResourceId(),
+		"relation", relation,
+		"resource_type", object.GetResourceType(),
+		"resource_id", object.GetResourceId(),
+		"resource_reporter", object.GetReporter().GetType(),
+		"latency_ms", latency.Milliseconds(),
+		"request_id", request_id.GetReqID(ctx),
+		"org_id", identity.GetIdentity(ctx).Identity.OrgID,
+		"for_update", forUpdate,
+	}
+
+	if err != nil {
+		fields = append(fields, "error", err)
+	}
+
+	globalManager.auditLog.Infow("Kessel authorization decision", fields...)
+}
+
+func auditItems(ctx context.Context, items []*kesselv2.CheckBulkRequestItem, results []checkResult) {
+	for i, result := range results {
+		auditDecision(ctx, items[i].Object, items[i].Relation, items[i].Subject, result.allowed, result.err, result.latency, false)
+	}
+}
+
+func newAuditLog(log *zap.SugaredLogger) *zap.SugaredLogger {
+	return log.Desugar().Named(AuditLoggerName).Sugar()
+}
@@ -0,0 +1,83 @@
+package kessel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/redhatinsights/platform-go-middlewares/v2/request_id"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func installAuditMock(t *testing.T, mock *MockClient) *observer.ObservedLogs {
+	t.Cleanup(mock.Install())
+
+	core, logs := observer.New(zap.InfoLevel)
+	globalManager.auditLog = newAuditLog(zap.New(core).Sugar())
+
+	return logs
+}
+
+func TestAuditDecision_Check(t *testing.T) {
+	logs := installAuditMock(t, NewMockKesselClient(true))
+
+	// the request ID is only set by the middleware
+	var ctx context.Context
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(servicePermissionContext())
+	req.Header.Set("x-rh-insights-request-id", "request-1")
+	request_id.ConfiguredRequestID("x-rh-insights-request-id")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	})).ServeHTTP(httptest.NewRecorder(), req)
+
+	allowed, err := CheckResourcePermission(ctx, ResourceTypeRun, "run-1", "cancel", zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, 1, logs.Len())
+
+	entry := logs.All()[0]
+	assert.Equal(t, AuditLoggerName, entry.LoggerName)
+	fields := entry.ContextMap()
+	assert.Equal(t, decisionAllowed, fields["decision"])
+	assert.Equal(t, "redhat/user-123", fields["subject_id"])
+	assert.Equal(t, "cancel", fields["relation"])
+	assert.Equal(t, ResourceTypeRun, fields["resource_type"])
+	assert.Equal(t, "run-1", fields["resource_id"])
+	assert.Equal(t, "request-1", fields["request_id"])
+	assert.Equal(t, "org-456", fields["org_id"])
+	assert.Contains(t, fields, "latency_ms")
+}
+
+func TestAuditDecision_Batch(t *testing.T) {
+	resetApplications()
+	logs := installAuditMock(t, NewMockKesselClientWithRules(
+		MockRule{Relation: ApplicationPermission("tasks"), Err: ErrMockUnavailable},
+		MockRule{Relation: ApplicationPermission("remediations"), Allowed: true},
+	))
+
+	_, err := CheckApplicationPermissions(servicePermissionContext(), "workspace-789", zap.NewNop().Sugar())
+
+	assert.Error(t, err)
+	assert.Equal(t, len(ApplicationNames()), logs.Len())
+
+	decisions := map[string]any{}
+	for _, entry := range logs.All() {
+		decisions[entry.ContextMap()["relation"].(string)] = entry.ContextMap()["decision"]
+	}
+
+	assert.Equal(t, decisionAllowed, decisions[ApplicationPermission("remediations")])
+	assert.Equal(t, decisionError, decisions[ApplicationPermission("tasks")])
+	assert.Equal(t, decisionDenied, decisions[ApplicationPermission("config_manager")])
+}
+
+func TestAuditDecision_Disabled(t *testing.T) {
+	t.Cleanup(NewMockKesselClient(true).Install())
+
+	// nothing to observe, the decision must not be logged to a nil logger
+	_, err := CheckResourcePermission(servicePermissionContext(), ResourceTypeRun, "run-1", "view", zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+}
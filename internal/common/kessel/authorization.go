@@ -7,8 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"sync"
+	"time"
 
 	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+	"github.com/project-kessel/inventory-client-go/common"
 	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -70,17 +74,44 @@ func buildKesselReferences(workspaceID, principalID string) (*kesselv2.ResourceR
 
 // getAuthCallOptions returns gRPC call options with authentication token if auth is enabled
 func getAuthCallOptions() ([]grpc.CallOption, error) {
-	var opts []grpc.CallOption
-	// Check tokenClient to determine if auth is enabled; the client.GetTokenCallOption()
-	// method internally manages token retrieval and caching
-	if globalManager != nil && globalManager.tokenClient != nil {
-		tokenOpts, err := globalManager.client.GetTokenCallOption()
+	if globalManager == nil {
+		return nil, nil
+	}
+
+	var token string
+	switch {
+	case globalManager.psk != "":
+		token = globalManager.psk
+	case globalManager.tokenClient != nil:
+		response, err := globalManager.tokenClient.GetToken()
 		if err != nil {
 			return nil, fmt.Errorf("OIDC token acquisition failed: %w", err)
 		}
-		opts = tokenOpts
+		token = response.AccessToken
+	default:
+		return nil, nil
+	}
+
+	if globalManager.insecure {
+		return []grpc.CallOption{common.WithInsecureBearerToken(token)}, nil
+	}
+
+	return []grpc.CallOption{common.WithBearerToken(token)}, nil
+}
+
+// guarded makes a single call to Kessel unless the circuit breaker is open
+func guarded(ctx context.Context, call func() error) error {
+	if err := globalManager.breaker.allow(); err != nil {
+		return err
 	}
-	return opts, nil
+
+	err := call()
+	if err != nil && errors.Is(ctx.Err(), context.Canceled) {
+		err = context.Canceled
+	}
+
+	globalManager.breaker.record(err)
+	return err
 }
 
 // checkPermissionInternal is the shared internal helper for permission checks
@@ -96,8 +127,11 @@ func checkPermissionInternal(
 	subject *kesselv2.SubjectReference,
 	opts []grpc.CallOption,
 	useCheckForUpdate bool,
-) (bool, error) {
-	var allowed bool
+) (allowed bool, err error) {
+	start := time.Now()
+	defer func() {
+		auditDecision(ctx, object, permission, subject, allowed, err, time.Since(start), useCheckForUpdate)
+	}()
 
 	if useCheckForUpdate {
 		request := &kesselv2.CheckForUpdateRequest{
@@ -119,7 +153,11 @@ func checkPermissionInternal(
 			"subject_reporter", subject.Resource.Reporter.Type,
 			"relation", permission)
 
-		response, err := globalManager.client.KesselInventoryService.CheckForUpdate(ctx, request, opts...)
+		var response *kesselv2.CheckForUpdateResponse
+		err := guarded(ctx, func() (err error) {
+			response, err = globalManager.client.KesselInventoryService.CheckForUpdate(ctx, request, opts...)
+			return
+		})
 		if err != nil {
 			return false, fmt.Errorf("Kessel check for update failed: %w", err)
 		}
@@ -132,9 +170,10 @@ func checkPermissionInternal(
 			"allowed", allowed)
 	} else {
 		request := &kesselv2.CheckRequest{
-			Object:   object,
-			Relation: permission,
-			Subject:  subject,
+			Object:      object,
+			Relation:    permission,
+			Subject:     subject,
+			Consistency: checkConsistency(ctx),
 		}
 
 		log.Debugw("Sending Kessel permission check request",
@@ -150,7 +189,11 @@ func checkPermissionInternal(
 			"subject_reporter", subject.Resource.Reporter.Type,
 			"relation", permission)
 
-		response, err := globalManager.client.KesselInventoryService.Check(ctx, request, opts...)
+		var response *kesselv2.CheckResponse
+		err := guarded(ctx, func() (err error) {
+			response, err = globalManager.client.KesselInventoryService.Check(ctx, request, opts...)
+			return
+		})
 		if err != nil {
 			return false, fmt.Errorf("Kessel check failed: %w", err)
 		}
@@ -238,6 +281,34 @@ func CheckPermissionForUpdate(ctx context.Context, workspaceID string, permissio
 	return checkPermissionInternal(ctx, workspaceID, permission, log, xrhid, principalID, object, subject, opts, true)
 }
 
+// CheckResourcePermission performs a Kessel authorization check for a user's permission on a resource of the given
+// type. Workspaces are reported by RBAC, any other resource (e.g. a run, see TupleWriter) by playbook-dispatcher.
+//
+// Parameters are the same as CheckPermission, with resourceID identifying the resource
+func CheckResourcePermission(ctx context.Context, resourceType, resourceID, permission string, log *zap.SugaredLogger) (bool, error) {
+	xrhid, principalID, err := validateClientAndIdentity(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	object, subject, err := buildKesselReferences(resourceID, principalID)
+	if err != nil {
+		return false, err
+	}
+
+	if resourceType != ResourceTypeWorkspace {
+		object.ResourceType = resourceType
+		object.Reporter.Type = ReporterTypePlaybookDispatcher
+	}
+
+	opts, err := getAuthCallOptions()
+	if err != nil {
+		return false, err
+	}
+
+	return checkPermissionInternal(ctx, resourceID, permission, log, xrhid, principalID, object, subject, opts, false)
+}
+
 // extractUserID extracts the user ID from the identity
 // Supports both User and ServiceAccount identity types (platform-go-middlewares v2)
 func extractUserID(xrhid identity.XRHID) (string, error) {
@@ -282,7 +353,7 @@ func GetWorkspaceID(ctx context.Context, orgID string, log *zap.SugaredLogger) (
 // CheckApplicationPermissions checks V2 Kessel permissions for multiple applications
 // and returns a list of application names that the user has access to.
 //
-// This function loops through the V2 application-specific permissions:
+// The V2 application-specific permissions are checked concurrently (see checkBatch):
 // - playbook-dispatcher:config_manager_run:read -> playbook_dispatcher_config_manager_run_view
 // - playbook-dispatcher:remediations_run:read -> playbook_dispatcher_remediations_run_view
 // - playbook-dispatcher:tasks_run:read -> playbook_dispatcher_tasks_run_view
@@ -295,11 +366,12 @@ func GetWorkspaceID(ctx context.Context, orgID string, log *zap.SugaredLogger) (
 // Returns:
 //   - allowedApps: List of application names the user has access to (e.g., ["remediations", "tasks"])
 //   - err: Non-nil error for structural failures (client not initialized, bad config, identity issues)
-//     Returns error immediately on first structural failure
+//     joining the failures of all applications whose check failed
 //     nil for successful checks (even if user has no permissions)
 //
 // Error Handling:
-//   - Structural failures (client == nil, auth config issues, bad identity): Returns error immediately
+//   - Structural failures (client == nil, auth config issues, bad identity): Returns error before any check is sent
+//   - Failed checks (network error, timeout): Returns error once all checks have completed
 //   - This allows callers to distinguish system failures from legitimate authorization denials
 //
 // Example usage:
@@ -334,22 +406,40 @@ func CheckApplicationPermissions(ctx context.Context, workspaceID string, log *z
 		return nil, fmt.Errorf("failed to get auth options: %w", err)
 	}
 
-	allowedApps := make([]string, 0, len(V2ApplicationPermissions))
+	appNames := ApplicationNames()
+	permissions := make([]string, len(appNames))
+	for i, appName := range appNames {
+		permissions[i] = ApplicationPermission(appName)
+	}
 
-	// Loop through each application and check its permission
-	// NOTE: We call checkPermissionInternal directly (instead of CheckPermission) to reuse
-	// the resolved identity, principal ID, and Kessel references across all permission checks.
-	// This avoids redundant identity extraction and reference building for each application,
-	// which is important when checking multiple permissions for the same user.
-	for appName, permission := range V2ApplicationPermissions {
-		allowed, err := checkPermissionInternal(ctx, workspaceID, permission, log, xrhid, principalID, object, subject, opts, false)
-		if err != nil {
+	// The resolved identity, principal ID, and Kessel references are shared by all permission checks
+	items := make([]*kesselv2.CheckBulkRequestItem, len(appNames))
+	for i := range appNames {
+		items[i] = &kesselv2.CheckBulkRequestItem{Object: object, Relation: permissions[i], Subject: subject}
+	}
+
+	log.Debugw("Sending Kessel application permission checks",
+		"workspace_id", workspaceID,
+		"principal_id", principalID,
+		"org_id", xrhid.Identity.OrgID,
+		"permissions", permissions)
+
+	results := checkItems(ctx, items, opts)
+
+	allowedApps := make([]string, 0, len(appNames))
+	var errs []error
+
+	for i, result := range results {
+		appName, permission := appNames[i], permissions[i]
+
+		if result.err != nil {
 			// Any error from checkPermissionInternal indicates a structural failure
-			// (network error, auth issues) - return immediately
-			return nil, fmt.Errorf("structural failure checking permission for %s: %w", appName, err)
+			// (network error, auth issues)
+			errs = append(errs, fmt.Errorf("structural failure checking permission for %s: %w", appName, result.err))
+			continue
 		}
 
-		if allowed {
+		if result.allowed {
 			allowedApps = append(allowedApps, appName)
 			log.Debugw("User has access to application",
 				"app", appName,
@@ -361,9 +451,140 @@ func CheckApplicationPermissions(ctx context.Context, workspaceID string, log *z
 		}
 	}
 
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
 	log.Infow("Application permission check complete",
 		"allowed_apps", allowedApps,
-		"total_checked", len(V2ApplicationPermissions))
+		"total_checked", len(appNames))
 
 	return allowedApps, nil
 }
+
+type checkResult struct {
+	allowed bool
+	err     error
+	latency time.Duration
+}
+
+// checkBatch runs the given number of permission checks using a pool of kessel.batch.concurrency workers so that the
+// latency of a batch does not grow with its size. Each check is limited to kessel.check.timeout. The results are
+// returned in the order of the checks.
+func checkBatch(ctx context.Context, size int, check func(ctx context.Context, i int) (bool, error)) []checkResult {
+	results := make([]checkResult, size)
+
+	concurrency, timeout := 1, time.Duration(0)
+	if globalManager != nil {
+		concurrency, timeout = max(globalManager.batchConcurrency, 1), globalManager.checkTimeout
+	}
+
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+
+	for range min(concurrency, size) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				checkCtx, cancel := ctx, context.CancelFunc(func() {})
+				if timeout > 0 {
+					checkCtx, cancel = context.WithTimeout(ctx, timeout)
+				}
+
+				start := time.Now()
+				results[i].allowed, results[i].err = check(checkCtx, i)
+				results[i].latency = time.Since(start)
+				cancel()
+			}
+		}()
+	}
+
+	for i := range size {
+		indexes <- i
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+// ErrListLimitReached is returned by ListResources along with the resources listed so far once kessel.list.max.results
+// resources were listed
+var ErrListLimitReached = errors.New("Kessel list limit reached")
+
+// ListResources lists the IDs of the resources of the given type the subject has the relation to.
+//
+// The resources are streamed in pages of kessel.list.page.size. Each page continues after the continuation token of the
+// last resource of the previous one until a page is incomplete or empty. At most kessel.list.max.results resources are
+// listed; the IDs listed so far are returned together with ErrListLimitReached if there are more.
+func ListResources(ctx context.Context, objectType *kesselv2.RepresentationType, relation string, subject *kesselv2.SubjectReference, opts []grpc.CallOption) ([]string, error) {
+	if globalManager == nil || globalManager.client == nil {
+		return nil, errors.New("Kessel client not initialized")
+	}
+
+	pageSize, maxResults := globalManager.listPageSize, globalManager.listMaxResults
+
+	ids := []string{}
+	var continuationToken *string
+
+	for {
+		request := &kesselv2.StreamedListObjectsRequest{
+			ObjectType:  objectType,
+			Relation:    relation,
+			Subject:     subject,
+			Consistency: checkConsistency(ctx),
+		}
+
+		if pageSize > 0 || continuationToken != nil {
+			request.Pagination = &kesselv2.RequestPagination{Limit: uint32(max(pageSize, 0)), ContinuationToken: continuationToken}
+		}
+
+		listed, limited := 0, false
+		var lastToken string
+
+		err := guarded(ctx, func() error {
+			// the stream is abandoned once the limit is reached
+			streamCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			stream, err := globalManager.client.KesselInventoryService.StreamedListObjects(streamCtx, request, opts...)
+			if err != nil {
+				return err
+			}
+
+			for {
+				response, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					return nil
+				} else if err != nil {
+					return err
+				}
+
+				if maxResults > 0 && len(ids) == maxResults {
+					limited = true
+					return nil
+				}
+
+				ids = append(ids, response.GetObject().GetResourceId())
+				lastToken = response.GetPagination().GetContinuationToken()
+				listed++
+			}
+		})
+
+		switch {
+		case err != nil:
+			return nil, fmt.Errorf("Kessel list failed: %w", err)
+		case limited:
+			return ids, ErrListLimitReached
+		case listed == 0 || (pageSize > 0 && listed < pageSize) || lastToken == "":
+			return ids, nil
+		case continuationToken != nil && *continuationToken == lastToken:
+			return nil, fmt.Errorf("Kessel returned the continuation token %s again", lastToken)
+		}
+
+		continuationToken = &lastToken
+	}
+}
@@ -3,7 +3,12 @@ package kessel
 import (
 	"context"
 	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
 	v1beta2 "github.com/project-kessel/inventory-client-go/v1beta2"
@@ -22,10 +27,18 @@ type mockKesselInventoryService struct {
 	lastCheckRequest       *kesselv2.CheckRequest
 	lastUpdateRequest      *kesselv2.CheckForUpdateRequest
 	checkFunc              func(ctx context.Context, in *kesselv2.CheckRequest, opts ...grpc.CallOption) (*kesselv2.CheckResponse, error)
+	// checks of a batch are sent concurrently
+	mu sync.Mutex
+	// IDs of the objects listed by StreamedListObjects, the continuation token of an object is its position
+	objects      []string
+	listError    error
+	listRequests []*kesselv2.StreamedListObjectsRequest
 }
 
 func (m *mockKesselInventoryService) Check(ctx context.Context, in *kesselv2.CheckRequest, opts ...grpc.CallOption) (*kesselv2.CheckResponse, error) {
+	m.mu.Lock()
 	m.lastCheckRequest = in
+	m.mu.Unlock()
 	if m.checkFunc != nil {
 		return m.checkFunc(ctx, in, opts...)
 	}
@@ -46,7 +59,44 @@ func (m *mockKesselInventoryService) DeleteResource(ctx context.Context, in *kes
 }
 
 func (m *mockKesselInventoryService) StreamedListObjects(ctx context.Context, in *kesselv2.StreamedListObjectsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[kesselv2.StreamedListObjectsResponse], error) {
-	return nil, errors.New("not implemented")
+	m.listRequests = append(m.listRequests, in)
+	if m.listError != nil {
+		return nil, m.listError
+	}
+
+	offset := 0
+	if token := in.GetPagination().GetContinuationToken(); token != "" {
+		offset, _ = strconv.Atoi(token)
+	}
+
+	stream := &listedObjectStream{}
+	for i := offset; i < len(m.objects); i++ {
+		if limit := int(in.GetPagination().GetLimit()); limit > 0 && len(stream.responses) == limit {
+			break
+		}
+
+		stream.responses = append(stream.responses, &kesselv2.StreamedListObjectsResponse{
+			Object:     &kesselv2.ResourceReference{ResourceId: m.objects[i]},
+			Pagination: &kesselv2.ResponsePagination{ContinuationToken: strconv.Itoa(i + 1)},
+		})
+	}
+
+	return stream, nil
+}
+
+type listedObjectStream struct {
+	grpc.ClientStream
+	responses []*kesselv2.StreamedListObjectsResponse
+}
+
+func (s *listedObjectStream) Recv() (*kesselv2.StreamedListObjectsResponse, error) {
+	if len(s.responses) == 0 {
+		return nil, io.EOF
+	}
+
+	response := s.responses[0]
+	s.responses = s.responses[1:]
+	return response, nil
 }
 
 func (m *mockKesselInventoryService) CheckSelf(ctx context.Context, in *kesselv2.CheckSelfRequest, opts ...grpc.CallOption) (*kesselv2.CheckSelfResponse, error) {
@@ -553,12 +603,12 @@ func TestCheckApplicationPermissions_Success(t *testing.T) {
 }
 
 func TestCheckApplicationPermissions_PartialAccess(t *testing.T) {
-	callCount := 0
+	var callCount atomic.Int32
 	mockService := &mockKesselInventoryService{}
 
 	// Set up response generator that allows only remediations
 	mockService.checkFunc = func(ctx context.Context, in *kesselv2.CheckRequest, opts ...grpc.CallOption) (*kesselv2.CheckResponse, error) {
-		callCount++
+		callCount.Add(1)
 
 		// Only allow remediations
 		if in.Relation == PermissionRemediationsRunView {
@@ -585,7 +635,94 @@ func TestCheckApplicationPermissions_PartialAccess(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, allowedApps, 1)
 	assert.Contains(t, allowedApps, "remediations")
-	assert.Equal(t, 3, callCount) // Should check all 3 applications
+	assert.Equal(t, int32(3), callCount.Load()) // Should check all 3 applications
+}
+
+func TestCheckApplicationPermissions_Concurrent(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	mockService := &mockKesselInventoryService{}
+
+	mockService.checkFunc = func(ctx context.Context, in *kesselv2.CheckRequest, opts ...grpc.CallOption) (*kesselv2.CheckResponse, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			previous := maxInFlight.Load()
+			if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		return &kesselv2.CheckResponse{Allowed: kesselv2.Allowed_ALLOWED_TRUE}, nil
+	}
+
+	cleanup := setupMockClient(mockService)
+	defer cleanup()
+	globalManager.batchConcurrency = 2
+
+	ctx := identity.WithIdentity(context.Background(), identity.XRHID{
+		Identity: identity.Identity{Type: "User", User: &identity.User{UserID: "user-123"}, OrgID: "org-456"},
+	})
+
+	allowedApps, err := CheckApplicationPermissions(ctx, "workspace-789", zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"config_manager", "remediations", "tasks"}, allowedApps)
+	assert.Equal(t, int32(2), maxInFlight.Load())
+}
+
+func TestCheckApplicationPermissions_Timeout(t *testing.T) {
+	mockService := &mockKesselInventoryService{}
+
+	// only the remediations check hangs
+	mockService.checkFunc = func(ctx context.Context, in *kesselv2.CheckRequest, opts ...grpc.CallOption) (*kesselv2.CheckResponse, error) {
+		if in.Relation == PermissionRemediationsRunView {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return &kesselv2.CheckResponse{Allowed: kesselv2.Allowed_ALLOWED_TRUE}, nil
+	}
+
+	cleanup := setupMockClient(mockService)
+	defer cleanup()
+	globalManager.batchConcurrency = 3
+	globalManager.checkTimeout = 10 * time.Millisecond
+
+	ctx := identity.WithIdentity(context.Background(), identity.XRHID{
+		Identity: identity.Identity{Type: "User", User: &identity.User{UserID: "user-123"}, OrgID: "org-456"},
+	})
+
+	allowedApps, err := CheckApplicationPermissions(ctx, "workspace-789", zap.NewNop().Sugar())
+
+	assert.Nil(t, allowedApps)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "structural failure checking permission for remediations")
+}
+
+func TestCheckApplicationPermissions_AggregatesErrors(t *testing.T) {
+	mockService := &mockKesselInventoryService{}
+
+	mockService.checkFunc = func(ctx context.Context, in *kesselv2.CheckRequest, opts ...grpc.CallOption) (*kesselv2.CheckResponse, error) {
+		if in.Relation == PermissionTasksRunView {
+			return &kesselv2.CheckResponse{Allowed: kesselv2.Allowed_ALLOWED_TRUE}, nil
+		}
+		return nil, errors.New("kessel unavailable")
+	}
+
+	cleanup := setupMockClient(mockService)
+	defer cleanup()
+
+	ctx := identity.WithIdentity(context.Background(), identity.XRHID{
+		Identity: identity.Identity{Type: "User", User: &identity.User{UserID: "user-123"}, OrgID: "org-456"},
+	})
+
+	allowedApps, err := CheckApplicationPermissions(ctx, "workspace-789", zap.NewNop().Sugar())
+
+	assert.Nil(t, allowedApps)
+	assert.Contains(t, err.Error(), "structural failure checking permission for config_manager")
+	assert.Contains(t, err.Error(), "structural failure checking permission for remediations")
+	assert.NotContains(t, err.Error(), "tasks")
 }
 
 func TestCheckApplicationPermissions_NoAccess(t *testing.T) {
@@ -711,3 +848,75 @@ func (m *mockRbacClientWithWorkspace) GetDefaultWorkspaceID(ctx context.Context,
 	m.lastOrgID = orgID
 	return m.workspaceID, m.err
 }
+
+func listResources(t *testing.T, mockService *mockKesselInventoryService, pageSize, maxResults int) ([]string, error) {
+	t.Helper()
+
+	defer setupMockClient(mockService)()
+	globalManager.listPageSize, globalManager.listMaxResults = pageSize, maxResults
+
+	subject := &kesselv2.SubjectReference{Resource: &kesselv2.ResourceReference{ResourceType: ResourceTypePrincipal, ResourceId: "redhat/user-123"}}
+	return ListResources(context.Background(), &kesselv2.RepresentationType{ResourceType: ResourceTypeWorkspace}, PermissionRunRead, subject, nil)
+}
+
+func TestListResources_Pages(t *testing.T) {
+	mockService := &mockKesselInventoryService{objects: []string{"ws-1", "ws-2", "ws-3", "ws-4", "ws-5"}}
+
+	ids, err := listResources(t, mockService, 2, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ws-1", "ws-2", "ws-3", "ws-4", "ws-5"}, ids)
+	assert.Len(t, mockService.listRequests, 3)
+	assert.Nil(t, mockService.listRequests[0].GetPagination().ContinuationToken)
+	assert.Equal(t, "2", mockService.listRequests[1].GetPagination().GetContinuationToken())
+	assert.Equal(t, "4", mockService.listRequests[2].GetPagination().GetContinuationToken())
+	assert.EqualValues(t, 2, mockService.listRequests[2].GetPagination().GetLimit())
+}
+
+func TestListResources_FullLastPage(t *testing.T) {
+	mockService := &mockKesselInventoryService{objects: []string{"ws-1", "ws-2"}}
+
+	ids, err := listResources(t, mockService, 2, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ws-1", "ws-2"}, ids)
+	// the empty page ends the listing
+	assert.Len(t, mockService.listRequests, 2)
+}
+
+func TestListResources_NoPageSize(t *testing.T) {
+	mockService := &mockKesselInventoryService{objects: []string{"ws-1", "ws-2"}}
+
+	ids, err := listResources(t, mockService, 0, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ws-1", "ws-2"}, ids)
+	assert.Nil(t, mockService.listRequests[0].Pagination)
+}
+
+func TestListResources_Limit(t *testing.T) {
+	mockService := &mockKesselInventoryService{objects: []string{"ws-1", "ws-2", "ws-3", "ws-4", "ws-5"}}
+
+	ids, err := listResources(t, mockService, 2, 3)
+
+	assert.ErrorIs(t, err, ErrListLimitReached)
+	assert.Equal(t, []string{"ws-1", "ws-2", "ws-3"}, ids)
+	assert.Len(t, mockService.listRequests, 2)
+}
+
+func TestListResources_Error(t *testing.T) {
+	mockService := &mockKesselInventoryService{listError: errors.New("connection refused")}
+
+	ids, err := listResources(t, mockService, 2, 0)
+
+	assert.ErrorContains(t, err, "connection refused")
+	assert.Nil(t, ids)
+}
+
+func TestListResources_ClientNotInitialized(t *testing.T) {
+	globalManager = nil
+
+	_, err := ListResources(context.Background(), &kesselv2.RepresentationType{ResourceType: ResourceTypeWorkspace}, PermissionRunRead, &kesselv2.SubjectReference{}, nil)
+
+	assert.ErrorContains(t, err, "Kessel client not initialized")
+}
@@ -0,0 +1,135 @@
+package kessel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// BatchModeAuto uses the bulk check RPC if Kessel supports it and parallel checks otherwise
+	BatchModeAuto = "auto"
+	// BatchModeBulk sends the checks of a batch in a single bulk check call
+	BatchModeBulk = "bulk"
+	// BatchModeParallel sends the checks of a batch as concurrent single checks (see checkBatch)
+	BatchModeParallel = "parallel"
+)
+
+// how long parallel checks are used in auto mode once Kessel turned out not to support bulk checks
+const bulkProbeInterval = 10 * time.Minute
+
+// Kessel does not support bulk checks until this time (unix nanoseconds), see BatchModeAuto
+var bulkUnsupportedUntil atomic.Int64
+
+func validateBatchMode(mode string) error {
+	switch mode {
+	case BatchModeAuto, BatchModeBulk, BatchModeParallel, "":
+		return nil
+	default:
+		return fmt.Errorf("unknown kessel.batch.mode: %q", mode)
+	}
+}
+
+func useBulk() bool {
+	switch globalManager.batchMode {
+	case BatchModeBulk:
+		return true
+	case BatchModeAuto:
+		return time.Now().UnixNano() >= bulkUnsupportedUntil.Load()
+	default:
+		return false
+	}
+}
+
+// checkItems runs the given checks in a single bulk check call or as parallel single checks depending on
+// kessel.batch.mode. In auto mode a bulk check Kessel does not implement is repeated as parallel single checks and bulk
+// checks are not attempted again for bulkProbeInterval. The results are returned in the order of the checks.
+func checkItems(ctx context.Context, items []*kesselv2.CheckBulkRequestItem, opts []grpc.CallOption) []checkResult {
+	results := sendItems(ctx, items, opts)
+	auditItems(ctx, items, results)
+	return results
+}
+
+func sendItems(ctx context.Context, items []*kesselv2.CheckBulkRequestItem, opts []grpc.CallOption) []checkResult {
+	if len(items) > 0 && useBulk() {
+		results, err := checkBulk(ctx, items, opts)
+		if status.Code(err) != codes.Unimplemented || globalManager.batchMode != BatchModeAuto {
+			return results
+		}
+
+		bulkUnsupportedUntil.Store(time.Now().Add(bulkProbeInterval).UnixNano())
+	}
+
+	return checkBatch(ctx, len(items), func(ctx context.Context, i int) (bool, error) {
+		request := &kesselv2.CheckRequest{
+			Object:      items[i].Object,
+			Relation:    items[i].Relation,
+			Subject:     items[i].Subject,
+			Consistency: checkConsistency(ctx),
+		}
+
+		var response *kesselv2.CheckResponse
+		err := guarded(ctx, func() (err error) {
+			response, err = globalManager.client.KesselInventoryService.Check(ctx, request, opts...)
+			return
+		})
+		if err != nil {
+			return false, err
+		}
+
+		return response.GetAllowed() == kesselv2.Allowed_ALLOWED_TRUE, nil
+	})
+}
+
+// checkBulk sends the checks in a single call. The error of the call is returned along with results failing with it.
+func checkBulk(ctx context.Context, items []*kesselv2.CheckBulkRequestItem, opts []grpc.CallOption) ([]checkResult, error) {
+	start := time.Now()
+
+	ctx, cancel := withCheckTimeout(ctx)
+	defer cancel()
+
+	request := &kesselv2.CheckBulkRequest{Items: items, Consistency: checkConsistency(ctx)}
+
+	var response *kesselv2.CheckBulkResponse
+	var unimplemented error
+	err := guarded(ctx, func() (err error) {
+		response, err = globalManager.client.KesselInventoryService.CheckBulk(ctx, request, opts...)
+		// a missing RPC does not mean that Kessel is failing
+		if status.Code(err) == codes.Unimplemented {
+			unimplemented, err = err, nil
+		}
+		return
+	})
+	if err == nil {
+		err = unimplemented
+	}
+	if err == nil && len(response.GetPairs()) != len(items) {
+		err = fmt.Errorf("bulk check returned %d results for %d checks", len(response.GetPairs()), len(items))
+	}
+
+	// the checks are decided together
+	latency := time.Since(start)
+
+	results := make([]checkResult, len(items))
+	for i := range results {
+		results[i].latency = latency
+
+		switch {
+		case err != nil:
+			results[i].err = err
+		case response.GetPairs()[i].GetError() != nil:
+			results[i].err = errors.New(response.GetPairs()[i].GetError().GetMessage())
+		default:
+			results[i].allowed = response.GetPairs()[i].GetItem().GetAllowed() == kesselv2.Allowed_ALLOWED_TRUE
+		}
+	}
+
+	return results, err
+}
@@ -0,0 +1,117 @@
+package kessel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func installBatchMock(t *testing.T, mode string, mock *MockClient) {
+	t.Cleanup(mock.Install())
+	globalManager.batchMode = mode
+
+	bulkUnsupportedUntil.Store(0)
+	t.Cleanup(func() { bulkUnsupportedUntil.Store(0) })
+}
+
+func bulkChecks(checks []MockCheck) (bulk int) {
+	for _, check := range checks {
+		if check.Bulk {
+			bulk++
+		}
+	}
+
+	return
+}
+
+func TestCheckApplicationPermissions_Bulk(t *testing.T) {
+	resetApplications()
+	mock := NewMockKesselClientWithRules(MockRule{Relation: ApplicationPermission("remediations"), Allowed: true})
+	installBatchMock(t, BatchModeBulk, mock)
+
+	allowed, err := CheckApplicationPermissions(servicePermissionContext(), "workspace-789", zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"remediations"}, allowed)
+	assert.Equal(t, len(ApplicationNames()), bulkChecks(mock.Checks()))
+}
+
+func TestCheckApplicationPermissions_Parallel(t *testing.T) {
+	resetApplications()
+	mock := NewMockKesselClientWithRules(MockRule{Relation: ApplicationPermission("remediations"), Allowed: true})
+	installBatchMock(t, BatchModeParallel, mock)
+
+	allowed, err := CheckApplicationPermissions(servicePermissionContext(), "workspace-789", zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"remediations"}, allowed)
+	assert.Len(t, mock.Checks(), len(ApplicationNames()))
+	assert.Zero(t, bulkChecks(mock.Checks()))
+}
+
+func TestCheckApplicationPermissions_AutoFallback(t *testing.T) {
+	resetApplications()
+	mock := NewMockKesselClientWithRules(MockRule{Relation: ApplicationPermission("remediations"), Allowed: true})
+	mock.NoBulk = true
+	installBatchMock(t, BatchModeAuto, mock)
+
+	allowed, err := CheckApplicationPermissions(servicePermissionContext(), "workspace-789", zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"remediations"}, allowed)
+	assert.Greater(t, bulkUnsupportedUntil.Load(), time.Now().UnixNano())
+	assert.False(t, globalManager.breaker.isOpen())
+
+	// bulk checks are supported once Kessel is upgraded and the probe interval is over
+	mock.NoBulk = false
+	mock.Reset()
+	bulkUnsupportedUntil.Store(time.Now().Add(-time.Second).UnixNano())
+
+	_, err = CheckApplicationPermissions(servicePermissionContext(), "workspace-789", zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(ApplicationNames()), bulkChecks(mock.Checks()))
+}
+
+func TestCheckApplicationPermissions_BulkUnsupported(t *testing.T) {
+	resetApplications()
+	mock := NewMockKesselClient(true)
+	mock.NoBulk = true
+	installBatchMock(t, BatchModeBulk, mock)
+
+	_, err := CheckApplicationPermissions(servicePermissionContext(), "workspace-789", zap.NewNop().Sugar())
+
+	assert.Error(t, err)
+	assert.Empty(t, mock.Checks())
+}
+
+func TestCheckBulk_ItemError(t *testing.T) {
+	mock := NewMockKesselClientWithRules(
+		MockRule{Relation: "edit", Err: ErrMockUnavailable},
+		MockRule{Allowed: true},
+	)
+	installBatchMock(t, BatchModeBulk, mock)
+
+	subject := &kesselv2.SubjectReference{Resource: &kesselv2.ResourceReference{ResourceType: ResourceTypePrincipal, ResourceId: "redhat/user-123"}}
+	object := &kesselv2.ResourceReference{ResourceType: ResourceTypeRun, ResourceId: "run-1"}
+
+	results := checkItems(context.Background(), []*kesselv2.CheckBulkRequestItem{
+		{Object: object, Relation: "view", Subject: subject},
+		{Object: object, Relation: "edit", Subject: subject},
+	}, nil)
+
+	assert.Len(t, results, 2)
+	assert.NoError(t, results[0].err)
+	assert.True(t, results[0].allowed)
+	assert.ErrorContains(t, results[1].err, ErrMockUnavailable.Error())
+}
+
+func TestValidateBatchMode(t *testing.T) {
+	assert.NoError(t, validateBatchMode(BatchModeAuto))
+	assert.NoError(t, validateBatchMode(""))
+	assert.Error(t, validateBatchMode("sequential"))
+}
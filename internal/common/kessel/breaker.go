@@ -0,0 +1,112 @@
+package kessel
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// ErrCircuitOpen is returned instead of calling Kessel while the circuit breaker is open
+var ErrCircuitOpen = errors.New("Kessel circuit breaker is open")
+
+var (
+	breakerOpen = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "kessel_circuit_breaker_open",
+		Help: "Whether calls to Kessel are currently rejected by the circuit breaker",
+	})
+
+	breakerRejectedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "kessel_circuit_breaker_rejected_total",
+		Help: "The total number of Kessel calls rejected by the open circuit breaker",
+	})
+)
+
+// breaker stops calling Kessel once threshold consecutive calls failed. After openInterval a single call is let
+// through: the circuit closes if it succeeds and stays open for another interval otherwise.
+// A nil breaker lets every call through.
+type breaker struct {
+	threshold    int
+	openInterval time.Duration
+	now          func() time.Time
+
+	lock     sync.Mutex
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// newBreaker returns nil (no circuit breaking) if threshold is not positive
+func newBreaker(threshold int, openInterval time.Duration) *breaker {
+	if threshold < 1 {
+		return nil
+	}
+
+	return &breaker{threshold: threshold, openInterval: openInterval, now: time.Now}
+}
+
+// allow returns ErrCircuitOpen if the call must not be made. Every allowed call must be followed by record.
+func (this *breaker) allow() error {
+	if this == nil {
+		return nil
+	}
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	if this.openedAt.IsZero() {
+		return nil
+	}
+
+	if this.probing || this.now().Before(this.openedAt.Add(this.openInterval)) {
+		breakerRejectedTotal.Inc()
+		return ErrCircuitOpen
+	}
+
+	this.probing = true
+	return nil
+}
+
+// isOpen tells whether calls are currently rejected, apart from the probe let through after openInterval
+func (this *breaker) isOpen() bool {
+	if this == nil {
+		return false
+	}
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	return !this.openedAt.IsZero()
+}
+
+// record updates the state with the outcome of an allowed call
+func (this *breaker) record(err error) {
+	if this == nil {
+		return
+	}
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		// the caller gave up, that says nothing about Kessel
+		this.probing = false
+	case err == nil:
+		this.failures = 0
+		this.openedAt = time.Time{}
+		this.probing = false
+		breakerOpen.Set(0)
+	default:
+		this.failures++
+
+		if this.probing || this.failures >= this.threshold {
+			this.openedAt = this.now()
+			this.probing = false
+			breakerOpen.Set(1)
+		}
+	}
+}
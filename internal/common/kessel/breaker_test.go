@@ -0,0 +1,138 @@
+package kessel
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func newTestBreaker(threshold int) (*breaker, *time.Time) {
+	now := time.Now()
+	b := newBreaker(threshold, 30*time.Second)
+	b.now = func() time.Time { return now }
+	return b, &now
+}
+
+func TestBreaker_Disabled(t *testing.T) {
+	b := newBreaker(0, 30*time.Second)
+
+	assert.Nil(t, b)
+	b.record(errors.New("unavailable"))
+	assert.NoError(t, b.allow())
+}
+
+func TestBreaker_OpensAfterThreshold(t *testing.T) {
+	b, _ := newTestBreaker(3)
+
+	for range 2 {
+		assert.NoError(t, b.allow())
+		b.record(errors.New("unavailable"))
+	}
+
+	// a success resets the count
+	assert.NoError(t, b.allow())
+	b.record(nil)
+
+	for range 3 {
+		assert.NoError(t, b.allow())
+		b.record(errors.New("unavailable"))
+	}
+
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+}
+
+func TestBreaker_HalfOpen(t *testing.T) {
+	b, now := newTestBreaker(1)
+
+	assert.NoError(t, b.allow())
+	b.record(errors.New("unavailable"))
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+
+	*now = now.Add(31 * time.Second)
+
+	// a single probe is let through
+	assert.NoError(t, b.allow())
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+
+	// the failed probe opens the circuit for another interval
+	b.record(errors.New("unavailable"))
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+
+	*now = now.Add(31 * time.Second)
+
+	assert.NoError(t, b.allow())
+	b.record(nil)
+
+	assert.NoError(t, b.allow())
+	assert.NoError(t, b.allow())
+}
+
+func TestBreaker_CanceledDoesNotCount(t *testing.T) {
+	b, now := newTestBreaker(1)
+
+	assert.NoError(t, b.allow())
+	b.record(context.Canceled)
+	assert.NoError(t, b.allow())
+
+	b.record(errors.New("unavailable"))
+	*now = now.Add(31 * time.Second)
+
+	// a canceled probe lets the next call probe again
+	assert.NoError(t, b.allow())
+	b.record(context.Canceled)
+	assert.NoError(t, b.allow())
+}
+
+func TestCheckApplicationPermissions_CircuitOpen(t *testing.T) {
+	var calls atomic.Int32
+	mockService := &mockKesselInventoryService{
+		checkFunc: func(ctx context.Context, in *kesselv2.CheckRequest, opts ...grpc.CallOption) (*kesselv2.CheckResponse, error) {
+			calls.Add(1)
+			return nil, errors.New("unavailable")
+		},
+	}
+	cleanup := setupMockClient(mockService)
+	defer cleanup()
+
+	globalManager.breaker = newBreaker(len(V2ApplicationPermissions), 30*time.Second)
+
+	ctx := identity.WithIdentity(context.Background(), identity.XRHID{
+		Identity: identity.Identity{Type: "User", User: &identity.User{UserID: "user-123"}, OrgID: "org-456"},
+	})
+	log := zap.NewNop().Sugar()
+
+	_, err := CheckApplicationPermissions(ctx, "workspace-123", log)
+	assert.Error(t, err)
+	assert.Equal(t, int32(len(V2ApplicationPermissions)), calls.Load())
+
+	// Kessel is not called anymore
+	_, err = CheckApplicationPermissions(ctx, "workspace-123", log)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(len(V2ApplicationPermissions)), calls.Load())
+}
+
+func TestListResources_CircuitOpen(t *testing.T) {
+	mockService := &mockKesselInventoryService{listError: errors.New("unavailable")}
+	defer setupMockClient(mockService)()
+	globalManager.breaker = newBreaker(1, 30*time.Second)
+
+	list := func() error {
+		subject := &kesselv2.SubjectReference{Resource: &kesselv2.ResourceReference{ResourceType: ResourceTypePrincipal, ResourceId: "redhat/user-123"}}
+		_, err := ListResources(context.Background(), &kesselv2.RepresentationType{ResourceType: ResourceTypeWorkspace}, PermissionRunRead, subject, nil)
+		return err
+	}
+
+	assert.ErrorContains(t, list(), "unavailable")
+
+	// Kessel is not called anymore
+	assert.ErrorIs(t, list(), ErrCircuitOpen)
+	assert.Len(t, mockService.listRequests, 1)
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
 	"github.com/project-kessel/inventory-client-go/common"
 	v1beta2 "github.com/project-kessel/inventory-client-go/v1beta2"
 	"github.com/spf13/viper"
@@ -20,15 +21,54 @@ type ClientManager struct {
 	client      *v1beta2.InventoryClient
 	tokenClient *common.TokenClient
 	rbacClient  RbacClient
+
+	// writes the relationships of runs, nil unless kessel.tuples.enabled is set
+	tupleClient kesselv2.KesselTupleServiceClient
+	conn        *connPool
+
+	// rejects calls while Kessel is failing, nil if disabled
+	breaker *breaker
+
+	// pre-shared token sent instead of an OIDC one if kessel.auth.type=psk
+	psk string
+	// tokens are sent over a plaintext connection
+	insecure bool
+
+	// services are filtered by service permissions (kessel.service_permissions.enabled)
+	servicePermissions bool
+
+	// authorization decisions are recorded here (kessel.audit.enabled), nil if disabled
+	auditLog *zap.SugaredLogger
+
+	// runs are related to their owner, who may access them without org-wide access (kessel.run_ownership.enabled)
+	runOwnership bool
+
+	// consistency requirement of Check calls (kessel.check.consistency), the server default if not set
+	consistency string
+
+	// how the checks of a batch are sent (kessel.batch.mode), parallel if not set
+	batchMode string
+	// number of concurrent Check calls of a batch, 1 if not set
+	batchConcurrency int
+
+	// number of resources requested per page of ListResources (kessel.list.page.size), the server default if not set
+	listPageSize int
+	// number of resources ListResources returns at most (kessel.list.max.results), unlimited if not set
+	listMaxResults int
+
+	// limit of a single call to Kessel (kessel.check.timeout), none if not set
+	checkTimeout time.Duration
 }
 
 var globalManager *ClientManager
 
 // Initialize creates and configures the Kessel inventory client
 // This should be called during application startup
+// The client is also created when only shadow mode is configured so that Kessel can be consulted before it is enabled
 func Initialize(cfg *viper.Viper, log *zap.SugaredLogger) error {
 	kesselEnabled := cfg.GetBool("kessel.enabled")
-	if !kesselEnabled {
+	shadowMode := strings.TrimSpace(cfg.GetString("kessel.shadow_mode"))
+	if !kesselEnabled && shadowMode == "" {
 		log.Infow("Kessel client disabled",
 			"kessel_enabled", kesselEnabled)
 		return nil
@@ -36,14 +76,18 @@ func Initialize(cfg *viper.Viper, log *zap.SugaredLogger) error {
 
 	kesselURL := cfg.GetString("kessel.url")
 	if kesselURL == "" {
-		return fmt.Errorf("kessel.url is required when kessel.enabled=true")
+		return fmt.Errorf("kessel.url is required when kessel.enabled=true or kessel.shadow_mode is set")
 	}
 
 	log.Infow("Initializing Kessel client",
 		"kessel_enabled", kesselEnabled,
+		"kessel_shadow_mode", shadowMode,
 		"kessel_url", kesselURL,
 		"kessel_auth_enabled", cfg.GetBool("kessel.auth.enabled"),
+		"kessel_auth_type", cfg.GetString("kessel.auth.type"),
+		"kessel_mtls", cfg.GetString("kessel.tls.cert.file") != "",
 		"kessel_insecure", cfg.GetBool("kessel.insecure"),
+		"kessel_connection_pool_size", cfg.GetInt("kessel.connection.pool.size"),
 		"kessel_auth_mode", cfg.GetString("kessel.auth.mode"),
 		"kessel_principal_domain", cfg.GetString("kessel.principal.domain"),
 		"kessel_auth_oidc_issuer", cfg.GetString("kessel.auth.oidc.issuer"))
@@ -54,31 +98,61 @@ func Initialize(cfg *viper.Viper, log *zap.SugaredLogger) error {
 	}
 
 	// Add authentication if enabled
+	var psk string
 	if cfg.GetBool("kessel.auth.enabled") {
-		clientID := cfg.GetString("kessel.auth.client.id")
-		clientSecret := cfg.GetString("kessel.auth.client.secret")
-		oidcIssuer := cfg.GetString("kessel.auth.oidc.issuer")
+		switch authType := cfg.GetString("kessel.auth.type"); authType {
+		case AuthTypeOIDC, "":
+			clientID := cfg.GetString("kessel.auth.client.id")
+			clientSecret := cfg.GetString("kessel.auth.client.secret")
+			oidcIssuer := cfg.GetString("kessel.auth.oidc.issuer")
 
-		if clientID == "" || clientSecret == "" || oidcIssuer == "" {
-			return fmt.Errorf("kessel authentication requires client.id, client.secret, and oidc.issuer")
+			if clientID == "" || clientSecret == "" || oidcIssuer == "" {
+				return fmt.Errorf("kessel authentication requires client.id, client.secret, and oidc.issuer")
+			}
+
+			options = append(options, common.WithAuthEnabled(clientID, clientSecret, oidcIssuer))
+		case AuthTypePSK:
+			if psk = cfg.GetString("kessel.auth.psk"); psk == "" {
+				return fmt.Errorf("kessel psk authentication requires kessel.auth.psk")
+			}
+		default:
+			return fmt.Errorf("unknown kessel.auth.type %q", authType)
 		}
+	}
 
-		options = append(options, common.WithAuthEnabled(clientID, clientSecret, oidcIssuer))
+	consistency := cfg.GetString("kessel.check.consistency")
+	if err := validateConsistency(consistency); err != nil {
+		return err
+	}
+
+	batchMode := cfg.GetString("kessel.batch.mode")
+	if err := validateBatchMode(batchMode); err != nil {
+		return err
 	}
 
 	kesselConfig := common.NewConfig(options...)
 
-	var err error
-	client, err := v1beta2.New(kesselConfig)
+	creds, err := transportCredentials(cfg)
+	if err != nil {
+		return err
+	}
+
+	// the connections are set up here as the inventory client cannot present a client certificate
+	conn, err := newConnPool(dnsTarget(kesselURL), cfg.GetInt("kessel.connection.pool.size"), dialOptions(cfg, creds)...)
 	if err != nil {
 		return fmt.Errorf("failed to create Kessel client: %w", err)
 	}
 
+	client := &v1beta2.InventoryClient{KesselInventoryService: kesselv2.NewKesselInventoryServiceClient(conn)}
+
 	// Create token client for authentication if enabled
+	// Tokens are cached and requested again shortly before they expire
 	var tokenClient *common.TokenClient
-	if cfg.GetBool("kessel.auth.enabled") {
+	if kesselConfig.EnableOIDCAuth {
 		tokenClient = common.NewTokenClient(kesselConfig)
 		log.Info("Kessel authentication enabled")
+	} else if psk != "" {
+		log.Info("Kessel pre-shared key authentication enabled")
 	}
 
 	// Create RBAC client for workspace lookups
@@ -107,11 +181,37 @@ func Initialize(cfg *viper.Viper, log *zap.SugaredLogger) error {
 
 	rbacClient := NewRbacClient(rbacURL, tokenClient, rbacTimeout, rbacClientConfig, log)
 
+	var tupleClient kesselv2.KesselTupleServiceClient
+	if cfg.GetBool("kessel.tuples.enabled") {
+		tupleClient = kesselv2.NewKesselTupleServiceClient(conn)
+		log.Info("Kessel relationship tuples of runs enabled")
+	}
+
+	var auditLog *zap.SugaredLogger
+	if cfg.GetBool("kessel.audit.enabled") {
+		auditLog = newAuditLog(log)
+		log.Info("Kessel authorization decision audit enabled")
+	}
+
 	// Store all clients in manager
 	globalManager = &ClientManager{
-		client:      client,
-		tokenClient: tokenClient,
-		rbacClient:  rbacClient,
+		client:             client,
+		tokenClient:        tokenClient,
+		rbacClient:         rbacClient,
+		tupleClient:        tupleClient,
+		conn:               conn,
+		psk:                psk,
+		insecure:           cfg.GetBool("kessel.insecure"),
+		breaker:            newBreaker(cfg.GetInt("kessel.breaker.failure.threshold"), time.Duration(cfg.GetInt64("kessel.breaker.open.interval"))*time.Second),
+		servicePermissions: cfg.GetBool("kessel.service_permissions.enabled"),
+		auditLog:           auditLog,
+		runOwnership:       cfg.GetBool("kessel.run_ownership.enabled"),
+		consistency:        consistency,
+		batchMode:          batchMode,
+		batchConcurrency:   cfg.GetInt("kessel.batch.concurrency"),
+		listPageSize:       cfg.GetInt("kessel.list.page.size"),
+		listMaxResults:     cfg.GetInt("kessel.list.max.results"),
+		checkTimeout:       time.Duration(cfg.GetInt64("kessel.check.timeout")) * time.Second,
 	}
 
 	log.Info("Kessel client initialized successfully")
@@ -157,11 +257,14 @@ func Close() error {
 		return nil
 	}
 
-	// The inventory client doesn't have an explicit Close method
-	// but we can clear the references
+	var err error
+	if globalManager.conn != nil {
+		err = globalManager.conn.Close()
+	}
+
 	globalManager = nil
 
-	return nil
+	return err
 }
 
 // GetAuthMode returns the current Kessel authorization mode from configuration
@@ -35,6 +35,20 @@ func TestInitialize_MissingURL(t *testing.T) {
 	assert.Contains(t, err.Error(), "kessel.url is required")
 }
 
+func TestInitialize_ShadowMode(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("kessel.enabled", false)
+	cfg.Set("kessel.shadow_mode", "*")
+	cfg.Set("kessel.url", "")
+	log := zap.NewNop().Sugar()
+
+	err := Initialize(cfg, log)
+
+	// the client is set up (and therefore validated) even though Kessel does not enforce anything
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "kessel.url is required")
+}
+
 func TestInitialize_MissingAuthCredentials(t *testing.T) {
 	cfg := viper.New()
 	cfg.Set("kessel.enabled", true)
@@ -49,6 +63,82 @@ func TestInitialize_MissingAuthCredentials(t *testing.T) {
 	assert.Contains(t, err.Error(), "client.id")
 }
 
+func TestInitialize_PSK(t *testing.T) {
+	originalManager := globalManager
+	defer func() { globalManager = originalManager }()
+
+	cfg := viper.New()
+	cfg.Set("kessel.enabled", true)
+	cfg.Set("kessel.url", "localhost:9091")
+	cfg.Set("kessel.insecure", true)
+	cfg.Set("kessel.auth.enabled", true)
+	cfg.Set("kessel.auth.type", AuthTypePSK)
+	cfg.Set("kessel.auth.psk", "secret-token")
+	log := zap.NewNop().Sugar()
+
+	err := Initialize(cfg, log)
+	assert.NoError(t, err)
+	defer Close()
+
+	assert.True(t, IsEnabled())
+	assert.Nil(t, GetTokenClient())
+
+	opts, err := getAuthCallOptions()
+	assert.NoError(t, err)
+	assert.Len(t, opts, 1)
+}
+
+func TestInitialize_PSKMissing(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("kessel.enabled", true)
+	cfg.Set("kessel.url", "localhost:9091")
+	cfg.Set("kessel.auth.enabled", true)
+	cfg.Set("kessel.auth.type", AuthTypePSK)
+	log := zap.NewNop().Sugar()
+
+	err := Initialize(cfg, log)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "kessel.auth.psk")
+}
+
+func TestInitialize_UnknownAuthType(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("kessel.enabled", true)
+	cfg.Set("kessel.url", "localhost:9091")
+	cfg.Set("kessel.auth.enabled", true)
+	cfg.Set("kessel.auth.type", "basic")
+	log := zap.NewNop().Sugar()
+
+	err := Initialize(cfg, log)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown kessel.auth.type")
+}
+
+func TestInitialize_UnknownConsistency(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("kessel.enabled", true)
+	cfg.Set("kessel.url", "localhost:9091")
+	cfg.Set("kessel.check.consistency", "strong")
+	log := zap.NewNop().Sugar()
+
+	err := Initialize(cfg, log)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown kessel.check.consistency")
+}
+
+func TestGetAuthCallOptions_NoAuth(t *testing.T) {
+	cleanup := SetClientForTesting(&v1beta2.InventoryClient{}, nil, nil)
+	defer cleanup()
+
+	opts, err := getAuthCallOptions()
+
+	assert.NoError(t, err)
+	assert.Empty(t, opts)
+}
+
 func TestGetClient_NotInitialized(t *testing.T) {
 	globalManager = nil
 
@@ -0,0 +1,64 @@
+package kessel
+
+import (
+	"context"
+	"fmt"
+
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+)
+
+const (
+	// ConsistencyMinimizeLatency lets Kessel answer checks from the fastest snapshot available
+	ConsistencyMinimizeLatency = "minimize_latency"
+	// ConsistencyAtLeastAsFresh requires checks to see the writes of the consistency token of the request context, if
+	// any (see WithConsistencyToken), and minimizes latency otherwise
+	ConsistencyAtLeastAsFresh = "at_least_as_fresh"
+	// ConsistencyAtLeastAsAcknowledged requires checks to see every write acknowledged by Kessel
+	ConsistencyAtLeastAsAcknowledged = "at_least_as_acknowledged"
+)
+
+type consistencyTokenKeyType int
+
+const consistencyTokenKey consistencyTokenKeyType = iota
+
+// WithConsistencyToken returns a context in which checks are at least as fresh as the given consistency token of a
+// write (e.g. the one returned by TupleWriter.WriteRun) if kessel.check.consistency=at_least_as_fresh
+func WithConsistencyToken(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, consistencyTokenKey, token)
+}
+
+func validateConsistency(consistency string) error {
+	switch consistency {
+	case ConsistencyMinimizeLatency, ConsistencyAtLeastAsFresh, ConsistencyAtLeastAsAcknowledged, "":
+		return nil
+	default:
+		return fmt.Errorf("unknown kessel.check.consistency: %q", consistency)
+	}
+}
+
+// checkConsistency returns the consistency requirement of a check, nil for the server default
+func checkConsistency(ctx context.Context) *kesselv2.Consistency {
+	if globalManager == nil {
+		return nil
+	}
+
+	switch globalManager.consistency {
+	case ConsistencyMinimizeLatency:
+		return &kesselv2.Consistency{Requirement: &kesselv2.Consistency_MinimizeLatency{MinimizeLatency: true}}
+	case ConsistencyAtLeastAsFresh:
+		if token, ok := ctx.Value(consistencyTokenKey).(string); ok {
+			return &kesselv2.Consistency{Requirement: &kesselv2.Consistency_AtLeastAsFresh{AtLeastAsFresh: &kesselv2.ConsistencyToken{Token: token}}}
+		}
+
+		// nothing was written the check needs to see
+		return &kesselv2.Consistency{Requirement: &kesselv2.Consistency_MinimizeLatency{MinimizeLatency: true}}
+	case ConsistencyAtLeastAsAcknowledged:
+		return &kesselv2.Consistency{Requirement: &kesselv2.Consistency_AtLeastAsAcknowledged{AtLeastAsAcknowledged: true}}
+	default:
+		return nil
+	}
+}
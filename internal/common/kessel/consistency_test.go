@@ -0,0 +1,75 @@
+package kessel
+
+import (
+	"context"
+	"testing"
+
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func TestCheckConsistency(t *testing.T) {
+	defer SetClientForTesting(nil, nil, nil)()
+
+	ctx := context.Background()
+	withToken := WithConsistencyToken(ctx, "token-1")
+
+	globalManager.consistency = ""
+	assert.Nil(t, checkConsistency(withToken))
+
+	globalManager.consistency = ConsistencyMinimizeLatency
+	assert.True(t, checkConsistency(withToken).GetMinimizeLatency())
+
+	globalManager.consistency = ConsistencyAtLeastAsFresh
+	assert.Equal(t, "token-1", checkConsistency(withToken).GetAtLeastAsFresh().GetToken())
+	assert.True(t, checkConsistency(ctx).GetMinimizeLatency())
+
+	globalManager.consistency = ConsistencyAtLeastAsAcknowledged
+	assert.True(t, checkConsistency(ctx).GetAtLeastAsAcknowledged())
+}
+
+func TestWithConsistencyToken_Empty(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, ctx, WithConsistencyToken(ctx, ""))
+}
+
+func TestValidateConsistency(t *testing.T) {
+	assert.NoError(t, validateConsistency(ConsistencyAtLeastAsFresh))
+	assert.NoError(t, validateConsistency(""))
+	assert.Error(t, validateConsistency("strong"))
+}
+
+func TestCheckResourcePermission_AtLeastAsFresh(t *testing.T) {
+	var request *kesselv2.CheckRequest
+	mockService := &mockKesselInventoryService{
+		checkFunc: func(ctx context.Context, in *kesselv2.CheckRequest, opts ...grpc.CallOption) (*kesselv2.CheckResponse, error) {
+			request = in
+			return &kesselv2.CheckResponse{Allowed: kesselv2.Allowed_ALLOWED_TRUE}, nil
+		},
+	}
+	defer setupMockClient(mockService)()
+	globalManager.consistency = ConsistencyAtLeastAsFresh
+
+	ctx := WithConsistencyToken(servicePermissionContext(), "token-1")
+	allowed, err := CheckResourcePermission(ctx, ResourceTypeRun, "c1ec0a98-9b7d-4b6a-9d8d-7e1f4c7e2d1a", "view", zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, ResourceTypeRun, request.Object.ResourceType)
+	assert.Equal(t, ReporterTypePlaybookDispatcher, request.Object.Reporter.Type)
+	assert.Equal(t, "token-1", request.GetConsistency().GetAtLeastAsFresh().GetToken())
+}
+
+func TestListResources_AtLeastAsFresh(t *testing.T) {
+	mockService := &mockKesselInventoryService{objects: []string{"run-1"}}
+	defer setupMockClient(mockService)()
+	globalManager.consistency = ConsistencyAtLeastAsFresh
+
+	ctx := WithConsistencyToken(context.Background(), "token-1")
+	_, err := ListResources(ctx, &kesselv2.RepresentationType{ResourceType: ResourceTypeRun}, "view", &kesselv2.SubjectReference{}, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", mockService.listRequests[0].GetConsistency().GetAtLeastAsFresh().GetToken())
+}
@@ -0,0 +1,29 @@
+package kessel
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"playbook-dispatcher/internal/common/utils"
+)
+
+// HealthCheck returns a check of the connection to Kessel for the readiness probe. It fails unless a connection is
+// ready within timeout. Kessel is reported degraded (see utils.Degraded) while the circuit breaker is open,
+// as well as if the client could not be initialized, in which case authorization falls back to RBAC.
+func HealthCheck(timeout time.Duration) func() error {
+	return func() error {
+		if globalManager == nil || globalManager.conn == nil {
+			return utils.Degraded(errors.New("Kessel client not initialized"))
+		}
+
+		if globalManager.breaker.isOpen() {
+			return utils.Degraded(ErrCircuitOpen)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		return globalManager.conn.waitReady(ctx)
+	}
+}
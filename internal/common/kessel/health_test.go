@@ -0,0 +1,78 @@
+package kessel
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestHealthCheck_NotInitialized(t *testing.T) {
+	defer SetClientForTesting(nil, nil, nil)()
+
+	err := HealthCheck(time.Second)()
+
+	assert.ErrorIs(t, err, utils.ErrDegraded)
+}
+
+func TestHealthCheck_CircuitOpen(t *testing.T) {
+	defer SetClientForTesting(nil, nil, nil)()
+
+	conn, err := grpc.NewClient("localhost:1", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	globalManager.conn = &connPool{conns: []*grpc.ClientConn{conn}}
+	globalManager.breaker = newBreaker(1, 30*time.Second)
+	globalManager.breaker.record(errors.New("unavailable"))
+
+	err = HealthCheck(time.Second)()
+
+	assert.ErrorIs(t, err, utils.ErrDegraded)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+}
+
+func TestHealthCheck_Unreachable(t *testing.T) {
+	defer SetClientForTesting(nil, nil, nil)()
+
+	// nothing listens on the port once the listener is closed
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer conn.Close()
+	globalManager.conn = &connPool{conns: []*grpc.ClientConn{conn}}
+
+	err = HealthCheck(200 * time.Millisecond)()
+
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, utils.ErrDegraded)
+	assert.Contains(t, err.Error(), "Kessel connection not ready")
+}
+
+func TestHealthCheck_Ready(t *testing.T) {
+	defer SetClientForTesting(nil, nil, nil)()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	server := grpc.NewServer()
+	go server.Serve(listener) //nolint:errcheck
+	defer server.Stop()
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer conn.Close()
+	globalManager.conn = &connPool{conns: []*grpc.ClientConn{conn}}
+
+	assert.NoError(t, HealthCheck(5*time.Second)())
+}
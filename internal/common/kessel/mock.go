@@ -0,0 +1,286 @@
+package kessel
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+	"github.com/project-kessel/inventory-client-go/v1beta2"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrMockUnavailable can be used as the error of a rule to simulate an outage of Kessel
+var ErrMockUnavailable = errors.New("kessel unavailable")
+
+// MockRule decides the checks it matches. Patterns are matched with path.Match (e.g. "org-456/*"); an empty pattern
+// matches anything.
+type MockRule struct {
+	// ID of the subject, e.g. "redhat/user-123"
+	Subject      string
+	Relation     string
+	ResourceType string
+	ResourceID   string
+
+	Allowed bool
+	// returned instead of a decision if set
+	Err error
+	// delay before the decision, cut short if the context of the check is done
+	Latency time.Duration
+}
+
+func matches(pattern, value string) bool {
+	if pattern == "" {
+		return true
+	}
+
+	matched, err := path.Match(pattern, value)
+	return err == nil && matched
+}
+
+func (this MockRule) matches(check MockCheck) bool {
+	return matches(this.Subject, check.Subject) &&
+		matches(this.Relation, check.Relation) &&
+		matches(this.ResourceType, check.ResourceType) &&
+		matches(this.ResourceID, check.ResourceID)
+}
+
+// MockCheck is a check received by a MockClient
+type MockCheck struct {
+	Subject      string
+	Relation     string
+	ResourceType string
+	ResourceID   string
+	// reporter of the resource
+	Reporter string
+	// the consistency token the check requires, if any
+	ConsistencyToken string
+	ForUpdate        bool
+	// received as part of a bulk check
+	Bulk bool
+}
+
+// MockClient is a Kessel inventory service deciding checks from a rule table. The first matching rule decides a
+// check; checks no rule matches are denied.
+type MockClient struct {
+	kesselv2.KesselInventoryServiceClient
+
+	// the default workspace of every organization
+	WorkspaceID string
+	// installed as if kessel.run_ownership.enabled was set
+	RunOwnership bool
+	// the bulk check RPC is not implemented, as in older Kessel versions
+	NoBulk bool
+
+	lock   sync.Mutex
+	rules  []MockRule
+	checks []MockCheck
+	// IDs of the objects listed for a resource type, relation and subject
+	objects map[MockCheck][]string
+}
+
+// NewMockKesselClient returns a mock that allows or denies every check
+func NewMockKesselClient(allowed bool) *MockClient {
+	return NewMockKesselClientWithRules(MockRule{Allowed: allowed})
+}
+
+// NewMockKesselClientWithRules returns a mock deciding checks with the given rules
+func NewMockKesselClientWithRules(rules ...MockRule) *MockClient {
+	return &MockClient{WorkspaceID: "mock-workspace-id", rules: rules}
+}
+
+// AddRule adds a rule that takes precedence over the existing ones
+func (this *MockClient) AddRule(rule MockRule) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.rules = append([]MockRule{rule}, this.rules...)
+}
+
+// AddObjects makes the objects of the given type listed (see OwnedRuns) for the relation and subject
+func (this *MockClient) AddObjects(resourceType, relation, subject string, ids ...string) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	if this.objects == nil {
+		this.objects = map[MockCheck][]string{}
+	}
+
+	key := MockCheck{ResourceType: resourceType, Relation: relation, Subject: subject}
+	this.objects[key] = append(this.objects[key], ids...)
+}
+
+// Checks returns the checks received so far in the order they were received
+func (this *MockClient) Checks() []MockCheck {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	return append([]MockCheck{}, this.checks...)
+}
+
+// Reset forgets the checks received so far
+func (this *MockClient) Reset() {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.checks = nil
+}
+
+// Install makes the mock the Kessel client used by the authorization functions of this package.
+// The returned function restores the previous client.
+func (this *MockClient) Install() func() {
+	cleanup := SetClientForTesting(&v1beta2.InventoryClient{KesselInventoryService: this}, nil, this)
+	globalManager.runOwnership = this.RunOwnership
+	return cleanup
+}
+
+// GetDefaultWorkspaceID implements RbacClient
+func (this *MockClient) GetDefaultWorkspaceID(ctx context.Context, orgID string) (string, error) {
+	return this.WorkspaceID, nil
+}
+
+func (this *MockClient) decide(ctx context.Context, check MockCheck) (kesselv2.Allowed, error) {
+	this.lock.Lock()
+	this.checks = append(this.checks, check)
+
+	rule := MockRule{}
+	for _, candidate := range this.rules {
+		if candidate.matches(check) {
+			rule = candidate
+			break
+		}
+	}
+	this.lock.Unlock()
+
+	if rule.Latency > 0 {
+		select {
+		case <-time.After(rule.Latency):
+		case <-ctx.Done():
+			return kesselv2.Allowed_ALLOWED_UNSPECIFIED, ctx.Err()
+		}
+	}
+
+	if rule.Err != nil {
+		return kesselv2.Allowed_ALLOWED_UNSPECIFIED, rule.Err
+	}
+
+	if rule.Allowed {
+		return kesselv2.Allowed_ALLOWED_TRUE, nil
+	}
+
+	return kesselv2.Allowed_ALLOWED_FALSE, nil
+}
+
+func mockCheckOf(object *kesselv2.ResourceReference, relation string, subject *kesselv2.SubjectReference) MockCheck {
+	return MockCheck{
+		Subject:      subject.GetResource().GetResourceId(),
+		Relation:     relation,
+		ResourceType: object.GetResourceType(),
+		ResourceID:   object.GetResourceId(),
+		Reporter:     object.GetReporter().GetType(),
+	}
+}
+
+func (this *MockClient) Check(ctx context.Context, in *kesselv2.CheckRequest, opts ...grpc.CallOption) (*kesselv2.CheckResponse, error) {
+	check := mockCheckOf(in.GetObject(), in.GetRelation(), in.GetSubject())
+	check.ConsistencyToken = in.GetConsistency().GetAtLeastAsFresh().GetToken()
+
+	allowed, err := this.decide(ctx, check)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kesselv2.CheckResponse{Allowed: allowed}, nil
+}
+
+func (this *MockClient) CheckForUpdate(ctx context.Context, in *kesselv2.CheckForUpdateRequest, opts ...grpc.CallOption) (*kesselv2.CheckForUpdateResponse, error) {
+	check := mockCheckOf(in.GetObject(), in.GetRelation(), in.GetSubject())
+	check.ForUpdate = true
+
+	allowed, err := this.decide(ctx, check)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kesselv2.CheckForUpdateResponse{Allowed: allowed}, nil
+}
+
+func (this *MockClient) CheckBulk(ctx context.Context, in *kesselv2.CheckBulkRequest, opts ...grpc.CallOption) (*kesselv2.CheckBulkResponse, error) {
+	if this.NoBulk {
+		return nil, status.Error(codes.Unimplemented, "unknown method CheckBulk")
+	}
+
+	response := &kesselv2.CheckBulkResponse{}
+	for _, item := range in.GetItems() {
+		check := mockCheckOf(item.GetObject(), item.GetRelation(), item.GetSubject())
+		check.ConsistencyToken = in.GetConsistency().GetAtLeastAsFresh().GetToken()
+		check.Bulk = true
+
+		pair := &kesselv2.CheckBulkResponsePair{Request: item}
+		if allowed, err := this.decide(ctx, check); err != nil {
+			pair.Response = &kesselv2.CheckBulkResponsePair_Error{Error: &rpcstatus.Status{Code: int32(codes.Unavailable), Message: err.Error()}}
+		} else {
+			pair.Response = &kesselv2.CheckBulkResponsePair_Item{Item: &kesselv2.CheckBulkResponseItem{Allowed: allowed}}
+		}
+
+		response.Pairs = append(response.Pairs, pair)
+	}
+
+	return response, nil
+}
+
+type mockObjectStream struct {
+	grpc.ClientStream
+	objects []*kesselv2.StreamedListObjectsResponse
+}
+
+func (this *mockObjectStream) Recv() (*kesselv2.StreamedListObjectsResponse, error) {
+	if len(this.objects) == 0 {
+		return nil, io.EOF
+	}
+
+	object := this.objects[0]
+	this.objects = this.objects[1:]
+	return object, nil
+}
+
+func (this *MockClient) StreamedListObjects(ctx context.Context, in *kesselv2.StreamedListObjectsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[kesselv2.StreamedListObjectsResponse], error) {
+	key := MockCheck{
+		ResourceType: in.GetObjectType().GetResourceType(),
+		Relation:     in.GetRelation(),
+		Subject:      in.GetSubject().GetResource().GetResourceId(),
+	}
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	// the continuation token of an object is its position
+	ids, offset := this.objects[key], 0
+	if token := in.GetPagination().GetContinuationToken(); token != "" {
+		var err error
+		if offset, err = strconv.Atoi(token); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid continuation token %s", token)
+		}
+	}
+
+	stream := &mockObjectStream{}
+	for i := offset; i < len(ids); i++ {
+		if limit := int(in.GetPagination().GetLimit()); limit > 0 && len(stream.objects) == limit {
+			break
+		}
+
+		stream.objects = append(stream.objects, &kesselv2.StreamedListObjectsResponse{
+			Object:     &kesselv2.ResourceReference{ResourceType: key.ResourceType, ResourceId: ids[i]},
+			Pagination: &kesselv2.ResponsePagination{ContinuationToken: strconv.Itoa(i + 1)},
+		})
+	}
+
+	return stream, nil
+}
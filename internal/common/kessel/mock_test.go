@@ -0,0 +1,89 @@
+package kessel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestMockClient_Rules(t *testing.T) {
+	mock := NewMockKesselClientWithRules(
+		MockRule{Relation: "playbook_dispatcher_remediations_run_view", Allowed: true},
+		MockRule{Relation: "playbook_dispatcher_*_run_view", Subject: "redhat/user-123", Allowed: true},
+		MockRule{Relation: "playbook_dispatcher_*_run_view"},
+	)
+	defer mock.Install()()
+
+	ctx := servicePermissionContext()
+	log := zap.NewNop().Sugar()
+
+	allowed, err := CheckPermission(ctx, "workspace-789", "playbook_dispatcher_tasks_run_view", log)
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+
+	mock.AddRule(MockRule{Relation: "playbook_dispatcher_tasks_run_view", Allowed: false})
+
+	allowed, err = CheckPermission(ctx, "workspace-789", "playbook_dispatcher_tasks_run_view", log)
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+
+	checks := mock.Checks()
+	assert.Len(t, checks, 2)
+	assert.Equal(t, MockCheck{
+		Subject:      "redhat/user-123",
+		Relation:     "playbook_dispatcher_tasks_run_view",
+		ResourceType: ResourceTypeWorkspace,
+		ResourceID:   "workspace-789",
+		Reporter:     ReporterTypeRBAC,
+	}, checks[0])
+
+	mock.Reset()
+	assert.Empty(t, mock.Checks())
+}
+
+func TestMockClient_NoMatchingRule(t *testing.T) {
+	mock := NewMockKesselClientWithRules(MockRule{ResourceType: ResourceTypeRun, Allowed: true})
+	defer mock.Install()()
+
+	allowed, err := CheckPermission(servicePermissionContext(), "workspace-789", PermissionRunRead, zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+}
+
+func TestMockClient_Error(t *testing.T) {
+	mock := NewMockKesselClientWithRules(MockRule{Err: ErrMockUnavailable})
+	defer mock.Install()()
+
+	allowed, err := CheckPermission(servicePermissionContext(), "workspace-789", PermissionRunRead, zap.NewNop().Sugar())
+
+	assert.ErrorIs(t, err, ErrMockUnavailable)
+	assert.False(t, allowed)
+}
+
+func TestMockClient_Latency(t *testing.T) {
+	mock := NewMockKesselClientWithRules(MockRule{Allowed: true, Latency: time.Minute})
+	defer mock.Install()()
+
+	ctx, cancel := context.WithTimeout(servicePermissionContext(), 10*time.Millisecond)
+	defer cancel()
+
+	allowed, err := CheckPermission(ctx, "workspace-789", PermissionRunRead, zap.NewNop().Sugar())
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, allowed)
+}
+
+func TestMockClient_Workspace(t *testing.T) {
+	mock := NewMockKesselClient(true)
+	mock.WorkspaceID = "workspace-123"
+	defer mock.Install()()
+
+	workspaceID, err := GetWorkspaceID(context.Background(), "org-456", zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "workspace-123", workspaceID)
+}
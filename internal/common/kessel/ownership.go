@@ -0,0 +1,91 @@
+package kessel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"go.uber.org/zap"
+)
+
+const (
+	// ResourceTypeUser represents a user of an organization identified by username, the only identifier of the
+	// principal that dispatched a run
+	ResourceTypeUser = "user"
+
+	// RelationOwner relates a run to the user that dispatched it
+	RelationOwner = "owner"
+)
+
+// UserIDFormat is the format of user IDs (org ID, username)
+const UserIDFormat = "%s/%s"
+
+// RunOwnershipEnabled reports whether kessel.run_ownership.enabled is set, i.e. whether the owner relationships of runs
+// are written and users may access the runs they dispatched without org-wide access
+func RunOwnershipEnabled() bool {
+	return globalManager != nil && globalManager.runOwnership
+}
+
+// usernameOf returns the username of the requester, the principal runs are created on behalf of
+func usernameOf(xrhid identity.XRHID) (string, error) {
+	switch {
+	case xrhid.Identity.User != nil && xrhid.Identity.User.Username != "":
+		return xrhid.Identity.User.Username, nil
+	case xrhid.Identity.ServiceAccount != nil && xrhid.Identity.ServiceAccount.Username != "":
+		return xrhid.Identity.ServiceAccount.Username, nil
+	default:
+		return "", errors.New("username is empty")
+	}
+}
+
+// OwnedRuns returns the IDs of the runs the requester is the owner of, i.e. that were dispatched on their behalf
+func OwnedRuns(ctx context.Context, log *zap.SugaredLogger) ([]string, error) {
+	if !RunOwnershipEnabled() || globalManager.client == nil {
+		return nil, errors.New("Kessel run ownership not enabled")
+	}
+
+	xrhid := identity.GetIdentity(ctx)
+
+	username, err := usernameOf(xrhid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract username: %w", err)
+	}
+
+	opts, err := getAuthCallOptions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auth options: %w", err)
+	}
+
+	reporter := ReporterTypePlaybookDispatcher
+	subject := &kesselv2.SubjectReference{
+		Resource: &kesselv2.ResourceReference{
+			ResourceType: ResourceTypeUser,
+			ResourceId:   fmt.Sprintf(UserIDFormat, xrhid.Identity.OrgID, username),
+			Reporter:     &kesselv2.ReporterReference{Type: ReporterTypePlaybookDispatcher},
+		},
+	}
+
+	runIDs, err := ListResources(ctx, &kesselv2.RepresentationType{ResourceType: ResourceTypeRun, ReporterType: &reporter}, RelationOwner, subject, opts)
+	if errors.Is(err, ErrListLimitReached) {
+		// the user only gets access to the runs listed
+		log.Warnw("User owns more runs than are listed, access is limited to the first ones",
+			"org_id", xrhid.Identity.OrgID,
+			"username", username,
+			"runs", len(runIDs))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to list owned runs: %w", err)
+	}
+
+	log.Debugw("Kessel owned runs lookup complete",
+		"org_id", xrhid.Identity.OrgID,
+		"username", username,
+		"runs", len(runIDs))
+
+	return runIDs, nil
+}
+
+func ownerRelationship(run Run) *kesselv2.Relationship {
+	return runRelationship(run.ID, RelationOwner, NamespacePlaybookDispatcher, ResourceTypeUser, fmt.Sprintf(UserIDFormat, run.OrgID, run.Owner))
+}
@@ -0,0 +1,91 @@
+package kessel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func ownerContext() context.Context {
+	return identity.WithIdentity(context.Background(), identity.XRHID{
+		Identity: identity.Identity{Type: "User", User: &identity.User{UserID: "user-123", Username: "jdoe"}, OrgID: "org-456"},
+	})
+}
+
+func TestOwnedRuns_Disabled(t *testing.T) {
+	defer NewMockKesselClient(true).Install()()
+
+	assert.False(t, RunOwnershipEnabled())
+
+	_, err := OwnedRuns(ownerContext(), zap.NewNop().Sugar())
+	assert.Error(t, err)
+}
+
+func TestOwnedRuns(t *testing.T) {
+	mock := NewMockKesselClient(false)
+	mock.AddObjects(ResourceTypeRun, RelationOwner, "org-456/jdoe", "run-1", "run-2")
+	mock.AddObjects(ResourceTypeRun, RelationOwner, "org-456/other", "run-3")
+	mock.RunOwnership = true
+	defer mock.Install()()
+
+	runs, err := OwnedRuns(ownerContext(), zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"run-1", "run-2"}, runs)
+}
+
+func TestOwnedRuns_NoUsername(t *testing.T) {
+	mock := NewMockKesselClient(false)
+	mock.RunOwnership = true
+	defer mock.Install()()
+
+	_, err := OwnedRuns(servicePermissionContext(), zap.NewNop().Sugar())
+
+	assert.ErrorContains(t, err, "username")
+}
+
+func TestTupleWriter_WriteRunOwner(t *testing.T) {
+	mockService := &mockKesselTupleService{}
+	defer setupMockTupleClient(mockService)()
+	globalManager.runOwnership = true
+
+	runID := uuid.New()
+	_, err := NewTupleWriter().WriteRun(context.Background(), Run{ID: runID, OrgID: "12345", Service: "remediations", Owner: "jdoe"})
+
+	assert.NoError(t, err)
+	assert.Len(t, mockService.createRequests[0].Tuples, 3)
+
+	owner := mockService.createRequests[0].Tuples[2]
+	assert.Equal(t, runID.String(), owner.Resource.Id)
+	assert.Equal(t, RelationOwner, owner.Relation)
+	assert.Equal(t, NamespacePlaybookDispatcher, owner.Subject.Subject.Type.Namespace)
+	assert.Equal(t, ResourceTypeUser, owner.Subject.Subject.Type.Name)
+	assert.Equal(t, "12345/jdoe", owner.Subject.Subject.Id)
+}
+
+func TestTupleWriter_WriteRunOwnerDisabled(t *testing.T) {
+	mockService := &mockKesselTupleService{}
+	defer setupMockTupleClient(mockService)()
+
+	_, err := NewTupleWriter().WriteRun(context.Background(), Run{ID: uuid.New(), OrgID: "12345", Service: "remediations", Owner: "jdoe"})
+
+	assert.NoError(t, err)
+	assert.Len(t, mockService.createRequests[0].Tuples, 2)
+}
+
+func TestOwnedRuns_Limit(t *testing.T) {
+	mock := NewMockKesselClient(false)
+	mock.AddObjects(ResourceTypeRun, RelationOwner, "org-456/jdoe", "run-1", "run-2", "run-3")
+	mock.RunOwnership = true
+	defer mock.Install()()
+	globalManager.listMaxResults = 2
+
+	runs, err := OwnedRuns(ownerContext(), zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"run-1", "run-2"}, runs)
+}
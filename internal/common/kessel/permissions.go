@@ -88,6 +88,7 @@ var PlaybookPermissions = map[string]Permission{
 
 // V2ApplicationPermissions maps application names to their Kessel permission names
 // Used for checking service-specific access via Kessel workspace permissions
+// These are the built-in applications, more are added at runtime (see RegisterApplications)
 //
 // The application names match the "service" field values used in the database:
 // - "config_manager" -> service field in runs table
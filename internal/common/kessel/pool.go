@@ -0,0 +1,144 @@
+package kessel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+)
+
+// spread the calls of a connection over all the addresses Kessel resolves to
+const roundRobinServiceConfig = `{"loadBalancingConfig": [{"round_robin": {}}]}`
+
+// connPool spreads calls over kessel.connection.pool.size connections to Kessel.
+//
+// Each connection reconnects on its own (with backoff) when the server goes away and re-resolves the address of Kessel
+// when it does so, so that a rollout of Kessel is followed without restarting the API.
+type connPool struct {
+	conns []*grpc.ClientConn
+	next  atomic.Uint64
+}
+
+func newConnPool(target string, size int, opts ...grpc.DialOption) (*connPool, error) {
+	if size < 1 {
+		size = 1
+	}
+
+	pool := &connPool{}
+
+	for i := 0; i < size; i++ {
+		conn, err := grpc.NewClient(target, opts...)
+		if err != nil {
+			pool.Close() //nolint:errcheck
+			return nil, err
+		}
+
+		pool.conns = append(pool.conns, conn)
+	}
+
+	return pool, nil
+}
+
+func (this *connPool) pick() *grpc.ClientConn {
+	return this.conns[(this.next.Add(1)-1)%uint64(len(this.conns))]
+}
+
+// Invoke implements grpc.ClientConnInterface
+func (this *connPool) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	return this.pick().Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream implements grpc.ClientConnInterface
+func (this *connPool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return this.pick().NewStream(ctx, desc, method, opts...)
+}
+
+// Close closes all the connections of the pool
+func (this *connPool) Close() error {
+	var errs []error
+	for _, conn := range this.conns {
+		errs = append(errs, conn.Close())
+	}
+
+	return errors.Join(errs...)
+}
+
+func (this *connPool) states() []connectivity.State {
+	states := make([]connectivity.State, len(this.conns))
+	for i, conn := range this.conns {
+		states[i] = conn.GetState()
+	}
+
+	return states
+}
+
+// waitReady connects idle connections and waits until at least one of them is ready
+func (this *connPool) waitReady(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ready := make(chan struct{}, len(this.conns))
+
+	for _, conn := range this.conns {
+		conn.Connect()
+
+		go func(conn *grpc.ClientConn) {
+			for state := conn.GetState(); state != connectivity.Ready; state = conn.GetState() {
+				if !conn.WaitForStateChange(ctx, state) {
+					return
+				}
+			}
+
+			ready <- struct{}{}
+		}(conn)
+	}
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("Kessel connection not ready: %v", this.states())
+	}
+}
+
+// dnsTarget makes the DNS resolver explicit for kessel.url values without a scheme
+func dnsTarget(url string) string {
+	if strings.Contains(url, ":///") {
+		return url
+	}
+
+	return "dns:///" + url
+}
+
+// dialOptions returns the options of the connections to Kessel based on kessel.keepalive.* and kessel.reconnect.*
+func dialOptions(cfg *viper.Viper, creds credentials.TransportCredentials) []grpc.DialOption {
+	reconnect := backoff.DefaultConfig
+	if maxDelay := time.Duration(cfg.GetInt64("kessel.reconnect.max_delay")) * time.Second; maxDelay > 0 {
+		reconnect.MaxDelay = maxDelay
+	}
+
+	options := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultServiceConfig(roundRobinServiceConfig),
+		grpc.WithConnectParams(grpc.ConnectParams{Backoff: reconnect, MinConnectTimeout: 20 * time.Second}),
+	}
+
+	if interval := time.Duration(cfg.GetInt64("kessel.keepalive.time")) * time.Second; interval > 0 {
+		options = append(options, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                interval,
+			Timeout:             time.Duration(cfg.GetInt64("kessel.keepalive.timeout")) * time.Second,
+			PermitWithoutStream: cfg.GetBool("kessel.keepalive.permit_without_stream"),
+		}))
+	}
+
+	return options
+}
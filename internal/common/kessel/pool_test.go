@@ -0,0 +1,85 @@
+package kessel
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type countingInventoryServer struct {
+	kesselv2.UnimplementedKesselInventoryServiceServer
+	checks int
+}
+
+func (this *countingInventoryServer) Check(ctx context.Context, in *kesselv2.CheckRequest) (*kesselv2.CheckResponse, error) {
+	this.checks++
+	return &kesselv2.CheckResponse{Allowed: kesselv2.Allowed_ALLOWED_TRUE}, nil
+}
+
+func startInventoryServer(t *testing.T) (string, *countingInventoryServer) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	service := &countingInventoryServer{}
+	server := grpc.NewServer()
+	kesselv2.RegisterKesselInventoryServiceServer(server, service)
+	go server.Serve(listener) //nolint:errcheck
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String(), service
+}
+
+func TestConnPool(t *testing.T) {
+	address, service := startInventoryServer(t)
+
+	cfg := viper.New()
+	cfg.Set("kessel.keepalive.time", 60)
+	cfg.Set("kessel.keepalive.timeout", 20)
+
+	pool, err := newConnPool(dnsTarget(address), 3, dialOptions(cfg, insecure.NewCredentials())...)
+	assert.NoError(t, err)
+	defer pool.Close()
+	assert.Len(t, pool.conns, 3)
+
+	client := kesselv2.NewKesselInventoryServiceClient(pool)
+	for i := 0; i < 6; i++ {
+		response, err := client.Check(context.Background(), &kesselv2.CheckRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, kesselv2.Allowed_ALLOWED_TRUE, response.GetAllowed())
+	}
+
+	assert.Equal(t, 6, service.checks)
+	assert.Equal(t, uint64(6), pool.next.Load())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, pool.waitReady(ctx))
+}
+
+func TestConnPool_MinimumSize(t *testing.T) {
+	pool, err := newConnPool("dns:///localhost:1", 0, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer pool.Close()
+
+	assert.Len(t, pool.conns, 1)
+}
+
+func TestDnsTarget(t *testing.T) {
+	assert.Equal(t, "dns:///kessel-inventory-api:9000", dnsTarget("kessel-inventory-api:9000"))
+	assert.Equal(t, "passthrough:///localhost:9000", dnsTarget("passthrough:///localhost:9000"))
+}
+
+func TestDialOptions_KeepaliveDisabled(t *testing.T) {
+	cfg := viper.New()
+	enabled := viper.New()
+	enabled.Set("kessel.keepalive.time", 60)
+
+	assert.Len(t, dialOptions(enabled, insecure.NewCredentials()), len(dialOptions(cfg, insecure.NewCredentials()))+1)
+}
@@ -0,0 +1,114 @@
+package kessel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+	"go.uber.org/zap"
+)
+
+const (
+	// ResourceTypeServicePermission represents the access of principals to the runs of one service in an organization
+	ResourceTypeServicePermission = "service_permission"
+	// ReporterTypePlaybookDispatcher is the reporter of the resources defined by playbook-dispatcher
+	ReporterTypePlaybookDispatcher = "playbook_dispatcher"
+
+	// RelationServiceViewer relates a principal to a service permission
+	RelationServiceViewer = "viewer"
+	// PermissionServiceView is granted to the viewers of a service permission
+	PermissionServiceView = "view"
+)
+
+// ServicePermissionIDFormat is the format of service permission IDs (org ID, service)
+const ServicePermissionIDFormat = "%s/%s"
+
+// ServicePermission grants a principal access to the runs of the given services in an organization.
+// It is the Kessel counterpart of an RBAC attribute filter on the service attribute.
+type ServicePermission struct {
+	OrgID    string
+	UserID   string
+	Services []string
+}
+
+// FilterByServicePermission narrows the services a principal has application permissions for down to the ones the
+// principal is a viewer of (see ServicePermission). The services are returned unchanged if
+// kessel.service_permissions.enabled is not set.
+func FilterByServicePermission(ctx context.Context, orgID string, services []string, log *zap.SugaredLogger) ([]string, error) {
+	if globalManager == nil || !globalManager.servicePermissions || len(services) == 0 {
+		return services, nil
+	}
+
+	_, principalID, err := validateClientAndIdentity(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot perform service permission checks: %w", err)
+	}
+
+	opts, err := getAuthCallOptions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auth options: %w", err)
+	}
+
+	subject := &kesselv2.SubjectReference{
+		Resource: &kesselv2.ResourceReference{
+			ResourceType: ResourceTypePrincipal,
+			ResourceId:   principalID,
+			Reporter:     &kesselv2.ReporterReference{Type: ReporterTypeRBAC},
+		},
+	}
+
+	items := make([]*kesselv2.CheckBulkRequestItem, len(services))
+	for i, service := range services {
+		items[i] = &kesselv2.CheckBulkRequestItem{
+			Object: &kesselv2.ResourceReference{
+				ResourceType: ResourceTypeServicePermission,
+				ResourceId:   fmt.Sprintf(ServicePermissionIDFormat, orgID, service),
+				Reporter:     &kesselv2.ReporterReference{Type: ReporterTypePlaybookDispatcher},
+			},
+			Relation: PermissionServiceView,
+			Subject:  subject,
+		}
+	}
+
+	results := checkItems(ctx, items, opts)
+
+	allowed := make([]string, 0, len(services))
+	var errs []error
+
+	for i, result := range results {
+		if result.err != nil {
+			errs = append(errs, fmt.Errorf("failed to check service permission for %s: %w", services[i], result.err))
+		} else if result.allowed {
+			allowed = append(allowed, services[i])
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	log.Debugw("Service permission check complete",
+		"org_id", orgID,
+		"principal_id", principalID,
+		"services", services,
+		"allowed_services", allowed)
+
+	return allowed, nil
+}
+
+func servicePermissionRelationship(orgID, service, userID string) *kesselv2.Relationship {
+	return &kesselv2.Relationship{
+		Resource: &kesselv2.RelationObjectReference{
+			Type: &kesselv2.RelationObjectType{Namespace: NamespacePlaybookDispatcher, Name: ResourceTypeServicePermission},
+			Id:   fmt.Sprintf(ServicePermissionIDFormat, orgID, service),
+		},
+		Relation: RelationServiceViewer,
+		Subject: &kesselv2.RelationSubjectReference{
+			Subject: &kesselv2.RelationObjectReference{
+				Type: &kesselv2.RelationObjectType{Namespace: NamespaceRBAC, Name: ResourceTypePrincipal},
+				Id:   fmt.Sprintf(PrincipalIDFormat, userID),
+			},
+		},
+	}
+}
@@ -0,0 +1,120 @@
+package kessel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func servicePermissionContext() context.Context {
+	return identity.WithIdentity(context.Background(), identity.XRHID{
+		Identity: identity.Identity{Type: "User", User: &identity.User{UserID: "user-123"}, OrgID: "org-456"},
+	})
+}
+
+func TestFilterByServicePermission_Disabled(t *testing.T) {
+	mockService := &mockKesselInventoryService{
+		checkFunc: func(ctx context.Context, in *kesselv2.CheckRequest, opts ...grpc.CallOption) (*kesselv2.CheckResponse, error) {
+			t.Fatal("Kessel must not be called")
+			return nil, nil
+		},
+	}
+	cleanup := setupMockClient(mockService)
+	defer cleanup()
+
+	services, err := FilterByServicePermission(servicePermissionContext(), "org-456", []string{"remediations", "tasks"}, zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"remediations", "tasks"}, services)
+}
+
+func TestFilterByServicePermission(t *testing.T) {
+	mockService := &mockKesselInventoryService{
+		checkFunc: func(ctx context.Context, in *kesselv2.CheckRequest, opts ...grpc.CallOption) (*kesselv2.CheckResponse, error) {
+			assert.Equal(t, ResourceTypeServicePermission, in.Object.ResourceType)
+			assert.Equal(t, ReporterTypePlaybookDispatcher, in.Object.Reporter.Type)
+			assert.Equal(t, PermissionServiceView, in.Relation)
+			assert.Equal(t, "redhat/user-123", in.Subject.Resource.ResourceId)
+
+			if in.Object.ResourceId == "org-456/remediations" {
+				return &kesselv2.CheckResponse{Allowed: kesselv2.Allowed_ALLOWED_TRUE}, nil
+			}
+			return &kesselv2.CheckResponse{Allowed: kesselv2.Allowed_ALLOWED_FALSE}, nil
+		},
+	}
+	cleanup := setupMockClient(mockService)
+	defer cleanup()
+	globalManager.servicePermissions = true
+
+	services, err := FilterByServicePermission(servicePermissionContext(), "org-456", []string{"remediations", "tasks"}, zap.NewNop().Sugar())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"remediations"}, services)
+}
+
+func TestFilterByServicePermission_Error(t *testing.T) {
+	mockService := &mockKesselInventoryService{
+		checkFunc: func(ctx context.Context, in *kesselv2.CheckRequest, opts ...grpc.CallOption) (*kesselv2.CheckResponse, error) {
+			if in.Object.ResourceId == "org-456/tasks" {
+				return nil, errors.New("unavailable")
+			}
+			return &kesselv2.CheckResponse{Allowed: kesselv2.Allowed_ALLOWED_TRUE}, nil
+		},
+	}
+	cleanup := setupMockClient(mockService)
+	defer cleanup()
+	globalManager.servicePermissions = true
+
+	services, err := FilterByServicePermission(servicePermissionContext(), "org-456", []string{"remediations", "tasks"}, zap.NewNop().Sugar())
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "tasks")
+	assert.Nil(t, services)
+}
+
+func TestTupleWriter_WriteServicePermission(t *testing.T) {
+	mockService := &mockKesselTupleService{}
+	cleanup := setupMockTupleClient(mockService)
+	defer cleanup()
+
+	err := NewTupleWriter().WriteServicePermission(context.Background(), ServicePermission{
+		OrgID:    "12345",
+		UserID:   "user-123",
+		Services: []string{"remediations", "tasks"},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, mockService.createRequests, 1)
+
+	request := mockService.createRequests[0]
+	assert.True(t, request.Upsert)
+	assert.Len(t, request.Tuples, 2)
+	assert.Equal(t, "12345/remediations", request.Tuples[0].Resource.Id)
+	assert.Equal(t, "12345/tasks", request.Tuples[1].Resource.Id)
+
+	for _, tuple := range request.Tuples {
+		assert.Equal(t, NamespacePlaybookDispatcher, tuple.Resource.Type.Namespace)
+		assert.Equal(t, ResourceTypeServicePermission, tuple.Resource.Type.Name)
+		assert.Equal(t, RelationServiceViewer, tuple.Relation)
+		assert.Equal(t, NamespaceRBAC, tuple.Subject.Subject.Type.Namespace)
+		assert.Equal(t, ResourceTypePrincipal, tuple.Subject.Subject.Type.Name)
+		assert.Equal(t, "redhat/user-123", tuple.Subject.Subject.Id)
+	}
+}
+
+func TestTupleWriter_WriteServicePermission_NoServices(t *testing.T) {
+	mockService := &mockKesselTupleService{}
+	cleanup := setupMockTupleClient(mockService)
+	defer cleanup()
+
+	err := NewTupleWriter().WriteServicePermission(context.Background(), ServicePermission{OrgID: "12345", UserID: "user-123"})
+
+	assert.NoError(t, err)
+	assert.Empty(t, mockService.createRequests)
+}
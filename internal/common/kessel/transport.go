@@ -0,0 +1,79 @@
+package kessel
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// AuthTypeOIDC authenticates with tokens obtained using the OIDC client credentials flow
+	AuthTypeOIDC = "oidc"
+	// AuthTypePSK authenticates with a pre-shared token
+	AuthTypePSK = "psk"
+)
+
+// transportCredentials returns the credentials of the gRPC connection to Kessel based on kessel.insecure and kessel.tls.*
+//
+// The server certificate is verified against kessel.tls.ca.file or, if not set, the system certificates. A client
+// certificate (mTLS) is presented if kessel.tls.cert.file and kessel.tls.key.file are set. The certificate is read
+// again on every handshake so that it can be rotated without a restart.
+func transportCredentials(cfg *viper.Viper) (credentials.TransportCredentials, error) {
+	if cfg.GetBool("kessel.insecure") {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig, err := clientTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+func clientTLSConfig(cfg *viper.Viper) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caFile := cfg.GetString("kessel.tls.ca.file"); caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Kessel CA certificate: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", caFile)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	certFile, keyFile := cfg.GetString("kessel.tls.cert.file"), cfg.GetString("kessel.tls.key.file")
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("kessel.tls.cert.file and kessel.tls.key.file must be set together")
+	}
+
+	if certFile != "" {
+		// fail on startup rather than on the first handshake
+		if _, err := tls.LoadX509KeyPair(certFile, keyFile); err != nil {
+			return nil, fmt.Errorf("failed to load Kessel client certificate: %w", err)
+		}
+
+		tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+			if err != nil {
+				return nil, err
+			}
+
+			return &cert, nil
+		}
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,138 @@
+package kessel
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeCertificate writes a self-signed certificate and its key to dir
+func writeCertificate(t *testing.T, dir, name string) (certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	certFile, keyFile = filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	assert.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+
+	return
+}
+
+func TestTransportCredentials_Insecure(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("kessel.insecure", true)
+
+	creds, err := transportCredentials(cfg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "insecure", creds.Info().SecurityProtocol)
+}
+
+func TestTransportCredentials_TLS(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("kessel.insecure", false)
+
+	creds, err := transportCredentials(cfg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "tls", creds.Info().SecurityProtocol)
+}
+
+func TestClientTLSConfig_CA(t *testing.T) {
+	certFile, _ := writeCertificate(t, t.TempDir(), "kessel-ca")
+
+	cfg := viper.New()
+	cfg.Set("kessel.tls.ca.file", certFile)
+
+	tlsConfig, err := clientTLSConfig(cfg)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, tlsConfig.RootCAs)
+	assert.Nil(t, tlsConfig.GetClientCertificate)
+}
+
+func TestClientTLSConfig_InvalidCA(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	assert.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0600))
+
+	cfg := viper.New()
+	cfg.Set("kessel.tls.ca.file", caFile)
+
+	_, err := clientTLSConfig(cfg)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no certificates found")
+}
+
+func TestClientTLSConfig_CertWithoutKey(t *testing.T) {
+	certFile, _ := writeCertificate(t, t.TempDir(), "playbook-dispatcher")
+
+	cfg := viper.New()
+	cfg.Set("kessel.tls.cert.file", certFile)
+
+	_, err := clientTLSConfig(cfg)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "must be set together")
+}
+
+func TestClientTLSConfig_ClientCertificateRotation(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCertificate(t, dir, "playbook-dispatcher")
+
+	cfg := viper.New()
+	cfg.Set("kessel.tls.cert.file", certFile)
+	cfg.Set("kessel.tls.key.file", keyFile)
+
+	tlsConfig, err := clientTLSConfig(cfg)
+	assert.NoError(t, err)
+
+	first, err := tlsConfig.GetClientCertificate(&tls.CertificateRequestInfo{})
+	assert.NoError(t, err)
+
+	// the rotated certificate is presented on the next handshake
+	writeCertificate(t, dir, "playbook-dispatcher")
+
+	second, err := tlsConfig.GetClientCertificate(&tls.CertificateRequestInfo{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.Certificate[0], second.Certificate[0])
+}
+
+func TestClientTLSConfig_MissingClientCertificate(t *testing.T) {
+	dir := t.TempDir()
+
+	cfg := viper.New()
+	cfg.Set("kessel.tls.cert.file", filepath.Join(dir, "tls.crt"))
+	cfg.Set("kessel.tls.key.file", filepath.Join(dir, "tls.key"))
+
+	_, err := clientTLSConfig(cfg)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load Kessel client certificate")
+}
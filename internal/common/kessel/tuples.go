@@ -0,0 +1,197 @@
+package kessel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+	"google.golang.org/grpc"
+)
+
+const (
+	// NamespacePlaybookDispatcher is the Kessel namespace of the resources defined by playbook-dispatcher
+	NamespacePlaybookDispatcher = "playbook_dispatcher"
+	// NamespaceRBAC is the Kessel namespace of the resources defined by RBAC
+	NamespaceRBAC = "rbac"
+
+	// ResourceTypeRun represents a playbook run
+	ResourceTypeRun = "run"
+	// ResourceTypeService represents the service (e.g. remediations) that owns runs
+	ResourceTypeService = "service"
+	// ResourceTypeTenant represents an organization
+	ResourceTypeTenant = "tenant"
+
+	// RelationOrg relates a run to the organization it belongs to
+	RelationOrg = "org"
+	// RelationService relates a run to the service that created it
+	RelationService = "service"
+)
+
+// TenantIDFormat is the format for organization (tenant) IDs in Kessel
+const TenantIDFormat = "redhat/%s"
+
+// Run identifies a run and the resources it is related to
+type Run struct {
+	ID      uuid.UUID
+	OrgID   string
+	Service string
+	// username of the principal the run was dispatched on behalf of, the owner of the run with
+	// kessel.run_ownership.enabled
+	Owner string
+}
+
+// TupleWriter maintains the relationships of runs in Kessel so that permissions can be checked on individual runs
+type TupleWriter interface {
+	// WriteRun creates the relationships of a run, existing ones are kept. Returns the consistency token of the write
+	// ("" if none) that checks need to be at least as fresh as in order to see the relationships (see WithConsistencyToken).
+	WriteRun(ctx context.Context, run Run) (string, error)
+	// DeleteRuns removes all relationships of the given runs
+	DeleteRuns(ctx context.Context, runIDs ...uuid.UUID) error
+	// WriteServicePermission makes the principal a viewer of the given services, existing relationships are kept
+	WriteServicePermission(ctx context.Context, permission ServicePermission) error
+}
+
+// NewTupleWriter returns a writer using the tuple client created by Initialize.
+// The writer does nothing if kessel.tuples.enabled is not set.
+func NewTupleWriter() TupleWriter {
+	if globalManager == nil || globalManager.tupleClient == nil {
+		return &noopTupleWriter{}
+	}
+
+	return &tupleWriter{client: globalManager.tupleClient, ownership: globalManager.runOwnership}
+}
+
+type noopTupleWriter struct{}
+
+func (this *noopTupleWriter) WriteRun(ctx context.Context, run Run) (string, error) {
+	return "", nil
+}
+
+func (this *noopTupleWriter) DeleteRuns(ctx context.Context, runIDs ...uuid.UUID) error {
+	return nil
+}
+
+func (this *noopTupleWriter) WriteServicePermission(ctx context.Context, permission ServicePermission) error {
+	return nil
+}
+
+type tupleWriter struct {
+	client kesselv2.KesselTupleServiceClient
+	// write the owner relationship of runs
+	ownership bool
+}
+
+func (this *tupleWriter) WriteRun(ctx context.Context, run Run) (string, error) {
+	opts, err := getAuthCallOptions()
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := withCheckTimeout(ctx)
+	defer cancel()
+
+	request := &kesselv2.CreateTuplesRequest{
+		Upsert: true,
+		Tuples: []*kesselv2.Relationship{
+			runRelationship(run.ID, RelationOrg, NamespaceRBAC, ResourceTypeTenant, fmt.Sprintf(TenantIDFormat, run.OrgID)),
+			runRelationship(run.ID, RelationService, NamespacePlaybookDispatcher, ResourceTypeService, run.Service),
+		},
+	}
+
+	if this.ownership && run.Owner != "" {
+		request.Tuples = append(request.Tuples, ownerRelationship(run))
+	}
+
+	// the tuple API is the only one writing arbitrary relationships of resources not reported to the inventory
+	response, err := this.client.CreateTuples(ctx, request, opts...) //nolint:staticcheck
+	if err != nil {
+		return "", fmt.Errorf("failed to write relationships of run %s: %w", run.ID, err)
+	}
+
+	return response.GetConsistencyToken().GetToken(), nil
+}
+
+func (this *tupleWriter) WriteServicePermission(ctx context.Context, permission ServicePermission) error {
+	if len(permission.Services) == 0 {
+		return nil
+	}
+
+	opts, err := getAuthCallOptions()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := withCheckTimeout(ctx)
+	defer cancel()
+
+	request := &kesselv2.CreateTuplesRequest{Upsert: true}
+	for _, service := range permission.Services {
+		request.Tuples = append(request.Tuples, servicePermissionRelationship(permission.OrgID, service, permission.UserID))
+	}
+
+	if _, err := this.client.CreateTuples(ctx, request, opts...); err != nil { //nolint:staticcheck
+		return fmt.Errorf("failed to write service permissions of %s in org %s: %w", permission.UserID, permission.OrgID, err)
+	}
+
+	return nil
+}
+
+func (this *tupleWriter) DeleteRuns(ctx context.Context, runIDs ...uuid.UUID) error {
+	opts, err := getAuthCallOptions()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, runID := range runIDs {
+		if err := this.deleteRun(ctx, runID, opts); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete relationships of run %s: %w", runID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (this *tupleWriter) deleteRun(ctx context.Context, runID uuid.UUID, opts []grpc.CallOption) error {
+	ctx, cancel := withCheckTimeout(ctx)
+	defer cancel()
+
+	namespace, resourceType, id := NamespacePlaybookDispatcher, ResourceTypeRun, runID.String()
+
+	_, err := this.client.DeleteTuples(ctx, &kesselv2.DeleteTuplesRequest{ //nolint:staticcheck
+		Filter: &kesselv2.RelationTupleFilter{
+			ResourceNamespace: &namespace,
+			ResourceType:      &resourceType,
+			ResourceId:        &id,
+		},
+	}, opts...)
+
+	return err
+}
+
+func runRelationship(runID uuid.UUID, relation, subjectNamespace, subjectType, subjectID string) *kesselv2.Relationship {
+	return &kesselv2.Relationship{
+		Resource: &kesselv2.RelationObjectReference{
+			Type: &kesselv2.RelationObjectType{Namespace: NamespacePlaybookDispatcher, Name: ResourceTypeRun},
+			Id:   runID.String(),
+		},
+		Relation: relation,
+		Subject: &kesselv2.RelationSubjectReference{
+			Subject: &kesselv2.RelationObjectReference{
+				Type: &kesselv2.RelationObjectType{Namespace: subjectNamespace, Name: subjectType},
+				Id:   subjectID,
+			},
+		},
+	}
+}
+
+// withCheckTimeout limits a single call to kessel.check.timeout, if set
+func withCheckTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if globalManager == nil || globalManager.checkTimeout <= 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, globalManager.checkTimeout)
+}
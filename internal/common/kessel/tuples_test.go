@@ -0,0 +1,140 @@
+package kessel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	kesselv2 "github.com/project-kessel/inventory-api/api/kessel/inventory/v1beta2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// mockKesselTupleService is a mock implementation of the Kessel tuple service
+type mockKesselTupleService struct {
+	createRequests []*kesselv2.CreateTuplesRequest
+	deleteRequests []*kesselv2.DeleteTuplesRequest
+	createError    error
+	createToken    string
+	deleteError    func(in *kesselv2.DeleteTuplesRequest) error
+}
+
+func (m *mockKesselTupleService) CreateTuples(ctx context.Context, in *kesselv2.CreateTuplesRequest, opts ...grpc.CallOption) (*kesselv2.CreateTuplesResponse, error) {
+	m.createRequests = append(m.createRequests, in)
+	if m.createError != nil {
+		return nil, m.createError
+	}
+	return &kesselv2.CreateTuplesResponse{ConsistencyToken: &kesselv2.ConsistencyToken{Token: m.createToken}}, nil
+}
+
+func (m *mockKesselTupleService) DeleteTuples(ctx context.Context, in *kesselv2.DeleteTuplesRequest, opts ...grpc.CallOption) (*kesselv2.DeleteTuplesResponse, error) {
+	m.deleteRequests = append(m.deleteRequests, in)
+	if m.deleteError != nil {
+		return nil, m.deleteError(in)
+	}
+	return &kesselv2.DeleteTuplesResponse{}, nil
+}
+
+func (m *mockKesselTupleService) ReadTuples(ctx context.Context, in *kesselv2.ReadTuplesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[kesselv2.ReadTuplesResponse], error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockKesselTupleService) AcquireLock(ctx context.Context, in *kesselv2.AcquireLockRequest, opts ...grpc.CallOption) (*kesselv2.AcquireLockResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func setupMockTupleClient(mockService *mockKesselTupleService) func() {
+	oldManager := globalManager
+	globalManager = &ClientManager{tupleClient: mockService}
+
+	return func() {
+		globalManager = oldManager
+	}
+}
+
+func TestNewTupleWriter_Disabled(t *testing.T) {
+	cleanup := SetClientForTesting(nil, nil, nil)
+	defer cleanup()
+
+	writer := NewTupleWriter()
+
+	assert.IsType(t, &noopTupleWriter{}, writer)
+	token, err := writer.WriteRun(context.Background(), Run{ID: uuid.New(), OrgID: "12345", Service: "remediations"})
+	assert.NoError(t, err)
+	assert.Empty(t, token)
+	assert.NoError(t, writer.DeleteRuns(context.Background(), uuid.New()))
+}
+
+func TestTupleWriter_WriteRun(t *testing.T) {
+	mockService := &mockKesselTupleService{createToken: "GhUKEzE3MDAwMDAwMDAwMDAwMDAwMDA="}
+	cleanup := setupMockTupleClient(mockService)
+	defer cleanup()
+
+	runID := uuid.New()
+	token, err := NewTupleWriter().WriteRun(context.Background(), Run{ID: runID, OrgID: "12345", Service: "remediations"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "GhUKEzE3MDAwMDAwMDAwMDAwMDAwMDA=", token)
+	assert.Len(t, mockService.createRequests, 1)
+
+	request := mockService.createRequests[0]
+	assert.True(t, request.Upsert)
+	assert.Len(t, request.Tuples, 2)
+
+	for _, tuple := range request.Tuples {
+		assert.Equal(t, NamespacePlaybookDispatcher, tuple.Resource.Type.Namespace)
+		assert.Equal(t, ResourceTypeRun, tuple.Resource.Type.Name)
+		assert.Equal(t, runID.String(), tuple.Resource.Id)
+	}
+
+	assert.Equal(t, RelationOrg, request.Tuples[0].Relation)
+	assert.Equal(t, NamespaceRBAC, request.Tuples[0].Subject.Subject.Type.Namespace)
+	assert.Equal(t, ResourceTypeTenant, request.Tuples[0].Subject.Subject.Type.Name)
+	assert.Equal(t, "redhat/12345", request.Tuples[0].Subject.Subject.Id)
+
+	assert.Equal(t, RelationService, request.Tuples[1].Relation)
+	assert.Equal(t, NamespacePlaybookDispatcher, request.Tuples[1].Subject.Subject.Type.Namespace)
+	assert.Equal(t, ResourceTypeService, request.Tuples[1].Subject.Subject.Type.Name)
+	assert.Equal(t, "remediations", request.Tuples[1].Subject.Subject.Id)
+}
+
+func TestTupleWriter_WriteRunError(t *testing.T) {
+	mockService := &mockKesselTupleService{createError: errors.New("unavailable")}
+	cleanup := setupMockTupleClient(mockService)
+	defer cleanup()
+
+	runID := uuid.New()
+	_, err := NewTupleWriter().WriteRun(context.Background(), Run{ID: runID, OrgID: "12345", Service: "remediations"})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), runID.String())
+	assert.Contains(t, err.Error(), "unavailable")
+}
+
+func TestTupleWriter_DeleteRuns(t *testing.T) {
+	failing := uuid.New()
+	mockService := &mockKesselTupleService{deleteError: func(in *kesselv2.DeleteTuplesRequest) error {
+		if in.Filter.GetResourceId() == failing.String() {
+			return errors.New("unavailable")
+		}
+		return nil
+	}}
+	cleanup := setupMockTupleClient(mockService)
+	defer cleanup()
+
+	deleted := uuid.New()
+	err := NewTupleWriter().DeleteRuns(context.Background(), deleted, failing)
+
+	// every run is attempted, only the failed ones are reported
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), failing.String())
+	assert.NotContains(t, err.Error(), deleted.String())
+
+	assert.Len(t, mockService.deleteRequests, 2)
+	filter := mockService.deleteRequests[0].Filter
+	assert.Equal(t, NamespacePlaybookDispatcher, filter.GetResourceNamespace())
+	assert.Equal(t, ResourceTypeRun, filter.GetResourceType())
+	assert.Equal(t, deleted.String(), filter.GetResourceId())
+	assert.Nil(t, filter.Relation)
+}
@@ -0,0 +1,20 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ApiToken grants read-only access to the public API on behalf of an organization
+type ApiToken struct {
+	ID    uuid.UUID `gorm:"type:uuid"`
+	OrgID string
+	Name  string
+	// hex-encoded sha256 of the token
+	Hash string
+
+	CreatedAt time.Time
+	// the token does not expire if not set
+	ExpiresAt *time.Time
+}
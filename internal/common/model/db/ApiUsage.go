@@ -0,0 +1,14 @@
+package db
+
+import "time"
+
+// ApiUsage holds the number of public API calls made by an organization within an hour (Period)
+type ApiUsage struct {
+	OrgID  string    `gorm:"primaryKey"`
+	Period time.Time `gorm:"primaryKey"`
+	Calls  int64
+}
+
+func (ApiUsage) TableName() string {
+	return "api_usage"
+}
@@ -0,0 +1,23 @@
+package db
+
+import (
+	"time"
+)
+
+// AuditChainHead is the single row holding the sequence number and hash of the latest audit event
+type AuditChainHead struct {
+	ID       int `gorm:"primaryKey"`
+	Sequence int64
+	Hash     string
+}
+
+func (AuditChainHead) TableName() string {
+	return "audit_chain_head"
+}
+
+// AuditChainAnchor is a periodically taken snapshot of the audit chain head
+type AuditChainAnchor struct {
+	Sequence  int64 `gorm:"primaryKey"`
+	Hash      string
+	CreatedAt time.Time
+}
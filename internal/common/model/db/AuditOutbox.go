@@ -0,0 +1,21 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditOutbox holds audit events that have not been delivered to the audit topic yet
+type AuditOutbox struct {
+	ID        uuid.UUID `gorm:"type:uuid"`
+	EventType string
+	OrgID     string
+	Payload   []byte `gorm:"type:jsonb"`
+	Sequence  int64
+	CreatedAt time.Time
+}
+
+func (AuditOutbox) TableName() string {
+	return "audit_outbox"
+}
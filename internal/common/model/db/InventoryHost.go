@@ -0,0 +1,16 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InventoryHost caches the display name of a host as last seen in the inventory so that run hosts
+// can be listed along with it without calling the inventory
+type InventoryHost struct {
+	OrgID       string    `gorm:"primaryKey"`
+	ID          uuid.UUID `gorm:"primaryKey;type:uuid"`
+	DisplayName string
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,15 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProcessedMessage records a response message that has already been applied so that the same message
+// consumed from another topic (e.g. while migrating to a new Kafka cluster) is not applied twice
+type ProcessedMessage struct {
+	RequestID     string    `gorm:"primaryKey"`
+	CorrelationID uuid.UUID `gorm:"primaryKey;type:uuid"`
+	ProcessedAt   time.Time `gorm:"default:now()"`
+}
@@ -8,14 +8,6 @@ import (
 	"github.com/google/uuid"
 )
 
-const (
-	RunStatusRunning  = "running"
-	RunStatusSuccess  = "success"
-	RunStatusFailure  = "failure"
-	RunStatusTimeout  = "timeout"
-	RunStatusCanceled = "canceled"
-)
-
 type Run struct {
 	ID      uuid.UUID `gorm:"type:uuid"`
 	OrgID   string    `gorm:"default:unknown"`
@@ -25,9 +17,10 @@ type Run struct {
 	CorrelationID uuid.UUID `gorm:"type:uuid"`
 	URL           string
 
-	Status string
-	Labels Labels
-	Events []byte `gorm:"default:[]"`
+	Status   string
+	Progress int
+	Labels   Labels
+	Events   []byte `gorm:"default:[]"`
 
 	PlaybookName   *string
 	PlaybookRunUrl string
@@ -39,6 +32,18 @@ type Run struct {
 	UpdatedAt    time.Time
 	Timeout      int
 	ResponseFull bool
+	// number of requests the hosts of the run were split into when dispatching it
+	DispatchChunks int `gorm:"default:1"`
+	// run (default) or check, in which case the playbook only reports the changes it would make
+	ExecutionMode string `gorm:"default:run"`
+	// consistency token of the write of the Kessel relationships of the run, see kessel.TupleWriter
+	KesselToken *string
+	// group of runs dispatched by the same request, see RunGroup
+	GroupID *uuid.UUID `gorm:"type:uuid"`
+	// run this run is a retry of, see /internal/v2/runs/{run_id}/retry
+	ParentRunID *uuid.UUID `gorm:"type:uuid"`
+	// upload time of the first response of the run
+	FirstResponseAt *time.Time
 }
 
 type Labels map[string]string
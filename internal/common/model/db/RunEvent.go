@@ -0,0 +1,23 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RunEvent records a transition of the status of a run
+type RunEvent struct {
+	ID    int64     `gorm:"primaryKey"`
+	RunID uuid.UUID `gorm:"type:uuid"`
+
+	// not set for the status the run was created with
+	PreviousStatus *string
+	Status         string
+	// what changed the status, see runevents.Source*
+	Source string
+	// ID of the message or request that changed the status, if any
+	SourceEventID *string
+
+	CreatedAt time.Time
+}
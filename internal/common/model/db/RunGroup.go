@@ -0,0 +1,19 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RunGroup ties together the runs of a playbook dispatched to multiple recipients by a single request
+type RunGroup struct {
+	ID      uuid.UUID `gorm:"type:uuid"`
+	OrgID   string
+	Service string
+	// name of the playbook
+	Name      string
+	Principal string
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,22 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RunHookExecution tracks the execution of a post-processing hook for a completed run
+type RunHookExecution struct {
+	RunID uuid.UUID `gorm:"primaryKey;type:uuid"`
+	Hook  string    `gorm:"primaryKey"`
+
+	// pending, done or dead_letter
+	State     string `gorm:"default:pending"`
+	Attempts  int
+	LastError *string
+
+	NextAttemptAt time.Time `gorm:"default:now()"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
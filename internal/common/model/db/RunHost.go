@@ -16,9 +16,18 @@ type RunHost struct {
 
 	SatSequence *int
 
-	Status string
-	Log    string
+	Status      string
+	Log         string
+	CancelState *string
+	// JSON array of the diffs reported by the tasks of the playbook
+	Diffs []byte
+	// JSON object of the facts, set_stats data and task results of the host
+	Artifacts []byte
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
+
+	// computed by queries that select them, never written
+	StdoutSize  *int64  `gorm:"->"`
+	DisplayName *string `gorm:"->"`
 }
@@ -0,0 +1,15 @@
+package db
+
+import (
+	"time"
+)
+
+// RunLimit overrides the default limit of concurrently running runs for an organization
+type RunLimit struct {
+	OrgID string `gorm:"primaryKey"`
+	// 0 disables the limit for the org
+	MaxRunning int
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
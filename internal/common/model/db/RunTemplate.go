@@ -0,0 +1,34 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RunTemplate describes a playbook run dispatched periodically according to a cron schedule
+type RunTemplate struct {
+	ID      uuid.UUID `gorm:"type:uuid"`
+	OrgID   string
+	Service string
+	// name of the playbook
+	Name      string
+	Principal string
+	Schedule  string
+
+	Recipient     uuid.UUID `gorm:"type:uuid"`
+	URL           string
+	WebConsoleURL *string
+	Labels        Labels
+	Timeout       *int
+
+	Enabled bool
+	// not set while the template is disabled
+	NextRunAt *time.Time
+	LastRunAt *time.Time
+	// not set if the last dispatch failed
+	LastRunID *uuid.UUID `gorm:"type:uuid"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
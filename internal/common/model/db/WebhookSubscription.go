@@ -0,0 +1,19 @@
+package db
+
+import (
+	"time"
+)
+
+// WebhookSubscription tracks whether the endpoint of a webhook subscription keeps failing
+type WebhookSubscription struct {
+	ID string `gorm:"primaryKey"`
+
+	// active or dead_letter
+	State string `gorm:"default:active"`
+	// number of attempts that failed in a row
+	Failures  int
+	LastError *string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -2,6 +2,19 @@ package generic
 
 import "github.com/google/uuid"
 
+const (
+	ExecutionModeRun = "run"
+	// ExecutionModeCheck runs the playbook in check (and diff) mode, i.e. without making any changes
+	ExecutionModeCheck = "check"
+)
+
+// priorities of dispatches, see dispatch.PriorityLimiter
+const (
+	PriorityHigh   = "high"
+	PriorityNormal = "normal"
+	PriorityLow    = "low"
+)
+
 type RunInput struct {
 	Recipient     uuid.UUID
 	Account       *string
@@ -15,6 +28,17 @@ type RunInput struct {
 	Name          *string
 	WebConsoleUrl *string
 	Principal     *string
+	ExecutionMode *string
+	// the run is kept until the recipient connects if it is not connected
+	WaitForConnection bool
+	// group the run is dispatched as part of, if any
+	GroupId *uuid.UUID
+	// run the run retries, if any
+	ParentRunId *uuid.UUID
+	// queue the requests of the run wait in for cloud connector, normal if not set
+	Priority string
+	// the recipient and the playbook URL are checked before the run is dispatched
+	CheckRecipient bool
 }
 
 type CancelInput struct {
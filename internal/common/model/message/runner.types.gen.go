@@ -47,6 +47,10 @@ type PlaybookRunResponseMessageYamlEventsElem struct {
 }
 
 type PlaybookRunResponseMessageYamlEventsElemEventData struct {
+	// data set by the playbook using set_stats, reported by the playbook_on_stats
+	// event
+	ArtifactData PlaybookRunResponseMessageYamlEventsElemEventDataArtifactData `json:"artifact_data,omitempty" yaml:"artifact_data,omitempty" mapstructure:"artifact_data,omitempty"`
+
 	// CrcDispatcherCorrelationId corresponds to the JSON schema field
 	// "crc_dispatcher_correlation_id".
 	CrcDispatcherCorrelationId *string `json:"crc_dispatcher_correlation_id,omitempty" yaml:"crc_dispatcher_correlation_id,omitempty" mapstructure:"crc_dispatcher_correlation_id,omitempty"`
@@ -67,8 +71,23 @@ type PlaybookRunResponseMessageYamlEventsElemEventData struct {
 
 	// PlaybookUuid corresponds to the JSON schema field "playbook_uuid".
 	PlaybookUuid *string `json:"playbook_uuid,omitempty" yaml:"playbook_uuid,omitempty" mapstructure:"playbook_uuid,omitempty"`
+
+	// result of the task as returned by the module, e.g. its diff (check and diff
+	// mode) or ansible_facts
+	Res PlaybookRunResponseMessageYamlEventsElemEventDataRes `json:"res,omitempty" yaml:"res,omitempty" mapstructure:"res,omitempty"`
+
+	// Task corresponds to the JSON schema field "task".
+	Task *string `json:"task,omitempty" yaml:"task,omitempty" mapstructure:"task,omitempty"`
 }
 
+// data set by the playbook using set_stats, reported by the playbook_on_stats
+// event
+type PlaybookRunResponseMessageYamlEventsElemEventDataArtifactData map[string]interface{}
+
+// result of the task as returned by the module, e.g. its diff (check and diff
+// mode) or ansible_facts
+type PlaybookRunResponseMessageYamlEventsElemEventDataRes map[string]interface{}
+
 // UnmarshalJSON implements json.Unmarshaler.
 func (j *PlaybookRunResponseMessageYamlEventsElemEventData) UnmarshalJSON(b []byte) error {
 	type Plain PlaybookRunResponseMessageYamlEventsElemEventData
@@ -0,0 +1,161 @@
+package runevents
+
+import (
+	"context"
+	"encoding/json"
+	"playbook-dispatcher/internal/common/utils"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// size of the buffer of each subscription; a subscriber that falls this far behind is dropped
+const subscriptionBuffer = 64
+
+const (
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+)
+
+// Broker fans the changes received from the database out to the subscribers of the runs they concern
+type Broker struct {
+	lock          sync.Mutex
+	subscriptions map[uuid.UUID]map[chan Change]struct{}
+	closed        bool
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		subscriptions: make(map[uuid.UUID]map[chan Change]struct{}),
+	}
+}
+
+// Subscribe returns a channel of the changes of the given run and a function that ends the subscription.
+// The channel is closed when the subscription ends, which also happens if the subscriber does not keep up with the
+// changes or the broker stops.
+func (this *Broker) Subscribe(runID uuid.UUID) (<-chan Change, func()) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	channel := make(chan Change, subscriptionBuffer)
+
+	if this.closed {
+		close(channel)
+		return channel, func() {}
+	}
+
+	if this.subscriptions[runID] == nil {
+		this.subscriptions[runID] = make(map[chan Change]struct{})
+	}
+
+	this.subscriptions[runID][channel] = struct{}{}
+
+	return channel, func() {
+		this.lock.Lock()
+		defer this.lock.Unlock()
+
+		this.remove(runID, channel)
+	}
+}
+
+// Dispatch delivers the change to the subscribers of its run
+func (this *Broker) Dispatch(change Change) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	for channel := range this.subscriptions[change.RunID] {
+		select {
+		case channel <- change:
+		default:
+			this.remove(change.RunID, channel)
+		}
+	}
+}
+
+// Start delivers the changes published on Channel in the background until ctx is done, after which all subscriptions
+// end. The connection is re-established (with backoff) if it fails; changes published in the meantime are lost.
+func (this *Broker) Start(ctx context.Context, connect func(ctx context.Context) (*pgx.Conn, error), wg *sync.WaitGroup) {
+	log := utils.GetLogFromContext(ctx)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer this.close()
+
+		delay := minReconnectDelay
+
+		for ctx.Err() == nil {
+			if err := this.listen(ctx, connect, log, func() { delay = minReconnectDelay }); err != nil && ctx.Err() == nil {
+				log.Errorw("Error listening for run events", "error", err, "retry", delay)
+			}
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(delay):
+			}
+
+			delay = min(2*delay, maxReconnectDelay)
+		}
+	}()
+}
+
+func (this *Broker) listen(ctx context.Context, connect func(ctx context.Context) (*pgx.Conn, error), log *zap.SugaredLogger, connected func()) error {
+	conn, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+Channel); err != nil {
+		return err
+	}
+
+	connected()
+	log.Infow("Listening for run events", "channel", Channel)
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		change := Change{}
+		if err := json.Unmarshal([]byte(notification.Payload), &change); err != nil {
+			log.Warnw("Ignoring malformed run event", "payload", notification.Payload, "error", err)
+			continue
+		}
+
+		this.Dispatch(change)
+	}
+}
+
+func (this *Broker) close() {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	for runID, channels := range this.subscriptions {
+		for channel := range channels {
+			this.remove(runID, channel)
+		}
+	}
+
+	this.closed = true
+}
+
+// must be called with the lock held
+func (this *Broker) remove(runID uuid.UUID, channel chan Change) {
+	if _, ok := this.subscriptions[runID][channel]; !ok {
+		return
+	}
+
+	delete(this.subscriptions[runID], channel)
+	close(channel)
+
+	if len(this.subscriptions[runID]) == 0 {
+		delete(this.subscriptions, runID)
+	}
+}
@@ -0,0 +1,68 @@
+package runevents
+
+import (
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Broker", func() {
+	var broker *Broker
+
+	BeforeEach(func() {
+		broker = NewBroker()
+	})
+
+	It("delivers changes to the subscribers of the run", func() {
+		runID := uuid.New()
+		first, unsubscribeFirst := broker.Subscribe(runID)
+		defer unsubscribeFirst()
+		second, unsubscribeSecond := broker.Subscribe(runID)
+		defer unsubscribeSecond()
+		other, unsubscribeOther := broker.Subscribe(uuid.New())
+		defer unsubscribeOther()
+
+		change := Change{Type: TypeHost, RunID: runID, Host: "localhost", Status: "success"}
+		broker.Dispatch(change)
+
+		Expect(first).To(Receive(Equal(change)))
+		Expect(second).To(Receive(Equal(change)))
+		Expect(other).ToNot(Receive())
+	})
+
+	It("closes the channel when unsubscribing", func() {
+		runID := uuid.New()
+		changes, unsubscribe := broker.Subscribe(runID)
+
+		unsubscribe()
+		unsubscribe()
+
+		Expect(changes).To(BeClosed())
+		Expect(broker.subscriptions).To(BeEmpty())
+
+		broker.Dispatch(Change{Type: TypeRun, RunID: runID, Status: "success"})
+	})
+
+	It("drops a subscriber that does not keep up", func() {
+		runID := uuid.New()
+		changes, unsubscribe := broker.Subscribe(runID)
+		defer unsubscribe()
+
+		for i := 0; i <= subscriptionBuffer; i++ {
+			broker.Dispatch(Change{Type: TypeRun, RunID: runID, Status: "running"})
+		}
+
+		Expect(changes).To(HaveLen(subscriptionBuffer))
+		Eventually(changes).Should(BeClosed())
+	})
+
+	It("ends all subscriptions when closed", func() {
+		changes, _ := broker.Subscribe(uuid.New())
+		broker.close()
+
+		Expect(changes).To(BeClosed())
+
+		late, _ := broker.Subscribe(uuid.New())
+		Expect(late).To(BeClosed())
+	})
+})
@@ -0,0 +1,68 @@
+package runevents
+
+import (
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// sources of status transitions
+const (
+	// SourceDispatch is the creation of the run
+	SourceDispatch = "dispatch"
+	// SourceReconnect is the dispatch of a run that waited for its recipient to connect
+	SourceReconnect = "reconnect"
+	// SourceResponse is a response message processed by the response consumer
+	SourceResponse = "response"
+	// SourceSweeper is the timeout of a run by the sweeper
+	SourceSweeper = "sweeper"
+	// SourceCancel is a cancel of the run sent to its recipient
+	SourceCancel = "cancel"
+)
+
+// Transition is a change of the status of a run to be recorded in its history
+type Transition struct {
+	RunID uuid.UUID
+	// empty for the status the run was created with
+	Previous string
+	Status   string
+	Source   string
+	// ID of the message or request that caused the transition, if any
+	SourceEventID string
+}
+
+// Record stores the transitions in the history of the runs (run_events) as part of the transaction of tx.
+// Transitions that leave the status unchanged are skipped.
+func Record(tx *gorm.DB, transitions ...Transition) error {
+	events := make([]dbModel.RunEvent, 0, len(transitions))
+
+	for _, transition := range transitions {
+		if transition.Previous == transition.Status {
+			continue
+		}
+
+		event := dbModel.RunEvent{
+			RunID:  transition.RunID,
+			Status: transition.Status,
+			Source: transition.Source,
+		}
+
+		if transition.Previous != "" {
+			event.PreviousStatus = utils.StringRef(transition.Previous)
+		}
+
+		if transition.SourceEventID != "" {
+			event.SourceEventID = utils.StringRef(transition.SourceEventID)
+		}
+
+		events = append(events, event)
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	return tx.Create(&events).Error
+}
@@ -0,0 +1,52 @@
+// Package runevents notifies the API of the changes other processes (the response consumer) make to runs so that the
+// API can stream them to clients without polling.
+//
+// Changes are sent using PostgreSQL NOTIFY as part of the transaction that makes them and are therefore only delivered
+// once it commits. Every API instance listens on the channel using a dedicated connection (see Broker.Listen).
+// Notifications are not persisted: changes made while an instance is not listening are not delivered to it.
+//
+// Transitions of the status of runs are additionally recorded in the run_events table (see Record), from which the
+// API serves the history of a run.
+package runevents
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Channel is the PostgreSQL notification channel changes are sent on
+const Channel = "playbook_dispatcher_run_events"
+
+const (
+	// TypeRun is the type of changes of the status of a run
+	TypeRun = "run"
+	// TypeHost is the type of changes of the status of a host of a run
+	TypeHost = "host"
+)
+
+// Change is a change of the status of a run or of one of its hosts
+type Change struct {
+	Type  string    `json:"type"`
+	RunID uuid.UUID `json:"run_id"`
+	// name of the host (inventory ID of Satellite hosts) for TypeHost
+	Host   string `json:"host,omitempty"`
+	Status string `json:"status"`
+}
+
+// Publish sends the changes to the listening API instances once the transaction of tx commits
+func Publish(tx *gorm.DB, changes ...Change) error {
+	for _, change := range changes {
+		payload, err := json.Marshal(change)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Exec("SELECT pg_notify(?, ?)", Channel, string(payload)).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
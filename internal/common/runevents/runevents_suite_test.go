@@ -0,0 +1,13 @@
+package runevents
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRunEvents(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Run Events Suite")
+}
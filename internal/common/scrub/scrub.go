@@ -0,0 +1,178 @@
+// Package scrub redacts secrets (passwords, tokens, keys) from playbook output before it is stored.
+//
+// Secrets are found using regular expressions (a set of built-in rules extended by stdout.scrub.patterns) and,
+// optionally, by looking for words whose Shannon entropy suggests they are randomly generated.
+package scrub
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	Redacted = "[REDACTED]"
+
+	RuleCustom  = "custom"
+	RuleEntropy = "entropy"
+
+	// the part of a match to redact, the whole match is redacted if a rule has no such group
+	secretGroup = "secret"
+)
+
+type rule struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+var builtinRules = []rule{
+	{"assignment", regexp.MustCompile(`(?i)(?:password|passwd|pwd|secret|token|api[_-]?key|access[_-]?key|private[_-]?key)["']?\s*[:=]\s*["']?(?P<secret>[^\s"',;]+)`)},
+	{"bearer", regexp.MustCompile(`(?i)\bbearer\s+(?P<secret>[A-Za-z0-9\-._~+/]+=*)`)},
+	{"private_key", regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)},
+	{"jwt", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`)},
+	{"aws_access_key", regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"github_token", regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`)},
+}
+
+// candidates for the entropy check: words made of the characters found in base64 or hex encoded secrets
+var wordPattern = regexp.MustCompile(`[A-Za-z0-9+/=_\-]+`)
+
+// Scrubber redacts secrets from text. Create using NewScrubber.
+type Scrubber struct {
+	enabled          bool
+	rules            []rule
+	entropyThreshold float64
+	entropyMinLength int
+	disabledServices map[string]bool
+}
+
+// NewScrubber builds a scrubber from stdout.scrub.* configuration.
+// Custom patterns (stdout.scrub.patterns) are separated by newlines as they may contain any other character.
+func NewScrubber(cfg *viper.Viper) (*Scrubber, error) {
+	result := &Scrubber{
+		enabled:          cfg.GetBool("stdout.scrub.enabled"),
+		rules:            append([]rule{}, builtinRules...),
+		entropyThreshold: cfg.GetFloat64("stdout.scrub.entropy.threshold"),
+		entropyMinLength: cfg.GetInt("stdout.scrub.entropy.min.length"),
+		disabledServices: map[string]bool{},
+	}
+
+	for _, pattern := range strings.Split(cfg.GetString("stdout.scrub.patterns"), "\n") {
+		if pattern = strings.TrimSpace(pattern); pattern == "" {
+			continue
+		}
+
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern in stdout.scrub.patterns: %w", err)
+		}
+
+		result.rules = append(result.rules, rule{name: RuleCustom, pattern: compiled})
+	}
+
+	for _, service := range strings.Split(cfg.GetString("stdout.scrub.disabled.services"), ",") {
+		if service = strings.TrimSpace(service); service != "" {
+			result.disabledServices[service] = true
+		}
+	}
+
+	return result, nil
+}
+
+// Applies tells whether output of runs dispatched by the given service should be scrubbed
+func (this *Scrubber) Applies(service string) bool {
+	return this.enabled && !this.disabledServices[service]
+}
+
+// Scrub returns the text with secrets replaced by Redacted along with the number of redactions per rule
+func (this *Scrubber) Scrub(text string) (string, map[string]int) {
+	counts := map[string]int{}
+
+	for _, rule := range this.rules {
+		text = redact(text, rule.pattern, func() { counts[rule.name]++ })
+	}
+
+	if this.entropyThreshold > 0 {
+		text = wordPattern.ReplaceAllStringFunc(text, func(word string) string {
+			if !this.looksRandom(word) {
+				return word
+			}
+
+			counts[RuleEntropy]++
+			return Redacted
+		})
+	}
+
+	return text, counts
+}
+
+func redact(text string, pattern *regexp.Regexp, matched func()) string {
+	group := pattern.SubexpIndex(secretGroup)
+
+	var result strings.Builder
+	last := 0
+
+	for _, match := range pattern.FindAllStringSubmatchIndex(text, -1) {
+		start, end := match[0], match[1]
+		if group > 0 {
+			start, end = match[2*group], match[2*group+1]
+		}
+
+		if start < 0 || text[start:end] == Redacted {
+			continue
+		}
+
+		result.WriteString(text[last:start])
+		result.WriteString(Redacted)
+		last = end
+		matched()
+	}
+
+	if last == 0 {
+		return text
+	}
+
+	result.WriteString(text[last:])
+	return result.String()
+}
+
+// a word is considered random if it is long enough, mixes letters of both cases with digits and has high entropy;
+// this leaves out e.g. paths, identifiers, UUIDs and hex encoded checksums
+func (this *Scrubber) looksRandom(word string) bool {
+	if len(word) < this.entropyMinLength {
+		return false
+	}
+
+	var upper, lower, digit bool
+	for _, c := range word {
+		switch {
+		case c >= 'A' && c <= 'Z':
+			upper = true
+		case c >= 'a' && c <= 'z':
+			lower = true
+		case c >= '0' && c <= '9':
+			digit = true
+		}
+	}
+
+	return upper && lower && digit && entropy(word) >= this.entropyThreshold
+}
+
+// Shannon entropy in bits per character
+func entropy(value string) (result float64) {
+	frequencies := map[rune]int{}
+	for _, c := range value {
+		frequencies[c]++
+	}
+
+	length := float64(len(value))
+	for _, count := range frequencies {
+		p := float64(count) / length
+		result -= p * math.Log2(p)
+	}
+
+	return
+}
@@ -0,0 +1,67 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/spf13/viper"
+)
+
+const (
+	awsStageCurrent  = "AWSCURRENT"
+	awsStagePrevious = "AWSPREVIOUS"
+)
+
+// reads the secret from AWS Secrets Manager using the default credential chain (e.g. the pod's service account role)
+type awsProvider struct {
+	client   *secretsmanager.SecretsManager
+	secretId string
+}
+
+func newAwsProvider(cfg *viper.Viper) provider {
+	awsSession := session.Must(session.NewSession(aws.NewConfig().WithRegion(cfg.GetString("secrets.aws.region"))))
+
+	return &awsProvider{
+		client:   secretsmanager.New(awsSession),
+		secretId: cfg.GetString("secrets.aws.secret.id"),
+	}
+}
+
+func (this *awsProvider) fetch(ctx context.Context) (current, previous map[string]string, err error) {
+	if current, err = this.fetchStage(ctx, awsStageCurrent); err != nil {
+		return nil, nil, err
+	}
+
+	previous, err = this.fetchStage(ctx, awsStagePrevious)
+
+	// the previous version only exists once the secret has been rotated
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) && awsErr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+		return current, nil, nil
+	}
+
+	return current, previous, err
+}
+
+func (this *awsProvider) fetchStage(ctx context.Context, stage string) (map[string]string, error) {
+	output, err := this.client.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId:     aws.String(this.secretId),
+		VersionStage: aws.String(stage),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(aws.StringValue(output.SecretString)), &values); err != nil {
+		return nil, err
+	}
+
+	return stringValues(values), nil
+}
@@ -0,0 +1,150 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"playbook-dispatcher/internal/common/config"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// keys of the secret with this prefix hold pre-shared keys of internal API principals (e.g. psk.remediations),
+// all other keys are configuration keys (e.g. db.password)
+const pskPrefix = "psk."
+
+// provider reads a secret stored as a JSON object of string values
+type provider interface {
+	// fetch returns the current version of the secret and the previous one (nil if there is none)
+	fetch(ctx context.Context) (current, previous map[string]string, err error)
+}
+
+var (
+	lock     sync.RWMutex
+	current  map[string]string
+	previous map[string]string
+	stop     context.CancelFunc
+)
+
+// Initialize loads the secret from the configured secret manager and keeps refreshing it in the background.
+// Configuration values found in the secret are made available through config.Get (the caller needs to get the configuration again),
+// changes of these are propagated using config.Reload.
+func Initialize(cfg *viper.Viper, log *zap.SugaredLogger) error {
+	var secretProvider provider
+
+	switch cfg.GetString("secrets.provider") {
+	case "":
+		return nil
+	case "aws":
+		secretProvider = newAwsProvider(cfg)
+	case "vault":
+		secretProvider = newVaultProvider(cfg)
+	default:
+		return fmt.Errorf("Unknown secrets provider %s", cfg.GetString("secrets.provider"))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stop = cancel
+
+	if _, err := refresh(ctx, secretProvider); err != nil {
+		return fmt.Errorf("failed to load secrets: %w", err)
+	}
+
+	log.Infow("Secrets loaded", "provider", cfg.GetString("secrets.provider"))
+
+	go func() {
+		ticker := time.NewTicker(cfg.GetDuration("secrets.refresh.interval") * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				changed, err := refresh(ctx, secretProvider)
+				if err != nil {
+					log.Errorw("Error refreshing secrets", "error", err)
+				} else if changed {
+					log.Info("Secrets changed, reloading configuration")
+					config.Reload(log)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Close stops refreshing secrets
+func Close() {
+	if stop != nil {
+		stop()
+	}
+}
+
+// GetPskKeys returns the pre-shared keys loaded from the secret manager by principal.
+// While a key is being rotated both the current and the previous key of the principal are valid.
+func GetPskKeys() map[string][]string {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	result := map[string][]string{}
+
+	for _, version := range []map[string]string{current, previous} {
+		for key, value := range version {
+			if strings.HasPrefix(key, pskPrefix) && value != "" {
+				principal := strings.ToLower(strings.TrimPrefix(key, pskPrefix))
+				result[principal] = append(result[principal], value)
+			}
+		}
+	}
+
+	return result
+}
+
+func refresh(ctx context.Context, secretProvider provider) (changed bool, err error) {
+	newCurrent, newPrevious, err := secretProvider.fetch(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if reflect.DeepEqual(current, newCurrent) && reflect.DeepEqual(previous, newPrevious) {
+		return false, nil
+	}
+
+	current, previous = newCurrent, newPrevious
+	config.SetSecrets(configValues(current))
+
+	return true, nil
+}
+
+func configValues(secret map[string]string) map[string]string {
+	result := map[string]string{}
+
+	for key, value := range secret {
+		if !strings.HasPrefix(key, pskPrefix) {
+			result[key] = value
+		}
+	}
+
+	return result
+}
+
+// secret values are expected to be strings, anything else is used in its JSON-decoded form
+func stringValues(values map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(values))
+
+	for key, value := range values {
+		result[key] = fmt.Sprint(value)
+	}
+
+	return result
+}
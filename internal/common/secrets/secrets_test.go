@@ -0,0 +1,143 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"playbook-dispatcher/internal/common/config"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type staticProvider struct {
+	current, previous map[string]string
+}
+
+func (this *staticProvider) fetch(ctx context.Context) (map[string]string, map[string]string, error) {
+	return this.current, this.previous, nil
+}
+
+func reset() {
+	current, previous = nil, nil
+	config.SetSecrets(nil)
+}
+
+func TestInitialize_Disabled(t *testing.T) {
+	err := Initialize(viper.New(), zap.NewNop().Sugar())
+	assert.NoError(t, err)
+	assert.Empty(t, GetPskKeys())
+}
+
+func TestInitialize_UnknownProvider(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("secrets.provider", "keepass")
+
+	err := Initialize(cfg, zap.NewNop().Sugar())
+	assert.Error(t, err)
+}
+
+func TestRefresh_ConfigValues(t *testing.T) {
+	defer reset()
+
+	changed, err := refresh(context.Background(), &staticProvider{
+		current: map[string]string{"db.password": "secret", "psk.remediations": "key"},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "secret", config.Get().GetString("db.password"))
+	assert.Empty(t, config.Get().GetString("psk.remediations"))
+}
+
+func TestRefresh_Unchanged(t *testing.T) {
+	defer reset()
+
+	provider := &staticProvider{current: map[string]string{"db.password": "secret"}}
+
+	_, err := refresh(context.Background(), provider)
+	require.NoError(t, err)
+
+	changed, err := refresh(context.Background(), provider)
+	require.NoError(t, err)
+	assert.False(t, changed)
+}
+
+func TestGetPskKeys_Rotation(t *testing.T) {
+	defer reset()
+
+	_, err := refresh(context.Background(), &staticProvider{
+		current:  map[string]string{"psk.Remediations": "new", "psk.config-manager": "key"},
+		previous: map[string]string{"psk.Remediations": "old"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"remediations":   {"new", "old"},
+		"config-manager": {"key"},
+	}, GetPskKeys())
+}
+
+func TestVaultProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/secret/data/playbook-dispatcher", r.URL.Path)
+		assert.Equal(t, "token", r.Header.Get("X-Vault-Token"))
+
+		switch r.URL.Query().Get("version") {
+		case "":
+			fmt.Fprint(w, `{"data": {"data": {"psk.remediations": "new", "db.port": 5432}, "metadata": {"version": 2}}}`)
+		case "1":
+			fmt.Fprint(w, `{"data": {"data": {"psk.remediations": "old"}, "metadata": {"version": 1}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := viper.New()
+	cfg.Set("secrets.vault.addr", server.URL)
+	cfg.Set("secrets.vault.token", "token")
+	cfg.Set("secrets.vault.mount", "secret")
+	cfg.Set("secrets.vault.path", "playbook-dispatcher")
+	cfg.Set("secrets.vault.timeout", 1)
+
+	current, previous, err := newVaultProvider(cfg).fetch(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"psk.remediations": "new", "db.port": "5432"}, current)
+	assert.Equal(t, map[string]string{"psk.remediations": "old"}, previous)
+}
+
+func TestVaultProvider_FirstVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"data": {"db.password": "secret"}, "metadata": {"version": 1}}}`)
+	}))
+	defer server.Close()
+
+	cfg := viper.New()
+	cfg.Set("secrets.vault.addr", server.URL)
+
+	current, previous, err := newVaultProvider(cfg).fetch(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"db.password": "secret"}, current)
+	assert.Nil(t, previous)
+}
+
+func TestVaultProvider_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	cfg := viper.New()
+	cfg.Set("secrets.vault.addr", server.URL)
+
+	_, _, err := newVaultProvider(cfg).fetch(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,99 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// reads the secret from a Vault KV version 2 secrets engine
+type vaultProvider struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+type vaultResponse struct {
+	Data struct {
+		Data     map[string]interface{} `json:"data"`
+		Metadata struct {
+			Version int `json:"version"`
+		} `json:"metadata"`
+	} `json:"data"`
+}
+
+func newVaultProvider(cfg *viper.Viper) provider {
+	return &vaultProvider{
+		client: &http.Client{Timeout: cfg.GetDuration("secrets.vault.timeout") * time.Second},
+		url: fmt.Sprintf("%s/v1/%s/data/%s",
+			strings.TrimSuffix(cfg.GetString("secrets.vault.addr"), "/"),
+			cfg.GetString("secrets.vault.mount"),
+			cfg.GetString("secrets.vault.path"),
+		),
+		token: cfg.GetString("secrets.vault.token"),
+	}
+}
+
+func (this *vaultProvider) fetch(ctx context.Context) (current, previous map[string]string, err error) {
+	latest, found, err := this.fetchVersion(ctx, 0)
+	if err != nil {
+		return nil, nil, err
+	} else if !found {
+		return nil, nil, fmt.Errorf("secret %s not found", this.url)
+	}
+
+	if version := latest.Data.Metadata.Version; version > 1 {
+		// the previous version may have been deleted in the meantime
+		prior, found, err := this.fetchVersion(ctx, version-1)
+		if err != nil {
+			return nil, nil, err
+		} else if found {
+			previous = stringValues(prior.Data.Data)
+		}
+	}
+
+	return stringValues(latest.Data.Data), previous, nil
+}
+
+// version 0 stands for the latest version
+func (this *vaultProvider) fetchVersion(ctx context.Context, version int) (*vaultResponse, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, this.url, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if version > 0 {
+		req.URL.RawQuery = fmt.Sprintf("version=%d", version)
+	}
+
+	req.Header.Set("X-Vault-Token", this.token)
+
+	res, err := this.client.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	} else if res.StatusCode != http.StatusOK {
+		return nil, false, fmt.Errorf("unexpected status code %d reading secret from vault", res.StatusCode)
+	}
+
+	result := &vaultResponse{}
+	if err := json.NewDecoder(res.Body).Decode(result); err != nil {
+		return nil, false, err
+	}
+
+	// deleted versions are returned with no data
+	if result.Data.Data == nil {
+		return nil, false, nil
+	}
+
+	return result, true, nil
+}
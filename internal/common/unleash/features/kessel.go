@@ -4,6 +4,7 @@ import (
 	"context"
 	"playbook-dispatcher/internal/common/config"
 	"playbook-dispatcher/internal/common/unleash"
+	"strings"
 
 	"github.com/Unleash/unleash-go-sdk/v5/api"
 	ucontext "github.com/Unleash/unleash-go-sdk/v5/context"
@@ -27,6 +28,7 @@ const (
 
 	// Mode selection source values for logging
 	ModeSourceDisabled              = "disabled"
+	ModeSourceOrgNotEnabled         = "org-not-enabled"
 	ModeSourceUnleash               = "unleash"
 	ModeSourceEnvironmentUnleashFallback = "environment-unleash-fallback"
 	ModeSourceEnvironment           = "environment"
@@ -112,7 +114,7 @@ func GetKesselAuthMode(cfg *viper.Viper, log *zap.SugaredLogger) string {
 //	mode := features.GetKesselAuthModeWithContext(ctx, cfg, log)
 //
 // Mode selection priority:
-//  1. If KESSEL_ENABLED=false, always return "rbac-only"
+//  1. If KESSEL_ENABLED=false, or KESSEL_ENABLED_ORGS is set and does not list the org, return "rbac-only"
 //  2. If UNLEASH_ENABLED=true, get mode from Unleash variant with context
 //  3. If Unleash unavailable or variant disabled, use KESSEL_AUTH_MODE environment variable
 //  4. If KESSEL_AUTH_MODE is invalid, return "rbac-only" as safe default
@@ -128,6 +130,15 @@ func GetKesselAuthModeWithContext(ctx context.Context, cfg *viper.Viper, log *za
 		return config.KesselModeRBACOnly
 	}
 
+	// Staged rollout: other tenants keep using RBAC
+	if orgID := identity.GetIdentity(ctx).Identity.OrgID; !isOrgEnabled(cfg, orgID) {
+		log.Debugw("Kessel authorization mode selected",
+			"source", ModeSourceOrgNotEnabled,
+			"org_id", orgID,
+			"mode", config.KesselModeRBACOnly)
+		return config.KesselModeRBACOnly
+	}
+
 	// Compute unleashEnabled once and reuse
 	unleashEnabled := cfg.GetBool("unleash.enabled")
 
@@ -180,6 +191,23 @@ func GetKesselAuthModeWithContext(ctx context.Context, cfg *viper.Viper, log *za
 	return mode
 }
 
+// isOrgEnabled reports whether Kessel authorization may be used for the organization, i.e. whether
+// kessel.enabled_orgs (comma-separated org IDs) is empty or lists it
+func isOrgEnabled(cfg *viper.Viper, orgID string) bool {
+	enabledOrgs := strings.TrimSpace(cfg.GetString("kessel.enabled_orgs"))
+	if enabledOrgs == "" {
+		return true
+	}
+
+	for _, enabledOrg := range strings.Split(enabledOrgs, ",") {
+		if orgID != "" && strings.TrimSpace(enabledOrg) == orgID {
+			return true
+		}
+	}
+
+	return false
+}
+
 // buildUnleashContext extracts organization ID from request context and builds Unleash context
 // This is used for per-org targeting and gradual rollout
 func buildUnleashContext(ctx context.Context, log *zap.SugaredLogger) ucontext.Context {
@@ -178,3 +178,29 @@ func TestGetVariantWithFallback_NilVariant(t *testing.T) {
 	assert.Equal(t, fallbackVariant, variant.Name)
 	assert.True(t, variant.Enabled)
 }
+
+func TestGetKesselAuthModeWithContext_EnabledOrgs(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("kessel.enabled", true)
+	cfg.Set("kessel.auth.mode", config.KesselModeKesselOnly)
+	cfg.Set("kessel.enabled_orgs", "12345, 67890")
+	log := zap.NewNop().Sugar()
+
+	orgContext := func(orgID string) context.Context {
+		return identity.WithIdentity(context.Background(), identity.XRHID{
+			Identity: identity.Identity{Type: "User", OrgID: orgID},
+		})
+	}
+
+	assert.Equal(t, config.KesselModeKesselOnly, GetKesselAuthModeWithContext(orgContext("12345"), cfg, log))
+	assert.Equal(t, config.KesselModeKesselOnly, GetKesselAuthModeWithContext(orgContext("67890"), cfg, log))
+	assert.Equal(t, config.KesselModeRBACOnly, GetKesselAuthModeWithContext(orgContext("11111"), cfg, log))
+	assert.Equal(t, config.KesselModeRBACOnly, GetKesselAuthModeWithContext(context.Background(), cfg, log))
+}
+
+func TestIsOrgEnabled_NoAllowlist(t *testing.T) {
+	cfg := viper.New()
+
+	assert.True(t, isOrgEnabled(cfg, "12345"))
+	assert.True(t, isOrgEnabled(cfg, ""))
+}
@@ -0,0 +1,16 @@
+package features
+
+import (
+	"playbook-dispatcher/internal/common/unleash"
+
+	"github.com/spf13/viper"
+)
+
+// MaintenanceFeatureFlag is the name of the Unleash feature flag that puts the service into read-only maintenance mode
+const MaintenanceFeatureFlag = "playbook-dispatcher-maintenance"
+
+// IsMaintenanceFlagEnabled reports whether maintenance mode is turned on in Unleash.
+// Returns false if Unleash is not enabled.
+func IsMaintenanceFlagEnabled(cfg *viper.Viper) bool {
+	return cfg.GetBool("unleash.enabled") && unleash.IsEnabled(MaintenanceFeatureFlag)
+}
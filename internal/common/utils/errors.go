@@ -9,6 +9,11 @@ type BlocklistedOrgIdError struct {
 	OrgID string
 }
 
+type PlaybookUrlNotAllowedError struct {
+	Service string
+	Url     string
+}
+
 func UnexpectedResponse(res *http.Response) error {
 	return fmt.Errorf(`unexpected status code "%d" or content type "%s"`, res.StatusCode, res.Header.Get("content-type"))
 }
@@ -16,3 +21,7 @@ func UnexpectedResponse(res *http.Response) error {
 func (this *BlocklistedOrgIdError) Error() string {
 	return fmt.Sprintf("This org_id (%s) is blocklisted.", this.OrgID)
 }
+
+func (this *PlaybookUrlNotAllowedError) Error() string {
+	return fmt.Sprintf("The playbook url (%s) is not allowed for service %s.", this.Url, this.Service)
+}
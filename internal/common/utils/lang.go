@@ -42,6 +42,10 @@ func IntRef(value int) *int {
 	return &value
 }
 
+func BoolRef(value bool) *bool {
+	return &value
+}
+
 func UUIDRef(value uuid.UUID) *uuid.UUID {
 	return &value
 }
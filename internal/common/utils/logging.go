@@ -2,8 +2,11 @@ package utils
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"os"
 	"playbook-dispatcher/internal/common/config"
+	"time"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -19,6 +22,7 @@ import (
 var (
 	sugar  *zap.SugaredLogger
 	writer *cloudwatchwriter.CloudWatchWriter
+	level  = zap.NewAtomicLevel()
 )
 
 func GetLoggerOrDie() *zap.SugaredLogger {
@@ -27,12 +31,27 @@ func GetLoggerOrDie() *zap.SugaredLogger {
 
 		logCfg := zap.NewProductionConfig()
 		logCfg.EncoderConfig.MessageKey = "message"
+		logCfg.Level = level
+		logCfg.Sampling = samplingConfig(cfg, "log.sampling")
 
 		err := logCfg.Level.UnmarshalText([]byte(cfg.GetString("log.level")))
 		if err != nil {
 			DieOnError(err)
 		}
 
+		// only follow actual changes of log.level so that a level set via LogLevelHandler survives unrelated reloads
+		configuredLevel := cfg.GetString("log.level")
+		config.OnReload(func(cfg *viper.Viper) {
+			if value := cfg.GetString("log.level"); value != configuredLevel {
+				if err := level.UnmarshalText([]byte(value)); err != nil {
+					sugar.Errorw("Ignoring invalid log level", "level", value, "error", err)
+					return
+				}
+
+				configuredLevel = value
+			}
+		})
+
 		options := []zap.Option{}
 
 		if len(cfg.GetString("log.cw.accessKeyId")) > 0 {
@@ -50,6 +69,39 @@ func GetLoggerOrDie() *zap.SugaredLogger {
 	return sugar
 }
 
+// LogLevelHandler returns a handler that reports (GET) or changes (PUT) the log level at runtime.
+// See zap.AtomicLevel.ServeHTTP for the request/response format.
+func LogLevelHandler() http.Handler {
+	return level
+}
+
+// WithSampling applies the sampling configured under log.sampling.<name> to the logger in the given context.
+// The context is returned unchanged if no sampling is configured for the given name.
+func WithSampling(ctx context.Context, cfg *viper.Viper, name string) context.Context {
+	sampling := samplingConfig(cfg, fmt.Sprintf("log.sampling.%s", name))
+	if sampling == nil {
+		return ctx
+	}
+
+	log := GetLogFromContext(ctx).Desugar().WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return zapcore.NewSamplerWithOptions(core, time.Second, sampling.Initial, sampling.Thereafter)
+	}))
+
+	return SetLog(ctx, log.Sugar())
+}
+
+func samplingConfig(cfg *viper.Viper, prefix string) *zap.SamplingConfig {
+	initial := cfg.GetInt(prefix + ".initial")
+	if initial <= 0 {
+		return nil
+	}
+
+	return &zap.SamplingConfig{
+		Initial:    initial,
+		Thereafter: cfg.GetInt(prefix + ".thereafter"),
+	}
+}
+
 func LogWithRequestId(log *zap.SugaredLogger, value string) *zap.SugaredLogger {
 	return log.With("request_id", value)
 }
@@ -85,7 +137,7 @@ func createCloudwatch(cfg *viper.Viper, level zap.AtomicLevel) (zap.Option, erro
 	cwc := zapcore.NewCore(
 		zapcore.NewJSONEncoder(encoderConfig),
 		zapcore.AddSync(writer),
-		zap.NewAtomicLevelAt(level.Level()),
+		level,
 	)
 
 	cloudwatch := zap.WrapCore(func(core zapcore.Core) zapcore.Core {
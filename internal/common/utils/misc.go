@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"os"
 	"playbook-dispatcher/internal/common/constants"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -134,6 +135,37 @@ func LoadSchemas(cfg *viper.Viper, schemaNames []string) (schemas []*jsonschema.
 	return
 }
 
+// IsPlaybookUrlAllowed checks the playbook URL of a run against the allowlisted URL patterns of the dispatching service
+// (playbook.url.allowlist.service.<service>) or, if the service has none, against playbook.url.allowlist.default.
+// A pattern is a URL in which * matches any sequence of characters. Every URL is allowed unless the allowlist is enabled.
+func IsPlaybookUrlAllowed(cfg *viper.Viper, service, playbookUrl string) bool {
+	if !cfg.GetBool("playbook.url.allowlist.enabled") {
+		return true
+	}
+
+	patterns := cfg.GetString("playbook.url.allowlist.service." + service)
+	if patterns == "" {
+		patterns = cfg.GetString("playbook.url.allowlist.default")
+	}
+
+	for _, pattern := range strings.Split(patterns, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" && matchUrlPattern(pattern, playbookUrl) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchUrlPattern(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$").MatchString(value)
+}
+
 func IsOrgIdBlocklisted(cfg *viper.Viper, orgId string) bool {
 	blocklistedOrgIds := strings.Split(cfg.GetString("blocklist.org.ids"), ",")
 	for _, blockedOrgId := range blocklistedOrgIds {
@@ -1,22 +1,103 @@
 package utils
 
 import (
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
 
+const defaultDependencyCacheTTL = 10 * time.Second
+
 type ProbeHandler struct {
 	fns []func() error
+
+	// how long the result of a dependency check is reused by Report
+	CacheTTL time.Duration
+
+	lock         sync.Mutex
+	dependencies []*dependency
+	shuttingDown atomic.Bool
+}
+
+type dependency struct {
+	name     string
+	critical bool
+	fn       func() error
+	last     *DependencyStatus
+}
+
+type DependencyStatus struct {
+	Status     string    `json:"status"`
+	Critical   bool      `json:"critical"`
+	Error      string    `json:"error,omitempty"`
+	CheckedAt  time.Time `json:"checked_at"`
+	DurationMs int64     `json:"duration_ms"`
+}
+
+type DependencyReport struct {
+	Status       string                      `json:"status"`
+	Dependencies map[string]DependencyStatus `json:"dependencies"`
+}
+
+const (
+	DependencyStatusOk       = "ok"
+	DependencyStatusDegraded = "degraded"
+	DependencyStatusFailing  = "failing"
+)
+
+// ErrDegraded marks a dependency that is available with reduced functionality, see Degraded
+var ErrDegraded = errors.New("degraded")
+
+// Degraded is returned by a dependency check to report the dependency as degraded rather than failing.
+// A degraded critical dependency does not fail Check.
+func Degraded(err error) error {
+	return fmt.Errorf("%w: %w", ErrDegraded, err)
 }
 
 func (this *ProbeHandler) Register(callback func() error) {
 	this.fns = append(this.fns, callback)
 }
 
+// RegisterDependency registers a named check that is reported by Report.
+// Critical dependencies are also part of Check, non-critical ones only show up in the report.
+// Modules running within the same process share the handler, so names of dependencies specific to a module are prefixed
+// with the module (e.g. validator.kafka). Registering a name twice is an error.
+func (this *ProbeHandler) RegisterDependency(name string, critical bool, callback func() error) error {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	for _, dependency := range this.dependencies {
+		if dependency.name == name {
+			return fmt.Errorf("dependency %s already registered", name)
+		}
+	}
+
+	if critical {
+		this.Register(callback)
+	}
+
+	this.dependencies = append(this.dependencies, &dependency{name: name, critical: critical, fn: callback})
+	return nil
+}
+
+// SetShuttingDown makes Check fail so that no new traffic is routed to the instance while it drains
+func (this *ProbeHandler) SetShuttingDown() {
+	this.shuttingDown.Store(true)
+}
+
 func (this *ProbeHandler) Check(ctx echo.Context) error {
+	if this.shuttingDown.Load() {
+		return ctx.String(http.StatusServiceUnavailable, "shutting down")
+	}
+
 	for _, fn := range this.fns {
-		if err := fn(); err != nil {
+		if err := fn(); err != nil && !errors.Is(err, ErrDegraded) {
 			GetLogFromEcho(ctx).Error(err)
 			return ctx.String(http.StatusInternalServerError, err.Error())
 		}
@@ -24,3 +105,131 @@ func (this *ProbeHandler) Check(ctx echo.Context) error {
 
 	return ctx.NoContent(http.StatusOK)
 }
+
+// Report responds with the status of each registered dependency.
+// Checks are run concurrently and their results are cached for CacheTTL.
+func (this *ProbeHandler) Report(ctx echo.Context) error {
+	report := this.GetReport()
+
+	if report.Status == DependencyStatusFailing {
+		return ctx.JSON(http.StatusServiceUnavailable, report)
+	}
+
+	return ctx.JSON(http.StatusOK, report)
+}
+
+// SetCacheTTL changes CacheTTL of a handler that is already serving requests
+func (this *ProbeHandler) SetCacheTTL(ttl time.Duration) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.CacheTTL = ttl
+}
+
+func (this *ProbeHandler) GetReport() DependencyReport {
+	this.lock.Lock()
+	ttl := this.CacheTTL
+	dependencies := make([]*dependency, len(this.dependencies))
+	copy(dependencies, this.dependencies)
+	this.lock.Unlock()
+
+	if ttl == 0 {
+		ttl = defaultDependencyCacheTTL
+	}
+
+	results := make([]DependencyStatus, len(dependencies))
+	var wg sync.WaitGroup
+
+	for i, dep := range dependencies {
+		this.lock.Lock()
+		last := dep.last
+		this.lock.Unlock()
+
+		if last != nil && time.Since(last.CheckedAt) < ttl {
+			results[i] = *last
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, dep *dependency) {
+			defer wg.Done()
+
+			start := time.Now()
+			err := dep.fn()
+
+			status := DependencyStatus{
+				Status:     DependencyStatusOk,
+				Critical:   dep.critical,
+				CheckedAt:  start,
+				DurationMs: time.Since(start).Milliseconds(),
+			}
+
+			if err != nil {
+				status.Status = DependencyStatusFailing
+				status.Error = err.Error()
+
+				if errors.Is(err, ErrDegraded) {
+					status.Status = DependencyStatusDegraded
+				}
+			}
+
+			this.lock.Lock()
+			dep.last = &status
+			this.lock.Unlock()
+
+			results[i] = status
+		}(i, dep)
+	}
+
+	wg.Wait()
+
+	report := DependencyReport{
+		Status:       DependencyStatusOk,
+		Dependencies: map[string]DependencyStatus{},
+	}
+
+	for i, dep := range dependencies {
+		report.Dependencies[dep.name] = results[i]
+
+		if results[i].Status != DependencyStatusOk {
+			if dep.critical && results[i].Status == DependencyStatusFailing {
+				report.Status = DependencyStatusFailing
+			} else if report.Status == DependencyStatusOk {
+				report.Status = DependencyStatusDegraded
+			}
+		}
+	}
+
+	return report
+}
+
+// HttpReachable returns a check that considers the given HTTP service available if it responds with a non-5xx status code
+func HttpReachable(url string, timeout time.Duration) func() error {
+	client := &http.Client{Timeout: timeout}
+
+	return func() error {
+		resp, err := client.Get(url)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+		}
+
+		return nil
+	}
+}
+
+// TcpReachable returns a check that considers the given service available if a TCP connection can be established
+func TcpReachable(address string, timeout time.Duration) func() error {
+	return func() error {
+		conn, err := net.DialTimeout("tcp", address, timeout)
+		if err != nil {
+			return err
+		}
+
+		return conn.Close()
+	}
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("Probes", func() {
+	Describe("dependencies", func() {
+		var handler *ProbeHandler
+
+		BeforeEach(func() {
+			handler = &ProbeHandler{}
+		})
+
+		It("caches the report", func() {
+			var calls atomic.Int32
+			Expect(handler.RegisterDependency("db", true, func() error {
+				calls.Add(1)
+				return nil
+			})).To(Succeed())
+
+			first := handler.GetReport()
+			second := handler.GetReport()
+
+			Expect(calls.Load()).To(BeEquivalentTo(1))
+			Expect(second.Dependencies["db"].CheckedAt).To(Equal(first.Dependencies["db"].CheckedAt))
+
+			handler.SetCacheTTL(time.Nanosecond)
+			time.Sleep(time.Millisecond)
+			handler.GetReport()
+
+			Expect(calls.Load()).To(BeEquivalentTo(2))
+		})
+
+		It("reports a failing critical dependency as failing", func() {
+			Expect(handler.RegisterDependency("db", true, func() error { return errors.New("down") })).To(Succeed())
+			Expect(handler.RegisterDependency("sources", false, func() error { return nil })).To(Succeed())
+
+			report := handler.GetReport()
+			Expect(report.Status).To(Equal(DependencyStatusFailing))
+			Expect(report.Dependencies["db"].Error).To(Equal("down"))
+			Expect(report.Dependencies["sources"].Status).To(Equal(DependencyStatusOk))
+		})
+
+		It("reports failing non-critical and degraded dependencies as degraded", func() {
+			Expect(handler.RegisterDependency("sources", false, func() error { return errors.New("down") })).To(Succeed())
+			Expect(handler.RegisterDependency("kessel", true, func() error { return Degraded(errors.New("circuit open")) })).To(Succeed())
+
+			report := handler.GetReport()
+			Expect(report.Status).To(Equal(DependencyStatusDegraded))
+			Expect(report.Dependencies["kessel"].Status).To(Equal(DependencyStatusDegraded))
+		})
+
+		It("rejects a dependency registered twice", func() {
+			Expect(handler.RegisterDependency("kafka", false, func() error { return nil })).To(Succeed())
+			Expect(handler.RegisterDependency("kafka", true, func() error { return nil })).ToNot(Succeed())
+
+			Expect(handler.GetReport().Dependencies["kafka"].Critical).To(BeFalse())
+		})
+
+		It("fails the check on a failing critical dependency only", func() {
+			check := func() int {
+				rec := httptest.NewRecorder()
+				req := httptest.NewRequest(http.MethodGet, "/ready", nil)
+				req = req.WithContext(SetLog(req.Context(), zap.NewNop().Sugar()))
+				ctx := echo.New().NewContext(req, rec)
+				Expect(handler.Check(ctx)).To(Succeed())
+				return rec.Code
+			}
+
+			Expect(handler.RegisterDependency("sources", false, func() error { return errors.New("down") })).To(Succeed())
+			Expect(check()).To(Equal(http.StatusOK))
+
+			Expect(handler.RegisterDependency("db", true, func() error { return errors.New("down") })).To(Succeed())
+			Expect(check()).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Describe("HttpReachable", func() {
+		var (
+			server *httptest.Server
+			status int
+		)
+
+		BeforeEach(func() {
+			status = http.StatusOK
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(status)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("considers a service responding with a non-5xx status reachable", func() {
+			Expect(HttpReachable(server.URL, time.Second)()).To(Succeed())
+
+			status = http.StatusUnauthorized
+			Expect(HttpReachable(server.URL, time.Second)()).To(Succeed())
+		})
+
+		It("fails on a 5xx status", func() {
+			status = http.StatusServiceUnavailable
+			Expect(HttpReachable(server.URL, time.Second)()).To(MatchError("unexpected status code 503"))
+		})
+
+		It("fails if the service is not reachable", func() {
+			url := server.URL
+			server.Close()
+
+			Expect(HttpReachable(url, time.Second)()).ToNot(Succeed())
+		})
+	})
+
+	Describe("TcpReachable", func() {
+		It("connects to a listening service", func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+			defer listener.Close()
+
+			Expect(TcpReachable(listener.Addr().String(), time.Second)()).To(Succeed())
+		})
+
+		It("fails if nothing listens on the address", func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+			address := listener.Addr().String()
+			listener.Close()
+
+			Expect(TcpReachable(address, time.Second)()).ToNot(Succeed())
+		})
+	})
+})
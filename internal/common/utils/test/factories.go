@@ -4,6 +4,7 @@ import (
 	dbModel "playbook-dispatcher/internal/common/model/db"
 	messageModel "playbook-dispatcher/internal/common/model/message"
 	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
 
 	"github.com/google/uuid"
 )
@@ -92,7 +93,7 @@ func NewRunWithStatus(orgId string, status string) dbModel.Run {
 }
 
 func NewRun(orgId string) dbModel.Run {
-	return NewRunWithStatus(orgId, "running")
+	return NewRunWithStatus(orgId, string(status.Running))
 }
 
 func NewRunsWithLocalhost(org_id string, n int) []dbModel.Run {
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"playbook-dispatcher/internal/common/config"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// NewTimeoutHttpRequestDoer returns a doer with the timeout (in seconds) configured under the given key.
+// Unlike http.Client.Timeout, the timeout follows changes of the key on config reload.
+func NewTimeoutHttpRequestDoer(cfg *viper.Viper, key string) HttpRequestDoer {
+	doer := &timeoutHttpRequestDoer{}
+	doer.timeout.Store(int64(cfg.GetDuration(key) * time.Second))
+
+	config.OnReload(func(cfg *viper.Viper) {
+		doer.timeout.Store(int64(cfg.GetDuration(key) * time.Second))
+	})
+
+	return doer
+}
+
+type timeoutHttpRequestDoer struct {
+	client  http.Client
+	timeout atomic.Int64
+}
+
+func (this *timeoutHttpRequestDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), time.Duration(this.timeout.Load()))
+
+	resp, err := this.client.Do(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// as with http.Client.Timeout the timeout covers reading the body
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (this *cancelOnClose) Close() error {
+	defer this.cancel()
+	return this.ReadCloser.Close()
+}
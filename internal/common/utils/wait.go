@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// WaitForDependency calls check until it succeeds, backing off exponentially between attempts.
+// It gives up once startup.wait.timeout seconds have passed (0 fails on the first error).
+func WaitForDependency(ctx context.Context, cfg *viper.Viper, name string, check func() error) error {
+	log := GetLogFromContext(ctx)
+
+	deadline := time.Now().Add(cfg.GetDuration("startup.wait.timeout") * time.Second)
+	backoff := cfg.GetDuration("startup.wait.backoff.initial.ms") * time.Millisecond
+	maxBackoff := cfg.GetDuration("startup.wait.backoff.max.ms") * time.Millisecond
+
+	for attempt := 1; ; attempt++ {
+		err := check()
+		if err == nil {
+			if attempt > 1 {
+				log.Infow("Dependency available", "dependency", name, "attempts", attempt)
+			}
+
+			return nil
+		}
+
+		if time.Now().Add(backoff).After(deadline) {
+			return fmt.Errorf("%s not available after %d attempts: %w", name, attempt, err)
+		}
+
+		log.Warnw("Dependency not available yet", "dependency", name, "attempt", attempt, "retry_in", backoff.String(), "error", err)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	endpointActive     = "active"
+	endpointDeadLetter = "dead_letter"
+)
+
+// ErrEndpointDeadLetter is returned by attempts that were not made because the endpoint of the subscription kept failing
+var ErrEndpointDeadLetter = errors.New("webhook endpoint is dead-lettered")
+
+// checkEndpoint fails if the endpoint of the subscription is dead-lettered, unless the attempt is the single probe
+// allowed per retry interval.
+func (this *Deliverer) checkEndpoint(ctx context.Context, id string) (probe bool, err error) {
+	if this.db == nil || this.deadLetterFailures <= 0 {
+		return false, nil
+	}
+
+	var subscription dbModel.WebhookSubscription
+	result := this.db.WithContext(ctx).Where("id = ?", id).Limit(1).Find(&subscription)
+	if result.Error != nil {
+		return false, result.Error
+	} else if result.RowsAffected == 0 || subscription.State != endpointDeadLetter {
+		return false, nil
+	}
+
+	if this.now().Sub(subscription.UpdatedAt) < this.deadLetterRetryInterval {
+		return false, ErrEndpointDeadLetter
+	}
+
+	// concurrent attempts race for the probe
+	result = this.db.WithContext(ctx).Model(&dbModel.WebhookSubscription{}).
+		Where("id = ? AND updated_at = ?", id, subscription.UpdatedAt).
+		UpdateColumn("updated_at", this.now())
+
+	if result.Error != nil {
+		return false, result.Error
+	} else if result.RowsAffected != 1 {
+		return false, ErrEndpointDeadLetter
+	}
+
+	return true, nil
+}
+
+// recordOutcome counts the attempts that failed in a row and dead-letters the endpoint once they reach the threshold.
+// A successful attempt makes the endpoint active again.
+func (this *Deliverer) recordOutcome(ctx context.Context, id string, probe bool, attemptErr error) {
+	if this.db == nil || this.deadLetterFailures <= 0 {
+		return
+	}
+
+	log := utils.GetLogFromContext(ctx).With("subscription", id)
+
+	if attemptErr == nil {
+		err := this.db.WithContext(ctx).Model(&dbModel.WebhookSubscription{}).
+			Where("id = ? AND (failures > 0 OR state != ?)", id, endpointActive).
+			UpdateColumns(map[string]interface{}{"state": endpointActive, "failures": 0, "last_error": nil, "updated_at": this.now()}).Error
+
+		if err != nil {
+			log.Errorw("Error resetting webhook endpoint failures", "error", err)
+		} else if probe {
+			log.Infow("Webhook endpoint recovered")
+		}
+
+		return
+	}
+
+	lastError := attemptErr.Error()
+	state := endpointActive
+	if this.deadLetterFailures <= 1 {
+		state = endpointDeadLetter
+	}
+
+	subscription := dbModel.WebhookSubscription{ID: id, State: state, Failures: 1, LastError: &lastError, UpdatedAt: this.now()}
+	err := this.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"failures":   gorm.Expr("webhook_subscriptions.failures + 1"),
+			"state":      gorm.Expr("CASE WHEN webhook_subscriptions.failures + 1 >= ? THEN ? ELSE webhook_subscriptions.state END", this.deadLetterFailures, endpointDeadLetter),
+			"last_error": lastError,
+			"updated_at": subscription.UpdatedAt,
+		}),
+	}, clause.Returning{Columns: []clause.Column{{Name: "state"}, {Name: "failures"}}}).Create(&subscription).Error
+
+	if err != nil {
+		log.Errorw("Error recording webhook endpoint failure", "error", err)
+	} else if subscription.State == endpointDeadLetter && subscription.Failures == this.deadLetterFailures {
+		log.Warnw("Webhook endpoint dead-lettered", "failures", subscription.Failures, "error", lastError)
+	}
+}
@@ -0,0 +1,197 @@
+// Package webhook delivers signed HTTP notifications to subscriber endpoints.
+//
+// Every delivery carries a signature header of the form
+//
+//	X-Dispatcher-Signature: t=<unix timestamp>,v1=<hex HMAC-SHA256 of "<timestamp>.<body>">
+//
+// computed with the secret of the subscription. Receivers should recompute the HMAC and reject deliveries
+// whose timestamp is too far off to prevent replays, pkg/webhook does both for receivers written in Go.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+const (
+	HeaderSignature = "X-Dispatcher-Signature"
+	HeaderDelivery  = "X-Dispatcher-Delivery"
+
+	signatureVersion = "v1"
+)
+
+type State string
+
+const (
+	StateDelivered  State = "delivered"
+	StateDeadLetter State = "dead_letter"
+)
+
+var deliveryTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "webhook_delivery_total",
+	Help: "The total number of webhook delivery attempts by result",
+}, []string{"result"})
+
+type Subscription struct {
+	Id     string
+	URL    string
+	Secret []byte
+}
+
+type Delivery struct {
+	// sent with every attempt so that receivers can discard duplicates
+	Id           uuid.UUID
+	Subscription Subscription
+	Body         []byte
+}
+
+type Result struct {
+	State    State
+	Attempts int
+	// the error of the last attempt if the delivery was dead-lettered
+	Err error
+}
+
+// Sign computes the signature header value of a delivery body sent at the given time
+func Sign(secret []byte, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	return fmt.Sprintf("t=%s,%s=%s", ts, signatureVersion, hex.EncodeToString(computeHmac(secret, ts, body)))
+}
+
+func computeHmac(secret []byte, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// Deliverer posts deliveries to subscriber endpoints, retrying failed attempts with exponential backoff.
+// A delivery that still fails after the configured number of attempts, or that the endpoint rejects
+// permanently (4xx other than 408 and 429), ends up in the dead-letter state.
+//
+// Unless db is nil, the deliverer also persists the number of attempts that failed in a row for every subscription.
+// Once it reaches webhook.dead_letter.failures the endpoint itself is dead-lettered and only probed once per
+// webhook.dead_letter.retry.interval until an attempt succeeds again.
+type Deliverer struct {
+	client          *http.Client
+	attempts        int
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	now             func() time.Time
+
+	db                      *gorm.DB
+	deadLetterFailures      int
+	deadLetterRetryInterval time.Duration
+}
+
+func NewDeliverer(cfg *viper.Viper, db *gorm.DB) *Deliverer {
+	return &Deliverer{
+		client:          &http.Client{Timeout: cfg.GetDuration("webhook.timeout") * time.Second},
+		attempts:        cfg.GetInt("webhook.retry.attempts"),
+		initialInterval: cfg.GetDuration("webhook.retry.initial.interval") * time.Second,
+		maxInterval:     cfg.GetDuration("webhook.retry.max.interval") * time.Second,
+		now:             time.Now,
+
+		db:                      db,
+		deadLetterFailures:      cfg.GetInt("webhook.dead_letter.failures"),
+		deadLetterRetryInterval: cfg.GetDuration("webhook.dead_letter.retry.interval") * time.Second,
+	}
+}
+
+func (this *Deliverer) Deliver(ctx context.Context, delivery Delivery) Result {
+	var err error
+
+	for attempt := 1; ; attempt++ {
+		var retryable bool
+		if retryable, err = this.Attempt(ctx, delivery); err == nil {
+			deliveryTotal.WithLabelValues(string(StateDelivered)).Inc()
+			return Result{State: StateDelivered, Attempts: attempt}
+		}
+
+		if !retryable || attempt >= this.attempts {
+			deliveryTotal.WithLabelValues(string(StateDeadLetter)).Inc()
+			return Result{State: StateDeadLetter, Attempts: attempt, Err: err}
+		}
+
+		deliveryTotal.WithLabelValues("retry").Inc()
+
+		select {
+		case <-time.After(this.backoff(attempt)):
+		case <-ctx.Done():
+			deliveryTotal.WithLabelValues(string(StateDeadLetter)).Inc()
+			return Result{State: StateDeadLetter, Attempts: attempt, Err: ctx.Err()}
+		}
+	}
+}
+
+// backoff returns the time to wait after the given (1-based) attempt
+func (this *Deliverer) backoff(attempt int) time.Duration {
+	interval := this.initialInterval
+	for i := 1; i < attempt && interval < this.maxInterval; i++ {
+		interval *= 2
+	}
+
+	return min(interval, this.maxInterval)
+}
+
+// Attempt posts the delivery once, leaving retries to the caller. retryable tells whether a failed attempt may succeed
+// if repeated. Attempts to a dead-lettered endpoint fail with ErrEndpointDeadLetter without being made.
+func (this *Deliverer) Attempt(ctx context.Context, delivery Delivery) (retryable bool, err error) {
+	if len(delivery.Subscription.Secret) == 0 {
+		return false, fmt.Errorf("subscription %s has no secret", delivery.Subscription.Id)
+	}
+
+	probe, err := this.checkEndpoint(ctx, delivery.Subscription.Id)
+	if err != nil {
+		return true, err
+	}
+
+	retryable, err = this.post(ctx, delivery)
+	// cancelled attempts say nothing about the endpoint
+	if ctx.Err() == nil {
+		this.recordOutcome(ctx, delivery.Subscription.Id, probe, err)
+	}
+
+	return retryable, err
+}
+
+func (this *Deliverer) post(ctx context.Context, delivery Delivery) (retryable bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Subscription.URL, bytes.NewReader(delivery.Body))
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderDelivery, delivery.Id.String())
+	// signed on every attempt so that retries are not rejected as replays
+	req.Header.Set(HeaderSignature, Sign(delivery.Subscription.Secret, this.now(), delivery.Body))
+
+	res, err := this.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return false, nil
+	case res.StatusCode == http.StatusRequestTimeout, res.StatusCode == http.StatusTooManyRequests, res.StatusCode >= 500:
+		return true, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	default:
+		return false, fmt.Errorf("delivery rejected with status code %d", res.StatusCode)
+	}
+}
@@ -0,0 +1,145 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+var _ = Describe("Webhook", func() {
+	secret := []byte("secret")
+
+	Describe("signature", func() {
+		It("signs the timestamp and body", func() {
+			signature := Sign(secret, time.Unix(1700000000, 0), []byte(`{"id":1}`))
+			Expect(signature).To(Equal("t=1700000000,v1=3dd1b9aef568d75f6790a84bd2e5dfa1f44409eef3cbdbd3f10b837376100c11"))
+		})
+
+		It("depends on the secret, timestamp and body", func() {
+			timestamp := time.Unix(1700000000, 0)
+			body := []byte(`{"id":1}`)
+			signature := Sign(secret, timestamp, body)
+
+			Expect(Sign([]byte("other"), timestamp, body)).ToNot(Equal(signature))
+			Expect(Sign(secret, timestamp.Add(time.Second), body)).ToNot(Equal(signature))
+			Expect(Sign(secret, timestamp, []byte(`{"id":2}`))).ToNot(Equal(signature))
+		})
+	})
+
+	Describe("delivery", func() {
+		var statuses []int
+		var requests []*http.Request
+		var bodies []string
+		var server *httptest.Server
+		var deliverer *Deliverer
+
+		BeforeEach(func() {
+			statuses = nil
+			requests = nil
+			bodies = nil
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				Expect(err).ToNot(HaveOccurred())
+
+				requests = append(requests, r)
+				bodies = append(bodies, string(body))
+
+				status := http.StatusOK
+				if len(statuses) > 0 {
+					status, statuses = statuses[0], statuses[1:]
+				}
+
+				w.WriteHeader(status)
+			}))
+
+			cfg := viper.New()
+			cfg.Set("webhook.timeout", 1)
+			cfg.Set("webhook.retry.attempts", 3)
+			cfg.Set("webhook.retry.initial.interval", 0)
+			cfg.Set("webhook.retry.max.interval", 0)
+			deliverer = NewDeliverer(cfg, nil)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		newDelivery := func() Delivery {
+			return Delivery{
+				Id:           uuid.New(),
+				Subscription: Subscription{Id: "sub1", URL: server.URL, Secret: secret},
+				Body:         []byte(`{"id":1}`),
+			}
+		}
+
+		It("posts a signed delivery", func() {
+			now := time.Unix(1700000000, 0)
+			deliverer.now = func() time.Time { return now }
+			delivery := newDelivery()
+
+			result := deliverer.Deliver(context.Background(), delivery)
+			Expect(result).To(Equal(Result{State: StateDelivered, Attempts: 1}))
+
+			Expect(requests).To(HaveLen(1))
+			Expect(bodies[0]).To(Equal(`{"id":1}`))
+			Expect(requests[0].Header.Get(HeaderDelivery)).To(Equal(delivery.Id.String()))
+			Expect(requests[0].Header.Get(HeaderSignature)).To(Equal(Sign(secret, now, delivery.Body)))
+		})
+
+		It("retries on server errors", func() {
+			statuses = []int{http.StatusBadGateway, http.StatusTooManyRequests}
+
+			result := deliverer.Deliver(context.Background(), newDelivery())
+			Expect(result.State).To(Equal(StateDelivered))
+			Expect(result.Attempts).To(Equal(3))
+			Expect(requests).To(HaveLen(3))
+		})
+
+		It("dead-letters a delivery failing every attempt", func() {
+			statuses = []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError}
+
+			result := deliverer.Deliver(context.Background(), newDelivery())
+			Expect(result.State).To(Equal(StateDeadLetter))
+			Expect(result.Attempts).To(Equal(3))
+			Expect(result.Err).To(HaveOccurred())
+		})
+
+		It("dead-letters a delivery rejected by the endpoint without retrying", func() {
+			statuses = []int{http.StatusGone}
+
+			result := deliverer.Deliver(context.Background(), newDelivery())
+			Expect(result.State).To(Equal(StateDeadLetter))
+			Expect(result.Attempts).To(Equal(1))
+		})
+
+		It("dead-letters a delivery of a subscription without a secret", func() {
+			delivery := newDelivery()
+			delivery.Subscription.Secret = nil
+
+			result := deliverer.Deliver(context.Background(), delivery)
+			Expect(result.State).To(Equal(StateDeadLetter))
+			Expect(result.Err).To(MatchError("subscription sub1 has no secret"))
+			Expect(requests).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("backoff",
+		func(attempt int, expected time.Duration) {
+			deliverer := &Deliverer{initialInterval: time.Second, maxInterval: 10 * time.Second}
+			Expect(deliverer.backoff(attempt)).To(Equal(expected))
+		},
+
+		Entry("first retry", 1, time.Second),
+		Entry("doubles", 3, 4*time.Second),
+		Entry("capped", 10, 10*time.Second),
+	)
+})
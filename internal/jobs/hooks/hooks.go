@@ -0,0 +1,193 @@
+// Package hooks executes the post-processing hooks services register for their runs once the runs complete, e.g. to
+// notify someone, publish the results to a topic of the service or update an external ticket.
+//
+// Hooks are defined and assigned to services in configuration:
+//
+//	RUN_HOOKS_SERVICES=remediations
+//	RUN_HOOKS_SERVICE_REMEDIATIONS=notify,results
+//	RUN_HOOKS_HOOK_NOTIFY_TYPE=webhook
+//	RUN_HOOKS_HOOK_NOTIFY_URL=https://remediations.example.com/internal/runs/completed
+//	RUN_HOOKS_HOOK_NOTIFY_SECRET=...
+//	RUN_HOOKS_HOOK_RESULTS_TYPE=kafka
+//	RUN_HOOKS_HOOK_RESULTS_TOPIC=platform.remediations.run-results
+//
+// Webhook hooks post the completed run, signed as described in internal/common/webhook, while kafka hooks produce it
+// to the given topic. Every hook of a run is executed at least once.
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"playbook-dispatcher/internal/common/kafka"
+	"playbook-dispatcher/internal/common/webhook"
+
+	k "github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+const (
+	TypeWebhook = "webhook"
+	TypeKafka   = "kafka"
+
+	eventTypeRunCompleted = "run.completed"
+)
+
+// Hook post-processes a completed run
+type Hook interface {
+	Execute(ctx context.Context, run RunCompleted) error
+}
+
+// RunCompleted is the representation of a completed run passed to hooks
+type RunCompleted struct {
+	EventType     string    `json:"event_type"`
+	Id            uuid.UUID `json:"id"`
+	OrgId         string    `json:"org_id"`
+	Service       string    `json:"service"`
+	CorrelationId uuid.UUID `json:"correlation_id"`
+	Recipient     uuid.UUID `json:"recipient"`
+	Status        string    `json:"status"`
+	ExecutionMode string    `json:"execution_mode"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	Hosts         []RunHost `json:"hosts"`
+}
+
+type RunHost struct {
+	Host        string     `json:"host"`
+	Status      string     `json:"status"`
+	InventoryId *uuid.UUID `json:"inventory_id,omitempty"`
+}
+
+// permanentError marks a failure that repeating the execution does not fix
+type permanentError struct {
+	err error
+}
+
+func (this *permanentError) Error() string {
+	return this.err.Error()
+}
+
+func (this *permanentError) Unwrap() error {
+	return this.err
+}
+
+func isPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+type webhookHook struct {
+	name         string
+	deliverer    *webhook.Deliverer
+	subscription webhook.Subscription
+}
+
+func (this *webhookHook) Execute(ctx context.Context, run RunCompleted) error {
+	body, err := json.Marshal(run)
+	if err != nil {
+		return &permanentError{err}
+	}
+
+	// the same for every attempt so that the receiver can discard duplicates
+	id := uuid.NewSHA1(run.Id, []byte(this.name))
+
+	retryable, err := this.deliverer.Attempt(ctx, webhook.Delivery{Id: id, Subscription: this.subscription, Body: body})
+	if err != nil && !retryable {
+		return &permanentError{err}
+	}
+
+	return err
+}
+
+type kafkaHook struct {
+	producer *k.Producer
+	topic    string
+}
+
+func (this *kafkaHook) Execute(ctx context.Context, run RunCompleted) error {
+	return kafka.Produce(this.producer, this.topic, run, run.Id.String(), kafka.Headers("event_type", run.EventType, "service", run.Service)...)
+}
+
+// definitions holds the hooks and the names of the hooks of each service
+type definitions struct {
+	hooks    map[string]Hook
+	services map[string][]string
+}
+
+// definitionsFromConfig reads the hooks of the services listed in run.hooks.services. producer is called for every
+// kafka hook and is expected to return the same instance each time. The state of webhook endpoints is persisted in db
+// unless it is nil.
+func definitionsFromConfig(cfg *viper.Viper, db *gorm.DB, producer func() (*k.Producer, error)) (result definitions, err error) {
+	result = definitions{hooks: map[string]Hook{}, services: map[string][]string{}}
+	deliverer := webhook.NewDeliverer(cfg, db)
+
+	for _, service := range splitList(cfg.GetString("run.hooks.services")) {
+		names := splitList(cfg.GetString("run.hooks.service." + service))
+		if len(names) == 0 {
+			return result, fmt.Errorf("no hooks configured for service %s", service)
+		}
+
+		for _, name := range names {
+			if _, ok := result.hooks[name]; ok {
+				continue
+			}
+
+			key := func(field string) string {
+				return fmt.Sprintf("run.hooks.hook.%s.%s", name, field)
+			}
+
+			switch hookType := cfg.GetString(key("type")); hookType {
+			case TypeWebhook:
+				if cfg.GetString(key("url")) == "" {
+					return result, fmt.Errorf("hook %s has no url", name)
+				} else if cfg.GetString(key("secret")) == "" {
+					return result, fmt.Errorf("hook %s has no secret", name)
+				}
+
+				result.hooks[name] = &webhookHook{
+					name:      name,
+					deliverer: deliverer,
+					subscription: webhook.Subscription{
+						Id:     name,
+						URL:    cfg.GetString(key("url")),
+						Secret: []byte(cfg.GetString(key("secret"))),
+					},
+				}
+			case TypeKafka:
+				if cfg.GetString(key("topic")) == "" {
+					return result, fmt.Errorf("hook %s has no topic", name)
+				}
+
+				instance, err := producer()
+				if err != nil {
+					return result, err
+				}
+
+				result.hooks[name] = &kafkaHook{producer: instance, topic: cfg.GetString(key("topic"))}
+			default:
+				return result, fmt.Errorf("hook %s has unknown type %q", name, hookType)
+			}
+		}
+
+		result.services[service] = names
+	}
+
+	return
+}
+
+func splitList(value string) (result []string) {
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,19 @@
+package hooks
+
+import (
+	"playbook-dispatcher/internal/common/utils/test"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Run Hooks Suite")
+}
+
+var (
+	orgId = test.WithOrgId()
+	db    = test.WithDatabase()
+)
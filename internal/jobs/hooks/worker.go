@@ -0,0 +1,320 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"playbook-dispatcher/internal/common/kafka"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+
+	k "github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	statePending    = "pending"
+	stateDone       = "done"
+	stateDeadLetter = "dead_letter"
+)
+
+var (
+	hookExecutionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "jobs_run_hook_executions_total",
+		Help: "The total number of run hook executions by hook and result",
+	}, []string{"hook", "result"})
+
+	hookExecutionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "jobs_run_hook_execution_duration_seconds",
+		Help:    "Duration of a run hook execution",
+		Buckets: prometheus.ExponentialBuckets(0.01, 4, 8),
+	}, []string{"hook"})
+
+	hookRunsEnqueuedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "jobs_run_hook_runs_enqueued_total",
+		Help: "The total number of completed runs a hook was scheduled for",
+	}, []string{"hook"})
+
+	hookWorkerErrorTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "jobs_run_hook_worker_error_total",
+		Help: "The total number of failed run hook worker cycles",
+	})
+)
+
+// Options configure the execution of hooks
+type Options struct {
+	// runs completed longer ago are not scheduled, finished executions are kept for as long
+	Lookback time.Duration
+	// number of executions processed within one transaction
+	BatchSize int
+	// failed executions are retried with exponential backoff until they have been attempted this many times
+	Attempts        int
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+}
+
+// OptionsFromConfig reads the worker options from configuration
+func OptionsFromConfig(cfg *viper.Viper) Options {
+	return Options{
+		Lookback:        cfg.GetDuration("run.hooks.lookback") * time.Second,
+		BatchSize:       cfg.GetInt("run.hooks.batch.size"),
+		Attempts:        cfg.GetInt("run.hooks.retry.attempts"),
+		InitialInterval: cfg.GetDuration("run.hooks.retry.initial.interval") * time.Second,
+		MaxInterval:     cfg.GetDuration("run.hooks.retry.max.interval") * time.Second,
+	}
+}
+
+// Result describes the outcome of a worker cycle
+type Result struct {
+	Enqueued   int64
+	Done       int
+	Retried    int
+	DeadLetter int
+}
+
+type worker struct {
+	db      *gorm.DB
+	options Options
+	definitions
+}
+
+// Start periodically schedules the hooks of the runs that completed since the last cycle and executes the pending ones.
+// Concurrent workers (one per jobs replica) skip executions locked by each other.
+func Start(ctx context.Context, cfg *viper.Viper, db *gorm.DB, ready *utils.ProbeHandler, wg *sync.WaitGroup) {
+	log := utils.GetLogFromContext(ctx)
+
+	var producer *k.Producer
+	definitions, err := definitionsFromConfig(cfg, db, func() (*k.Producer, error) {
+		if producer != nil {
+			return producer, nil
+		}
+
+		var err error
+		if producer, err = kafka.NewProducer(cfg); err != nil {
+			return nil, err
+		}
+
+		// executions are retried while kafka is unavailable
+		err = ready.RegisterDependency("hooks.kafka", false, func() error {
+			return kafka.Ping(cfg.GetInt("kafka.timeout"), producer)
+		})
+
+		return producer, err
+	})
+	utils.DieOnError(err)
+
+	this := &worker{db: db, options: OptionsFromConfig(cfg), definitions: definitions}
+	ticker := time.NewTicker(cfg.GetDuration("run.hooks.interval") * time.Second)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer ticker.Stop()
+
+		if producer != nil {
+			defer producer.Close()
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := this.run(ctx); err != nil {
+					log.Errorw("Error executing run hooks", "error", err)
+					hookWorkerErrorTotal.Inc()
+				}
+			}
+		}
+	}()
+}
+
+// run schedules the hooks of recently completed runs, executes the pending executions and removes old finished ones
+func (this *worker) run(ctx context.Context) (result Result, err error) {
+	if result.Enqueued, err = this.enqueue(ctx); err != nil {
+		return
+	}
+
+	for {
+		var batch Result
+		var processed int
+
+		if batch, processed, err = this.process(ctx); err != nil {
+			return
+		}
+
+		result.Done += batch.Done
+		result.Retried += batch.Retried
+		result.DeadLetter += batch.DeadLetter
+
+		if processed < this.options.BatchSize {
+			break
+		}
+	}
+
+	err = this.db.WithContext(ctx).
+		Where("state <> ?", statePending).
+		Where("updated_at < ?", time.Now().Add(-this.options.Lookback)).
+		Delete(&dbModel.RunHookExecution{}).Error
+
+	return
+}
+
+// enqueue creates an execution of each hook of the runs of a service that completed within the lookback window.
+// Runs that already have one are skipped.
+func (this *worker) enqueue(ctx context.Context) (enqueued int64, err error) {
+	completed := []string{}
+	for _, value := range status.Values() {
+		if value.IsTerminal() {
+			completed = append(completed, string(value))
+		}
+	}
+
+	since := time.Now().Add(-this.options.Lookback)
+
+	for service, hooks := range this.services {
+		for _, hook := range hooks {
+			result := this.db.WithContext(ctx).Exec(`INSERT INTO run_hook_executions (run_id, hook)
+				SELECT runs.id, ? FROM runs WHERE runs.service = ? AND runs.status IN ? AND runs.updated_at >= ?
+				ON CONFLICT DO NOTHING`, hook, service, completed, since)
+
+			if result.Error != nil {
+				return enqueued, result.Error
+			}
+
+			enqueued += result.RowsAffected
+			hookRunsEnqueuedTotal.WithLabelValues(hook).Add(float64(result.RowsAffected))
+		}
+	}
+
+	return
+}
+
+// process executes a single batch of pending executions and returns the number of executions processed
+func (this *worker) process(ctx context.Context) (result Result, processed int, err error) {
+	log := utils.GetLogFromContext(ctx)
+
+	err = this.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var executions []dbModel.RunHookExecution
+
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("state = ?", statePending).
+			Where("next_attempt_at <= ?", time.Now()).
+			Order("next_attempt_at").
+			Limit(this.options.BatchSize).
+			Find(&executions).Error; err != nil {
+			return err
+		}
+
+		processed = len(executions)
+
+		for _, execution := range executions {
+			execErr := this.execute(ctx, tx, execution)
+			execution.Attempts++
+
+			updates := map[string]interface{}{"attempts": execution.Attempts, "updated_at": time.Now()}
+
+			outcome := "success"
+
+			switch {
+			case execErr == nil:
+				updates["state"] = stateDone
+				result.Done++
+			case isPermanent(execErr) || execution.Attempts >= this.options.Attempts:
+				updates["state"] = stateDeadLetter
+				updates["last_error"] = execErr.Error()
+				outcome = stateDeadLetter
+				result.DeadLetter++
+			default:
+				updates["next_attempt_at"] = time.Now().Add(this.backoff(execution.Attempts))
+				updates["last_error"] = execErr.Error()
+				outcome = "retry"
+				result.Retried++
+			}
+
+			hookExecutionsTotal.WithLabelValues(execution.Hook, outcome).Inc()
+
+			if execErr != nil {
+				log.Warnw("Run hook execution failed", "hook", execution.Hook, "run_id", execution.RunID.String(), "attempt", execution.Attempts, "outcome", outcome, "error", execErr)
+			}
+
+			if err := tx.Model(&dbModel.RunHookExecution{}).
+				Where("run_id = ? AND hook = ?", execution.RunID, execution.Hook).
+				Updates(updates).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return
+}
+
+func (this *worker) execute(ctx context.Context, tx *gorm.DB, execution dbModel.RunHookExecution) error {
+	hook, ok := this.hooks[execution.Hook]
+	if !ok {
+		return &permanentError{fmt.Errorf("hook %s is not configured", execution.Hook)}
+	}
+
+	run, err := loadRun(tx, execution)
+	if err != nil {
+		return err
+	}
+
+	started := time.Now()
+	defer func() {
+		hookExecutionDuration.WithLabelValues(execution.Hook).Observe(time.Since(started).Seconds())
+	}()
+
+	return hook.Execute(ctx, run)
+}
+
+func loadRun(tx *gorm.DB, execution dbModel.RunHookExecution) (run RunCompleted, err error) {
+	var dbRun dbModel.Run
+	if err = tx.Where("id = ?", execution.RunID).First(&dbRun).Error; err != nil {
+		return
+	}
+
+	var dbHosts []dbModel.RunHost
+	if err = tx.Select("host", "status", "inventory_id").Where("run_id = ?", execution.RunID).Order("host").Find(&dbHosts).Error; err != nil {
+		return
+	}
+
+	run = RunCompleted{
+		EventType:     eventTypeRunCompleted,
+		Id:            dbRun.ID,
+		OrgId:         dbRun.OrgID,
+		Service:       dbRun.Service,
+		CorrelationId: dbRun.CorrelationID,
+		Recipient:     dbRun.Recipient,
+		Status:        dbRun.Status,
+		ExecutionMode: dbRun.ExecutionMode,
+		CreatedAt:     dbRun.CreatedAt,
+		UpdatedAt:     dbRun.UpdatedAt,
+		Hosts:         make([]RunHost, len(dbHosts)),
+	}
+
+	for i, host := range dbHosts {
+		run.Hosts[i] = RunHost{Host: host.Host, Status: host.Status, InventoryId: host.InventoryID}
+	}
+
+	return
+}
+
+// backoff returns the time to wait after the given (1-based) attempt
+func (this *worker) backoff(attempt int) time.Duration {
+	interval := this.options.InitialInterval
+	for i := 1; i < attempt && interval < this.options.MaxInterval; i++ {
+		interval *= 2
+	}
+
+	return min(interval, this.options.MaxInterval)
+}
@@ -0,0 +1,265 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"playbook-dispatcher/internal/common/config"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+	"playbook-dispatcher/internal/common/webhook"
+	"playbook-dispatcher/pkg/status"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+)
+
+type hookMock struct {
+	err   error
+	calls []RunCompleted
+}
+
+func (this *hookMock) Execute(ctx context.Context, run RunCompleted) error {
+	this.calls = append(this.calls, run)
+	return this.err
+}
+
+var _ = Describe("Run hooks", func() {
+	var hook *hookMock
+	var instance *worker
+	var service string
+
+	BeforeEach(func() {
+		hook = &hookMock{}
+		service = "hooks-" + uuid.New().String()[:8]
+
+		instance = &worker{
+			db:      db(),
+			options: Options{Lookback: time.Hour, BatchSize: 10, Attempts: 2, InitialInterval: time.Hour, MaxInterval: time.Hour},
+			definitions: definitions{
+				hooks:    map[string]Hook{"notify": hook},
+				services: map[string][]string{service: {"notify"}},
+			},
+		}
+	})
+
+	createRun := func(runStatus status.Status) dbModel.Run {
+		run := test.NewRunWithStatus(orgId(), string(runStatus))
+		run.Service = service
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		host := test.NewRunHost(run.ID, string(runStatus), nil)
+		Expect(db().Create(&host).Error).ToNot(HaveOccurred())
+
+		return run
+	}
+
+	executionOf := func(run dbModel.Run) (execution dbModel.RunHookExecution) {
+		Expect(db().Where("run_id = ? AND hook = ?", run.ID, "notify").First(&execution).Error).ToNot(HaveOccurred())
+		return
+	}
+
+	It("executes the hooks of completed runs once", func() {
+		run := createRun(status.Success)
+		createRun(status.Running)
+
+		result, err := instance.run(test.TestContext())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Enqueued).To(BeEquivalentTo(1))
+		Expect(result.Done).To(Equal(1))
+
+		Expect(hook.calls).To(HaveLen(1))
+		Expect(hook.calls[0].Id).To(Equal(run.ID))
+		Expect(hook.calls[0].Status).To(Equal("success"))
+		Expect(hook.calls[0].Service).To(Equal(service))
+		Expect(hook.calls[0].Hosts).To(HaveLen(1))
+		Expect(executionOf(run).State).To(Equal(stateDone))
+
+		_, err = instance.run(test.TestContext())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hook.calls).To(HaveLen(1))
+	})
+
+	It("ignores runs of other services", func() {
+		run := createRun(status.Failure)
+		Expect(db().Model(&run).Update("service", "other").Error).ToNot(HaveOccurred())
+
+		_, err := instance.run(test.TestContext())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hook.calls).To(BeEmpty())
+	})
+
+	It("retries failed executions", func() {
+		hook.err = errors.New("unavailable")
+		run := createRun(status.Timeout)
+
+		result, err := instance.run(test.TestContext())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Retried).To(Equal(1))
+
+		execution := executionOf(run)
+		Expect(execution.State).To(Equal(statePending))
+		Expect(execution.Attempts).To(Equal(1))
+		Expect(*execution.LastError).To(Equal("unavailable"))
+		Expect(execution.NextAttemptAt).To(BeTemporally(">", time.Now().Add(50*time.Minute)))
+
+		Expect(db().Model(&execution).Where("run_id = ?", run.ID).Update("next_attempt_at", time.Now()).Error).ToNot(HaveOccurred())
+
+		result, err = instance.run(test.TestContext())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.DeadLetter).To(Equal(1))
+		Expect(executionOf(run).State).To(Equal(stateDeadLetter))
+		Expect(hook.calls).To(HaveLen(2))
+	})
+
+	It("does not retry permanent failures", func() {
+		hook.err = &permanentError{errors.New("rejected")}
+		run := createRun(status.Canceled)
+
+		result, err := instance.run(test.TestContext())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.DeadLetter).To(Equal(1))
+		Expect(executionOf(run).State).To(Equal(stateDeadLetter))
+	})
+
+	Describe("configuration", func() {
+		It("reads the hooks of each service", func() {
+			cfg := config.Get()
+			cfg.Set("run.hooks.services", "remediations, config-manager")
+			cfg.Set("run.hooks.service.remediations", "notify")
+			cfg.Set("run.hooks.service.config-manager", "notify")
+			cfg.Set("run.hooks.hook.notify.type", TypeWebhook)
+			cfg.Set("run.hooks.hook.notify.url", "http://localhost/hook")
+			cfg.Set("run.hooks.hook.notify.secret", "secret")
+
+			result, err := definitionsFromConfig(cfg, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.services).To(HaveKeyWithValue("remediations", []string{"notify"}))
+			Expect(result.services).To(HaveKeyWithValue("config-manager", []string{"notify"}))
+			Expect(result.hooks).To(HaveKey("notify"))
+		})
+
+		It("rejects hooks of unknown type", func() {
+			cfg := config.Get()
+			cfg.Set("run.hooks.services", "remediations")
+			cfg.Set("run.hooks.service.remediations", "ticket")
+			cfg.Set("run.hooks.hook.ticket.type", "email")
+
+			_, err := definitionsFromConfig(cfg, nil, nil)
+			Expect(err).To(MatchError(`hook ticket has unknown type "email"`))
+		})
+
+		It("rejects webhook hooks without a secret", func() {
+			cfg := config.Get()
+			cfg.Set("run.hooks.services", "remediations")
+			cfg.Set("run.hooks.service.remediations", "unsigned")
+			cfg.Set("run.hooks.hook.unsigned.type", TypeWebhook)
+			cfg.Set("run.hooks.hook.unsigned.url", "http://localhost/hook")
+
+			_, err := definitionsFromConfig(cfg, nil, nil)
+			Expect(err).To(MatchError("hook unsigned has no secret"))
+		})
+	})
+
+	Describe("webhook", func() {
+		var statusCode int
+		var calls int
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			calls = 0
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.WriteHeader(statusCode)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		webhookHookOf := func(name string, withDb bool) Hook {
+			cfg := config.Get()
+			cfg.Set("run.hooks.services", "remediations")
+			cfg.Set("run.hooks.service.remediations", name)
+			cfg.Set("run.hooks.hook."+name+".type", TypeWebhook)
+			cfg.Set("run.hooks.hook."+name+".url", fmt.Sprintf("%s/hook", server.URL))
+			cfg.Set("run.hooks.hook."+name+".secret", "secret")
+			cfg.Set("webhook.dead_letter.failures", 2)
+			cfg.Set("webhook.dead_letter.retry.interval", 3600)
+
+			var database *gorm.DB
+			if withDb {
+				database = db()
+			}
+
+			result, err := definitionsFromConfig(cfg, database, nil)
+			Expect(err).ToNot(HaveOccurred())
+			return result.hooks[name]
+		}
+
+		subscriptionOf := func(name string) (subscription dbModel.WebhookSubscription) {
+			Expect(db().Where("id = ?", name).Take(&subscription).Error).ToNot(HaveOccurred())
+			return
+		}
+
+		It("treats rejected deliveries as permanent failures", func() {
+			statusCode = http.StatusBadRequest
+
+			err := webhookHookOf("notify", false).Execute(test.TestContext(), RunCompleted{Id: uuid.New()})
+			Expect(isPermanent(err)).To(BeTrue())
+		})
+
+		It("dead-letters endpoints that keep failing", func() {
+			statusCode = http.StatusServiceUnavailable
+			name := "notify" + uuid.New().String()[:8]
+			hook := webhookHookOf(name, true)
+
+			for i := 0; i < 2; i++ {
+				err := hook.Execute(test.TestContext(), RunCompleted{Id: uuid.New()})
+				Expect(err).To(HaveOccurred())
+				Expect(isPermanent(err)).To(BeFalse())
+			}
+
+			subscription := subscriptionOf(name)
+			Expect(subscription.State).To(Equal("dead_letter"))
+			Expect(subscription.Failures).To(Equal(2))
+			Expect(*subscription.LastError).To(Equal("unexpected status code 503"))
+
+			// the endpoint is not called until the retry interval elapses
+			err := hook.Execute(test.TestContext(), RunCompleted{Id: uuid.New()})
+			Expect(err).To(MatchError(webhook.ErrEndpointDeadLetter))
+			Expect(isPermanent(err)).To(BeFalse())
+			Expect(calls).To(Equal(2))
+		})
+
+		It("probes dead-lettered endpoints once per retry interval", func() {
+			statusCode = http.StatusOK
+			name := "notify" + uuid.New().String()[:8]
+			hook := webhookHookOf(name, true)
+
+			lastError := "unexpected status code 503"
+			Expect(db().Create(&dbModel.WebhookSubscription{
+				ID:        name,
+				State:     "dead_letter",
+				Failures:  5,
+				LastError: &lastError,
+				UpdatedAt: time.Now().Add(-2 * time.Hour),
+			}).Error).ToNot(HaveOccurred())
+
+			Expect(hook.Execute(test.TestContext(), RunCompleted{Id: uuid.New()})).To(Succeed())
+			Expect(calls).To(Equal(1))
+
+			subscription := subscriptionOf(name)
+			Expect(subscription.State).To(Equal("active"))
+			Expect(subscription.Failures).To(BeZero())
+			Expect(subscription.LastError).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,64 @@
+package jobs
+
+import (
+	"context"
+	"playbook-dispatcher/internal/api/audit"
+	"playbook-dispatcher/internal/api/slo"
+	"playbook-dispatcher/internal/api/stuck"
+	"playbook-dispatcher/internal/common/db"
+	"playbook-dispatcher/internal/common/kafka"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/jobs/hooks"
+	"playbook-dispatcher/internal/jobs/sweeper"
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
+// Start runs the periodic background jobs (SLO evaluation, stuck run detection, timeout sweeper, run hooks, audit event relay and anchoring).
+// The jobs are safe to run in multiple replicas at the same time.
+func Start(
+	ctx context.Context,
+	cfg *viper.Viper,
+	errors chan<- error,
+	ready, live *utils.ProbeHandler,
+	wg *sync.WaitGroup,
+) {
+	db, release := db.Shared(ctx, cfg, ready, live)
+
+	jobs := sync.WaitGroup{}
+
+	slo.Start(ctx, cfg, db, &jobs)
+	stuck.Start(ctx, cfg, db, &jobs)
+
+	if cfg.GetBool("timeout.sweeper.enabled") {
+		sweeper.Start(ctx, cfg, db, &jobs)
+	}
+
+	if cfg.GetBool("run.hooks.enabled") {
+		hooks.Start(ctx, cfg, db, ready, &jobs)
+	}
+
+	if cfg.GetBool("audit.enabled") {
+		producer, err := kafka.NewProducer(cfg)
+		utils.DieOnError(err)
+
+		// events are kept in the outbox while kafka is unavailable
+		err = ready.RegisterDependency("audit.kafka", false, func() error {
+			return kafka.Ping(cfg.GetInt("kafka.timeout"), producer)
+		})
+		utils.DieOnError(err)
+
+		audit.StartRelay(ctx, cfg, db, producer, &jobs)
+		audit.StartAnchoring(ctx, cfg, db, &jobs)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer utils.GetLogFromContext(ctx).Debug("Jobs stopped")
+		defer release()
+
+		jobs.Wait()
+	}()
+}
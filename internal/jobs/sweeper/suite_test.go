@@ -0,0 +1,19 @@
+package sweeper
+
+import (
+	"playbook-dispatcher/internal/common/utils/test"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Timeout Sweeper Suite")
+}
+
+var (
+	orgId = test.WithOrgId()
+	db    = test.WithDatabase()
+)
@@ -0,0 +1,271 @@
+package sweeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/runevents"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	sweepRunsTimedOutTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "jobs_timeout_sweeper_runs_timed_out_total",
+		Help: "The total number of runs transitioned to the timeout state",
+	}, []string{"worker"})
+
+	sweepRunHostsTimedOutTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "jobs_timeout_sweeper_run_hosts_timed_out_total",
+		Help: "The total number of run hosts transitioned to the timeout state",
+	}, []string{"worker"})
+
+	sweepBatchesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "jobs_timeout_sweeper_batches_total",
+		Help: "The total number of batches processed",
+	}, []string{"worker"})
+
+	sweepErrorTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "jobs_timeout_sweeper_error_total",
+		Help: "The total number of failed sweeps",
+	}, []string{"worker"})
+
+	sweepDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "jobs_timeout_sweeper_duration_seconds",
+		Help:    "Duration of a complete sweep",
+		Buckets: prometheus.ExponentialBuckets(0.01, 4, 8),
+	}, []string{"worker"})
+
+	sweepLastSuccess = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "jobs_timeout_sweeper_last_success_timestamp_seconds",
+		Help: "The time of the last completed sweep",
+	}, []string{"worker"})
+)
+
+// Options select the runs a sweep is responsible for
+type Options struct {
+	// runs are timed out once they are running for longer than their timeout plus grace
+	Grace time.Duration
+	// runs waiting for their recipient to connect are timed out once they wait for longer than this
+	WaitWindow time.Duration
+	// number of runs processed within one transaction
+	BatchSize int
+	// runs are partitioned by the hash of their id, a sweep only processes the runs of the given worker
+	WorkerCount int
+	WorkerIndex int
+}
+
+// Result describes the outcome of a sweep
+type Result struct {
+	Runs     int64
+	RunHosts int64
+	Batches  int
+}
+
+func (this Options) worker() string {
+	return strconv.Itoa(this.WorkerIndex)
+}
+
+func (this Options) validate() error {
+	if this.BatchSize < 1 {
+		return fmt.Errorf("invalid batch size %d", this.BatchSize)
+	}
+
+	if this.WorkerCount < 1 || this.WorkerIndex < 0 || this.WorkerIndex >= this.WorkerCount {
+		return fmt.Errorf("invalid worker %d of %d", this.WorkerIndex, this.WorkerCount)
+	}
+
+	return nil
+}
+
+// OptionsFromConfig reads the sweeper options from configuration
+func OptionsFromConfig(cfg *viper.Viper) Options {
+	return Options{
+		Grace:       cfg.GetDuration("timeout.sweeper.grace") * time.Second,
+		WaitWindow:  cfg.GetDuration("wait.for.connection.window") * time.Second,
+		BatchSize:   cfg.GetInt("timeout.sweeper.batch.size"),
+		WorkerCount: cfg.GetInt("timeout.sweeper.worker.count"),
+		WorkerIndex: cfg.GetInt("timeout.sweeper.worker.index"),
+	}
+}
+
+// Sweep transitions runs that are still running past created_at + timeout + grace (and their running hosts) to the timeout state.
+// Runs still waiting for their recipient past created_at + the wait window are timed out as well.
+// Runs are scanned in batches ordered by id so that every batch is a short transaction regardless of the number of timed-out runs.
+func Sweep(ctx context.Context, db *gorm.DB, options Options) (result Result, err error) {
+	if err = options.validate(); err != nil {
+		return
+	}
+
+	log := utils.GetLogFromContext(ctx).With("worker", options.WorkerIndex, "workers", options.WorkerCount)
+	worker := options.worker()
+	started := time.Now()
+
+	defer func() {
+		if err != nil {
+			sweepErrorTotal.WithLabelValues(worker).Inc()
+			return
+		}
+
+		sweepDuration.WithLabelValues(worker).Observe(time.Since(started).Seconds())
+		sweepLastSuccess.WithLabelValues(worker).SetToCurrentTime()
+	}()
+
+	var after *uuid.UUID
+
+	for {
+		var dbRuns []dbModel.Run
+
+		query := db.WithContext(ctx).
+			Model(&dbModel.Run{}).
+			Select("id", "org_id", "correlation_id", "recipient", "status").
+			Where(expired(db, options)).
+			Order("runs.id").
+			Limit(options.BatchSize)
+
+		if options.WorkerCount > 1 {
+			query = query.Where("(hashtext(runs.id::text) & 2147483647) % ? = ?", options.WorkerCount, options.WorkerIndex)
+		}
+
+		if after != nil {
+			query = query.Where("runs.id > ?", *after)
+		}
+
+		if err = query.Find(&dbRuns).Error; err != nil {
+			return
+		}
+
+		if len(dbRuns) == 0 {
+			break
+		}
+
+		var runs, runHosts int64
+		if runs, runHosts, err = timeoutBatch(ctx, db, dbRuns, options); err != nil {
+			return
+		}
+
+		result.Runs += runs
+		result.RunHosts += runHosts
+		result.Batches++
+
+		sweepRunsTimedOutTotal.WithLabelValues(worker).Add(float64(runs))
+		sweepRunHostsTimedOutTotal.WithLabelValues(worker).Add(float64(runHosts))
+		sweepBatchesTotal.WithLabelValues(worker).Inc()
+
+		log.Infow("Timed out batch of runs", "batch", result.Batches, "runs", runs, "run_hosts", runHosts)
+
+		if len(dbRuns) < options.BatchSize {
+			break
+		}
+
+		after = &dbRuns[len(dbRuns)-1].ID
+	}
+
+	log.Infow("Finished updating timed-out runs", "runs", result.Runs, "run_hosts", result.RunHosts, "batches", result.Batches)
+	return
+}
+
+// expired matches the runs past the deadline of their status
+func expired(db *gorm.DB, options Options) *gorm.DB {
+	return db.Where("runs.status IN ? AND runs.created_at + (runs.timeout + ?) * interval '1 second' <= NOW()", status.Strings(status.Active()...), int(options.Grace.Seconds())).
+		Or("runs.status = ? AND runs.created_at + ? * interval '1 second' <= NOW()", status.WaitingForConnection, int(options.WaitWindow.Seconds()))
+}
+
+func timeoutBatch(ctx context.Context, db *gorm.DB, dbRuns []dbModel.Run, options Options) (runs, runHosts int64, err error) {
+	log := utils.GetLogFromContext(ctx)
+
+	ids := make([]uuid.UUID, len(dbRuns))
+	for i, run := range dbRuns {
+		log.Infow("Updating timed-out run", "run_id", run.ID.String(), "org_id", run.OrgID, "correlation_id", run.CorrelationID.String(), "recipient", run.Recipient.String())
+		ids[i] = run.ID
+	}
+
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the deadline is checked again as the run may have finished, or been dispatched and be subject to the timeout of running runs, in the meantime
+		var timedOut []dbModel.Run
+		result := tx.Model(&timedOut).
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+			Where("runs.id IN ?", ids).
+			Where(expired(db, options)).
+			Update("status", status.Timeout)
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		runs = result.RowsAffected
+
+		if err := recordTimeouts(tx, dbRuns, timedOut); err != nil {
+			return err
+		}
+
+		result = tx.Model(&dbModel.RunHost{}).
+			Where("run_hosts.run_id IN (?)", tx.Model(&dbModel.Run{}).Select("id").Where("runs.id IN ?", ids).Where("runs.status", status.Timeout)).
+			Where("run_hosts.status IN ?", status.Strings(status.Running, status.WaitingForConnection)).
+			Update("status", status.Timeout)
+
+		runHosts = result.RowsAffected
+
+		return result.Error
+	})
+
+	return
+}
+
+// recordTimeouts records the transitions of the runs that timed out in their history
+func recordTimeouts(tx *gorm.DB, selected, timedOut []dbModel.Run) error {
+	previous := make(map[uuid.UUID]string, len(selected))
+	for _, run := range selected {
+		previous[run.ID] = run.Status
+	}
+
+	transitions := make([]runevents.Transition, len(timedOut))
+	for i, run := range timedOut {
+		transitions[i] = runevents.Transition{RunID: run.ID, Previous: previous[run.ID], Status: string(status.Timeout), Source: runevents.SourceSweeper}
+	}
+
+	return runevents.Record(tx, transitions...)
+}
+
+// Start runs a sweep every timeout.sweeper.interval seconds
+func Start(ctx context.Context, cfg *viper.Viper, db *gorm.DB, wg *sync.WaitGroup) {
+	log := utils.GetLogFromContext(ctx)
+	options := OptionsFromConfig(cfg)
+	utils.DieOnError(options.validate())
+
+	ticker := time.NewTicker(cfg.GetDuration("timeout.sweeper.interval") * time.Second)
+
+	sweep := func() {
+		if _, err := Sweep(ctx, db, options); err != nil {
+			log.Errorw("Error sweeping timed-out runs", "error", err)
+		}
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer ticker.Stop()
+
+		sweep()
+
+		for {
+			select {
+			case <-ticker.C:
+				sweep()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,161 @@
+package sweeper
+
+import (
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+	"playbook-dispatcher/pkg/status"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timeout sweeper", func() {
+	options := Options{BatchSize: 2, WorkerCount: 1}
+
+	createRun := func(runStatus status.Status, age time.Duration) dbModel.Run {
+		run := test.NewRunWithStatus(orgId(), string(runStatus))
+		run.Timeout = 60
+		run.CreatedAt = time.Now().Add(-age)
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		host := test.NewRunHost(run.ID, string(runStatus), nil)
+		Expect(db().Create(&host).Error).ToNot(HaveOccurred())
+
+		return run
+	}
+
+	statusOf := func(id uuid.UUID) (run, host string) {
+		Expect(db().Model(&dbModel.Run{}).Select("status").Where("id = ?", id).Scan(&run).Error).ToNot(HaveOccurred())
+		Expect(db().Model(&dbModel.RunHost{}).Select("status").Where("run_id = ?", id).Scan(&host).Error).ToNot(HaveOccurred())
+		return
+	}
+
+	It("times out runs past their timeout in batches", func() {
+		runs := []dbModel.Run{
+			createRun(status.Running, time.Hour),
+			createRun(status.Running, time.Hour),
+			createRun(status.Running, time.Hour),
+		}
+
+		result, err := Sweep(test.TestContext(), db(), options)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Runs).To(BeNumerically(">=", 3))
+		Expect(result.Batches).To(BeNumerically(">=", 2))
+
+		for _, run := range runs {
+			runStatus, hostStatus := statusOf(run.ID)
+			Expect(runStatus).To(BeEquivalentTo(status.Timeout))
+			Expect(hostStatus).To(BeEquivalentTo(status.Timeout))
+		}
+	})
+
+	It("times out runs that never acknowledged a cancel", func() {
+		canceling := createRun(status.Canceling, time.Hour)
+		// only the run reports the cancel, its hosts keep running until they acknowledge it
+		Expect(db().Model(&dbModel.RunHost{}).Where("run_id = ?", canceling.ID).Update("status", status.Running).Error).ToNot(HaveOccurred())
+
+		_, err := Sweep(test.TestContext(), db(), options)
+		Expect(err).ToNot(HaveOccurred())
+
+		runStatus, hostStatus := statusOf(canceling.ID)
+		Expect(runStatus).To(BeEquivalentTo(status.Timeout))
+		Expect(hostStatus).To(BeEquivalentTo(status.Timeout))
+	})
+
+	It("records the timeouts in the history of the runs", func() {
+		waiting := createRun(status.WaitingForConnection, 30*24*time.Hour)
+
+		_, err := Sweep(test.TestContext(), db(), Options{BatchSize: 2, WorkerCount: 1, WaitWindow: time.Hour})
+		Expect(err).ToNot(HaveOccurred())
+
+		var history []dbModel.RunEvent
+		Expect(db().Where("run_id = ?", waiting.ID).Find(&history).Error).ToNot(HaveOccurred())
+		Expect(history).To(HaveLen(1))
+		Expect(*history[0].PreviousStatus).To(BeEquivalentTo(status.WaitingForConnection))
+		Expect(history[0].Status).To(BeEquivalentTo(status.Timeout))
+		Expect(history[0].Source).To(Equal("sweeper"))
+		Expect(history[0].SourceEventID).To(BeNil())
+	})
+
+	It("leaves other runs alone", func() {
+		running := createRun(status.Running, 0)
+		finished := createRun(status.Success, time.Hour)
+
+		_, err := Sweep(test.TestContext(), db(), options)
+		Expect(err).ToNot(HaveOccurred())
+
+		runStatus, hostStatus := statusOf(running.ID)
+		Expect(runStatus).To(BeEquivalentTo(status.Running))
+		Expect(hostStatus).To(BeEquivalentTo(status.Running))
+
+		runStatus, _ = statusOf(finished.ID)
+		Expect(runStatus).To(BeEquivalentTo(status.Success))
+	})
+
+	It("respects the grace period", func() {
+		run := createRun(status.Running, 2*time.Minute)
+
+		_, err := Sweep(test.TestContext(), db(), Options{Grace: time.Hour, BatchSize: 10, WorkerCount: 1})
+		Expect(err).ToNot(HaveOccurred())
+
+		runStatus, _ := statusOf(run.ID)
+		Expect(runStatus).To(BeEquivalentTo(status.Running))
+	})
+
+	It("times out runs waiting for their recipient past the window", func() {
+		expired := createRun(status.WaitingForConnection, 2*time.Hour)
+		waiting := createRun(status.WaitingForConnection, 2*time.Minute)
+
+		_, err := Sweep(test.TestContext(), db(), Options{WaitWindow: time.Hour, BatchSize: 10, WorkerCount: 1})
+		Expect(err).ToNot(HaveOccurred())
+
+		runStatus, hostStatus := statusOf(expired.ID)
+		Expect(runStatus).To(BeEquivalentTo(status.Timeout))
+		Expect(hostStatus).To(BeEquivalentTo(status.Timeout))
+
+		runStatus, hostStatus = statusOf(waiting.ID)
+		Expect(runStatus).To(BeEquivalentTo(status.WaitingForConnection))
+		Expect(hostStatus).To(BeEquivalentTo(status.WaitingForConnection))
+	})
+
+	It("leaves runs dispatched after they were selected alone", func() {
+		run := createRun(status.Running, 2*time.Hour)
+		Expect(db().Model(&dbModel.Run{}).Where("id = ?", run.ID).Update("timeout", 3*3600).Error).ToNot(HaveOccurred())
+
+		// the run was still waiting for its recipient when it was selected
+		run.Status = string(status.WaitingForConnection)
+
+		runs, runHosts, err := timeoutBatch(test.TestContext(), db(), []dbModel.Run{run}, Options{WaitWindow: time.Hour, BatchSize: 10, WorkerCount: 1})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(runs).To(BeZero())
+		Expect(runHosts).To(BeZero())
+
+		runStatus, hostStatus := statusOf(run.ID)
+		Expect(runStatus).To(BeEquivalentTo(status.Running))
+		Expect(hostStatus).To(BeEquivalentTo(status.Running))
+	})
+
+	It("partitions runs across workers", func() {
+		runs := []dbModel.Run{}
+		for i := 0; i < 10; i++ {
+			runs = append(runs, createRun(status.Running, time.Hour))
+		}
+
+		for worker := 0; worker < 3; worker++ {
+			_, err := Sweep(test.TestContext(), db(), Options{BatchSize: 4, WorkerCount: 3, WorkerIndex: worker})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		for _, run := range runs {
+			runStatus, _ := statusOf(run.ID)
+			Expect(runStatus).To(BeEquivalentTo(status.Timeout))
+		}
+	})
+
+	It("rejects invalid worker options", func() {
+		_, err := Sweep(test.TestContext(), db(), Options{BatchSize: 10, WorkerCount: 2, WorkerIndex: 2})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -2,6 +2,7 @@ package responseConsumer
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -10,9 +11,12 @@ import (
 	kafkaUtils "playbook-dispatcher/internal/common/kafka"
 	"playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/model/message"
+	"playbook-dispatcher/internal/common/runevents"
 	"playbook-dispatcher/internal/common/satellite"
+	"playbook-dispatcher/internal/common/scrub"
 	"playbook-dispatcher/internal/common/utils"
 	"playbook-dispatcher/internal/response-consumer/instrumentation"
+	"playbook-dispatcher/pkg/status"
 
 	k "github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/google/uuid"
@@ -25,6 +29,8 @@ const (
 	EventPlaybookOnStats  = "playbook_on_stats"
 	EventRunnerOnFailed   = "runner_on_failed"
 	EventExecutorOnFailed = "executor_on_failed"
+	// reported by rhc-worker-playbook once it stopped the playbook as the run was canceled
+	EventExecutorOnCanceled = "executor_on_canceled"
 
 	EventSatPlaybookFinished  = "playbook_run_finished"
 	EventSatPlaybookCompleted = "playbook_run_completed"
@@ -36,6 +42,12 @@ const (
 
 type handler struct {
 	db *gorm.DB
+	// skip messages that have already been applied (e.g. consumed from both topics during a migration)
+	dedup bool
+	// redacts secrets from the output before it is stored, nil if not configured
+	scrubber *scrub.Scrubber
+	// size limit of the artifacts stored for a run host, 0 if not limited
+	artifactsMaxSize int
 }
 
 func (this *handler) BeforeUpdate(ctx context.Context, tx *gorm.DB) (err error) {
@@ -71,38 +83,63 @@ func (this *handler) onMessage(ctx context.Context, msg *k.Message) {
 		"offset", msg.TopicPartition.Offset.String(),
 	)
 
-	var status string
+	var runStatus status.Status
 	var eventsSerialized []byte
 
 	var runsUpdated int64
+	var duplicate bool
+	var firstResponse bool
 
 	run := db.Run{}
 
 	err = this.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if this.dedup {
+			// rolled back together with the update if the message cannot be applied
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&db.ProcessedMessage{RequestID: requestId, CorrelationID: correlationId})
+			if result.Error != nil {
+				return result.Error
+			}
+
+			if duplicate = result.RowsAffected == 0; duplicate {
+				return nil
+			}
+		}
+
 		baseQuery := tx.Model(db.Run{}).
 			Where("org_id = ?", value.OrgId).
 			Where("correlation_id = ?", correlationId)
 
-		selectResult := baseQuery.Select("id", "status", "response_full").First(&run)
+		selectResult := baseQuery.Select("id", "status", "response_full", "service", "dispatch_chunks", "created_at").First(&run)
+
+		if selectResult.Error == nil && this.scrubber != nil && this.scrubber.Applies(run.Service) {
+			scrubOutput(ctx, this.scrubber, run.Service, value)
+		}
 
 		if requestType == satMessageHeaderValue {
 			satellite.SortSatEvents(value.SatEvents)
 
-			status = inferSatPlaybookStatus(value.SatEvents)
+			runStatus = inferSatPlaybookStatus(value.SatEvents)
 			eventsSerialized = utils.MustMarshal(value.SatEvents)
 
 			if !run.ResponseFull {
-				status = checkSatStatusPartial(value.SatEvents)
+				runStatus = checkSatStatusPartial(value.SatEvents)
 			}
 
-			if run.Status == db.RunStatusFailure || run.Status == db.RunStatusCanceled {
-				status = run.Status
+			if previous := status.Status(run.Status); previous == status.Failure || previous == status.Canceled {
+				runStatus = previous
+			}
+
+			// the status of a run dispatched in chunks is derived from all of its hosts once they are updated
+			if run.DispatchChunks > 1 {
+				runStatus = status.Status(run.Status)
 			}
 		} else {
-			status = inferStatus(value.RunnerEvents, nil)
+			runStatus = inferStatus(value.RunnerEvents, nil)
 			eventsSerialized = utils.MustMarshal(value.RunnerEvents)
 		}
 
+		runStatus = keepCanceling(run.Status, runStatus)
+
 		if selectResult.Error != nil {
 			if errors.Is(selectResult.Error, gorm.ErrRecordNotFound) {
 				return nil
@@ -113,7 +150,7 @@ func (this *handler) onMessage(ctx context.Context, msg *k.Message) {
 		}
 
 		toUpdate := db.Run{
-			Status: status,
+			Status: string(runStatus),
 			Events: eventsSerialized,
 		}
 
@@ -123,7 +160,7 @@ func (this *handler) onMessage(ctx context.Context, msg *k.Message) {
 			Where("org_id = ?", value.OrgId).
 			Where("correlation_id = ?", correlationId).
 			Where("id = ?", run.ID).
-			Where("status not in ?", []string{db.RunStatusSuccess, db.RunStatusFailure}).
+			Where("status not in ?", status.Strings(status.Final()...)).
 			Select("status", "events").
 			Updates(toUpdate)
 		if updateResult.Error != nil {
@@ -133,6 +170,17 @@ func (this *handler) onMessage(ctx context.Context, msg *k.Message) {
 			runsUpdated = updateResult.RowsAffected
 		}
 
+		if runsUpdated > 0 {
+			transition := runevents.Transition{RunID: run.ID, Previous: run.Status, Status: string(runStatus), Source: runevents.SourceResponse, SourceEventID: requestId}
+			if err := runevents.Record(tx, transition); err != nil {
+				return err
+			}
+
+			if firstResponse, err = recordFirstResponse(tx, run.ID, value.Uploaded); err != nil {
+				return err
+			}
+		}
+
 		var toCreate []db.RunHost
 
 		if requestType == runnerMessageHeaderValue {
@@ -153,11 +201,26 @@ func (this *handler) onMessage(ctx context.Context, msg *k.Message) {
 					ID:     uuid.New(),
 					RunID:  run.ID,
 					Host:   host,
-					Status: inferStatus(value.RunnerEvents, &host),
+					Status: string(inferStatus(value.RunnerEvents, &host)),
 					Log:    ansible.GetStdout(*value.RunnerEvents, nil),
+					Diffs:  getDiffs(ctx, *value.RunnerEvents, host),
+
+					Artifacts: this.getArtifacts(ctx, *value.RunnerEvents, host),
 				}
 			})
-			return createRecord(ctx, tx, toCreate)
+			if err := createRecord(ctx, tx, toCreate); err != nil {
+				return err
+			}
+
+			if err := acknowledgeRunnerCancel(tx, run.ID); err != nil {
+				return err
+			}
+
+			if err := publishChanges(tx, run.ID, runStatus, runsUpdated > 0, toCreate); err != nil {
+				return err
+			}
+
+			return updateProgress(ctx, tx, run.ID)
 		} else if requestType == satMessageHeaderValue {
 			hosts := satellite.GetSatHosts(*value.SatEvents)
 
@@ -173,33 +236,53 @@ func (this *handler) onMessage(ctx context.Context, msg *k.Message) {
 					RunID:       run.ID,
 					InventoryID: &inventoryId,
 					SatSequence: satHost.Sequence,
-					Status:      inferSatHostStatus(value.SatEvents, host),
+					Status:      string(inferSatHostStatus(value.SatEvents, host)),
 					Log:         satHost.Console,
 				}
 			})
-			return satUpdateRecord(ctx, tx, run.ResponseFull, toCreate)
+			if err := satUpdateRecord(ctx, tx, run.ResponseFull, toCreate); err != nil {
+				return err
+			}
+
+			if run.DispatchChunks > 1 {
+				chunkedStatus, err := updateChunkedRunStatus(ctx, tx, run, requestId)
+				if err != nil {
+					return err
+				}
+
+				runStatus = chunkedStatus
+			}
+
+			if err := publishChanges(tx, run.ID, runStatus, runsUpdated > 0 || run.DispatchChunks > 1, toCreate); err != nil {
+				return err
+			}
+
+			return updateProgress(ctx, tx, run.ID)
 		}
 
 		return nil
 	})
 
 	if err != nil {
-		instrumentation.PlaybookRunUpdateError(ctx, err, status, run.ID)
+		instrumentation.PlaybookRunUpdateError(ctx, err, runStatus, run.ID)
+	} else if duplicate {
+		instrumentation.DuplicateMessage(ctx, *msg.TopicPartition.Topic)
 	} else if runsUpdated > 0 {
-		instrumentation.PlaybookRunUpdated(ctx, status, run.ID)
+		instrumentation.PlaybookRunUpdated(ctx, runStatus, run.ID)
+		observeLifecycle(ctx, run, runStatus, requestType, value.Uploaded, firstResponse)
 	} else {
-		instrumentation.PlaybookRunUpdateMiss(ctx, status)
+		instrumentation.PlaybookRunUpdateMiss(ctx, runStatus)
 	}
 }
 
 func satAssignmentWithCase(responseFull bool, updateHost db.RunHost) map[string]interface{} {
-	satSequence, status, log := *updateHost.SatSequence, updateHost.Status, updateHost.Log
+	satSequence, hostStatus, log := *updateHost.SatSequence, updateHost.Status, updateHost.Log
 
-	updateMap := map[string]interface{}{
-		"status":       status,
+	updateMap := acknowledgeCancel(updateHost, map[string]interface{}{
+		"status":       hostStatus,
 		"sat_sequence": satSequence,
 		"log":          log,
-	}
+	})
 
 	if !responseFull {
 		updateMap["log"] = gorm.Expr(`CASE WHEN (sat_sequence IS NULL AND ? > 0) OR sat_sequence + 1 < ? THEN log || '\n\u2026\n' || ? ELSE log || ? END`, satSequence, satSequence, log, log)
@@ -208,6 +291,32 @@ func satAssignmentWithCase(responseFull bool, updateHost db.RunHost) map[string]
 	return updateMap
 }
 
+// a host reporting the canceled status acknowledges the cancel requested when the run was canceled
+func acknowledgeCancel(updateHost db.RunHost, updateMap map[string]interface{}) map[string]interface{} {
+	if updateHost.Status == string(status.Canceled) {
+		updateMap["cancel_state"] = gorm.Expr("CASE WHEN cancel_state = ? THEN ? ELSE cancel_state END", status.CancelRequested, status.CancelAcked)
+	}
+
+	return updateMap
+}
+
+// the hosts of a run of directly connected hosts report the canceled status all at once, see acknowledgeCancel
+func acknowledgeRunnerCancel(tx *gorm.DB, runID uuid.UUID) error {
+	return tx.Model(&db.RunHost{}).
+		Where("run_id = ? AND status = ? AND cancel_state = ?", runID, status.Canceled, status.CancelRequested).
+		Update("cancel_state", status.CancelAcked).Error
+}
+
+// keepCanceling leaves a canceling run canceling until the executor acknowledges the cancel or reports that the
+// playbook finished
+func keepCanceling(previous string, runStatus status.Status) status.Status {
+	if runStatus == status.Running && status.Status(previous) == status.Canceling {
+		return status.Canceling
+	}
+
+	return runStatus
+}
+
 func satUpdateRecord(ctx context.Context, tx *gorm.DB, responseFull bool, toUpdate []db.RunHost) error {
 	for _, runHost := range toUpdate {
 		resultValues := db.RunHost{}
@@ -219,7 +328,7 @@ func satUpdateRecord(ctx context.Context, tx *gorm.DB, responseFull bool, toUpda
 		} else {
 			// only update status when runHost.SatSequence is nil e.g. when runHost finished
 			updateResult.Where("run_id = ? AND inventory_id = ?", runHost.RunID, runHost.InventoryID).
-				Updates(map[string]interface{}{"status": runHost.Status})
+				Updates(acknowledgeCancel(runHost, map[string]interface{}{"status": runHost.Status}))
 		}
 
 		if updateResult.Error != nil {
@@ -230,11 +339,145 @@ func satUpdateRecord(ctx context.Context, tx *gorm.DB, responseFull bool, toUpda
 	return nil
 }
 
+// publishChanges notifies the API of the new status of the run (if it was updated) and of its hosts
+func publishChanges(tx *gorm.DB, runID uuid.UUID, runStatus status.Status, runUpdated bool, hosts []db.RunHost) error {
+	changes := make([]runevents.Change, 0, len(hosts)+1)
+
+	if runUpdated {
+		changes = append(changes, runevents.Change{Type: runevents.TypeRun, RunID: runID, Status: string(runStatus)})
+	}
+
+	for _, host := range hosts {
+		name := host.Host
+		if host.InventoryID != nil {
+			name = host.InventoryID.String()
+		}
+
+		changes = append(changes, runevents.Change{Type: runevents.TypeHost, RunID: runID, Host: name, Status: host.Status})
+	}
+
+	return runevents.Publish(tx, changes...)
+}
+
+// updateChunkedRunStatus derives the status of a run dispatched in several chunks from the status of all of its hosts
+func updateChunkedRunStatus(ctx context.Context, tx *gorm.DB, run db.Run, requestId string) (status.Status, error) {
+	var hostStatuses []string
+	if err := tx.Model(&db.RunHost{}).Distinct("status").Where("run_id = ?", run.ID).Pluck("status", &hostStatuses).Error; err != nil {
+		utils.GetLogFromContext(ctx).Errorw("Error reading run host statuses from db", "error", err)
+		return "", err
+	}
+
+	runStatus := keepCanceling(run.Status, aggregateHostStatus(hostStatuses))
+
+	result := tx.Model(&db.Run{}).
+		Where("id = ?", run.ID).
+		Where("status not in ?", status.Strings(status.Final()...)).
+		Update("status", runStatus)
+
+	if result.Error != nil {
+		utils.GetLogFromContext(ctx).Errorw("Error updating run status in db", "error", result.Error)
+		return "", result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		transition := runevents.Transition{RunID: run.ID, Previous: run.Status, Status: string(runStatus), Source: runevents.SourceResponse, SourceEventID: requestId}
+		if err := runevents.Record(tx, transition); err != nil {
+			return "", err
+		}
+	}
+
+	return runStatus, nil
+}
+
+// aggregateHostStatus returns the status of a run given the statuses of its hosts, following inferSatPlaybookStatus
+func aggregateHostStatus(hostStatuses []string) status.Status {
+	failed := false
+	canceled := false
+
+	for _, hostStatus := range hostStatuses {
+		switch status.Status(hostStatus) {
+		case status.Running:
+			return status.Running
+		case status.Failure:
+			failed = true
+		case status.Canceled:
+			canceled = true
+		}
+	}
+
+	switch {
+	case failed:
+		return status.Failure
+	case canceled:
+		return status.Canceled
+	default:
+		return status.Success
+	}
+}
+
+// updateProgress sets the progress of the run to the share of its hosts that finished the playbook, or to 100 once the
+// executor reports the run as finished
+func updateProgress(ctx context.Context, tx *gorm.DB, runID uuid.UUID) error {
+	finishedHosts := tx.Model(&db.RunHost{}).
+		Select("100 * count(*) FILTER (WHERE status <> ?) / greatest(count(*), 1)", status.Running).
+		Where("run_id = ?", runID)
+
+	result := tx.Model(&db.Run{}).
+		Where("id = ?", runID).
+		UpdateColumn("progress", gorm.Expr("CASE WHEN status IN ? THEN 100 ELSE (?) END", status.Strings(status.Final()...), finishedHosts))
+
+	if result.Error != nil {
+		utils.GetLogFromContext(ctx).Errorw("Error updating run progress in db", "error", result.Error)
+		return result.Error
+	}
+
+	return nil
+}
+
+func getDiffs(ctx context.Context, events []message.PlaybookRunResponseMessageYamlEventsElem, host string) []byte {
+	diffs := ansible.GetDiffs(events, host)
+	if len(diffs) == 0 {
+		return nil
+	}
+
+	value, err := json.Marshal(diffs)
+	if err != nil {
+		utils.GetLogFromContext(ctx).Errorw("Error serializing diffs", "error", err, "host", host)
+		return nil
+	}
+
+	return value
+}
+
+func (this *handler) getArtifacts(ctx context.Context, events []message.PlaybookRunResponseMessageYamlEventsElem, host string) []byte {
+	artifacts := ansible.GetArtifacts(events, host)
+
+	value, err := json.Marshal(artifacts)
+	if err != nil {
+		utils.GetLogFromContext(ctx).Errorw("Error serializing artifacts", "error", err, "host", host)
+		return nil
+	}
+
+	if this.artifactsMaxSize > 0 && len(value) > this.artifactsMaxSize {
+		utils.GetLogFromContext(ctx).Warnw("Artifacts exceed the size limit, dropping task results", "size", len(value), "host", host)
+
+		artifacts.Results = []ansible.Result{}
+		artifacts.Truncated = true
+
+		if value, err = json.Marshal(artifacts); err != nil {
+			utils.GetLogFromContext(ctx).Errorw("Error serializing artifacts", "error", err, "host", host)
+			return nil
+		}
+	}
+
+	return value
+}
+
 func createRecord(ctx context.Context, tx *gorm.DB, toCreate []db.RunHost) error {
 
 	successOrFailure := clause.OrConditions{Exprs: []clause.Expression{
-		clause.Eq{Column: "run_hosts.status", Value: db.RunStatusSuccess},
-		clause.Eq{Column: "run_hosts.status", Value: db.RunStatusFailure},
+		clause.Eq{Column: "run_hosts.status", Value: status.Success},
+		clause.Eq{Column: "run_hosts.status", Value: status.Failure},
 	}}
 
 	notMarkedAsComplete := clause.Where{Exprs: []clause.Expression{clause.Not(successOrFailure)}}
@@ -243,7 +486,7 @@ func createRecord(ctx context.Context, tx *gorm.DB, toCreate []db.RunHost) error
 		Clauses(clause.OnConflict{
 			Where:     notMarkedAsComplete,
 			Columns:   []clause.Column{{Name: "run_id"}, {Name: "host"}},
-			DoUpdates: clause.AssignmentColumns([]string{"status", "log"}),
+			DoUpdates: clause.AssignmentColumns([]string{"status", "log", "diffs", "artifacts"}),
 		}).
 		Create(&toCreate)
 
@@ -255,9 +498,10 @@ func createRecord(ctx context.Context, tx *gorm.DB, toCreate []db.RunHost) error
 	return nil
 }
 
-func inferStatus(events *[]message.PlaybookRunResponseMessageYamlEventsElem, host *string) string {
+func inferStatus(events *[]message.PlaybookRunResponseMessageYamlEventsElem, host *string) status.Status {
 	finished := false
 	failed := false
+	canceled := false
 
 	for _, event := range *events {
 		if event.Event == EventPlaybookOnStats {
@@ -277,33 +521,39 @@ func inferStatus(events *[]message.PlaybookRunResponseMessageYamlEventsElem, hos
 			failed = true
 			finished = true
 		}
+
+		if event.Event == EventExecutorOnCanceled {
+			canceled = true
+		}
 	}
 
 	switch {
 	case finished && failed:
-		return db.RunStatusFailure
+		return status.Failure
 	case finished && !failed:
-		return db.RunStatusSuccess
+		return status.Success
+	case canceled:
+		return status.Canceled
 	default:
-		return db.RunStatusRunning
+		return status.Running
 	}
 }
 
-func satStatusEventDbMap(status message.PlaybookSatRunResponseMessageYamlEventsElemStatus) string {
+func satStatusEventDbMap(eventStatus message.PlaybookSatRunResponseMessageYamlEventsElemStatus) status.Status {
 	switch {
-	case status == EventSatStatusSuccess:
-		return db.RunStatusSuccess
-	case status == EventSatStatusFailure:
-		return db.RunStatusFailure
-	case status == EventSatStatusCanceled:
-		return db.RunStatusCanceled
+	case eventStatus == EventSatStatusSuccess:
+		return status.Success
+	case eventStatus == EventSatStatusFailure:
+		return status.Failure
+	case eventStatus == EventSatStatusCanceled:
+		return status.Canceled
 	default:
-		return db.RunStatusRunning
+		return status.Running
 	}
 }
 
-func inferSatPlaybookStatus(events *[]message.PlaybookSatRunResponseMessageYamlEventsElem) string {
-	hostStatusMap := make(map[string]string)
+func inferSatPlaybookStatus(events *[]message.PlaybookSatRunResponseMessageYamlEventsElem) status.Status {
+	hostStatusMap := make(map[string]status.Status)
 
 	for _, event := range *events {
 		if event.Type == EventSatPlaybookCompleted {
@@ -312,7 +562,7 @@ func inferSatPlaybookStatus(events *[]message.PlaybookSatRunResponseMessageYamlE
 
 		if event.Host != nil {
 			if _, ok := hostStatusMap[*event.Host]; !ok {
-				hostStatusMap[*event.Host] = db.RunStatusRunning
+				hostStatusMap[*event.Host] = status.Running
 			}
 			if event.Status != nil {
 				hostStatusMap[*event.Host] = satStatusEventDbMap(*event.Status)
@@ -323,29 +573,29 @@ func inferSatPlaybookStatus(events *[]message.PlaybookSatRunResponseMessageYamlE
 	failed := false
 	canceled := false
 
-	for _, status := range hostStatusMap {
-		if status == db.RunStatusRunning {
-			return status
+	for _, hostStatus := range hostStatusMap {
+		if hostStatus == status.Running {
+			return hostStatus
 		}
-		if status == db.RunStatusFailure {
+		if hostStatus == status.Failure {
 			failed = true
 		}
-		if status == db.RunStatusCanceled {
+		if hostStatus == status.Canceled {
 			canceled = true
 		}
 	}
 
 	switch {
 	case failed:
-		return db.RunStatusFailure
+		return status.Failure
 	case canceled:
-		return db.RunStatusCanceled
+		return status.Canceled
 	default:
-		return db.RunStatusSuccess
+		return status.Success
 	}
 }
 
-func inferSatHostStatus(events *[]message.PlaybookSatRunResponseMessageYamlEventsElem, host string) string {
+func inferSatHostStatus(events *[]message.PlaybookSatRunResponseMessageYamlEventsElem, host string) status.Status {
 	for _, event := range *events {
 		if event.Host != nil && *event.Host != host {
 			continue
@@ -355,10 +605,10 @@ func inferSatHostStatus(events *[]message.PlaybookSatRunResponseMessageYamlEvent
 		}
 	}
 
-	return db.RunStatusRunning
+	return status.Running
 }
 
-func checkSatStatusPartial(events *[]message.PlaybookSatRunResponseMessageYamlEventsElem) string {
+func checkSatStatusPartial(events *[]message.PlaybookSatRunResponseMessageYamlEventsElem) status.Status {
 	// for response_full = false, set run status to "running" unless "playbook_run_completed" signal is received
 	for _, event := range *events {
 		if event.Type != EventSatPlaybookCompleted || event.Status == nil {
@@ -368,13 +618,36 @@ func checkSatStatusPartial(events *[]message.PlaybookSatRunResponseMessageYamlEv
 		return satStatusEventDbMap(*event.Status)
 	}
 
-	return db.RunStatusRunning
+	return status.Running
+}
+
+// recordFirstResponse stores the time the first response of the run was uploaded at and tells whether this is that response
+func recordFirstResponse(tx *gorm.DB, runID uuid.UUID, uploaded time.Time) (bool, error) {
+	if uploaded.IsZero() {
+		uploaded = time.Now()
+	}
+
+	result := tx.Model(&db.Run{}).Where("id = ? AND first_response_at IS NULL", runID).UpdateColumn("first_response_at", uploaded)
+	return result.RowsAffected > 0, result.Error
+}
+
+// observeLifecycle records how long it took for the run to get its first response and to reach a terminal state.
+// The time of the upload is used rather than the time of processing so that consumer lag does not skew the numbers.
+func observeLifecycle(ctx context.Context, run db.Run, runStatus status.Status, requestType string, uploaded time.Time, firstResponse bool) {
+	if firstResponse {
+		instrumentation.RunFirstResponse(ctx, run.Service, requestType, uploaded.Sub(run.CreatedAt))
+	}
+
+	if !status.Status(run.Status).IsTerminal() && runStatus.IsTerminal() {
+		instrumentation.RunTerminalState(ctx, run.Service, requestType, runStatus, uploaded.Sub(run.CreatedAt))
+	}
 }
 
 type parsedMessageInfo struct {
 	OrgId           string
 	B64Identity     string
 	UploadTimestamp string
+	Uploaded        time.Time
 	RunnerEvents    *[]message.PlaybookRunResponseMessageYamlEventsElem
 	SatEvents       *[]message.PlaybookSatRunResponseMessageYamlEventsElem
 }
@@ -392,6 +665,7 @@ func parseMessage(ctx context.Context, requestType string, msg *k.Message) *pars
 			OrgId:           value.OrgId,
 			B64Identity:     value.B64Identity,
 			UploadTimestamp: value.UploadTimestamp.Format(time.RFC3339),
+			Uploaded:        value.UploadTimestamp,
 			RunnerEvents:    &value.Events,
 		}
 	} else {
@@ -406,6 +680,7 @@ func parseMessage(ctx context.Context, requestType string, msg *k.Message) *pars
 			OrgId:           value.OrgId,
 			B64Identity:     value.B64Identity,
 			UploadTimestamp: value.UploadTimestamp.Format(time.RFC3339),
+			Uploaded:        value.UploadTimestamp,
 			SatEvents:       &value.Events,
 		}
 	}
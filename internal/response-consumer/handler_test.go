@@ -8,7 +8,9 @@ import (
 	messageModel "playbook-dispatcher/internal/common/model/message"
 	"playbook-dispatcher/internal/common/utils"
 	"playbook-dispatcher/internal/common/utils/test"
+	"playbook-dispatcher/pkg/status"
 	"sort"
+	"strings"
 
 	k "github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/google/uuid"
@@ -167,6 +169,35 @@ var _ = Describe("handler", func() {
 		Expect(hosts[0].Log).To(Equal(log))
 	}
 
+	Describe("deduplication", func() {
+		It("applies a message delivered twice only once", func() {
+			instance.dedup = true
+
+			var data = test.NewRun(orgId())
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			events := createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+				"runner_on_ok",
+				"playbook_on_stats",
+			)
+
+			msg := newRunnerResponseMessage(events, data.CorrelationID)
+			instance.onMessage(test.TestContext(), msg)
+			instance.onMessage(test.TestContext(), msg)
+
+			var processed []dbModel.ProcessedMessage
+			Expect(db().Where("correlation_id = ?", data.CorrelationID).Find(&processed).Error).ToNot(HaveOccurred())
+			Expect(processed).To(HaveLen(1))
+			Expect(processed[0].RequestID).To(Equal("test"))
+
+			run := fetchRun(data.ID)
+			Expect(run.Status).To(Equal("success"))
+			checkHost(data.ID, "success", nil, "", nil)
+		})
+	})
+
 	Describe("state update", func() {
 		It("noop on empty list of events", func() {
 			var data = test.NewRun(orgId())
@@ -203,6 +234,54 @@ var _ = Describe("handler", func() {
 			checkHost(data.ID, "success", nil, "", nil)
 		})
 
+		It("records the transition of the run status in its history", func() {
+			var data = test.NewRun(orgId())
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			events := createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+				"runner_on_ok",
+				"playbook_on_stats",
+			)
+
+			instance.onMessage(test.TestContext(), newRunnerResponseMessage(events, data.CorrelationID))
+
+			var history []dbModel.RunEvent
+			Expect(db().Where("run_id = ?", data.ID).Find(&history).Error).ToNot(HaveOccurred())
+			Expect(history).To(HaveLen(1))
+			Expect(*history[0].PreviousStatus).To(Equal("running"))
+			Expect(history[0].Status).To(Equal("success"))
+			Expect(history[0].Source).To(Equal("response"))
+			Expect(*history[0].SourceEventID).To(Equal("test"))
+		})
+
+		It("records the first response of the run once", func() {
+			var data = test.NewRun(orgId())
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+			// the run gets updated before it receives any response
+			Expect(db().Model(&data).Update("kessel_token", "token").Error).ToNot(HaveOccurred())
+
+			instance.onMessage(test.TestContext(), newRunnerResponseMessage(createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+			), data.CorrelationID))
+
+			first := fetchRun(data.ID).FirstResponseAt
+			Expect(first).ToNot(BeNil())
+
+			instance.onMessage(test.TestContext(), newRunnerResponseMessage(createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+				"runner_on_ok",
+				"playbook_on_stats",
+			), data.CorrelationID))
+
+			run := fetchRun(data.ID)
+			Expect(run.Status).To(Equal("success"))
+			Expect(*run.FirstResponseAt).To(BeTemporally("==", *first))
+		})
+
 		It("updates the run status based on executor_on_failed events", func() {
 			var data = test.NewRun(orgId())
 			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
@@ -362,6 +441,49 @@ var _ = Describe("handler", func() {
 			checkHost(data.ID, "failure", nil, "", nil)
 		})
 
+		It("leaves a canceling run canceling until the cancel is acknowledged", func() {
+			var data = test.NewRun(orgId())
+			data.Status = string(status.Canceling)
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			events := createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+			)
+
+			instance.onMessage(test.TestContext(), newRunnerResponseMessage(events, data.CorrelationID))
+
+			run := fetchRun(data.ID)
+			Expect(run.Status).To(Equal("canceling"))
+			checkHost(data.ID, "running", nil, "", nil)
+		})
+
+		It("updates the run status based on executor_on_canceled events", func() {
+			var data = test.NewRun(orgId())
+			data.Status = string(status.Canceling)
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			cancelRequested := string(status.CancelRequested)
+			host := test.NewRunHost(data.ID, "running", nil)
+			host.CancelState = &cancelRequested
+			Expect(db().Create(&host).Error).ToNot(HaveOccurred())
+
+			events := createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+				EventExecutorOnCanceled,
+			)
+
+			instance.onMessage(test.TestContext(), newRunnerResponseMessage(events, data.CorrelationID))
+
+			run := fetchRun(data.ID)
+			Expect(run.Status).To(Equal("canceled"))
+			checkHost(data.ID, "canceled", nil, "", nil)
+
+			hosts := fetchHosts(data.ID)
+			Expect(*hosts[0].CancelState).To(Equal(string(status.CancelAcked)))
+		})
+
 		It("updates multiple hosts involved in a run", func() {
 			var data = test.NewRun(orgId())
 			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
@@ -415,6 +537,85 @@ var _ = Describe("handler", func() {
 			Expect(hosts[0].Log).To(Equal("a1b2"))
 			Expect(hosts[1].Log).To(Equal("a1b2"))
 		})
+
+		It("stores the diffs reported by the host", func() {
+			var data = test.NewRun(orgId())
+			data.ExecutionMode = "check"
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			events := createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+				"playbook_on_task_start",
+				"runner_on_ok",
+				"playbook_on_stats",
+			)
+
+			(*events)[3].EventData.Task = utils.StringRef("configure motd")
+			(*events)[3].EventData.Res = messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataRes{
+				"diff": []interface{}{map[string]interface{}{"before": "hello\n", "after": "welcome\n"}},
+			}
+
+			instance.onMessage(test.TestContext(), newRunnerResponseMessage(events, data.CorrelationID))
+
+			hosts := fetchHosts(data.ID)
+			Expect(hosts).To(HaveLen(1))
+			Expect(hosts[0].Diffs).To(MatchJSON(`[{"task": "configure motd", "diff": [{"before": "hello\\n", "after": "welcome\\n"}]}]`))
+		})
+
+		It("stores the artifacts reported by the host", func() {
+			var data = test.NewRun(orgId())
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			events := createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+				"playbook_on_task_start",
+				"runner_on_ok",
+				"playbook_on_stats",
+			)
+
+			(*events)[3].EventData.Task = utils.StringRef("Gathering Facts")
+			(*events)[3].EventData.Res = messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataRes{
+				"ansible_facts": map[string]interface{}{"ansible_distribution": "RedHat"},
+			}
+			(*events)[4].EventData.ArtifactData = messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataArtifactData{"report_version": 2}
+
+			instance.onMessage(test.TestContext(), newRunnerResponseMessage(events, data.CorrelationID))
+
+			hosts := fetchHosts(data.ID)
+			Expect(hosts).To(HaveLen(1))
+			Expect(hosts[0].Artifacts).To(MatchJSON(`{
+				"facts": {"ansible_distribution": "RedHat"},
+				"stats": {"report_version": 2},
+				"results": [{"task": "Gathering Facts", "event": "runner_on_ok", "result": {"ansible_facts": {"ansible_distribution": "RedHat"}}}]
+			}`))
+		})
+
+		It("drops the task results of artifacts over the size limit", func() {
+			instance.artifactsMaxSize = 100
+
+			var data = test.NewRun(orgId())
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			events := createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+				"playbook_on_task_start",
+				"runner_on_ok",
+				"playbook_on_stats",
+			)
+
+			(*events)[3].EventData.Res = messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataRes{
+				"stdout": strings.Repeat("x", 200),
+			}
+
+			instance.onMessage(test.TestContext(), newRunnerResponseMessage(events, data.CorrelationID))
+
+			hosts := fetchHosts(data.ID)
+			Expect(hosts).To(HaveLen(1))
+			Expect(hosts[0].Artifacts).To(MatchJSON(`{"facts": {}, "stats": {}, "results": [], "truncated": true}`))
+		})
 	})
 
 	Describe("correlation", func() {
@@ -525,6 +726,39 @@ var _ = Describe("handler", func() {
 			checkHost(data.ID, "canceled", &seq, "", &inventoryId)
 		})
 
+		It("tracks which hosts acknowledged a requested cancel", func() {
+			var data = test.NewRun(orgId())
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			cancelRequested := string(status.CancelRequested)
+			inventoryId1, inventoryId2 := uuid.New(), uuid.New()
+			host1 := test.NewRunHost(data.ID, "running", &inventoryId1)
+			host1.CancelState = &cancelRequested
+			host2 := test.NewRunHost(data.ID, "running", &inventoryId2)
+			host2.Host = "host2"
+			host2.CancelState = &cancelRequested
+			Expect(db().Create(&[]dbModel.RunHost{host1, host2}).Error).ToNot(HaveOccurred())
+
+			events := buildSatEvents(
+				data.CorrelationID,
+				satPlaybookRunFinishedEvent(inventoryId1.String(), "canceled"),
+				satPlaybookRunFinishedEvent(inventoryId2.String(), "success"),
+			)
+
+			instance.onMessage(test.TestContext(), newSatResponseMessage(events, data.CorrelationID))
+
+			cancelStates := map[uuid.UUID]string{}
+			for _, host := range fetchHosts(data.ID) {
+				Expect(host.CancelState).ToNot(BeNil())
+				cancelStates[*host.InventoryID] = *host.CancelState
+			}
+
+			Expect(cancelStates).To(Equal(map[uuid.UUID]string{
+				inventoryId1: string(status.CancelAcked),
+				inventoryId2: cancelRequested,
+			}))
+		})
+
 		It("updates multiple satellite hosts involved in a run", func() {
 			var data = test.NewRun(orgId())
 			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
@@ -573,6 +807,72 @@ var _ = Describe("handler", func() {
 			Expect(hosts[1].Log).To(Equal("e5f6"))
 		})
 
+		It("reports the progress of a satellite run", func() {
+			var data = test.NewRun(orgId())
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			inventoryId1, inventoryId2 := uuid.New(), uuid.New()
+			host1 := test.NewRunHost(data.ID, "running", &inventoryId1)
+			host2 := test.NewRunHost(data.ID, "running", &inventoryId2)
+			host2.Host = "localhost2"
+			Expect(db().Create(&[]dbModel.RunHost{host1, host2}).Error).ToNot(HaveOccurred())
+
+			events := buildSatEvents(
+				data.CorrelationID,
+				satPlaybookRunUpdateEvent(0, inventoryId1.String(), "a"),
+				satPlaybookRunUpdateEvent(0, inventoryId2.String(), "b"),
+				satPlaybookRunFinishedEvent(inventoryId1.String(), "success"),
+			)
+
+			instance.onMessage(test.TestContext(), newSatResponseMessage(events, data.CorrelationID))
+
+			run := fetchRun(data.ID)
+			Expect(run.Status).To(Equal("running"))
+			Expect(run.Progress).To(Equal(50))
+
+			events = buildSatEvents(
+				data.CorrelationID,
+				satPlaybookRunFinishedEvent(inventoryId2.String(), "success"),
+				satPlaybookRunCompletedEvent("success"),
+			)
+
+			instance.onMessage(test.TestContext(), newSatResponseMessage(events, data.CorrelationID))
+
+			run = fetchRun(data.ID)
+			Expect(run.Status).To(Equal("success"))
+			Expect(run.Progress).To(Equal(100))
+		})
+
+		It("finishes a run dispatched in chunks once all of its hosts finish", func() {
+			var data = test.NewRun(orgId())
+			data.DispatchChunks = 2
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			inventoryId1, inventoryId2 := uuid.New(), uuid.New()
+			host1 := test.NewRunHost(data.ID, "running", &inventoryId1)
+			host2 := test.NewRunHost(data.ID, "running", &inventoryId2)
+			host2.Host = "localhost2"
+			Expect(db().Create(&[]dbModel.RunHost{host1, host2}).Error).ToNot(HaveOccurred())
+
+			events := buildSatEvents(
+				data.CorrelationID,
+				satPlaybookRunFinishedEvent(inventoryId1.String(), "failure"),
+				satPlaybookRunCompletedEvent("failure"),
+			)
+
+			instance.onMessage(test.TestContext(), newSatResponseMessage(events, data.CorrelationID))
+			Expect(fetchRun(data.ID).Status).To(Equal("running"))
+
+			events = buildSatEvents(
+				data.CorrelationID,
+				satPlaybookRunFinishedEvent(inventoryId2.String(), "success"),
+				satPlaybookRunCompletedEvent("success"),
+			)
+
+			instance.onMessage(test.TestContext(), newSatResponseMessage(events, data.CorrelationID))
+			Expect(fetchRun(data.ID).Status).To(Equal("failure"))
+		})
+
 		It("correctly updates satellite hosts from an out-of-order multi-host run", func() {
 			var data = test.NewRun(orgId())
 			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
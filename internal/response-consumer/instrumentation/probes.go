@@ -2,7 +2,10 @@ package instrumentation
 
 import (
 	"context"
+	commonInstrumentation "playbook-dispatcher/internal/common/instrumentation"
 	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/pkg/status"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
@@ -20,11 +23,28 @@ var (
 		Help: "The total number of run updates that did not match any known playbook run",
 	})
 
+	duplicateMessageTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "response_consumer_duplicate_message_total",
+		Help: "The total number of messages skipped because they have already been applied",
+	})
+
 	playbookSequenceOutOfOrder = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "response_consumer_playbook_run_sequence_out_of_order_total",
 		Help: "The total number of run updates that are consumed out of order",
 	})
 
+	runFirstResponseDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "response_consumer_run_first_response_duration_seconds",
+		Help:    "Time from the creation of a run until the first response from the recipient is uploaded",
+		Buckets: commonInstrumentation.RunLifecycleBuckets,
+	}, []string{"dispatching_service", "request"})
+
+	runTerminalStateDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "response_consumer_run_terminal_state_duration_seconds",
+		Help:    "Time from the creation of a run until it reaches a terminal state",
+		Buckets: commonInstrumentation.RunLifecycleBuckets,
+	}, []string{"dispatching_service", "request", "status"})
+
 	errorTotal = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "response_consumer_error_total",
 		Help: "The total number of errors during payloads processing",
@@ -34,6 +54,16 @@ var (
 		Name: "response_consumer_validation_failure_total",
 		Help: "The total number of invalid payloads",
 	}, []string{"type"})
+
+	stdoutRedactedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "response_consumer_stdout_redacted_total",
+		Help: "The total number of secrets redacted from playbook output",
+	}, []string{"dispatching_service", "rule"})
+
+	// the dispatching service is taken from the request that created the run
+	runFirstResponseDurationServices = commonInstrumentation.NewLabelGuard("response_consumer_run_first_response_duration_seconds", "dispatching_service", commonInstrumentation.DefaultLabelLimit)
+	runTerminalStateDurationServices = commonInstrumentation.NewLabelGuard("response_consumer_run_terminal_state_duration_seconds", "dispatching_service", commonInstrumentation.DefaultLabelLimit)
+	stdoutRedactedServices           = commonInstrumentation.NewLabelGuard("response_consumer_stdout_redacted_total", "dispatching_service", commonInstrumentation.DefaultLabelLimit)
 )
 
 const (
@@ -42,18 +72,30 @@ const (
 	labelHeaderMissing  = "header_missing"
 )
 
-func PlaybookRunUpdated(ctx context.Context, status string, runId uuid.UUID) {
-	utils.GetLogFromContext(ctx).Infow("Updated run", "runStatus", status, "run_id", runId.String())
+func PlaybookRunUpdated(ctx context.Context, runStatus status.Status, runId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Infow("Updated run", "runStatus", runStatus, "run_id", runId.String())
 	playbookRunUpdatedTotal.Inc()
 }
 
-func PlaybookRunUpdateMiss(ctx context.Context, status string) {
-	utils.GetLogFromContext(ctx).Warnw("No run to update", "runStatus", status)
+func RunFirstResponse(ctx context.Context, service string, requestType string, duration time.Duration) {
+	runFirstResponseDuration.WithLabelValues(runFirstResponseDurationServices.Value(service), requestType).Observe(duration.Seconds())
+}
+
+func RunTerminalState(ctx context.Context, service string, requestType string, runStatus status.Status, duration time.Duration) {
+	runTerminalStateDuration.WithLabelValues(runTerminalStateDurationServices.Value(service), requestType, runStatus.String()).Observe(duration.Seconds())
+}
+
+func StdoutRedacted(ctx context.Context, service string, rule string, count int) {
+	stdoutRedactedTotal.WithLabelValues(stdoutRedactedServices.Value(service), rule).Add(float64(count))
+}
+
+func PlaybookRunUpdateMiss(ctx context.Context, runStatus status.Status) {
+	utils.GetLogFromContext(ctx).Warnw("No run to update", "runStatus", runStatus)
 	playbookRunUpdateMissTotal.Inc()
 }
 
-func PlaybookRunUpdateError(ctx context.Context, err error, status string, runId uuid.UUID) {
-	utils.GetLogFromContext(ctx).Errorw("Error updating run", "runStatus", status, "error", err, "run_id", runId.String())
+func PlaybookRunUpdateError(ctx context.Context, err error, runStatus status.Status, runId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Errorw("Error updating run", "runStatus", runStatus, "error", err, "run_id", runId.String())
 	errorTotal.WithLabelValues(labelDbUpdate).Inc()
 }
 
@@ -67,6 +109,11 @@ func CannotReadHeaders(ctx context.Context, err error) {
 	errorTotal.WithLabelValues(labelHeaderMissing).Inc()
 }
 
+func DuplicateMessage(ctx context.Context, topic string) {
+	utils.GetLogFromContext(ctx).Debugw("Skipping message that has already been applied", "topic", topic)
+	duplicateMessageTotal.Inc()
+}
+
 func PlaybookRunUpdateSequenceOrder(ctx context.Context) {
 	utils.GetLogFromContext(ctx).Errorw("Run update is out of order")
 	playbookSequenceOutOfOrder.Inc()
@@ -4,12 +4,17 @@ import (
 	"context"
 	"playbook-dispatcher/internal/common/db"
 	"playbook-dispatcher/internal/common/kafka"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/scrub"
 	"playbook-dispatcher/internal/common/utils"
 	"playbook-dispatcher/internal/response-consumer/instrumentation"
 	"sync"
+	"time"
 
+	k "github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/qri-io/jsonschema"
 	"github.com/spf13/viper"
+	"gorm.io/gorm"
 )
 
 const (
@@ -25,6 +30,8 @@ func Start(
 	ready, live *utils.ProbeHandler,
 	wg *sync.WaitGroup,
 ) {
+	ctx = utils.WithSampling(ctx, cfg, "response.consumer")
+
 	instrumentation.Start()
 
 	schemaMapper := make(map[string]*jsonschema.Schema)
@@ -34,33 +41,111 @@ func Start(
 	schemaMapper[runnerMessageHeaderValue] = schemas[0]
 	schemaMapper[satMessageHeaderValue] = schemas[1]
 
-	db, sql := db.Connect(ctx, cfg)
-	ready.Register(sql.Ping)
-	live.Register(sql.Ping)
+	db, release := db.Shared(ctx, cfg, ready, live)
 
 	kafkaTimeout := cfg.GetInt("kafka.timeout")
 	consumer, err := kafka.NewConsumer(ctx, cfg, cfg.GetString("topic.updates"))
 	utils.DieOnError(err)
 
-	ready.Register(func() error {
+	err = ready.RegisterDependency("response-consumer.kafka", true, func() error {
 		return kafka.Ping(kafkaTimeout, consumer)
 	})
+	utils.DieOnError(err)
+
+	dedupWindow := cfg.GetDuration("response.consumer.dedup.window") * time.Second
+
+	scrubber, err := scrub.NewScrubber(cfg)
+	utils.DieOnError(err)
 
 	handler := &handler{
-		db: db,
+		db:       db,
+		dedup:    dedupWindow > 0,
+		scrubber: scrubber,
+
+		artifactsMaxSize: cfg.GetInt("run.host.artifacts.max.size"),
 	}
 
 	headerPredicate := kafka.FilterByHeaderPredicate(utils.GetLogFromContext(ctx), requestTypeHeader, runnerMessageHeaderValue, satMessageHeaderValue)
 	validationPredicate := kafka.SchemaValidationPredicate(ctx, requestTypeHeader, schemaMapper)
 
-	start := kafka.NewConsumerEventLoop(ctx, consumer, headerPredicate, validationPredicate, handler.onMessage, errors)
+	consumers := sync.WaitGroup{}
+
+	startConsumer := func(consumer *k.Consumer) {
+		start := kafka.NewConsumerEventLoop(ctx, consumer, headerPredicate, validationPredicate, handler.onMessage, errors)
+
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			// commits the offsets of messages handled so far
+			defer consumer.Close()
+			start()
+		}()
+	}
+
+	startConsumer(consumer)
+
+	// while migrating to a new topic or cluster both the old and the new topic are consumed
+	if secondaryTopic := cfg.GetString("topic.secondary.updates"); secondaryTopic != "" {
+		servers := cfg.GetString("kafka.secondary.bootstrap.servers")
+		if servers == "" {
+			servers = cfg.GetString("kafka.bootstrap.servers")
+		}
+
+		if !handler.dedup {
+			utils.GetLogFromContext(ctx).Warn("Consuming a secondary topic without deduplication, messages present in both topics are applied twice")
+		}
+
+		utils.GetLogFromContext(ctx).Infow("Consuming secondary topic", "topic", secondaryTopic, "servers", servers)
+
+		secondaryConsumer, err := kafka.NewConsumerFromServers(ctx, cfg, servers, secondaryTopic)
+		utils.DieOnError(err)
 
+		err = ready.RegisterDependency("response-consumer.kafka-secondary", true, func() error {
+			return kafka.Ping(kafkaTimeout, secondaryConsumer)
+		})
+		utils.DieOnError(err)
+
+		startConsumer(secondaryConsumer)
+	}
+
+	if handler.dedup {
+		startDedupCleanup(ctx, db, dedupWindow, &consumers)
+	}
+
+	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		defer utils.GetLogFromContext(ctx).Debug("Response consumer stopped")
-		defer sql.Close()
-		defer consumer.Close()
-		wg.Add(1)
-		start()
+		defer release()
+		consumers.Wait()
+	}()
+}
+
+// startDedupCleanup periodically removes the records of messages processed longer than the deduplication window ago
+func startDedupCleanup(ctx context.Context, db *gorm.DB, window time.Duration, wg *sync.WaitGroup) {
+	log := utils.GetLogFromContext(ctx)
+	ticker := time.NewTicker(time.Minute)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				result := db.WithContext(ctx).
+					Where("processed_at < ?", time.Now().Add(-window)).
+					Delete(&dbModel.ProcessedMessage{})
+
+				if result.Error != nil {
+					log.Errorw("Error removing processed messages", "error", result.Error)
+				} else {
+					log.Debugw("Removed processed messages", "count", result.RowsAffected)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
 	}()
 }
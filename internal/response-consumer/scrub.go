@@ -0,0 +1,68 @@
+package responseConsumer
+
+import (
+	"context"
+
+	"playbook-dispatcher/internal/common/scrub"
+	"playbook-dispatcher/internal/response-consumer/instrumentation"
+)
+
+// scrubOutput redacts secrets from the output carried by the events in place.
+// Both the events and the host logs are derived from the events so this needs to happen before either is stored.
+func scrubOutput(ctx context.Context, scrubber *scrub.Scrubber, service string, value *parsedMessageInfo) {
+	counts := map[string]int{}
+
+	scrubText := func(text *string) *string {
+		if text == nil {
+			return nil
+		}
+
+		scrubbed, redacted := scrubber.Scrub(*text)
+		for rule, count := range redacted {
+			counts[rule] += count
+		}
+
+		return &scrubbed
+	}
+
+	if value.RunnerEvents != nil {
+		for i := range *value.RunnerEvents {
+			event := &(*value.RunnerEvents)[i]
+			event.Stdout = scrubText(event.Stdout)
+
+			if event.EventData != nil {
+				scrubValue(map[string]interface{}(event.EventData.Res), scrubText)
+				scrubValue(map[string]interface{}(event.EventData.ArtifactData), scrubText)
+			}
+		}
+	}
+
+	if value.SatEvents != nil {
+		for i := range *value.SatEvents {
+			event := &(*value.SatEvents)[i]
+			event.Console = scrubText(event.Console)
+		}
+	}
+
+	for rule, count := range counts {
+		instrumentation.StdoutRedacted(ctx, service, rule, count)
+	}
+}
+
+// task results and artifacts (e.g. diffs of the files the playbook changes) are as likely to contain secrets as the output
+func scrubValue(value interface{}, scrubText func(*string) *string) interface{} {
+	switch value := value.(type) {
+	case string:
+		return *scrubText(&value)
+	case []interface{}:
+		for i := range value {
+			value[i] = scrubValue(value[i], scrubText)
+		}
+	case map[string]interface{}:
+		for key := range value {
+			value[key] = scrubValue(value[key], scrubText)
+		}
+	}
+
+	return value
+}
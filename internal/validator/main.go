@@ -23,6 +23,8 @@ func Start(
 	ready, live *utils.ProbeHandler,
 	wg *sync.WaitGroup,
 ) {
+	ctx = utils.WithSampling(ctx, cfg, "validator")
+
 	var schemaNames = []string{"schema.runner.event", "schema.rhcsat.event"}
 	schemas := utils.LoadSchemas(cfg, schemaNames)
 
@@ -47,29 +49,35 @@ func Start(
 	storageConnector := newStorageConnector(cfg)
 	var validateWg sync.WaitGroup
 
-	ready.Register(func() error {
+	err = ready.RegisterDependency("validator.kafka", true, func() error {
 		return kafka.Ping(kafkaTimeout, consumer, producer)
 	})
+	utils.DieOnError(err)
 
 	predicate := kafka.FilterByHeaderPredicate(utils.GetLogFromContext(ctx), payloadTypeHeader, playbookPayloadHeaderValue, playbookSatPayloadHeaderValue)
 
 	start := kafka.NewConsumerEventLoop(ctx, consumer, predicate, nil, handler.onMessage, errors)
 
+	wg.Add(1)
+	validateWg.Add(1)
+
+	go storageConnector.initiateFetchWorkers(storageConnectorConcurrency, handler.requestsChan, handler.validateChan)
+	go handler.initiateValidationWorker(&validateWg)
+
 	go func() {
 		defer wg.Done()
-		defer utils.GetLogFromContext(ctx).Debug("Validator stopped")
-		defer producer.Close()
-		defer utils.GetLogFromContext(ctx).Infof("Producer flushed with %d pending messages", producer.Flush(kafkaTimeout))
-		defer validateWg.Wait()
-		defer close(handler.requestsChan)
-		defer consumer.Close()
+		log := utils.GetLogFromContext(ctx)
 
-		wg.Add(1)
-		validateWg.Add(1)
+		start()
 
-		go storageConnector.initiateFetchWorkers(storageConnectorConcurrency, handler.requestsChan, handler.validateChan)
-		go handler.initiateValidationWorker(&validateWg)
+		// finish validation of messages already handed over to workers before their offsets get committed
+		close(handler.requestsChan)
+		validateWg.Wait()
 
-		start()
+		log.Infof("Producer flushed with %d pending messages", producer.Flush(kafkaTimeout))
+		producer.Close()
+		consumer.Close()
+
+		log.Debug("Validator stopped")
 	}()
 }
@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS runs_correlation_id_index;
@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS runs_correlation_id_index ON runs (correlation_id);
@@ -0,0 +1,7 @@
+CREATE TABLE api_usage (
+    org_id varchar(10) NOT NULL,
+    period timestamptz NOT NULL,
+    calls bigint NOT NULL default 0,
+
+    PRIMARY KEY (org_id, period)
+);
@@ -0,0 +1,9 @@
+CREATE TABLE audit_outbox (
+    id uuid PRIMARY KEY,
+    event_type varchar(64) NOT NULL,
+    org_id varchar(10) NOT NULL default '',
+    payload jsonb NOT NULL,
+    created_at timestamptz NOT NULL default now()
+);
+
+CREATE INDEX audit_outbox_created_at ON audit_outbox (created_at);
@@ -0,0 +1,9 @@
+CREATE TABLE processed_messages (
+    request_id text NOT NULL,
+    correlation_id uuid NOT NULL,
+    processed_at timestamptz NOT NULL default now(),
+
+    PRIMARY KEY (request_id, correlation_id)
+);
+
+CREATE INDEX processed_messages_processed_at ON processed_messages (processed_at);
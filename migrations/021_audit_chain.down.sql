@@ -0,0 +1,5 @@
+DROP TABLE audit_chain_anchors;
+
+DROP TABLE audit_chain_head;
+
+ALTER TABLE audit_outbox DROP COLUMN sequence;
@@ -0,0 +1,17 @@
+ALTER TABLE audit_outbox ADD COLUMN sequence bigint NOT NULL default 0;
+
+CREATE INDEX audit_outbox_sequence ON audit_outbox (sequence);
+
+CREATE TABLE audit_chain_head (
+    id integer PRIMARY KEY CHECK (id = 1),
+    sequence bigint NOT NULL,
+    hash varchar(64) NOT NULL
+);
+
+INSERT INTO audit_chain_head (id, sequence, hash) VALUES (1, 0, '');
+
+CREATE TABLE audit_chain_anchors (
+    sequence bigint PRIMARY KEY,
+    hash varchar(64) NOT NULL,
+    created_at timestamptz NOT NULL default now()
+);
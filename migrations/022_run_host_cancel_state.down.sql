@@ -0,0 +1 @@
+ALTER TABLE run_hosts DROP COLUMN cancel_state;
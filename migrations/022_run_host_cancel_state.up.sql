@@ -0,0 +1 @@
+ALTER TABLE run_hosts ADD COLUMN cancel_state varchar;
@@ -0,0 +1 @@
+ALTER TABLE runs ADD COLUMN progress smallint NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE runs ADD COLUMN dispatch_chunks smallint NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+ALTER TABLE run_hosts DROP COLUMN diffs;
+ALTER TABLE runs DROP COLUMN execution_mode;
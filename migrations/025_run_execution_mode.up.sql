@@ -0,0 +1,2 @@
+ALTER TABLE runs ADD COLUMN execution_mode varchar NOT NULL DEFAULT 'run';
+ALTER TABLE run_hosts ADD COLUMN diffs jsonb;
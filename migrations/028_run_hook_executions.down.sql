@@ -0,0 +1,2 @@
+DROP INDEX runs_service_updated_at_index;
+DROP TABLE run_hook_executions;
@@ -0,0 +1,18 @@
+CREATE TABLE run_hook_executions (
+    run_id uuid NOT NULL REFERENCES runs ON DELETE CASCADE,
+    hook varchar NOT NULL,
+
+    state varchar NOT NULL default 'pending',
+    attempts integer NOT NULL default 0,
+    last_error text,
+    next_attempt_at timestamptz NOT NULL default now(),
+
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now(),
+
+    PRIMARY KEY (run_id, hook)
+);
+
+CREATE INDEX run_hook_executions_pending ON run_hook_executions (next_attempt_at) WHERE state = 'pending';
+CREATE INDEX run_hook_executions_updated_at ON run_hook_executions (updated_at);
+CREATE INDEX runs_service_updated_at_index ON runs (service, updated_at);
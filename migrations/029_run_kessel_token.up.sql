@@ -0,0 +1 @@
+ALTER TABLE runs ADD COLUMN kessel_token text;
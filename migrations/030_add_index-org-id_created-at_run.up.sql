@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS runs_org_id_created_at_id_index ON runs (org_id, created_at, id);
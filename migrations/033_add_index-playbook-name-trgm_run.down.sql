@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS runs_playbook_name_trgm_index;
@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS runs_playbook_name_trgm_index ON runs USING gin (playbook_name gin_trgm_ops);
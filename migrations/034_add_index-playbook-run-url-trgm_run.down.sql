@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS runs_playbook_run_url_trgm_index;
@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS runs_playbook_run_url_trgm_index ON runs USING gin (playbook_run_url gin_trgm_ops);
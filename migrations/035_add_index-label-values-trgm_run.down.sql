@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS runs_label_values_trgm_index;
@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS runs_label_values_trgm_index ON runs USING gin ((jsonb_path_query_array(labels, '$.*')::text) gin_trgm_ops);
@@ -0,0 +1,8 @@
+CREATE TABLE inventory_hosts (
+    org_id varchar(10) NOT NULL,
+    id uuid NOT NULL,
+    display_name varchar NOT NULL,
+    updated_at timestamptz NOT NULL default now(),
+
+    PRIMARY KEY (org_id, id)
+);
@@ -0,0 +1,13 @@
+CREATE TABLE run_events (
+    id bigserial PRIMARY KEY,
+    run_id uuid NOT NULL REFERENCES runs ON DELETE CASCADE,
+
+    previous_status varchar,
+    status varchar NOT NULL,
+    source varchar NOT NULL,
+    source_event_id varchar,
+
+    created_at timestamptz NOT NULL default now()
+);
+
+CREATE INDEX run_events_run_id_created_at ON run_events (run_id, created_at);
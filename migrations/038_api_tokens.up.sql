@@ -0,0 +1,12 @@
+CREATE TABLE api_tokens (
+    id uuid PRIMARY KEY,
+    org_id varchar(10) NOT NULL,
+    name varchar NOT NULL,
+    -- sha256 of the token, the token itself is not stored
+    hash varchar NOT NULL UNIQUE,
+
+    created_at timestamptz NOT NULL default now(),
+    expires_at timestamptz
+);
+
+CREATE INDEX api_tokens_org_id ON api_tokens (org_id);
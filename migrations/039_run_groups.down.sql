@@ -0,0 +1,2 @@
+ALTER TABLE runs DROP COLUMN group_id;
+DROP TABLE run_groups;
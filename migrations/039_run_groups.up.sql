@@ -0,0 +1,11 @@
+CREATE TABLE run_groups (
+    id uuid PRIMARY KEY,
+    org_id varchar(10) NOT NULL,
+    service varchar NOT NULL,
+    name varchar NOT NULL,
+    principal varchar NOT NULL,
+
+    created_at timestamptz NOT NULL default now()
+);
+
+ALTER TABLE runs ADD COLUMN group_id uuid REFERENCES run_groups ON DELETE SET NULL;
@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS runs_group_id_index;
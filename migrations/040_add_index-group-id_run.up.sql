@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS runs_group_id_index ON runs (group_id) WHERE group_id IS NOT NULL;
@@ -0,0 +1,27 @@
+CREATE TABLE run_templates (
+    id uuid PRIMARY KEY,
+    org_id varchar(10) NOT NULL,
+    service varchar NOT NULL,
+    principal varchar NOT NULL,
+    name varchar NOT NULL,
+    -- cron expression, evaluated in UTC
+    schedule varchar NOT NULL,
+
+    recipient uuid NOT NULL,
+    url varchar NOT NULL,
+    web_console_url varchar,
+    labels jsonb NOT NULL default '{}',
+    timeout int,
+
+    enabled boolean NOT NULL default true,
+    -- not set while the template is disabled
+    next_run_at timestamptz,
+    last_run_at timestamptz,
+    last_run_id uuid REFERENCES runs ON DELETE SET NULL,
+
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now()
+);
+
+CREATE INDEX run_templates_org_id ON run_templates (org_id);
+CREATE INDEX run_templates_next_run_at ON run_templates (next_run_at) WHERE next_run_at IS NOT NULL;
@@ -0,0 +1,9 @@
+-- overrides of run.concurrency.limit for individual orgs
+CREATE TABLE run_limits (
+    org_id varchar(10) PRIMARY KEY,
+    -- maximum number of runs of the org in the running state, 0 disables the limit
+    max_running int NOT NULL CHECK (max_running >= 0),
+
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now()
+);
@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS runs_org_id_running_index;
@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS runs_org_id_running_index ON runs (org_id) WHERE status = 'running';
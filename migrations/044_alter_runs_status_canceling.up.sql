@@ -0,0 +1 @@
+ALTER TYPE runs_status ADD VALUE IF NOT EXISTS 'canceling';
@@ -0,0 +1 @@
+ALTER TABLE runs ADD COLUMN parent_run_id uuid REFERENCES runs ON DELETE SET NULL;
@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS runs_parent_run_id_index;
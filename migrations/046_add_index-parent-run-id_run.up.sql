@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS runs_parent_run_id_index ON runs (parent_run_id) WHERE parent_run_id IS NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE runs ADD COLUMN first_response_at timestamptz;
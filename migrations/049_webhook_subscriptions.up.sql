@@ -0,0 +1,10 @@
+CREATE TABLE webhook_subscriptions (
+    id varchar PRIMARY KEY,
+
+    state varchar NOT NULL default 'active',
+    failures integer NOT NULL default 0,
+    last_error text,
+
+    created_at timestamptz NOT NULL default now(),
+    updated_at timestamptz NOT NULL default now()
+);
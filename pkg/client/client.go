@@ -0,0 +1,336 @@
+// Package client is the Go client of the playbook-dispatcher public and internal APIs.
+//
+// It wraps the clients generated from the OpenAPI specifications (see the public and private packages) with
+// authentication, retries and iterators that transparently page through list endpoints:
+//
+//	c, err := client.New("http://playbook-dispatcher-api:8000", client.WithPsk(os.Getenv("DISPATCHER_PSK")))
+//	created, err := c.Dispatch(ctx, []private.RunInputV2{...})
+//
+//	for run, err := range c.Runs(ctx, public.ApiRunsListParams{}) {
+//		...
+//	}
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"time"
+
+	"playbook-dispatcher/pkg/client/private"
+	"playbook-dispatcher/pkg/client/public"
+)
+
+const (
+	headerIdentity  = "x-rh-identity"
+	headerRequestId = "x-rh-insights-request-id"
+
+	defaultPageSize = 100
+)
+
+// Client calls the playbook-dispatcher API. The generated clients are exposed for operations not wrapped here.
+type Client struct {
+	Public   *public.ClientWithResponses
+	Private  *private.ClientWithResponses
+	pageSize int
+}
+
+type options struct {
+	httpClient *http.Client
+	retry      RetryPolicy
+	psk        string
+	identity   string
+	pageSize   int
+}
+
+type Option func(*options)
+
+// WithPsk authenticates calls of the internal API using the given pre-shared key
+func WithPsk(key string) Option {
+	return func(o *options) {
+		o.psk = key
+	}
+}
+
+// WithIdentity sends the given (base64-encoded) identity header, required by the public API and some internal operations
+func WithIdentity(identity string) Option {
+	return func(o *options) {
+		o.identity = identity
+	}
+}
+
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = httpClient
+	}
+}
+
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = policy
+	}
+}
+
+// WithPageSize sets the number of items fetched per request by the iterators
+func WithPageSize(size int) Option {
+	return func(o *options) {
+		o.pageSize = size
+	}
+}
+
+func New(server string, opts ...Option) (*Client, error) {
+	o := &options{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		retry:      DefaultRetryPolicy,
+		pageSize:   defaultPageSize,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	doer := &retryingDoer{client: o.httpClient, policy: o.retry}
+
+	headers := func(ctx context.Context, req *http.Request) error {
+		if o.psk != "" {
+			req.Header.Set("Authorization", "PSK "+o.psk)
+		}
+
+		if o.identity != "" {
+			req.Header.Set(headerIdentity, o.identity)
+		}
+
+		if requestId, ok := ctx.Value(requestIdKey{}).(string); ok {
+			req.Header.Set(headerRequestId, requestId)
+		}
+
+		return nil
+	}
+
+	publicClient, err := public.NewClientWithResponses(server, public.WithHTTPClient(doer), public.WithRequestEditorFn(headers))
+	if err != nil {
+		return nil, err
+	}
+
+	privateClient, err := private.NewClientWithResponses(server, private.WithHTTPClient(doer), private.WithRequestEditorFn(headers))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{Public: publicClient, Private: privateClient, pageSize: o.pageSize}, nil
+}
+
+type requestIdKey struct{}
+
+// WithRequestId makes calls using the returned context carry the given request id
+func WithRequestId(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, requestIdKey{}, requestId)
+}
+
+// APIError is returned when the service responds with an unexpected status code
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (this *APIError) Error() string {
+	if this.Message == "" {
+		return fmt.Sprintf("playbook-dispatcher responded with status code %d", this.StatusCode)
+	}
+
+	return fmt.Sprintf("playbook-dispatcher responded with status code %d: %s", this.StatusCode, this.Message)
+}
+
+func checkResponse[T any](res *http.Response, body []byte, expectedStatus int, value *T) (T, error) {
+	if res.StatusCode == expectedStatus && value != nil {
+		return *value, nil
+	}
+
+	var zero T
+	apiError := &APIError{StatusCode: res.StatusCode}
+
+	var payload struct {
+		Message string `json:"message"`
+	}
+
+	if json.Unmarshal(body, &payload) == nil {
+		apiError.Message = payload.Message
+	}
+
+	return zero, apiError
+}
+
+// Dispatch creates playbook runs. The result of each run is reported separately, check the code of each item.
+func (this *Client) Dispatch(ctx context.Context, runs []private.RunInputV2) (private.RunsCreated, error) {
+	res, err := this.Private.ApiInternalV2RunsCreateWithResponse(ctx, runs)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkResponse(res.HTTPResponse, res.Body, http.StatusMultiStatus, res.JSON207)
+}
+
+// Cancel cancels playbook runs. The result of each cancellation is reported separately, check the code of each item.
+func (this *Client) Cancel(ctx context.Context, runs []private.CancelInputV2) (private.RunsCanceled, error) {
+	res, err := this.Private.ApiInternalV2RunsCancelWithResponse(ctx, runs)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkResponse(res.HTTPResponse, res.Body, http.StatusMultiStatus, res.JSON207)
+}
+
+// RecipientStatus tells whether the given recipients are connected
+func (this *Client) RecipientStatus(ctx context.Context, recipients []private.RecipientWithOrg) ([]private.RecipientStatus, error) {
+	res, err := this.Private.ApiInternalV2RecipientsStatusWithResponse(ctx, recipients)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkResponse(res.HTTPResponse, res.Body, http.StatusOK, res.JSON200)
+}
+
+// ConnectionStatus resolves the recipients the given hosts are reachable through
+func (this *Client) ConnectionStatus(ctx context.Context, hosts private.HostsWithOrgId) (private.HighLevelRecipientStatus, error) {
+	res, err := this.Private.ApiInternalHighlevelConnectionStatusWithResponse(ctx, hosts)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkResponse(res.HTTPResponse, res.Body, http.StatusOK, res.JSON200)
+}
+
+// Runs iterates over the runs matching the given parameters (public API). Limit and Offset of the parameters set the
+// page size and the starting position. Iteration stops after the first error.
+func (this *Client) Runs(ctx context.Context, params public.ApiRunsListParams) iter.Seq2[public.Run, error] {
+	return paginate(this.pageSize, params.Limit, params.Offset, func(limit, offset int) ([]public.Run, int, error) {
+		params.Limit, params.Offset = &limit, &offset
+
+		res, err := this.Public.ApiRunsListWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		page, err := checkResponse(res.HTTPResponse, res.Body, http.StatusOK, res.JSON200)
+		return page.Data, page.Meta.Total, err
+	})
+}
+
+// RunHosts iterates over the run hosts matching the given parameters (public API)
+func (this *Client) RunHosts(ctx context.Context, params public.ApiRunHostsListParams) iter.Seq2[public.RunHost, error] {
+	return paginate(this.pageSize, params.Limit, params.Offset, func(limit, offset int) ([]public.RunHost, int, error) {
+		params.Limit, params.Offset = &limit, &offset
+
+		res, err := this.Public.ApiRunHostsListWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		page, err := checkResponse(res.HTTPResponse, res.Body, http.StatusOK, res.JSON200)
+		return page.Data, page.Meta.Total, err
+	})
+}
+
+// Run returns the given run with all its fields and the number of its hosts in each status (public API)
+func (this *Client) Run(ctx context.Context, runId public.RunId) (public.Run, error) {
+	res, err := this.Public.ApiRunGetWithResponse(ctx, runId)
+	if err != nil {
+		return public.Run{}, err
+	}
+
+	return checkResponse(res.HTTPResponse, res.Body, http.StatusOK, res.JSON200)
+}
+
+// RunHistory returns the transitions of the status of the given run, oldest first (public API)
+func (this *Client) RunHistory(ctx context.Context, runId public.RunId) ([]public.RunStatusTransition, error) {
+	res, err := this.Public.ApiRunHistoryGetWithResponse(ctx, runId)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := checkResponse(res.HTTPResponse, res.Body, http.StatusOK, res.JSON200)
+	return history.Data, err
+}
+
+// RunStatuses looks up the status of the given runs by run ID and by correlation ID, up to 200 identifiers at once.
+// Runs that do not exist are left out of the result (public API).
+func (this *Client) RunStatuses(ctx context.Context, lookup public.RunStatusLookup) (public.RunStatuses, error) {
+	res, err := this.Public.ApiRunsStatusWithResponse(ctx, lookup)
+	if err != nil {
+		return public.RunStatuses{}, err
+	}
+
+	return checkResponse(res.HTTPResponse, res.Body, http.StatusOK, res.JSON200)
+}
+
+// RunHostArtifacts returns the facts, set_stats data and task results the given host of a run reported (public API)
+func (this *Client) RunHostArtifacts(ctx context.Context, runId public.RunId, host string) (public.RunHostArtifacts, error) {
+	res, err := this.Public.ApiRunHostArtifactsGetWithResponse(ctx, runId, host)
+	if err != nil {
+		return public.RunHostArtifacts{}, err
+	}
+
+	return checkResponse(res.HTTPResponse, res.Body, http.StatusOK, res.JSON200)
+}
+
+// RunHostStdout returns the output of the given host of a run (public API)
+func (this *Client) RunHostStdout(ctx context.Context, runHostId public.RunHostId) (string, error) {
+	res, err := this.Public.ApiRunHostStdoutGetWithResponse(ctx, runHostId)
+	if err != nil {
+		return "", err
+	}
+
+	stdout := string(res.Body)
+	return checkResponse(res.HTTPResponse, res.Body, http.StatusOK, &stdout)
+}
+
+// InternalRunHosts iterates over the run hosts matching the given parameters (internal API)
+func (this *Client) InternalRunHosts(ctx context.Context, params private.ApiInternalV2RunHostsListParams) iter.Seq2[public.RunHost, error] {
+	return paginate(this.pageSize, params.Limit, params.Offset, func(limit, offset int) ([]public.RunHost, int, error) {
+		params.Limit, params.Offset = &limit, &offset
+
+		res, err := this.Private.ApiInternalV2RunHostsListWithResponse(ctx, &params)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		page, err := checkResponse(res.HTTPResponse, res.Body, http.StatusOK, res.JSON200)
+		return page.Data, page.Meta.Total, err
+	})
+}
+
+// paginate fetches pages until the total number of items has been reached or a page comes back empty
+func paginate[T any](pageSize int, limit, offset *int, fetch func(limit, offset int) ([]T, int, error)) iter.Seq2[T, error] {
+	if limit != nil {
+		pageSize = *limit
+	}
+
+	start := 0
+	if offset != nil {
+		start = *offset
+	}
+
+	return func(yield func(T, error) bool) {
+		for position := start; ; {
+			page, total, err := fetch(pageSize, position)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			position += len(page)
+			if len(page) == 0 || position >= total {
+				return
+			}
+		}
+	}
+}
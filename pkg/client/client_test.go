@@ -0,0 +1,241 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"playbook-dispatcher/pkg/client/private"
+	"playbook-dispatcher/pkg/client/public"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Client", func() {
+	var server *httptest.Server
+	var handler http.HandlerFunc
+	var calls atomic.Int32
+
+	BeforeEach(func() {
+		calls.Store(0)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			handler(w, r)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newClient := func(opts ...Option) *Client {
+		opts = append([]Option{WithRetryPolicy(RetryPolicy{Attempts: 3, InitialInterval: time.Millisecond, MaxInterval: time.Millisecond})}, opts...)
+		c, err := New(server.URL, opts...)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	writeJSON := func(w http.ResponseWriter, status int, value interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		Expect(json.NewEncoder(w).Encode(value)).To(Succeed())
+	}
+
+	dispatch := func(c *Client) (private.RunsCreated, error) {
+		return c.Dispatch(context.Background(), []private.RunInputV2{{
+			Recipient: uuid.New(),
+			OrgId:     "12345",
+			Url:       "https://example.com/playbook.yml",
+			Name:      "test",
+			Principal: "test-user",
+		}})
+	}
+
+	It("sends the configured authentication headers", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Header.Get("Authorization")).To(Equal("PSK secret"))
+			Expect(r.Header.Get("x-rh-identity")).To(Equal("eyJpZGVudGl0eSI6e319"))
+			Expect(r.Header.Get("x-rh-insights-request-id")).To(Equal("request-1"))
+			writeJSON(w, http.StatusMultiStatus, private.RunsCreated{{Code: 201}})
+		}
+
+		c := newClient(WithPsk("secret"), WithIdentity("eyJpZGVudGl0eSI6e319"))
+		created, err := c.Dispatch(WithRequestId(context.Background(), "request-1"), []private.RunInputV2{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(HaveLen(1))
+	})
+
+	It("returns the message of an error response", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusForbidden, public.Error{Message: "Pre-shared key expired"})
+		}
+
+		_, err := dispatch(newClient())
+		Expect(err).To(MatchError(&APIError{StatusCode: http.StatusForbidden, Message: "Pre-shared key expired"}))
+	})
+
+	It("gets a single run", func() {
+		runId := uuid.New()
+
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/api/playbook-dispatcher/v1/runs/" + runId.String()))
+			writeJSON(w, http.StatusOK, public.Run{Id: &runId, Hosts: &public.RunHostCounts{Total: 2, Success: 2}})
+		}
+
+		run, err := newClient().Run(context.Background(), runId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*run.Id).To(Equal(runId))
+		Expect(run.Hosts.Success).To(Equal(2))
+	})
+
+	It("reports a run that does not exist", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusNotFound, public.Error{Message: "Run not found"})
+		}
+
+		_, err := newClient().Run(context.Background(), uuid.New())
+		Expect(err).To(MatchError(ContainSubstring("Run not found")))
+	})
+
+	It("gets the history of a run", func() {
+		runId := uuid.New()
+
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal(fmt.Sprintf("/api/playbook-dispatcher/v1/runs/%s/history", runId)))
+			writeJSON(w, http.StatusOK, public.RunHistory{Data: []public.RunStatusTransition{{Status: public.RunStatusRunning, Source: "dispatch"}}})
+		}
+
+		history, err := newClient().RunHistory(context.Background(), runId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(history).To(HaveLen(1))
+		Expect(history[0].Status).To(Equal(public.RunStatusRunning))
+	})
+
+	It("looks up the status of runs", func() {
+		runId := uuid.New()
+
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.URL.Path).To(Equal("/api/playbook-dispatcher/v1/runs/status"))
+
+			var lookup public.RunStatusLookup
+			Expect(json.NewDecoder(r.Body).Decode(&lookup)).To(Succeed())
+			Expect(*lookup.Ids).To(Equal([]uuid.UUID{runId}))
+
+			writeJSON(w, http.StatusOK, public.RunStatuses{Ids: map[string]public.RunStatus{runId.String(): public.RunStatusSuccess}})
+		}
+
+		statuses, err := newClient().RunStatuses(context.Background(), public.RunStatusLookup{Ids: &[]uuid.UUID{runId}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statuses.Ids).To(HaveKeyWithValue(runId.String(), public.RunStatusSuccess))
+	})
+
+	It("gets the output of a run host", func() {
+		runHostId := uuid.New()
+
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal(fmt.Sprintf("/api/playbook-dispatcher/v1/run_hosts/%s/stdout", runHostId)))
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			fmt.Fprint(w, "PLAY [all] ***")
+		}
+
+		stdout, err := newClient().RunHostStdout(context.Background(), runHostId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdout).To(Equal("PLAY [all] ***"))
+	})
+
+	Describe("retries", func() {
+		It("retries throttled requests", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				if calls.Load() < 3 {
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+
+				writeJSON(w, http.StatusMultiStatus, private.RunsCreated{{Code: 201}})
+			}
+
+			_, err := dispatch(newClient())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls.Load()).To(BeEquivalentTo(3))
+		})
+
+		It("does not retry a failed dispatch that may have been processed", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+
+			_, err := dispatch(newClient())
+			Expect(err).To(MatchError(&APIError{StatusCode: http.StatusInternalServerError}))
+			Expect(calls.Load()).To(BeEquivalentTo(1))
+		})
+
+		It("retries failed reads up to the number of attempts", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+			}
+
+			for _, err := range newClient().Runs(context.Background(), public.ApiRunsListParams{}) {
+				Expect(err).To(MatchError(&APIError{StatusCode: http.StatusBadGateway}))
+			}
+
+			Expect(calls.Load()).To(BeEquivalentTo(3))
+		})
+	})
+
+	Describe("pagination", func() {
+		const total = 7
+
+		BeforeEach(func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+				offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+				runs := public.Runs{Data: []public.Run{}, Meta: public.Meta{Total: total}}
+				for i := offset; i < min(offset+limit, total); i++ {
+					name := fmt.Sprintf("run-%d", i)
+					runs.Data = append(runs.Data, public.Run{Name: &name})
+				}
+
+				runs.Meta.Count = len(runs.Data)
+				writeJSON(w, http.StatusOK, runs)
+			}
+		})
+
+		names := func(c *Client, params public.ApiRunsListParams) (result []string) {
+			for run, err := range c.Runs(context.Background(), params) {
+				Expect(err).ToNot(HaveOccurred())
+				result = append(result, *run.Name)
+			}
+
+			return
+		}
+
+		It("iterates over all pages", func() {
+			Expect(names(newClient(WithPageSize(3)), public.ApiRunsListParams{})).To(Equal([]string{
+				"run-0", "run-1", "run-2", "run-3", "run-4", "run-5", "run-6",
+			}))
+			Expect(calls.Load()).To(BeEquivalentTo(3))
+		})
+
+		It("starts at the given offset", func() {
+			offset := 5
+			Expect(names(newClient(), public.ApiRunsListParams{Offset: &offset})).To(Equal([]string{"run-5", "run-6"}))
+		})
+
+		It("stops fetching once the caller stops iterating", func() {
+			for range newClient(WithPageSize(2)).Runs(context.Background(), public.ApiRunsListParams{}) {
+				break
+			}
+
+			Expect(calls.Load()).To(BeEquivalentTo(1))
+		})
+	})
+})
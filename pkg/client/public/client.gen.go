@@ -0,0 +1,3146 @@
+// Package public provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.6.0 DO NOT EDIT.
+package public
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for CancelState.
+const (
+	CancelAcked     CancelState = "cancel_acked"
+	CancelRequested CancelState = "cancel_requested"
+)
+
+// Valid indicates whether the value is a known member of the CancelState enum.
+func (e CancelState) Valid() bool {
+	switch e {
+	case CancelAcked:
+		return true
+	case CancelRequested:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ExecutionMode.
+const (
+	ExecutionModeCheck ExecutionMode = "check"
+	ExecutionModeRun   ExecutionMode = "run"
+)
+
+// Valid indicates whether the value is a known member of the ExecutionMode enum.
+func (e ExecutionMode) Valid() bool {
+	switch e {
+	case ExecutionModeCheck:
+		return true
+	case ExecutionModeRun:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ExportFormat.
+const (
+	ExportFormatCsv    ExportFormat = "csv"
+	ExportFormatJson   ExportFormat = "json"
+	ExportFormatNdjson ExportFormat = "ndjson"
+)
+
+// Valid indicates whether the value is a known member of the ExportFormat enum.
+func (e ExportFormat) Valid() bool {
+	switch e {
+	case ExportFormatCsv:
+		return true
+	case ExportFormatJson:
+		return true
+	case ExportFormatNdjson:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for LabelsOperatorNullable.
+const (
+	LabelsOperatorAnd LabelsOperatorNullable = "and"
+	LabelsOperatorOr  LabelsOperatorNullable = "or"
+)
+
+// Valid indicates whether the value is a known member of the LabelsOperatorNullable enum.
+func (e LabelsOperatorNullable) Valid() bool {
+	switch e {
+	case LabelsOperatorAnd:
+		return true
+	case LabelsOperatorOr:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RunStatus.
+const (
+	RunStatusCanceled             RunStatus = "canceled"
+	RunStatusCanceling            RunStatus = "canceling"
+	RunStatusFailure              RunStatus = "failure"
+	RunStatusRunning              RunStatus = "running"
+	RunStatusSuccess              RunStatus = "success"
+	RunStatusTimeout              RunStatus = "timeout"
+	RunStatusWaitingForConnection RunStatus = "waiting_for_connection"
+)
+
+// Valid indicates whether the value is a known member of the RunStatus enum.
+func (e RunStatus) Valid() bool {
+	switch e {
+	case RunStatusCanceled:
+		return true
+	case RunStatusCanceling:
+		return true
+	case RunStatusFailure:
+		return true
+	case RunStatusRunning:
+		return true
+	case RunStatusSuccess:
+		return true
+	case RunStatusTimeout:
+		return true
+	case RunStatusWaitingForConnection:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RunStatusTransitionSource.
+const (
+	Dispatch  RunStatusTransitionSource = "dispatch"
+	Reconnect RunStatusTransitionSource = "reconnect"
+	Response  RunStatusTransitionSource = "response"
+	Sweeper   RunStatusTransitionSource = "sweeper"
+)
+
+// Valid indicates whether the value is a known member of the RunStatusTransitionSource enum.
+func (e RunStatusTransitionSource) Valid() bool {
+	switch e {
+	case Dispatch:
+		return true
+	case Reconnect:
+		return true
+	case Response:
+		return true
+	case Sweeper:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for StatusNullable.
+const (
+	StatusNullableCanceled             StatusNullable = "canceled"
+	StatusNullableCanceling            StatusNullable = "canceling"
+	StatusNullableFailure              StatusNullable = "failure"
+	StatusNullableRunning              StatusNullable = "running"
+	StatusNullableSuccess              StatusNullable = "success"
+	StatusNullableTimeout              StatusNullable = "timeout"
+	StatusNullableWaitingForConnection StatusNullable = "waiting_for_connection"
+)
+
+// Valid indicates whether the value is a known member of the StatusNullable enum.
+func (e StatusNullable) Valid() bool {
+	switch e {
+	case StatusNullableCanceled:
+		return true
+	case StatusNullableCanceling:
+		return true
+	case StatusNullableFailure:
+		return true
+	case StatusNullableRunning:
+		return true
+	case StatusNullableSuccess:
+		return true
+	case StatusNullableTimeout:
+		return true
+	case StatusNullableWaitingForConnection:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ApiRunHostsListParamsFieldsData.
+const (
+	ApiRunHostsListParamsFieldsDataCancelState      ApiRunHostsListParamsFieldsData = "cancel_state"
+	ApiRunHostsListParamsFieldsDataDiffs            ApiRunHostsListParamsFieldsData = "diffs"
+	ApiRunHostsListParamsFieldsDataDisplayName      ApiRunHostsListParamsFieldsData = "display_name"
+	ApiRunHostsListParamsFieldsDataHost             ApiRunHostsListParamsFieldsData = "host"
+	ApiRunHostsListParamsFieldsDataId               ApiRunHostsListParamsFieldsData = "id"
+	ApiRunHostsListParamsFieldsDataInventoryId      ApiRunHostsListParamsFieldsData = "inventory_id"
+	ApiRunHostsListParamsFieldsDataLastUpdatedDelta ApiRunHostsListParamsFieldsData = "last_updated_delta"
+	ApiRunHostsListParamsFieldsDataLinks            ApiRunHostsListParamsFieldsData = "links"
+	ApiRunHostsListParamsFieldsDataRun              ApiRunHostsListParamsFieldsData = "run"
+	ApiRunHostsListParamsFieldsDataStatus           ApiRunHostsListParamsFieldsData = "status"
+	ApiRunHostsListParamsFieldsDataStdout           ApiRunHostsListParamsFieldsData = "stdout"
+	ApiRunHostsListParamsFieldsDataStdoutSize       ApiRunHostsListParamsFieldsData = "stdout_size"
+)
+
+// Valid indicates whether the value is a known member of the ApiRunHostsListParamsFieldsData enum.
+func (e ApiRunHostsListParamsFieldsData) Valid() bool {
+	switch e {
+	case ApiRunHostsListParamsFieldsDataCancelState:
+		return true
+	case ApiRunHostsListParamsFieldsDataDiffs:
+		return true
+	case ApiRunHostsListParamsFieldsDataDisplayName:
+		return true
+	case ApiRunHostsListParamsFieldsDataHost:
+		return true
+	case ApiRunHostsListParamsFieldsDataId:
+		return true
+	case ApiRunHostsListParamsFieldsDataInventoryId:
+		return true
+	case ApiRunHostsListParamsFieldsDataLastUpdatedDelta:
+		return true
+	case ApiRunHostsListParamsFieldsDataLinks:
+		return true
+	case ApiRunHostsListParamsFieldsDataRun:
+		return true
+	case ApiRunHostsListParamsFieldsDataStatus:
+		return true
+	case ApiRunHostsListParamsFieldsDataStdout:
+		return true
+	case ApiRunHostsListParamsFieldsDataStdoutSize:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ApiRunsListParamsFieldsData.
+const (
+	ApiRunsListParamsFieldsDataCorrelationId ApiRunsListParamsFieldsData = "correlation_id"
+	ApiRunsListParamsFieldsDataCreatedAt     ApiRunsListParamsFieldsData = "created_at"
+	ApiRunsListParamsFieldsDataExecutionMode ApiRunsListParamsFieldsData = "execution_mode"
+	ApiRunsListParamsFieldsDataId            ApiRunsListParamsFieldsData = "id"
+	ApiRunsListParamsFieldsDataLabels        ApiRunsListParamsFieldsData = "labels"
+	ApiRunsListParamsFieldsDataLinks         ApiRunsListParamsFieldsData = "links"
+	ApiRunsListParamsFieldsDataName          ApiRunsListParamsFieldsData = "name"
+	ApiRunsListParamsFieldsDataOrgId         ApiRunsListParamsFieldsData = "org_id"
+	ApiRunsListParamsFieldsDataParentRunId   ApiRunsListParamsFieldsData = "parent_run_id"
+	ApiRunsListParamsFieldsDataProgress      ApiRunsListParamsFieldsData = "progress"
+	ApiRunsListParamsFieldsDataRecipient     ApiRunsListParamsFieldsData = "recipient"
+	ApiRunsListParamsFieldsDataService       ApiRunsListParamsFieldsData = "service"
+	ApiRunsListParamsFieldsDataStatus        ApiRunsListParamsFieldsData = "status"
+	ApiRunsListParamsFieldsDataTimeout       ApiRunsListParamsFieldsData = "timeout"
+	ApiRunsListParamsFieldsDataUpdatedAt     ApiRunsListParamsFieldsData = "updated_at"
+	ApiRunsListParamsFieldsDataUrl           ApiRunsListParamsFieldsData = "url"
+	ApiRunsListParamsFieldsDataWebConsoleUrl ApiRunsListParamsFieldsData = "web_console_url"
+)
+
+// Valid indicates whether the value is a known member of the ApiRunsListParamsFieldsData enum.
+func (e ApiRunsListParamsFieldsData) Valid() bool {
+	switch e {
+	case ApiRunsListParamsFieldsDataCorrelationId:
+		return true
+	case ApiRunsListParamsFieldsDataCreatedAt:
+		return true
+	case ApiRunsListParamsFieldsDataExecutionMode:
+		return true
+	case ApiRunsListParamsFieldsDataId:
+		return true
+	case ApiRunsListParamsFieldsDataLabels:
+		return true
+	case ApiRunsListParamsFieldsDataLinks:
+		return true
+	case ApiRunsListParamsFieldsDataName:
+		return true
+	case ApiRunsListParamsFieldsDataOrgId:
+		return true
+	case ApiRunsListParamsFieldsDataParentRunId:
+		return true
+	case ApiRunsListParamsFieldsDataProgress:
+		return true
+	case ApiRunsListParamsFieldsDataRecipient:
+		return true
+	case ApiRunsListParamsFieldsDataService:
+		return true
+	case ApiRunsListParamsFieldsDataStatus:
+		return true
+	case ApiRunsListParamsFieldsDataTimeout:
+		return true
+	case ApiRunsListParamsFieldsDataUpdatedAt:
+		return true
+	case ApiRunsListParamsFieldsDataUrl:
+		return true
+	case ApiRunsListParamsFieldsDataWebConsoleUrl:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ApiRunHostsListV2ParamsFieldsData.
+const (
+	ApiRunHostsListV2ParamsFieldsDataAnsibleHost      ApiRunHostsListV2ParamsFieldsData = "ansible_host"
+	ApiRunHostsListV2ParamsFieldsDataCancelState      ApiRunHostsListV2ParamsFieldsData = "cancel_state"
+	ApiRunHostsListV2ParamsFieldsDataDiffs            ApiRunHostsListV2ParamsFieldsData = "diffs"
+	ApiRunHostsListV2ParamsFieldsDataDisplayName      ApiRunHostsListV2ParamsFieldsData = "display_name"
+	ApiRunHostsListV2ParamsFieldsDataId               ApiRunHostsListV2ParamsFieldsData = "id"
+	ApiRunHostsListV2ParamsFieldsDataInventoryId      ApiRunHostsListV2ParamsFieldsData = "inventory_id"
+	ApiRunHostsListV2ParamsFieldsDataLastUpdatedDelta ApiRunHostsListV2ParamsFieldsData = "last_updated_delta"
+	ApiRunHostsListV2ParamsFieldsDataLinks            ApiRunHostsListV2ParamsFieldsData = "links"
+	ApiRunHostsListV2ParamsFieldsDataRunId            ApiRunHostsListV2ParamsFieldsData = "run_id"
+	ApiRunHostsListV2ParamsFieldsDataStatus           ApiRunHostsListV2ParamsFieldsData = "status"
+	ApiRunHostsListV2ParamsFieldsDataStdout           ApiRunHostsListV2ParamsFieldsData = "stdout"
+	ApiRunHostsListV2ParamsFieldsDataStdoutSize       ApiRunHostsListV2ParamsFieldsData = "stdout_size"
+)
+
+// Valid indicates whether the value is a known member of the ApiRunHostsListV2ParamsFieldsData enum.
+func (e ApiRunHostsListV2ParamsFieldsData) Valid() bool {
+	switch e {
+	case ApiRunHostsListV2ParamsFieldsDataAnsibleHost:
+		return true
+	case ApiRunHostsListV2ParamsFieldsDataCancelState:
+		return true
+	case ApiRunHostsListV2ParamsFieldsDataDiffs:
+		return true
+	case ApiRunHostsListV2ParamsFieldsDataDisplayName:
+		return true
+	case ApiRunHostsListV2ParamsFieldsDataId:
+		return true
+	case ApiRunHostsListV2ParamsFieldsDataInventoryId:
+		return true
+	case ApiRunHostsListV2ParamsFieldsDataLastUpdatedDelta:
+		return true
+	case ApiRunHostsListV2ParamsFieldsDataLinks:
+		return true
+	case ApiRunHostsListV2ParamsFieldsDataRunId:
+		return true
+	case ApiRunHostsListV2ParamsFieldsDataStatus:
+		return true
+	case ApiRunHostsListV2ParamsFieldsDataStdout:
+		return true
+	case ApiRunHostsListV2ParamsFieldsDataStdoutSize:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ApiRunsListV2ParamsFieldsData.
+const (
+	ApiRunsListV2ParamsFieldsDataCorrelationId ApiRunsListV2ParamsFieldsData = "correlation_id"
+	ApiRunsListV2ParamsFieldsDataCreatedAt     ApiRunsListV2ParamsFieldsData = "created_at"
+	ApiRunsListV2ParamsFieldsDataExecutionMode ApiRunsListV2ParamsFieldsData = "execution_mode"
+	ApiRunsListV2ParamsFieldsDataId            ApiRunsListV2ParamsFieldsData = "id"
+	ApiRunsListV2ParamsFieldsDataLabels        ApiRunsListV2ParamsFieldsData = "labels"
+	ApiRunsListV2ParamsFieldsDataLinks         ApiRunsListV2ParamsFieldsData = "links"
+	ApiRunsListV2ParamsFieldsDataName          ApiRunsListV2ParamsFieldsData = "name"
+	ApiRunsListV2ParamsFieldsDataOrgId         ApiRunsListV2ParamsFieldsData = "org_id"
+	ApiRunsListV2ParamsFieldsDataParentRunId   ApiRunsListV2ParamsFieldsData = "parent_run_id"
+	ApiRunsListV2ParamsFieldsDataPrincipal     ApiRunsListV2ParamsFieldsData = "principal"
+	ApiRunsListV2ParamsFieldsDataProgress      ApiRunsListV2ParamsFieldsData = "progress"
+	ApiRunsListV2ParamsFieldsDataRecipient     ApiRunsListV2ParamsFieldsData = "recipient"
+	ApiRunsListV2ParamsFieldsDataService       ApiRunsListV2ParamsFieldsData = "service"
+	ApiRunsListV2ParamsFieldsDataStatus        ApiRunsListV2ParamsFieldsData = "status"
+	ApiRunsListV2ParamsFieldsDataTimeout       ApiRunsListV2ParamsFieldsData = "timeout"
+	ApiRunsListV2ParamsFieldsDataUpdatedAt     ApiRunsListV2ParamsFieldsData = "updated_at"
+	ApiRunsListV2ParamsFieldsDataUrl           ApiRunsListV2ParamsFieldsData = "url"
+	ApiRunsListV2ParamsFieldsDataWebConsoleUrl ApiRunsListV2ParamsFieldsData = "web_console_url"
+)
+
+// Valid indicates whether the value is a known member of the ApiRunsListV2ParamsFieldsData enum.
+func (e ApiRunsListV2ParamsFieldsData) Valid() bool {
+	switch e {
+	case ApiRunsListV2ParamsFieldsDataCorrelationId:
+		return true
+	case ApiRunsListV2ParamsFieldsDataCreatedAt:
+		return true
+	case ApiRunsListV2ParamsFieldsDataExecutionMode:
+		return true
+	case ApiRunsListV2ParamsFieldsDataId:
+		return true
+	case ApiRunsListV2ParamsFieldsDataLabels:
+		return true
+	case ApiRunsListV2ParamsFieldsDataLinks:
+		return true
+	case ApiRunsListV2ParamsFieldsDataName:
+		return true
+	case ApiRunsListV2ParamsFieldsDataOrgId:
+		return true
+	case ApiRunsListV2ParamsFieldsDataParentRunId:
+		return true
+	case ApiRunsListV2ParamsFieldsDataPrincipal:
+		return true
+	case ApiRunsListV2ParamsFieldsDataProgress:
+		return true
+	case ApiRunsListV2ParamsFieldsDataRecipient:
+		return true
+	case ApiRunsListV2ParamsFieldsDataService:
+		return true
+	case ApiRunsListV2ParamsFieldsDataStatus:
+		return true
+	case ApiRunsListV2ParamsFieldsDataTimeout:
+		return true
+	case ApiRunsListV2ParamsFieldsDataUpdatedAt:
+		return true
+	case ApiRunsListV2ParamsFieldsDataUrl:
+		return true
+	case ApiRunsListV2ParamsFieldsDataWebConsoleUrl:
+		return true
+	default:
+		return false
+	}
+}
+
+// Account Identifier of the tenant
+type Account = string
+
+// CancelState Set on the hosts of a Satellite run that were still running the playbook when the run was canceled. A host acknowledges the cancel by reporting the canceled status (cancel_acked). A host that reports success or failure while the cancel is still requested finished the playbook anyway.
+type CancelState string
+
+// CreatedAt A timestamp when the entry was created
+type CreatedAt = time.Time
+
+// Error defines model for Error.
+type Error struct {
+	Message string `json:"message"`
+}
+
+// ExecutionMode Whether the playbook is run normally (run) or in check mode (check). In check mode Ansible reports the changes it would make, including their diffs, without making them.
+type ExecutionMode string
+
+// ExportFormat Representation of a list. `json` returns a page of results, `csv` and `ndjson` export all the results.
+type ExportFormat string
+
+// InventoryDisplayName Display name of the host in the inventory, as last seen by playbook-dispatcher. Not set if the host has not been looked up in the inventory yet.
+type InventoryDisplayName = string
+
+// InventoryIdNullable defines model for InventoryIdNullable.
+type InventoryIdNullable = string
+
+// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
+type Labels map[string]string
+
+// LabelsOperatorNullable Whether runs need to match all (`and`) or any (`or`) of the label filters. With `or`, repeating a label matches any of the given values.
+type LabelsOperatorNullable string
+
+// Links defines model for Links.
+type Links struct {
+	// First relative link to the first page of the query results
+	First string `json:"first"`
+
+	// Last relative link to the last page of the query results
+	Last string `json:"last"`
+
+	// Next relative link to the next page of the query results
+	Next *string `json:"next,omitempty"`
+
+	// Previous relative link to the previous page of the query results
+	Previous *string `json:"previous,omitempty"`
+}
+
+// Meta Information about returned entities
+type Meta struct {
+	// Count number of results returned
+	Count int `json:"count"`
+
+	// Total total number of results matching the query
+	Total int `json:"total"`
+}
+
+// OrgId Identifier of the tenant
+type OrgId = string
+
+// ParentRunId Run this run is a retry of (see /internal/v2/runs/{run_id}/retry). Following the parent of each run gives the chain of retries.
+type ParentRunId = openapi_types.UUID
+
+// PlaybookName Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
+type PlaybookName = string
+
+// Principal Username of the user on whose behalf the run was dispatched
+type Principal = string
+
+// Run defines model for Run.
+type Run struct {
+	// Account Identifier of the tenant
+	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
+	Account *Account `json:"account,omitempty"`
+
+	// CorrelationId Unique identifier used to match work request with responses
+	CorrelationId *RunCorrelationId `json:"correlation_id,omitempty"`
+
+	// CreatedAt A timestamp when the entry was created
+	CreatedAt *CreatedAt `json:"created_at,omitempty"`
+
+	// ExecutionMode Whether the playbook is run normally (run) or in check mode (check). In check mode Ansible reports the changes it would make, including their diffs, without making them.
+	ExecutionMode *ExecutionMode `json:"execution_mode,omitempty"`
+
+	// Hosts Number of hosts in each status. Only returned when getting a single run, and for each group of runs.
+	Hosts *RunHostCounts `json:"hosts,omitempty"`
+
+	// Id Unique identifier of a Playbook run
+	Id *RunId `json:"id,omitempty"`
+
+	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
+	Labels *Labels `json:"labels,omitempty"`
+
+	// Links Links to the resources related to the run
+	Links *RunLinks `json:"links,omitempty"`
+
+	// Name Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
+	Name *PlaybookName `json:"name,omitempty"`
+
+	// OrgId Identifier of the tenant
+	OrgId *OrgId `json:"org_id,omitempty"`
+
+	// ParentRunId Run this run is a retry of (see /internal/v2/runs/{run_id}/retry). Following the parent of each run gives the chain of retries.
+	ParentRunId *ParentRunId `json:"parent_run_id,omitempty"`
+
+	// Progress Share of the hosts of the run that finished the playbook, in percent. It is 100 once the run succeeds or fails.
+	Progress *RunProgress `json:"progress,omitempty"`
+
+	// Recipient Identifier of the host to which a given Playbook is addressed
+	Recipient *RunRecipient `json:"recipient,omitempty"`
+
+	// Service Service that triggered the given Playbook run
+	Service *Service `json:"service,omitempty"`
+
+	// Status Current status of a Playbook run. A run that asked to wait for its recipient to connect is waiting_for_connection until it is dispatched. A canceled run is canceling until the recipient acknowledges the cancel.
+	Status *RunStatus `json:"status,omitempty"`
+
+	// Timeout Amount of seconds after which the run is considered failed due to timeout
+	Timeout *RunTimeout `json:"timeout,omitempty"`
+
+	// UpdatedAt A timestamp when the entry was last updated
+	UpdatedAt *UpdatedAt `json:"updated_at,omitempty"`
+
+	// Url URL hosting the Playbook
+	Url *Url `json:"url,omitempty"`
+
+	// WebConsoleUrl URL that points to the section of the web console where the user find more information about the playbook run. The field is optional but highly suggested.
+	WebConsoleUrl *WebConsoleUrl `json:"web_console_url,omitempty"`
+}
+
+// RunCorrelationId Unique identifier used to match work request with responses
+type RunCorrelationId = string
+
+// RunCounts Number of runs in each status
+type RunCounts struct {
+	Canceled             int `json:"canceled"`
+	Canceling            int `json:"canceling"`
+	Failure              int `json:"failure"`
+	Running              int `json:"running"`
+	Success              int `json:"success"`
+	Timeout              int `json:"timeout"`
+	Total                int `json:"total"`
+	WaitingForConnection int `json:"waiting_for_connection"`
+}
+
+// RunGroup defines model for RunGroup.
+type RunGroup struct {
+	// Hosts Number of hosts in each status. Only returned when getting a single run, and for each group of runs.
+	Hosts RunHostCounts `json:"hosts"`
+
+	// Runs Number of runs in each status
+	Runs RunCounts `json:"runs"`
+
+	// Value Value of the label shared by the runs of the group
+	Value string `json:"value"`
+}
+
+// RunGroups defines model for RunGroups.
+type RunGroups struct {
+	Data  []RunGroup `json:"data"`
+	Links Links      `json:"links"`
+
+	// Meta Information about returned entities
+	Meta Meta `json:"meta"`
+}
+
+// RunHistory defines model for RunHistory.
+type RunHistory struct {
+	Data []RunStatusTransition `json:"data"`
+}
+
+// RunHost defines model for RunHost.
+type RunHost struct {
+	// CancelState Set on the hosts of a Satellite run that were still running the playbook when the run was canceled. A host acknowledges the cancel by reporting the canceled status (cancel_acked). A host that reports success or failure while the cancel is still requested finished the playbook anyway.
+	CancelState *CancelState `json:"cancel_state,omitempty"`
+
+	// Diffs Diffs reported by the tasks of the playbook for the given host (in check mode the changes that would be made). Only reported by hosts connected using rhc.
+	Diffs *RunHostDiffs `json:"diffs,omitempty"`
+
+	// DisplayName Display name of the host in the inventory, as last seen by playbook-dispatcher. Not set if the host has not been looked up in the inventory yet.
+	DisplayName *InventoryDisplayName `json:"display_name,omitempty"`
+
+	// Host Name used to identify a host within Ansible inventory
+	Host *string `json:"host,omitempty"`
+
+	// Id Unique identifier of a host of a Playbook run
+	Id          *RunHostId          `json:"id,omitempty"`
+	InventoryId *openapi_types.UUID `json:"inventory_id,omitempty"`
+
+	// LastUpdatedDelta Number of seconds since the host was last updated (e.g. since it last reported output)
+	LastUpdatedDelta *RunHostLastUpdatedDelta `json:"last_updated_delta,omitempty"`
+	Links            *RunHostLinks            `json:"links,omitempty"`
+	Run              *Run                     `json:"run,omitempty"`
+
+	// Status Current status of a Playbook run. A run that asked to wait for its recipient to connect is waiting_for_connection until it is dispatched. A canceled run is canceling until the recipient acknowledges the cancel.
+	Status *RunStatus `json:"status,omitempty"`
+
+	// Stdout Output produced by running Ansible Playbook on the given host. As it can be large, it is only returned if requested explicitly (see also the stdout link).
+	Stdout *string `json:"stdout,omitempty"`
+
+	// StdoutSize Size of the output of the host in bytes
+	StdoutSize *RunHostStdoutSize `json:"stdout_size,omitempty"`
+}
+
+// RunHostArtifacts defines model for RunHostArtifacts.
+type RunHostArtifacts struct {
+	// Facts Facts gathered on the host (ansible_facts)
+	Facts map[string]interface{} `json:"facts"`
+
+	// Results Results of the tasks run on the host, in order
+	Results []RunHostTaskResult `json:"results"`
+
+	// Stats Data set by the playbook using set_stats
+	Stats map[string]interface{} `json:"stats"`
+
+	// Truncated Set if the results were left out as the artifacts exceeded the size limit
+	Truncated *bool `json:"truncated,omitempty"`
+}
+
+// RunHostCounts Number of hosts in each status. Only returned when getting a single run, and for each group of runs.
+type RunHostCounts struct {
+	Canceled int `json:"canceled"`
+	Failure  int `json:"failure"`
+	Running  int `json:"running"`
+	Success  int `json:"success"`
+	Timeout  int `json:"timeout"`
+	Total    int `json:"total"`
+}
+
+// RunHostDiffs Diffs reported by the tasks of the playbook for the given host (in check mode the changes that would be made). Only reported by hosts connected using rhc.
+type RunHostDiffs = []struct {
+	// Diff Diff as reported by the Ansible module, e.g. a list of before/after pairs
+	Diff interface{} `json:"diff,omitempty"`
+
+	// Task Name of the task that reported the diff
+	Task *string `json:"task,omitempty"`
+}
+
+// RunHostId Unique identifier of a host of a Playbook run
+type RunHostId = openapi_types.UUID
+
+// RunHostLastUpdatedDelta Number of seconds since the host was last updated (e.g. since it last reported output)
+type RunHostLastUpdatedDelta = int64
+
+// RunHostLinks defines model for RunHostLinks.
+type RunHostLinks struct {
+	InventoryHost *string `json:"inventory_host,omitempty"`
+
+	// Stdout Output of the host, supporting range requests
+	Stdout *string `json:"stdout,omitempty"`
+}
+
+// RunHostStdoutSize Size of the output of the host in bytes
+type RunHostStdoutSize = int64
+
+// RunHostTaskResult defines model for RunHostTaskResult.
+type RunHostTaskResult struct {
+	// Event Ansible Runner event reporting the result, e.g. runner_on_ok or runner_on_failed
+	Event string `json:"event"`
+
+	// Result Result as returned by the Ansible module
+	Result map[string]interface{} `json:"result"`
+
+	// Task Name of the task
+	Task string `json:"task"`
+}
+
+// RunHostV2 defines model for RunHostV2.
+type RunHostV2 struct {
+	// AnsibleHost Name used to identify a host within Ansible inventory
+	AnsibleHost *string `json:"ansible_host,omitempty"`
+
+	// CancelState Set on the hosts of a Satellite run that were still running the playbook when the run was canceled. A host acknowledges the cancel by reporting the canceled status (cancel_acked). A host that reports success or failure while the cancel is still requested finished the playbook anyway.
+	CancelState *CancelState `json:"cancel_state,omitempty"`
+
+	// Diffs Diffs reported by the tasks of the playbook for the given host (in check mode the changes that would be made). Only reported by hosts connected using rhc.
+	Diffs *RunHostDiffs `json:"diffs,omitempty"`
+
+	// DisplayName Display name of the host in the inventory, as last seen by playbook-dispatcher. Not set if the host has not been looked up in the inventory yet.
+	DisplayName *InventoryDisplayName `json:"display_name,omitempty"`
+
+	// Id Unique identifier of a host of a Playbook run
+	Id          *RunHostId          `json:"id,omitempty"`
+	InventoryId *openapi_types.UUID `json:"inventory_id,omitempty"`
+
+	// LastUpdatedDelta Number of seconds since the host was last updated (e.g. since it last reported output)
+	LastUpdatedDelta *RunHostLastUpdatedDelta `json:"last_updated_delta,omitempty"`
+	Links            *RunHostLinks            `json:"links,omitempty"`
+
+	// RunId Unique identifier of a Playbook run
+	RunId *RunId `json:"run_id,omitempty"`
+
+	// Status Current status of a Playbook run. A run that asked to wait for its recipient to connect is waiting_for_connection until it is dispatched. A canceled run is canceling until the recipient acknowledges the cancel.
+	Status *RunStatus `json:"status,omitempty"`
+
+	// Stdout Output produced by running Ansible Playbook on the given host. As it can be large, it is only returned if requested explicitly (see also the stdout link).
+	Stdout *string `json:"stdout,omitempty"`
+
+	// StdoutSize Size of the output of the host in bytes
+	StdoutSize *RunHostStdoutSize `json:"stdout_size,omitempty"`
+}
+
+// RunHosts defines model for RunHosts.
+type RunHosts struct {
+	Data  []RunHost `json:"data"`
+	Links Links     `json:"links"`
+
+	// Meta Information about returned entities
+	Meta Meta `json:"meta"`
+}
+
+// RunHostsV2 defines model for RunHostsV2.
+type RunHostsV2 struct {
+	Data  []RunHostV2 `json:"data"`
+	Links Links       `json:"links"`
+
+	// Meta Information about returned entities
+	Meta Meta `json:"meta"`
+}
+
+// RunId Unique identifier of a Playbook run
+type RunId = openapi_types.UUID
+
+// RunLabelsNullable defines model for RunLabelsNullable.
+type RunLabelsNullable map[string]string
+
+// RunLinks Links to the resources related to the run
+type RunLinks struct {
+	// Cancel Internal operation canceling the run, to be called by the service that dispatched it with the run ID in the request body. Only set while the run is running.
+	Cancel  *string `json:"cancel,omitempty"`
+	Events  *string `json:"events,omitempty"`
+	History *string `json:"history,omitempty"`
+
+	// Hosts Hosts involved in the run
+	Hosts *string `json:"hosts,omitempty"`
+	Self  *string `json:"self,omitempty"`
+
+	// WebConsole URL that points to the section of the web console where the user find more information about the playbook run. The field is optional but highly suggested.
+	WebConsole *WebConsoleUrl `json:"web_console,omitempty"`
+}
+
+// RunProgress Share of the hosts of the run that finished the playbook, in percent. It is 100 once the run succeeds or fails.
+type RunProgress = int
+
+// RunRecipient Identifier of the host to which a given Playbook is addressed
+type RunRecipient = openapi_types.UUID
+
+// RunStatus Current status of a Playbook run. A run that asked to wait for its recipient to connect is waiting_for_connection until it is dispatched. A canceled run is canceling until the recipient acknowledges the cancel.
+type RunStatus string
+
+// RunStatusLookup defines model for RunStatusLookup.
+type RunStatusLookup struct {
+	CorrelationIds *[]openapi_types.UUID `json:"correlation_ids,omitempty"`
+	Ids            *[]RunId              `json:"ids,omitempty"`
+}
+
+// RunStatusTransition defines model for RunStatusTransition.
+type RunStatusTransition struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// PreviousStatus Status of the run before the transition, not set for the status the run was created with
+	PreviousStatus *RunStatus `json:"previous_status,omitempty"`
+
+	// Source What changed the status: the creation of the run (dispatch), its dispatch once the recipient connected (reconnect), a response of the recipient (response) or its timeout (sweeper)
+	Source RunStatusTransitionSource `json:"source"`
+
+	// SourceEventId ID of the request (dispatch) or response message (response) that changed the status, to be looked up in the logs
+	SourceEventId *string `json:"source_event_id,omitempty"`
+
+	// Status Current status of a Playbook run. A run that asked to wait for its recipient to connect is waiting_for_connection until it is dispatched. A canceled run is canceling until the recipient acknowledges the cancel.
+	Status RunStatus `json:"status"`
+}
+
+// RunStatusTransitionSource What changed the status: the creation of the run (dispatch), its dispatch once the recipient connected (reconnect), a response of the recipient (response) or its timeout (sweeper)
+type RunStatusTransitionSource string
+
+// RunStatuses defines model for RunStatuses.
+type RunStatuses struct {
+	// CorrelationIds Status of the runs by correlation ID
+	CorrelationIds map[string]RunStatus `json:"correlation_ids"`
+
+	// Ids Status of the runs by run ID
+	Ids map[string]RunStatus `json:"ids"`
+}
+
+// RunTimeout Amount of seconds after which the run is considered failed due to timeout
+type RunTimeout = int
+
+// RunV2 defines model for RunV2.
+type RunV2 struct {
+	// CorrelationId Unique identifier used to match work request with responses
+	CorrelationId *RunCorrelationId `json:"correlation_id,omitempty"`
+
+	// CreatedAt A timestamp when the entry was created
+	CreatedAt *CreatedAt `json:"created_at,omitempty"`
+
+	// ExecutionMode Whether the playbook is run normally (run) or in check mode (check). In check mode Ansible reports the changes it would make, including their diffs, without making them.
+	ExecutionMode *ExecutionMode `json:"execution_mode,omitempty"`
+
+	// Id Unique identifier of a Playbook run
+	Id *RunId `json:"id,omitempty"`
+
+	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
+	Labels *Labels `json:"labels,omitempty"`
+
+	// Links Links to the resources related to the run
+	Links *RunLinks `json:"links,omitempty"`
+
+	// Name Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
+	Name *PlaybookName `json:"name,omitempty"`
+
+	// OrgId Identifier of the tenant
+	OrgId *OrgId `json:"org_id,omitempty"`
+
+	// ParentRunId Run this run is a retry of (see /internal/v2/runs/{run_id}/retry). Following the parent of each run gives the chain of retries.
+	ParentRunId *ParentRunId `json:"parent_run_id,omitempty"`
+
+	// Principal Username of the user on whose behalf the run was dispatched
+	Principal *Principal `json:"principal,omitempty"`
+
+	// Progress Share of the hosts of the run that finished the playbook, in percent. It is 100 once the run succeeds or fails.
+	Progress *RunProgress `json:"progress,omitempty"`
+
+	// Recipient Identifier of the host to which a given Playbook is addressed
+	Recipient *RunRecipient `json:"recipient,omitempty"`
+
+	// Service Service that triggered the given Playbook run
+	Service *Service `json:"service,omitempty"`
+
+	// Status Current status of a Playbook run. A run that asked to wait for its recipient to connect is waiting_for_connection until it is dispatched. A canceled run is canceling until the recipient acknowledges the cancel.
+	Status *RunStatus `json:"status,omitempty"`
+
+	// Timeout Amount of seconds after which the run is considered failed due to timeout
+	Timeout *RunTimeout `json:"timeout,omitempty"`
+
+	// UpdatedAt A timestamp when the entry was last updated
+	UpdatedAt *UpdatedAt `json:"updated_at,omitempty"`
+
+	// Url URL hosting the Playbook
+	Url *Url `json:"url,omitempty"`
+
+	// WebConsoleUrl URL that points to the section of the web console where the user find more information about the playbook run. The field is optional but highly suggested.
+	WebConsoleUrl *WebConsoleUrl `json:"web_console_url,omitempty"`
+}
+
+// Runs defines model for Runs.
+type Runs struct {
+	Data  []Run `json:"data"`
+	Links Links `json:"links"`
+
+	// Meta Information about returned entities
+	Meta Meta `json:"meta"`
+}
+
+// RunsV2 defines model for RunsV2.
+type RunsV2 struct {
+	Data  []RunV2 `json:"data"`
+	Links Links   `json:"links"`
+
+	// Meta Information about returned entities
+	Meta Meta `json:"meta"`
+}
+
+// Service Service that triggered the given Playbook run
+type Service = string
+
+// ServiceNullable defines model for ServiceNullable.
+type ServiceNullable = string
+
+// StatusListNullable Comma-separated list of statuses, any of which matches
+type StatusListNullable = string
+
+// StatusNullable defines model for StatusNullable.
+type StatusNullable string
+
+// UpdatedAt A timestamp when the entry was last updated
+type UpdatedAt = time.Time
+
+// Url URL hosting the Playbook
+type Url = string
+
+// WebConsoleUrl URL that points to the section of the web console where the user find more information about the playbook run. The field is optional but highly suggested.
+type WebConsoleUrl = string
+
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// Format Representation of a list. `json` returns a page of results, `csv` and `ndjson` export all the results.
+type Format = ExportFormat
+
+// Limit defines model for Limit.
+type Limit = int
+
+// Offset defines model for Offset.
+type Offset = int
+
+// RunHostFields defines model for RunHostFields.
+type RunHostFields struct {
+	Data *[]string `json:"data,omitempty"`
+}
+
+// RunHostFieldsV2 defines model for RunHostFieldsV2.
+type RunHostFieldsV2 struct {
+	Data *[]string `json:"data,omitempty"`
+}
+
+// RunHostFilter defines model for RunHostFilter.
+type RunHostFilter struct {
+	InventoryId *InventoryIdNullable `json:"inventory_id,omitempty"`
+	Run         *struct {
+		Id      *string            `json:"id,omitempty"`
+		Labels  *RunLabelsNullable `json:"labels,omitempty"`
+		Service *ServiceNullable   `json:"service,omitempty"`
+	} `json:"run,omitempty"`
+	Status *StatusNullable `json:"status,omitempty"`
+}
+
+// RunsFields defines model for RunsFields.
+type RunsFields struct {
+	Data *[]string `json:"data,omitempty"`
+}
+
+// RunsFieldsV2 defines model for RunsFieldsV2.
+type RunsFieldsV2 struct {
+	Data *[]string `json:"data,omitempty"`
+}
+
+// RunsFilter defines model for RunsFilter.
+type RunsFilter struct {
+	CorrelationId *string `json:"correlation_id,omitempty"`
+
+	// CreatedAfter Only runs created at or after the given time (RFC 3339)
+	CreatedAfter *string `json:"created_after,omitempty"`
+
+	// CreatedBefore Only runs created before the given time (RFC 3339)
+	CreatedBefore *string            `json:"created_before,omitempty"`
+	Labels        *RunLabelsNullable `json:"labels,omitempty"`
+
+	// LabelsOperator Whether runs need to match all (`and`) or any (`or`) of the label filters. With `or`, repeating a label matches any of the given values.
+	LabelsOperator *LabelsOperatorNullable `json:"labels_operator,omitempty"`
+	Recipient      *string                 `json:"recipient,omitempty"`
+	Service        *ServiceNullable        `json:"service,omitempty"`
+
+	// Status Comma-separated list of statuses, any of which matches
+	Status *StatusListNullable `json:"status,omitempty"`
+}
+
+// RunsSortBy defines model for RunsSortBy.
+type RunsSortBy = string
+
+// Search defines model for Search.
+type Search = string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = Error
+
+// Forbidden defines model for Forbidden.
+type Forbidden = Error
+
+// NotFound defines model for NotFound.
+type NotFound = Error
+
+// ApiRunHostsListParams defines parameters for ApiRunHostsList.
+type ApiRunHostsListParams struct {
+	// Filter Allows for filtering based on various criteria
+	Filter *RunHostFilter `json:"filter,omitempty"`
+
+	// Fields Defines fields to be returned in the response.
+	Fields *RunHostFields `json:"fields,omitempty"`
+
+	// Limit Maximum number of results to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Indicates the starting position of the query relative to the complete set of items that match the query
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Position of the page in the results, as found in the links of the previous page. Selects cursor-based pagination, which remains fast for large result sets, instead of offset-based pagination. An empty value requests the first page. Results are ordered by creation time and ID. Cannot be combined with `offset`.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Format Exports all the results matching the filters as CSV (a column per selected field) or newline-delimited JSON instead of returning a page of them. Pagination parameters are ignored when exporting. CSV can also be requested using the `Accept: text/csv` header.
+	Format *Format `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ApiRunHostsListParamsFieldsData defines parameters for ApiRunHostsList.
+type ApiRunHostsListParamsFieldsData string
+
+// ApiRunsListParams defines parameters for ApiRunsList.
+type ApiRunsListParams struct {
+	// Filter Allows for filtering based on various criteria
+	Filter *RunsFilter `json:"filter,omitempty"`
+
+	// Fields Defines fields to be returned in the response.
+	Fields *RunsFields `json:"fields,omitempty"`
+
+	// SortBy Sort order as a comma-separated list of fields, each optionally followed by `:asc` or `:desc` (the default). Runs can be sorted by `created_at`, `updated_at`, `status`, `name`, `service` and the value of a label (`labels.<key>`), e.g. `status:asc,created_at:desc`. Cursor pagination only supports sorting by `created_at`.
+	SortBy *RunsSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+
+	// Limit Maximum number of results to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Indicates the starting position of the query relative to the complete set of items that match the query
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Position of the page in the results, as found in the links of the previous page. Selects cursor-based pagination, which remains fast for large result sets, instead of offset-based pagination. An empty value requests the first page. Results are ordered by creation time and ID. Cannot be combined with `offset`.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Search Returns the runs whose playbook name, web console URL or any label value contains the given text, ignoring case. Values of encrypted labels are not searched.
+	Search *Search `form:"search,omitempty" json:"search,omitempty"`
+
+	// Format Exports all the results matching the filters as CSV (a column per selected field) or newline-delimited JSON instead of returning a page of them. Pagination parameters are ignored when exporting. CSV can also be requested using the `Accept: text/csv` header.
+	Format *Format `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ApiRunsListParamsFieldsData defines parameters for ApiRunsList.
+type ApiRunsListParamsFieldsData string
+
+// ApiRunsGroupsParams defines parameters for ApiRunsGroups.
+type ApiRunsGroupsParams struct {
+	// Label Key of the label to group the runs by
+	Label string `form:"label" json:"label"`
+
+	// Limit Maximum number of results to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Indicates the starting position of the query relative to the complete set of items that match the query
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ApiRunHostsListV2Params defines parameters for ApiRunHostsListV2.
+type ApiRunHostsListV2Params struct {
+	// Filter Allows for filtering based on various criteria
+	Filter *RunHostFilter `json:"filter,omitempty"`
+
+	// Fields Defines fields to be returned in the response.
+	Fields *RunHostFieldsV2 `json:"fields,omitempty"`
+
+	// Limit Maximum number of results to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Indicates the starting position of the query relative to the complete set of items that match the query
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Position of the page in the results, as found in the links of the previous page. Selects cursor-based pagination, which remains fast for large result sets, instead of offset-based pagination. An empty value requests the first page. Results are ordered by creation time and ID. Cannot be combined with `offset`.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Format Exports all the results matching the filters as CSV (a column per selected field) or newline-delimited JSON instead of returning a page of them. Pagination parameters are ignored when exporting. CSV can also be requested using the `Accept: text/csv` header.
+	Format *Format `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ApiRunHostsListV2ParamsFieldsData defines parameters for ApiRunHostsListV2.
+type ApiRunHostsListV2ParamsFieldsData string
+
+// ApiRunsListV2Params defines parameters for ApiRunsListV2.
+type ApiRunsListV2Params struct {
+	// Filter Allows for filtering based on various criteria
+	Filter *RunsFilter `json:"filter,omitempty"`
+
+	// Fields Defines fields to be returned in the response.
+	Fields *RunsFieldsV2 `json:"fields,omitempty"`
+
+	// SortBy Sort order as a comma-separated list of fields, each optionally followed by `:asc` or `:desc` (the default). Runs can be sorted by `created_at`, `updated_at`, `status`, `name`, `service` and the value of a label (`labels.<key>`), e.g. `status:asc,created_at:desc`. Cursor pagination only supports sorting by `created_at`.
+	SortBy *RunsSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+
+	// Limit Maximum number of results to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Indicates the starting position of the query relative to the complete set of items that match the query
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Position of the page in the results, as found in the links of the previous page. Selects cursor-based pagination, which remains fast for large result sets, instead of offset-based pagination. An empty value requests the first page. Results are ordered by creation time and ID. Cannot be combined with `offset`.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Search Returns the runs whose playbook name, web console URL or any label value contains the given text, ignoring case. Values of encrypted labels are not searched.
+	Search *Search `form:"search,omitempty" json:"search,omitempty"`
+
+	// Format Exports all the results matching the filters as CSV (a column per selected field) or newline-delimited JSON instead of returning a page of them. Pagination parameters are ignored when exporting. CSV can also be requested using the `Accept: text/csv` header.
+	Format *Format `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ApiRunsListV2ParamsFieldsData defines parameters for ApiRunsListV2.
+type ApiRunsListV2ParamsFieldsData string
+
+// ApiRunsStatusJSONRequestBody defines body for ApiRunsStatus for application/json ContentType.
+type ApiRunsStatusJSONRequestBody = RunStatusLookup
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ApiRunHostsList request
+	ApiRunHostsList(ctx context.Context, params *ApiRunHostsListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunHostStdoutGet request
+	ApiRunHostStdoutGet(ctx context.Context, runHostId RunHostId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunsList request
+	ApiRunsList(ctx context.Context, params *ApiRunsListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunsGroups request
+	ApiRunsGroups(ctx context.Context, params *ApiRunsGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunsStatusWithBody request with any body
+	ApiRunsStatusWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiRunsStatus(ctx context.Context, body ApiRunsStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunGet request
+	ApiRunGet(ctx context.Context, runId RunId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunEvents request
+	ApiRunEvents(ctx context.Context, runId RunId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunHistoryGet request
+	ApiRunHistoryGet(ctx context.Context, runId RunId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunHostArtifactsGet request
+	ApiRunHostArtifactsGet(ctx context.Context, runId RunId, host string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunHostsListV2 request
+	ApiRunHostsListV2(ctx context.Context, params *ApiRunHostsListV2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunsListV2 request
+	ApiRunsListV2(ctx context.Context, params *ApiRunsListV2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ApiRunHostsList(ctx context.Context, params *ApiRunHostsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunHostsListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunHostStdoutGet(ctx context.Context, runHostId RunHostId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunHostStdoutGetRequest(c.Server, runHostId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunsList(ctx context.Context, params *ApiRunsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunsListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunsGroups(ctx context.Context, params *ApiRunsGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunsGroupsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunsStatusWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunsStatusRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunsStatus(ctx context.Context, body ApiRunsStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunsStatusRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunGet(ctx context.Context, runId RunId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunGetRequest(c.Server, runId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunEvents(ctx context.Context, runId RunId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunEventsRequest(c.Server, runId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunHistoryGet(ctx context.Context, runId RunId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunHistoryGetRequest(c.Server, runId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunHostArtifactsGet(ctx context.Context, runId RunId, host string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunHostArtifactsGetRequest(c.Server, runId, host)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunHostsListV2(ctx context.Context, params *ApiRunHostsListV2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunHostsListV2Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunsListV2(ctx context.Context, params *ApiRunsListV2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunsListV2Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewApiRunHostsListRequest generates requests for ApiRunHostsList
+func NewApiRunHostsListRequest(server string, params *ApiRunHostsListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/run_hosts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "fields", *params.Fields, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "format", *params.Format, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiRunHostStdoutGetRequest generates requests for ApiRunHostStdoutGet
+func NewApiRunHostStdoutGetRequest(server string, runHostId RunHostId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "run_host_id", runHostId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/run_hosts/%s/stdout", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiRunsListRequest generates requests for ApiRunsList
+func NewApiRunsListRequest(server string, params *ApiRunsListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/runs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "fields", *params.Fields, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sort_by", *params.SortBy, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search", *params.Search, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "format", *params.Format, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiRunsGroupsRequest generates requests for ApiRunsGroups
+func NewApiRunsGroupsRequest(server string, params *ApiRunsGroupsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/runs/groups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "label", params.Label, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiRunsStatusRequest calls the generic ApiRunsStatus builder with application/json body
+func NewApiRunsStatusRequest(server string, body ApiRunsStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiRunsStatusRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewApiRunsStatusRequestWithBody generates requests for ApiRunsStatus with any type of body
+func NewApiRunsStatusRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/runs/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApiRunGetRequest generates requests for ApiRunGet
+func NewApiRunGetRequest(server string, runId RunId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "run_id", runId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/runs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiRunEventsRequest generates requests for ApiRunEvents
+func NewApiRunEventsRequest(server string, runId RunId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "run_id", runId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/runs/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiRunHistoryGetRequest generates requests for ApiRunHistoryGet
+func NewApiRunHistoryGetRequest(server string, runId RunId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "run_id", runId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/runs/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiRunHostArtifactsGetRequest generates requests for ApiRunHostArtifactsGet
+func NewApiRunHostArtifactsGetRequest(server string, runId RunId, host string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "run_id", runId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "host", host, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/runs/%s/hosts/%s/artifacts", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiRunHostsListV2Request generates requests for ApiRunHostsListV2
+func NewApiRunHostsListV2Request(server string, params *ApiRunHostsListV2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v2/run_hosts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "fields", *params.Fields, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "format", *params.Format, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiRunsListV2Request generates requests for ApiRunsListV2
+func NewApiRunsListV2Request(server string, params *ApiRunsListV2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v2/runs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "fields", *params.Fields, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sort_by", *params.SortBy, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search", *params.Search, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "format", *params.Format, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ApiRunHostsListWithResponse request
+	ApiRunHostsListWithResponse(ctx context.Context, params *ApiRunHostsListParams, reqEditors ...RequestEditorFn) (*ApiRunHostsListResponse, error)
+
+	// ApiRunHostStdoutGetWithResponse request
+	ApiRunHostStdoutGetWithResponse(ctx context.Context, runHostId RunHostId, reqEditors ...RequestEditorFn) (*ApiRunHostStdoutGetResponse, error)
+
+	// ApiRunsListWithResponse request
+	ApiRunsListWithResponse(ctx context.Context, params *ApiRunsListParams, reqEditors ...RequestEditorFn) (*ApiRunsListResponse, error)
+
+	// ApiRunsGroupsWithResponse request
+	ApiRunsGroupsWithResponse(ctx context.Context, params *ApiRunsGroupsParams, reqEditors ...RequestEditorFn) (*ApiRunsGroupsResponse, error)
+
+	// ApiRunsStatusWithBodyWithResponse request with any body
+	ApiRunsStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiRunsStatusResponse, error)
+
+	ApiRunsStatusWithResponse(ctx context.Context, body ApiRunsStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiRunsStatusResponse, error)
+
+	// ApiRunGetWithResponse request
+	ApiRunGetWithResponse(ctx context.Context, runId RunId, reqEditors ...RequestEditorFn) (*ApiRunGetResponse, error)
+
+	// ApiRunEventsWithResponse request
+	ApiRunEventsWithResponse(ctx context.Context, runId RunId, reqEditors ...RequestEditorFn) (*ApiRunEventsResponse, error)
+
+	// ApiRunHistoryGetWithResponse request
+	ApiRunHistoryGetWithResponse(ctx context.Context, runId RunId, reqEditors ...RequestEditorFn) (*ApiRunHistoryGetResponse, error)
+
+	// ApiRunHostArtifactsGetWithResponse request
+	ApiRunHostArtifactsGetWithResponse(ctx context.Context, runId RunId, host string, reqEditors ...RequestEditorFn) (*ApiRunHostArtifactsGetResponse, error)
+
+	// ApiRunHostsListV2WithResponse request
+	ApiRunHostsListV2WithResponse(ctx context.Context, params *ApiRunHostsListV2Params, reqEditors ...RequestEditorFn) (*ApiRunHostsListV2Response, error)
+
+	// ApiRunsListV2WithResponse request
+	ApiRunsListV2WithResponse(ctx context.Context, params *ApiRunsListV2Params, reqEditors ...RequestEditorFn) (*ApiRunsListV2Response, error)
+}
+
+type ApiRunHostsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunHosts
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunHostsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunHostsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunHostStdoutGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunHostStdoutGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunHostStdoutGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Runs
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunsGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunGroups
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunsGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunsGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunsStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunStatuses
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunsStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunsStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Run
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunHistoryGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunHistory
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunHistoryGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunHistoryGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunHostArtifactsGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunHostArtifacts
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunHostArtifactsGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunHostArtifactsGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunHostsListV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunHostsV2
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunHostsListV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunHostsListV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunsListV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunsV2
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunsListV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunsListV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ApiRunHostsListWithResponse request returning *ApiRunHostsListResponse
+func (c *ClientWithResponses) ApiRunHostsListWithResponse(ctx context.Context, params *ApiRunHostsListParams, reqEditors ...RequestEditorFn) (*ApiRunHostsListResponse, error) {
+	rsp, err := c.ApiRunHostsList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunHostsListResponse(rsp)
+}
+
+// ApiRunHostStdoutGetWithResponse request returning *ApiRunHostStdoutGetResponse
+func (c *ClientWithResponses) ApiRunHostStdoutGetWithResponse(ctx context.Context, runHostId RunHostId, reqEditors ...RequestEditorFn) (*ApiRunHostStdoutGetResponse, error) {
+	rsp, err := c.ApiRunHostStdoutGet(ctx, runHostId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunHostStdoutGetResponse(rsp)
+}
+
+// ApiRunsListWithResponse request returning *ApiRunsListResponse
+func (c *ClientWithResponses) ApiRunsListWithResponse(ctx context.Context, params *ApiRunsListParams, reqEditors ...RequestEditorFn) (*ApiRunsListResponse, error) {
+	rsp, err := c.ApiRunsList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunsListResponse(rsp)
+}
+
+// ApiRunsGroupsWithResponse request returning *ApiRunsGroupsResponse
+func (c *ClientWithResponses) ApiRunsGroupsWithResponse(ctx context.Context, params *ApiRunsGroupsParams, reqEditors ...RequestEditorFn) (*ApiRunsGroupsResponse, error) {
+	rsp, err := c.ApiRunsGroups(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunsGroupsResponse(rsp)
+}
+
+// ApiRunsStatusWithBodyWithResponse request with arbitrary body returning *ApiRunsStatusResponse
+func (c *ClientWithResponses) ApiRunsStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiRunsStatusResponse, error) {
+	rsp, err := c.ApiRunsStatusWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunsStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiRunsStatusWithResponse(ctx context.Context, body ApiRunsStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiRunsStatusResponse, error) {
+	rsp, err := c.ApiRunsStatus(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunsStatusResponse(rsp)
+}
+
+// ApiRunGetWithResponse request returning *ApiRunGetResponse
+func (c *ClientWithResponses) ApiRunGetWithResponse(ctx context.Context, runId RunId, reqEditors ...RequestEditorFn) (*ApiRunGetResponse, error) {
+	rsp, err := c.ApiRunGet(ctx, runId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunGetResponse(rsp)
+}
+
+// ApiRunEventsWithResponse request returning *ApiRunEventsResponse
+func (c *ClientWithResponses) ApiRunEventsWithResponse(ctx context.Context, runId RunId, reqEditors ...RequestEditorFn) (*ApiRunEventsResponse, error) {
+	rsp, err := c.ApiRunEvents(ctx, runId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunEventsResponse(rsp)
+}
+
+// ApiRunHistoryGetWithResponse request returning *ApiRunHistoryGetResponse
+func (c *ClientWithResponses) ApiRunHistoryGetWithResponse(ctx context.Context, runId RunId, reqEditors ...RequestEditorFn) (*ApiRunHistoryGetResponse, error) {
+	rsp, err := c.ApiRunHistoryGet(ctx, runId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunHistoryGetResponse(rsp)
+}
+
+// ApiRunHostArtifactsGetWithResponse request returning *ApiRunHostArtifactsGetResponse
+func (c *ClientWithResponses) ApiRunHostArtifactsGetWithResponse(ctx context.Context, runId RunId, host string, reqEditors ...RequestEditorFn) (*ApiRunHostArtifactsGetResponse, error) {
+	rsp, err := c.ApiRunHostArtifactsGet(ctx, runId, host, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunHostArtifactsGetResponse(rsp)
+}
+
+// ApiRunHostsListV2WithResponse request returning *ApiRunHostsListV2Response
+func (c *ClientWithResponses) ApiRunHostsListV2WithResponse(ctx context.Context, params *ApiRunHostsListV2Params, reqEditors ...RequestEditorFn) (*ApiRunHostsListV2Response, error) {
+	rsp, err := c.ApiRunHostsListV2(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunHostsListV2Response(rsp)
+}
+
+// ApiRunsListV2WithResponse request returning *ApiRunsListV2Response
+func (c *ClientWithResponses) ApiRunsListV2WithResponse(ctx context.Context, params *ApiRunsListV2Params, reqEditors ...RequestEditorFn) (*ApiRunsListV2Response, error) {
+	rsp, err := c.ApiRunsListV2(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunsListV2Response(rsp)
+}
+
+// ParseApiRunHostsListResponse parses an HTTP response from a ApiRunHostsListWithResponse call
+func ParseApiRunHostsListResponse(rsp *http.Response) (*ApiRunHostsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunHostsListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunHosts
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseApiRunHostStdoutGetResponse parses an HTTP response from a ApiRunHostStdoutGetWithResponse call
+func ParseApiRunHostStdoutGetResponse(rsp *http.Response) (*ApiRunHostStdoutGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunHostStdoutGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiRunsListResponse parses an HTTP response from a ApiRunsListWithResponse call
+func ParseApiRunsListResponse(rsp *http.Response) (*ApiRunsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunsListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Runs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseApiRunsGroupsResponse parses an HTTP response from a ApiRunsGroupsWithResponse call
+func ParseApiRunsGroupsResponse(rsp *http.Response) (*ApiRunsGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunsGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunGroups
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiRunsStatusResponse parses an HTTP response from a ApiRunsStatusWithResponse call
+func ParseApiRunsStatusResponse(rsp *http.Response) (*ApiRunsStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunsStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunStatuses
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiRunGetResponse parses an HTTP response from a ApiRunGetWithResponse call
+func ParseApiRunGetResponse(rsp *http.Response) (*ApiRunGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Run
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiRunEventsResponse parses an HTTP response from a ApiRunEventsWithResponse call
+func ParseApiRunEventsResponse(rsp *http.Response) (*ApiRunEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiRunHistoryGetResponse parses an HTTP response from a ApiRunHistoryGetWithResponse call
+func ParseApiRunHistoryGetResponse(rsp *http.Response) (*ApiRunHistoryGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunHistoryGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiRunHostArtifactsGetResponse parses an HTTP response from a ApiRunHostArtifactsGetWithResponse call
+func ParseApiRunHostArtifactsGetResponse(rsp *http.Response) (*ApiRunHostArtifactsGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunHostArtifactsGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunHostArtifacts
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiRunHostsListV2Response parses an HTTP response from a ApiRunHostsListV2WithResponse call
+func ParseApiRunHostsListV2Response(rsp *http.Response) (*ApiRunHostsListV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunHostsListV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunHostsV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseApiRunsListV2Response parses an HTTP response from a ApiRunsListV2WithResponse call
+func ParseApiRunsListV2Response(rsp *http.Response) (*ApiRunsListV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunsListV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunsV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+// Requests are retried on network errors, 429 and 5xx responses with exponential backoff (respecting Retry-After).
+// Non-idempotent requests (e.g. dispatching runs) are only retried on 429 and 503, which the service returns
+// before processing the request, so that a retry never creates runs twice.
+type RetryPolicy struct {
+	// total number of attempts, 1 disables retries
+	Attempts        int
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	Attempts:        4,
+	InitialInterval: 250 * time.Millisecond,
+	MaxInterval:     10 * time.Second,
+}
+
+// retryingDoer implements the HttpRequestDoer of the generated clients
+type retryingDoer struct {
+	client *http.Client
+	policy RetryPolicy
+}
+
+func (this *retryingDoer) Do(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := this.client.Do(req)
+
+		if attempt >= this.policy.Attempts || !retryable(req, res, err) || (req.Body != nil && req.GetBody == nil) {
+			return res, err
+		}
+
+		wait := this.backoff(attempt)
+		if res != nil {
+			if retryAfter, parseErr := strconv.Atoi(res.Header.Get("Retry-After")); parseErr == nil {
+				wait = max(wait, time.Duration(retryAfter)*time.Second)
+			}
+
+			// allows the connection to be reused
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func (this *retryingDoer) backoff(attempt int) time.Duration {
+	interval := this.policy.InitialInterval
+	for i := 1; i < attempt && interval < this.policy.MaxInterval; i++ {
+		interval *= 2
+	}
+
+	return min(interval, this.policy.MaxInterval)
+}
+
+func retryable(req *http.Request, res *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	idempotent := req.Method == http.MethodGet || req.Method == http.MethodHead
+
+	switch {
+	case err != nil:
+		return idempotent
+	case res.StatusCode == http.StatusTooManyRequests, res.StatusCode == http.StatusServiceUnavailable:
+		return true
+	case res.StatusCode >= 500:
+		return idempotent
+	default:
+		return false
+	}
+}
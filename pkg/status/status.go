@@ -0,0 +1,113 @@
+// Package status defines the statuses of runs and run hosts and the rules for moving between them.
+//
+// A run starts as running. The executor (rhc or Satellite) eventually reports it as success or failure, after which
+// the status never changes again. The dispatcher itself marks runs as timeout (once the timeout of the run elapses)
+// or canceled; those are terminal as well but a response the executor sends late still overrides them.
+//
+// A run the cancel of which was sent to the executor is canceling until the executor acknowledges the cancel (canceled)
+// or reports that the playbook finished anyway (success or failure).
+//
+// A run that asks to wait for its recipient to connect starts as waiting_for_connection instead and becomes running
+// once it is dispatched.
+package status
+
+import (
+	"fmt"
+)
+
+// Status is the status of a run or of a run host
+type Status string
+
+const (
+	Running  Status = "running"
+	Success  Status = "success"
+	Failure  Status = "failure"
+	Timeout  Status = "timeout"
+	Canceled Status = "canceled"
+	// WaitingForConnection is the status of a run not dispatched yet as its recipient is not connected
+	WaitingForConnection Status = "waiting_for_connection"
+	// Canceling is the status of a run the cancel of which was sent but not acknowledged yet
+	Canceling Status = "canceling"
+)
+
+var values = []Status{Running, Success, Failure, Timeout, Canceled, WaitingForConnection, Canceling}
+
+// Values returns all statuses
+func Values() []Status {
+	return append([]Status{}, values...)
+}
+
+// Parse returns the status the given value represents
+func Parse(value string) (Status, error) {
+	status := Status(value)
+	if !status.Valid() {
+		return "", fmt.Errorf("unknown status %q", value)
+	}
+
+	return status, nil
+}
+
+// Strings returns the values of the given statuses, e.g. to use them in a query
+func Strings(statuses ...Status) []string {
+	result := make([]string, len(statuses))
+	for i, status := range statuses {
+		result[i] = string(status)
+	}
+
+	return result
+}
+
+func (this Status) String() string {
+	return string(this)
+}
+
+// Valid tells whether the status is one of the known statuses
+func (this Status) Valid() bool {
+	switch this {
+	case Running, Success, Failure, Timeout, Canceled, WaitingForConnection, Canceling:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTerminal tells whether a run in this status is no longer expected to make progress
+func (this Status) IsTerminal() bool {
+	return this.Valid() && this != Running && this != WaitingForConnection && this != Canceling
+}
+
+// Active returns the statuses of runs dispatched to the executor that did not finish yet, i.e. the runs that time out
+// once their timeout elapses
+func Active() []Status {
+	return []Status{Running, Canceling}
+}
+
+// IsFinal tells whether the status was reported by the executor and therefore never changes again
+func (this Status) IsFinal() bool {
+	return this == Success || this == Failure
+}
+
+// Final returns the statuses that never change again
+func Final() []Status {
+	return []Status{Success, Failure}
+}
+
+// CanTransition tells whether a run (or run host) in the status from may be moved to the status to
+func CanTransition(from, to Status) bool {
+	if !from.Valid() || !to.Valid() {
+		return false
+	}
+
+	return from == to || !from.IsFinal()
+}
+
+// CancelState tracks the cancel of a run on each of its hosts
+type CancelState string
+
+const (
+	// CancelRequested is set on the hosts still running the playbook when the run is canceled. A host that reports
+	// success or failure afterwards finished the playbook anyway.
+	CancelRequested CancelState = "cancel_requested"
+	// CancelAcked is set once the host reports that it canceled the playbook
+	CancelAcked CancelState = "cancel_acked"
+)
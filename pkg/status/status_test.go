@@ -0,0 +1,76 @@
+package status
+
+import (
+	"playbook-dispatcher/pkg/client/public"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Status", func() {
+	It("parses known statuses", func() {
+		for _, value := range Values() {
+			parsed, err := Parse(string(value))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed).To(Equal(value))
+		}
+	})
+
+	It("rejects unknown statuses", func() {
+		_, err := Parse("pending")
+		Expect(err).To(HaveOccurred())
+		Expect(Status("").Valid()).To(BeFalse())
+	})
+
+	It("matches the statuses of the API", func() {
+		for _, value := range Values() {
+			Expect(public.RunStatus(value).Valid()).To(BeTrue(), string(value))
+		}
+
+		for _, value := range []public.RunStatus{public.RunStatusRunning, public.RunStatusSuccess, public.RunStatusFailure, public.RunStatusTimeout, public.RunStatusCanceled, public.RunStatusWaitingForConnection, public.RunStatusCanceling} {
+			Expect(Status(value).Valid()).To(BeTrue(), string(value))
+		}
+	})
+
+	It("converts statuses to strings", func() {
+		Expect(Strings(Success, Failure)).To(Equal([]string{"success", "failure"}))
+		Expect(Strings()).To(BeEmpty())
+	})
+
+	DescribeTable("terminal and final statuses",
+		func(status Status, terminal, final bool) {
+			Expect(status.IsTerminal()).To(Equal(terminal))
+			Expect(status.IsFinal()).To(Equal(final))
+		},
+		Entry("running", Running, false, false),
+		Entry("success", Success, true, true),
+		Entry("failure", Failure, true, true),
+		Entry("timeout", Timeout, true, false),
+		Entry("canceled", Canceled, true, false),
+		Entry("waiting for connection", WaitingForConnection, false, false),
+		Entry("canceling", Canceling, false, false),
+		Entry("unknown", Status("pending"), false, false),
+	)
+
+	DescribeTable("transitions",
+		func(from, to Status, allowed bool) {
+			Expect(CanTransition(from, to)).To(Equal(allowed))
+		},
+		Entry("running to success", Running, Success, true),
+		Entry("running to timeout", Running, Timeout, true),
+		Entry("waiting for connection to running", WaitingForConnection, Running, true),
+		Entry("running to running", Running, Running, true),
+		Entry("running to canceling", Running, Canceling, true),
+		Entry("canceling to canceled", Canceling, Canceled, true),
+		Entry("canceling to success", Canceling, Success, true),
+		Entry("timeout to success", Timeout, Success, true),
+		Entry("canceled to failure", Canceled, Failure, true),
+		Entry("success to success", Success, Success, true),
+		Entry("success to failure", Success, Failure, false),
+		Entry("failure to running", Failure, Running, false),
+		Entry("failure to timeout", Failure, Timeout, false),
+		Entry("running to unknown", Running, Status("pending"), false),
+		Entry("unknown to running", Status("pending"), Running, false),
+	)
+})
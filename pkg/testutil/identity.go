@@ -0,0 +1,73 @@
+// Package testutil helps services integrating with playbook-dispatcher write tests against it.
+//
+// It provides builders for the resources of the API (runs, run hosts), for the response messages the dispatcher
+// consumes from Kafka and for identity headers, as well as a helper that starts the service and its dependencies
+// using docker compose:
+//
+//	service, err := testutil.StartService(ctx, testutil.ServiceOptions{ComposeFile: "../playbook-dispatcher/docker-compose.yml"})
+//	defer service.Stop(ctx)
+//
+//	c, err := service.Client()
+//	created, err := c.Dispatch(ctx, []private.RunInputV2{testutil.NewRunInput("12345")})
+package testutil
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// Identity describes the x-rh-identity header of a request
+type Identity struct {
+	OrgId         string
+	AccountNumber string
+	// Type is the identity type, e.g. User, System or ServiceAccount
+	Type     string
+	Username string
+	// CertCN is the common name of the certificate of a System identity (i.e. its Subscription Manager id)
+	CertCN string
+}
+
+// UserIdentity returns the identity of a user of the given tenant
+func UserIdentity(orgId string) Identity {
+	return Identity{
+		OrgId:    orgId,
+		Type:     "User",
+		Username: "test-user",
+	}
+}
+
+// SystemIdentity returns the identity of a host of the given tenant, as used by rhc and Satellite
+func SystemIdentity(orgId, cn string) Identity {
+	return Identity{
+		OrgId:  orgId,
+		Type:   "System",
+		CertCN: cn,
+	}
+}
+
+// Encode returns the base64-encoded value of the x-rh-identity header
+func (this Identity) Encode() string {
+	identity := map[string]interface{}{
+		"org_id":   this.OrgId,
+		"type":     this.Type,
+		"internal": map[string]string{"org_id": this.OrgId},
+	}
+
+	if this.AccountNumber != "" {
+		identity["account_number"] = this.AccountNumber
+	}
+
+	switch this.Type {
+	case "System":
+		identity["system"] = map[string]string{"cn": this.CertCN, "cert_type": "system"}
+	default:
+		identity["user"] = map[string]string{"username": this.Username}
+	}
+
+	data, err := json.Marshal(map[string]interface{}{"identity": identity})
+	if err != nil {
+		panic(err)
+	}
+
+	return base64.StdEncoding.EncodeToString(data)
+}
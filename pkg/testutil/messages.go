@@ -0,0 +1,160 @@
+package testutil
+
+import (
+	"encoding/json"
+	"time"
+
+	k "github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/google/uuid"
+)
+
+const (
+	// RunnerUpdatesTopic is the topic the dispatcher consumes run updates from in docker-compose
+	RunnerUpdatesTopic = "platform.playbook-dispatcher.runner-updates"
+
+	headerRequestId     = "x-rh-insights-request-id"
+	headerCorrelationId = "x-rh-insights-playbook-dispatcher-correlation-id"
+	headerRequestType   = "service"
+
+	runnerRequestType = "playbook"
+)
+
+type RunnerEventData struct {
+	Playbook                   string `json:"playbook,omitempty"`
+	PlaybookUuid               string `json:"playbook_uuid,omitempty"`
+	Host                       string `json:"host,omitempty"`
+	CrcDispatcherCorrelationId string `json:"crc_dispatcher_correlation_id,omitempty"`
+	CrcDispatcherErrorCode     string `json:"crc_dispatcher_error_code,omitempty"`
+	CrcDispatcherErrorDetails  string `json:"crc_dispatcher_error_details,omitempty"`
+}
+
+// RunnerEvent is an ansible-runner job event as reported by rhc
+type RunnerEvent struct {
+	Event     string           `json:"event"`
+	Uuid      string           `json:"uuid"`
+	Counter   int              `json:"counter"`
+	Stdout    string           `json:"stdout,omitempty"`
+	StartLine int              `json:"start_line"`
+	EndLine   int              `json:"end_line"`
+	EventData *RunnerEventData `json:"event_data,omitempty"`
+}
+
+// RunnerResponse is the response message produced for a run executed by rhc
+type RunnerResponse struct {
+	OrgId           string        `json:"org_id"`
+	RequestId       string        `json:"request_id"`
+	B64Identity     string        `json:"b64_identity"`
+	UploadTimestamp time.Time     `json:"upload_timestamp"`
+	Events          []RunnerEvent `json:"events"`
+
+	correlationId uuid.UUID
+}
+
+// NewRunnerResponse returns a response message for the run with the given correlation id without any events
+func NewRunnerResponse(orgId string, correlationId uuid.UUID) *RunnerResponse {
+	return &RunnerResponse{
+		OrgId:           orgId,
+		RequestId:       uuid.New().String(),
+		B64Identity:     SystemIdentity(orgId, uuid.New().String()).Encode(),
+		UploadTimestamp: time.Now().UTC(),
+		Events:          []RunnerEvent{},
+		correlationId:   correlationId,
+	}
+}
+
+// WithEvent appends an event to the message, setting its counter and line numbers
+func (this *RunnerResponse) WithEvent(event string, host string, stdout string) *RunnerResponse {
+	line := 0
+	if len(this.Events) > 0 {
+		line = this.Events[len(this.Events)-1].EndLine
+	}
+
+	endLine := line
+	if stdout != "" {
+		endLine++
+	}
+
+	this.Events = append(this.Events, RunnerEvent{
+		Event:     event,
+		Uuid:      uuid.New().String(),
+		Counter:   len(this.Events),
+		Stdout:    stdout,
+		StartLine: line,
+		EndLine:   endLine,
+		EventData: &RunnerEventData{Host: host},
+	})
+
+	return this
+}
+
+// WithRunStarted appends the events reported when the playbook of the run starts
+func (this *RunnerResponse) WithRunStarted() *RunnerResponse {
+	this.WithEvent("executor_on_start", "", "")
+	this.Events[len(this.Events)-1].EventData.CrcDispatcherCorrelationId = this.correlationId.String()
+
+	return this.
+		WithEvent("playbook_on_start", "", "").
+		WithEvent("playbook_on_play_start", "", "PLAY [test] *******")
+}
+
+// WithHostOk appends the events of a task succeeding on the given host
+func (this *RunnerResponse) WithHostOk(host string) *RunnerResponse {
+	return this.
+		WithEvent("playbook_on_task_start", "", "TASK [test] *******").
+		WithEvent("runner_on_start", host, "").
+		WithEvent("runner_on_ok", host, "ok: ["+host+"]")
+}
+
+// WithHostFailed appends the events of a task failing on the given host
+func (this *RunnerResponse) WithHostFailed(host string) *RunnerResponse {
+	return this.
+		WithEvent("playbook_on_task_start", "", "TASK [test] *******").
+		WithEvent("runner_on_start", host, "").
+		WithEvent("runner_on_failed", host, "fatal: ["+host+"]: FAILED!")
+}
+
+// WithRunFinished appends the events reported once the playbook finishes (or fails) on all hosts
+func (this *RunnerResponse) WithRunFinished() *RunnerResponse {
+	return this.WithEvent("playbook_on_stats", "", "PLAY RECAP *******")
+}
+
+// NewRunnerResponseOk returns the response message of a run that succeeded on the given hosts
+func NewRunnerResponseOk(orgId string, correlationId uuid.UUID, hosts ...string) *RunnerResponse {
+	response := NewRunnerResponse(orgId, correlationId).WithRunStarted()
+
+	for _, host := range hosts {
+		response.WithHostOk(host)
+	}
+
+	return response.WithRunFinished()
+}
+
+// NewRunnerResponseFailed returns the response message of a run that failed on the given hosts
+func NewRunnerResponseFailed(orgId string, correlationId uuid.UUID, hosts ...string) *RunnerResponse {
+	response := NewRunnerResponse(orgId, correlationId).WithRunStarted()
+
+	for _, host := range hosts {
+		response.WithHostFailed(host)
+	}
+
+	return response.WithRunFinished()
+}
+
+// KafkaMessage returns the message, including the headers set by ingress, ready to be produced to the given topic
+func (this *RunnerResponse) KafkaMessage(topic string) (*k.Message, error) {
+	value, err := json.Marshal(this)
+	if err != nil {
+		return nil, err
+	}
+
+	return &k.Message{
+		TopicPartition: k.TopicPartition{Topic: &topic, Partition: k.PartitionAny},
+		Key:            []byte(this.correlationId.String()),
+		Value:          value,
+		Headers: []k.Header{
+			{Key: headerRequestId, Value: []byte(this.RequestId)},
+			{Key: headerCorrelationId, Value: []byte(this.correlationId.String())},
+			{Key: headerRequestType, Value: []byte(runnerRequestType)},
+		},
+	}, nil
+}
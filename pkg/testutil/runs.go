@@ -0,0 +1,89 @@
+package testutil
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"playbook-dispatcher/pkg/client/private"
+	"playbook-dispatcher/pkg/client/public"
+)
+
+const (
+	testPlaybookUrl = "http://example.com/playbooks/test.yml"
+	testPrincipal   = "test-user"
+	testService     = "test"
+	testHost        = "localhost"
+	testTimeout     = 3600
+)
+
+// NewRunInput returns a dispatch request for a new run addressed to a random recipient
+func NewRunInput(orgId string) private.RunInputV2 {
+	return private.RunInputV2{
+		OrgId:     orgId,
+		Recipient: uuid.New(),
+		Name:      "test playbook",
+		Principal: testPrincipal,
+		Url:       testPlaybookUrl,
+	}
+}
+
+// NewRunInputWithHosts returns a dispatch request that pre-allocates a run host for each of the given Ansible hosts
+func NewRunInputWithHosts(orgId string, hosts ...string) private.RunInputV2 {
+	input := NewRunInput(orgId)
+
+	runHosts := make(private.RunInputHosts, len(hosts))
+	for i := range hosts {
+		runHosts[i].AnsibleHost = &hosts[i]
+	}
+
+	input.Hosts = &runHosts
+	return input
+}
+
+// NewRun returns a run resource as returned by the public API, e.g. to stub responses of the dispatcher
+func NewRun(orgId string) public.Run {
+	return NewRunWithStatus(orgId, public.RunStatusRunning)
+}
+
+func NewRunWithStatus(orgId string, status public.RunStatus) public.Run {
+	id := uuid.New()
+	recipient := uuid.New()
+	correlationId := uuid.New().String()
+	now := time.Now().UTC()
+	name := "test playbook"
+	service := testService
+	url := testPlaybookUrl
+	timeout := testTimeout
+
+	return public.Run{
+		Id:            &id,
+		OrgId:         &orgId,
+		Recipient:     &recipient,
+		CorrelationId: &correlationId,
+		Name:          &name,
+		Service:       &service,
+		Status:        &status,
+		Timeout:       &timeout,
+		Url:           &url,
+		Labels:        &public.Labels{},
+		CreatedAt:     &now,
+		UpdatedAt:     &now,
+	}
+}
+
+// NewRunHost returns a run host resource of the given run
+func NewRunHost(run public.Run, status public.RunStatus) public.RunHost {
+	return NewRunHostWithHostname(run, status, testHost)
+}
+
+func NewRunHostWithHostname(run public.Run, status public.RunStatus, host string) public.RunHost {
+	stdout := ""
+
+	return public.RunHost{
+		Host:   &host,
+		Run:    &run,
+		Status: &status,
+		Stdout: &stdout,
+	}
+}
@@ -0,0 +1,147 @@
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"playbook-dispatcher/pkg/client"
+)
+
+// ServiceOptions configures StartService. Zero values default to the setup of the docker-compose.yml of this repository.
+type ServiceOptions struct {
+	// ComposeFile is the path of the docker compose file defining the dispatcher and its dependencies
+	ComposeFile string
+	// Project is the docker compose project name, set it to run several instances side by side
+	Project string
+	// Services limits the services started, all services of the compose file are started by default
+	Services []string
+	// URL is the address the dispatcher API is published on
+	URL string
+	// Psk is the pre-shared key configured for the dispatcher (PSK_AUTH_TEST in docker-compose.yml)
+	Psk string
+	// StartTimeout limits how long to wait for the API to become available
+	StartTimeout time.Duration
+	// Build rebuilds the dispatcher image from source before starting it
+	Build bool
+}
+
+// Service is a running instance of the dispatcher, started by StartService
+type Service struct {
+	URL     string
+	Psk     string
+	options ServiceOptions
+}
+
+func (this ServiceOptions) withDefaults() ServiceOptions {
+	if this.ComposeFile == "" {
+		this.ComposeFile = "docker-compose.yml"
+	}
+
+	if this.Project == "" {
+		this.Project = "playbook-dispatcher-test"
+	}
+
+	if this.URL == "" {
+		this.URL = "http://localhost:8000"
+	}
+
+	if this.Psk == "" {
+		this.Psk = "xwKhCUzgJ8"
+	}
+
+	if this.StartTimeout == 0 {
+		this.StartTimeout = 3 * time.Minute
+	}
+
+	return this
+}
+
+// StartService starts the dispatcher and its dependencies (database, Kafka) using docker compose and waits for the API
+// to become available. The containers are removed, along with their volumes, by Stop.
+func StartService(ctx context.Context, options ServiceOptions) (*Service, error) {
+	service := &Service{options: options.withDefaults()}
+	service.URL = service.options.URL
+	service.Psk = service.options.Psk
+
+	args := []string{"up", "--detach"}
+	if service.options.Build {
+		args = append(args, "--build")
+	}
+
+	if err := service.compose(ctx, append(args, service.options.Services...)...); err != nil {
+		return nil, err
+	}
+
+	if err := service.waitUntilAvailable(ctx); err != nil {
+		if stopErr := service.Stop(context.Background()); stopErr != nil {
+			return nil, fmt.Errorf("%w (cleanup failed: %s)", err, stopErr)
+		}
+
+		return nil, err
+	}
+
+	return service, nil
+}
+
+// Client returns an API client of the service authenticated using its pre-shared key
+func (this *Service) Client(opts ...client.Option) (*client.Client, error) {
+	return client.New(this.URL, append([]client.Option{client.WithPsk(this.Psk)}, opts...)...)
+}
+
+// Stop removes the containers and volumes of the service
+func (this *Service) Stop(ctx context.Context) error {
+	return this.compose(ctx, "down", "--volumes", "--remove-orphans")
+}
+
+func (this *Service) compose(ctx context.Context, args ...string) error {
+	cmd := exec.CommandContext(ctx, "docker", append([]string{"compose", "--file", this.options.ComposeFile, "--project-name", this.options.Project}, args...)...)
+
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("docker compose %s failed: %w\n%s", args[0], err, output.String())
+	}
+
+	return nil
+}
+
+func (this *Service) waitUntilAvailable(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, this.options.StartTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		if this.available(ctx) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("playbook-dispatcher not available at %s: %w", this.URL, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (this *Service) available(ctx context.Context) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, this.URL+"/internal/version", nil)
+	if err != nil {
+		return false
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false
+	}
+
+	defer res.Body.Close()
+	return res.StatusCode == http.StatusOK
+}
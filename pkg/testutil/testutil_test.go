@@ -0,0 +1,117 @@
+package testutil
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	messageModel "playbook-dispatcher/internal/common/model/message"
+	"playbook-dispatcher/pkg/client/public"
+)
+
+var _ = Describe("Test fixtures", func() {
+	Describe("identity", func() {
+		decode := func(identity Identity) map[string]interface{} {
+			data, err := base64.StdEncoding.DecodeString(identity.Encode())
+			Expect(err).ToNot(HaveOccurred())
+
+			var result map[string]map[string]interface{}
+			Expect(json.Unmarshal(data, &result)).To(Succeed())
+			return result["identity"]
+		}
+
+		It("encodes a user identity", func() {
+			identity := decode(UserIdentity("12345"))
+
+			Expect(identity["org_id"]).To(Equal("12345"))
+			Expect(identity["type"]).To(Equal("User"))
+			Expect(identity["internal"]).To(HaveKeyWithValue("org_id", "12345"))
+			Expect(identity["user"]).To(HaveKeyWithValue("username", "test-user"))
+			Expect(identity).ToNot(HaveKey("account_number"))
+		})
+
+		It("encodes a system identity", func() {
+			identity := decode(SystemIdentity("12345", "cn"))
+
+			Expect(identity["type"]).To(Equal("System"))
+			Expect(identity["system"]).To(HaveKeyWithValue("cn", "cn"))
+			Expect(identity).ToNot(HaveKey("user"))
+		})
+	})
+
+	Describe("runs", func() {
+		It("builds a dispatch request with hosts", func() {
+			input := NewRunInputWithHosts("12345", "host1", "host2")
+
+			Expect(input.OrgId).To(Equal("12345"))
+			Expect(input.Recipient).ToNot(Equal(uuid.Nil))
+			Expect(*input.Hosts).To(HaveLen(2))
+			Expect(*(*input.Hosts)[1].AnsibleHost).To(Equal("host2"))
+		})
+
+		It("builds a run host of a run", func() {
+			run := NewRunWithStatus("12345", public.RunStatusSuccess)
+			host := NewRunHost(run, public.RunStatusSuccess)
+
+			Expect(*run.Status).To(Equal(public.RunStatusSuccess))
+			Expect(*host.Host).To(Equal("localhost"))
+			Expect(*host.Run.Id).To(Equal(*run.Id))
+		})
+	})
+
+	Describe("runner response", func() {
+		It("builds the events of a successful run", func() {
+			correlationId := uuid.New()
+			response := NewRunnerResponseOk("12345", correlationId, "host1")
+
+			events := []string{}
+			for i, event := range response.Events {
+				Expect(event.Counter).To(Equal(i))
+				events = append(events, event.Event)
+			}
+
+			Expect(events).To(Equal([]string{
+				"executor_on_start",
+				"playbook_on_start",
+				"playbook_on_play_start",
+				"playbook_on_task_start",
+				"runner_on_start",
+				"runner_on_ok",
+				"playbook_on_stats",
+			}))
+
+			Expect(response.Events[0].EventData.CrcDispatcherCorrelationId).To(Equal(correlationId.String()))
+			Expect(response.Events[5].EventData.Host).To(Equal("host1"))
+			Expect(response.Events[5].StartLine).To(Equal(response.Events[4].EndLine))
+		})
+
+		It("builds a Kafka message with the headers set by ingress", func() {
+			correlationId := uuid.New()
+			response := NewRunnerResponseFailed("12345", correlationId, "host1")
+
+			msg, err := response.KafkaMessage(RunnerUpdatesTopic)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*msg.TopicPartition.Topic).To(Equal(RunnerUpdatesTopic))
+
+			headers := map[string]string{}
+			for _, header := range msg.Headers {
+				headers[header.Key] = string(header.Value)
+			}
+
+			Expect(headers).To(Equal(map[string]string{
+				"x-rh-insights-request-id":                         response.RequestId,
+				"x-rh-insights-playbook-dispatcher-correlation-id": correlationId.String(),
+				"service": "playbook",
+			}))
+
+			var value messageModel.PlaybookRunResponseMessageYaml
+			Expect(json.Unmarshal(msg.Value, &value)).To(Succeed())
+			Expect(value.OrgId).To(Equal("12345"))
+			Expect(value.Events).To(HaveLen(7))
+			Expect(value.Events[5].Event).To(Equal("runner_on_failed"))
+		})
+	})
+})
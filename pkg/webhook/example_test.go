@@ -0,0 +1,42 @@
+package webhook_test
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"playbook-dispatcher/pkg/webhook"
+)
+
+func ExampleVerifier_Middleware() {
+	verifier := webhook.NewVerifier(
+		[]byte(os.Getenv("WEBHOOK_SECRET")),
+		// keep accepting the previous secret until the subscription is updated
+		webhook.WithSecret([]byte(os.Getenv("WEBHOOK_SECRET_PREVIOUS"))),
+		webhook.WithTolerance(2*time.Minute),
+	)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		log.Printf("delivery %s: %v", r.Header.Get(webhook.HeaderDelivery), event)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	http.Handle("/webhook", verifier.Middleware(handler))
+}
+
+func ExampleVerifier_Verify() {
+	verifier := webhook.NewVerifier([]byte("secret"))
+
+	body := []byte(`{"event_type":"update"}`)
+	if err := verifier.Verify("t=1700000000,v1=0000", body); err != nil {
+		log.Print(err)
+	}
+}
@@ -0,0 +1,186 @@
+// Package webhook verifies the signature of webhook deliveries sent by playbook-dispatcher.
+//
+// Every delivery carries the header
+//
+//	X-Dispatcher-Signature: t=<unix timestamp>,v1=<hex HMAC-SHA256 of "<timestamp>.<body>">
+//
+// computed with the secret of the subscription. A delivery is accepted if one of its v1 signatures matches any of
+// the configured secrets (so that a secret can be rotated) and if it was signed within the tolerance, which prevents
+// captured deliveries from being replayed later on:
+//
+//	verifier := webhook.NewVerifier([]byte(os.Getenv("WEBHOOK_SECRET")))
+//	http.Handle("/webhook", verifier.Middleware(handler))
+//
+// Retried deliveries carry the same X-Dispatcher-Delivery header, receivers should use it to discard duplicates.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderSignature = "X-Dispatcher-Signature"
+	HeaderDelivery  = "X-Dispatcher-Delivery"
+
+	// DefaultTolerance is how far the signing time of a delivery may be off
+	DefaultTolerance = 5 * time.Minute
+
+	signatureVersion = "v1"
+	// deliveries are small JSON documents, anything larger is rejected before it is read completely
+	maxBodySize = 1024 * 1024
+)
+
+var (
+	ErrMissingSignature   = errors.New("missing signature")
+	ErrInvalidSignature   = errors.New("invalid signature")
+	ErrSignatureExpired   = errors.New("signature timestamp outside of the tolerance")
+	ErrMalformedSignature = errors.New("malformed signature header")
+	ErrBodyTooLarge       = errors.New("body too large")
+)
+
+type Verifier struct {
+	secrets   [][]byte
+	tolerance time.Duration
+	now       func() time.Time
+}
+
+type Option func(*Verifier)
+
+// WithTolerance sets how far the signing time of a delivery may be off (DefaultTolerance by default)
+func WithTolerance(tolerance time.Duration) Option {
+	return func(v *Verifier) {
+		v.tolerance = tolerance
+	}
+}
+
+// WithSecret adds another accepted secret, e.g. the previous secret while the subscription is being rotated
+func WithSecret(secret []byte) Option {
+	return func(v *Verifier) {
+		v.secrets = append(v.secrets, secret)
+	}
+}
+
+func NewVerifier(secret []byte, opts ...Option) *Verifier {
+	verifier := &Verifier{
+		secrets:   [][]byte{secret},
+		tolerance: DefaultTolerance,
+		now:       time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(verifier)
+	}
+
+	return verifier
+}
+
+// Verify checks the value of the signature header against the body of a delivery
+func (this *Verifier) Verify(header string, body []byte) error {
+	if header == "" {
+		return ErrMissingSignature
+	}
+
+	timestamp, signatures, err := parseHeader(header)
+	if err != nil {
+		return err
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrMalformedSignature
+	}
+
+	if age := this.now().Sub(time.Unix(unix, 0)); age > this.tolerance || age < -this.tolerance {
+		return ErrSignatureExpired
+	}
+
+	for _, secret := range this.secrets {
+		expected := computeHmac(secret, timestamp, body)
+
+		for _, signature := range signatures {
+			if hmac.Equal(signature, expected) {
+				return nil
+			}
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+// VerifyRequest reads the body of a delivery and verifies its signature.
+// The body is returned so that it can be processed after it was verified.
+func (this *Verifier) VerifyRequest(req *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) > maxBodySize {
+		return nil, ErrBodyTooLarge
+	}
+
+	return body, this.Verify(req.Header.Get(HeaderSignature), body)
+}
+
+// Middleware responds with 401 to deliveries that fail verification.
+// The body of verified deliveries remains readable by the wrapped handler.
+func (this *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := this.VerifyRequest(r)
+		if errors.Is(err, ErrBodyTooLarge) {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, r)
+	})
+}
+
+func parseHeader(header string) (timestamp string, signatures [][]byte, err error) {
+	for _, part := range strings.Split(header, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			return "", nil, ErrMalformedSignature
+		}
+
+		switch key {
+		case "t":
+			timestamp = value
+		case signatureVersion:
+			signature, err := hex.DecodeString(value)
+			if err != nil {
+				return "", nil, ErrMalformedSignature
+			}
+
+			signatures = append(signatures, signature)
+		}
+		// other schemes are skipped so that new signature versions can be rolled out without breaking receivers
+	}
+
+	if timestamp == "" || len(signatures) == 0 {
+		return "", nil, ErrMalformedSignature
+	}
+
+	return timestamp, signatures, nil
+}
+
+func computeHmac(secret []byte, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
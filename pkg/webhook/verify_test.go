@@ -0,0 +1,103 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	delivery "playbook-dispatcher/internal/common/webhook"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Signature verification", func() {
+	var (
+		secret   = []byte("secret")
+		body     = []byte(`{"event_type":"update"}`)
+		now      = time.Unix(1700000000, 0)
+		verifier *Verifier
+	)
+
+	BeforeEach(func() {
+		verifier = NewVerifier(secret)
+		verifier.now = func() time.Time { return now }
+	})
+
+	It("accepts a delivery signed by the dispatcher", func() {
+		Expect(verifier.Verify(delivery.Sign(secret, now.Add(-time.Minute), body), body)).To(Succeed())
+	})
+
+	It("rejects a modified body", func() {
+		header := delivery.Sign(secret, now, body)
+		Expect(verifier.Verify(header, []byte(`{"event_type":"create"}`))).To(MatchError(ErrInvalidSignature))
+	})
+
+	It("rejects a delivery signed with another secret", func() {
+		Expect(verifier.Verify(delivery.Sign([]byte("other"), now, body), body)).To(MatchError(ErrInvalidSignature))
+	})
+
+	It("accepts a delivery signed with a secret being rotated out", func() {
+		verifier = NewVerifier([]byte("new"), WithSecret(secret))
+		verifier.now = func() time.Time { return now }
+
+		Expect(verifier.Verify(delivery.Sign(secret, now, body), body)).To(Succeed())
+	})
+
+	It("rejects replayed deliveries", func() {
+		Expect(verifier.Verify(delivery.Sign(secret, now.Add(-6*time.Minute), body), body)).To(MatchError(ErrSignatureExpired))
+		Expect(verifier.Verify(delivery.Sign(secret, now.Add(6*time.Minute), body), body)).To(MatchError(ErrSignatureExpired))
+	})
+
+	It("accepts one of several signatures and skips unknown schemes", func() {
+		valid := strings.TrimPrefix(delivery.Sign(secret, now, body), "t=1700000000,")
+		header := "t=1700000000,v0=abc,v1=" + strings.Repeat("00", 32) + "," + valid
+
+		Expect(verifier.Verify(header, body)).To(Succeed())
+	})
+
+	DescribeTable("rejects malformed headers",
+		func(header string, expected error) {
+			Expect(verifier.Verify(header, body)).To(MatchError(expected))
+		},
+		Entry("missing", "", ErrMissingSignature),
+		Entry("no timestamp", "v1=abcd", ErrMalformedSignature),
+		Entry("no signature", "t=1700000000", ErrMalformedSignature),
+		Entry("invalid timestamp", "t=now,v1=abcd", ErrMalformedSignature),
+		Entry("invalid hex", "t=1700000000,v1=xyz", ErrMalformedSignature),
+		Entry("no key", "t=1700000000,abcd", ErrMalformedSignature),
+	)
+
+	Describe("middleware", func() {
+		serve := func(header string) (*httptest.ResponseRecorder, string) {
+			var received string
+			handler := verifier.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				data, _ := io.ReadAll(r.Body)
+				received = string(data)
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(string(body)))
+			req.Header.Set(HeaderSignature, header)
+			res := httptest.NewRecorder()
+			handler.ServeHTTP(res, req)
+			return res, received
+		}
+
+		It("passes verified deliveries on", func() {
+			res, received := serve(delivery.Sign(secret, now, body))
+
+			Expect(res.Code).To(Equal(http.StatusOK))
+			Expect(received).To(Equal(string(body)))
+		})
+
+		It("rejects deliveries that fail verification", func() {
+			res, received := serve(delivery.Sign([]byte("other"), now, body))
+
+			Expect(res.Code).To(Equal(http.StatusUnauthorized))
+			Expect(received).To(BeEmpty())
+		})
+	})
+})
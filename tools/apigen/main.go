@@ -0,0 +1,118 @@
+// apigen generates the server stubs and clients of the public and internal API from the OpenAPI specifications in
+// schema/ using oapi-codegen. With -check the code is generated into a temporary directory and compared with the
+// checked in files instead, failing if any of them is out of date.
+//
+// Run from the root of the repository:
+//
+//	go run ./tools/apigen [-check] [-oapi-codegen <path>]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+const (
+	publicSpec  = "schema/public.openapi.yaml"
+	privateSpec = "schema/private.openapi.yaml"
+)
+
+// the private spec references schemas of the public one, which are mapped to the package generated from it
+func publicMapping(pkg string) string {
+	return "-import-mapping=./public.openapi.yaml:" + pkg
+}
+
+type target struct {
+	output string
+	spec   string
+	args   []string
+}
+
+var targets = []target{
+	// served API
+	{"internal/api/controllers/public/spec.gen.go", publicSpec, []string{"-generate", "server,spec", "-package", "public"}},
+	{"internal/api/controllers/public/types.gen.go", publicSpec, []string{"-generate", "types", "-package", "public"}},
+	{"internal/api/controllers/private/spec.gen.go", privateSpec, []string{"-generate", "server,spec", "-package", "private", publicMapping("playbook-dispatcher/internal/api/controllers/public")}},
+	{"internal/api/controllers/private/types.gen.go", privateSpec, []string{"-generate", "types", "-package", "private", publicMapping("playbook-dispatcher/internal/api/controllers/public")}},
+
+	// clients used by the API tests
+	{"internal/api/tests/public/client.gen.go", publicSpec, []string{"-generate", "client,types", "-package", "public"}},
+	{"internal/api/tests/private/client.gen.go", privateSpec, []string{"-generate", "client,types", "-package", "private", publicMapping("playbook-dispatcher/internal/api/controllers/public")}},
+
+	// Go client
+	{"pkg/client/public/client.gen.go", publicSpec, []string{"-generate", "client,types", "-package", "public"}},
+	{"pkg/client/private/client.gen.go", privateSpec, []string{"-generate", "client,types", "-package", "private", publicMapping("playbook-dispatcher/pkg/client/public")}},
+}
+
+func main() {
+	check := flag.Bool("check", false, "verify that the generated code is up to date instead of writing it")
+	generator := flag.String("oapi-codegen", "oapi-codegen", "oapi-codegen binary")
+	flag.Parse()
+
+	if err := run(*generator, *check); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(generator string, check bool) error {
+	tmp, err := os.MkdirTemp("", "apigen")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	outdated := []string{}
+
+	for i, target := range targets {
+		output := target.output
+		if check {
+			output = filepath.Join(tmp, fmt.Sprintf("%d.go", i))
+		}
+
+		args := append(append([]string{}, target.args...), "-o", output, target.spec)
+		cmd := exec.Command(generator, args...)
+		cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("error generating %s: %w", target.output, err)
+		}
+
+		if !check {
+			fmt.Println("generated", target.output)
+			continue
+		}
+
+		generated, err := os.ReadFile(output)
+		if err != nil {
+			return err
+		}
+
+		current, err := os.ReadFile(target.output)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		if !bytes.Equal(generated, current) {
+			outdated = append(outdated, target.output)
+		}
+	}
+
+	if len(outdated) > 0 {
+		for _, file := range outdated {
+			fmt.Fprintln(os.Stderr, "out of date:", file)
+		}
+
+		return fmt.Errorf("generated code does not match the OpenAPI specifications, run make generate-api")
+	}
+
+	if check {
+		fmt.Println("generated code is up to date")
+	}
+
+	return nil
+}